@@ -19,6 +19,7 @@ func NewListCommand() *cobra.Command {
 	var (
 		output    string
 		namespace string
+		selector  string
 	)
 	var command = &cobra.Command{
 		Use: "list",
@@ -27,7 +28,7 @@ func NewListCommand() *cobra.Command {
 			ctx := client.GetContext()
 			client := workflowarchive.NewArchivedWorkflowServiceClient(conn)
 			resp, err := client.ListArchivedWorkflows(ctx, &workflowarchive.ListArchivedWorkflowsRequest{
-				ListOptions: &metav1.ListOptions{FieldSelector: "metadata.namespace=" + namespace},
+				ListOptions: &metav1.ListOptions{FieldSelector: "metadata.namespace=" + namespace, LabelSelector: selector},
 			})
 			if err != nil {
 				log.Fatal(err)
@@ -57,5 +58,6 @@ func NewListCommand() *cobra.Command {
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
 	command.Flags().StringVarP(&namespace, "namespace", "n", "", "The namespace")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin' and 'exists'")
 	return command
 }
@@ -0,0 +1,298 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	"github.com/argoproj/argo/cmd/server/workflow"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/packer"
+)
+
+func NewCompareCommand() *cobra.Command {
+	var (
+		onlyChanged bool
+	)
+	var command = &cobra.Command{
+		Use:   "compare WORKFLOW1 WORKFLOW2",
+		Short: "compare the nodes of two runs of the same workflow",
+		Example: `# Compare the durations, phases, parameters and output artifacts of the nodes of two workflows:
+
+  argo compare my-wf-xxvb9 my-wf-k2m4z
+
+# Only show the nodes which differ:
+
+  argo compare my-wf-xxvb9 my-wf-k2m4z --only-changed
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			wf1 := getWorkflowForComparison(args[0])
+			wf2 := getWorkflowForComparison(args[1])
+			printComparison(wf1, wf2, compareWorkflows(wf1, wf2), onlyChanged)
+		},
+	}
+	command.Flags().BoolVar(&onlyChanged, "only-changed", false, "Only show the nodes which differ between the workflows")
+	return command
+}
+
+func getWorkflowForComparison(name string) *wfv1.Workflow {
+	var wf *wfv1.Workflow
+	var err error
+	if client.ArgoServer != "" {
+		conn := client.GetClientConn()
+		defer conn.Close()
+		ns, _, _ := client.Config.Namespace()
+		apiClient, ctx := GetWFApiServerGRPCClient(conn)
+		wf, err = apiClient.GetWorkflow(ctx, &workflow.WorkflowGetRequest{Name: name, Namespace: ns})
+	} else {
+		wf, err = InitWorkflowClient().Get(name, metav1.GetOptions{})
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = packer.DecompressWorkflow(wf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return wf
+}
+
+// nodeComparison is a node of either or both workflows, matched by its name relative to its workflow
+type nodeComparison struct {
+	name  string
+	node1 *wfv1.NodeStatus
+	node2 *wfv1.NodeStatus
+	// artifacts1 and artifacts2 are the locations of the output artifacts of the nodes, by name
+	artifacts1 map[string]string
+	artifacts2 map[string]string
+}
+
+// compareWorkflows matches up the nodes of the two workflows, ordered by name
+func compareWorkflows(wf1, wf2 *wfv1.Workflow) []nodeComparison {
+	comparisons := make(map[string]*nodeComparison)
+	get := func(name string) *nodeComparison {
+		c, ok := comparisons[name]
+		if !ok {
+			c = &nodeComparison{name: name}
+			comparisons[name] = c
+		}
+		return c
+	}
+	for _, node := range wf1.Status.Nodes {
+		node := node
+		c := get(relativeNodeName(wf1, node))
+		c.node1 = &node
+		c.artifacts1 = artifactLocations(wf1, node)
+	}
+	for _, node := range wf2.Status.Nodes {
+		node := node
+		c := get(relativeNodeName(wf2, node))
+		c.node2 = &node
+		c.artifacts2 = artifactLocations(wf2, node)
+	}
+	var result []nodeComparison
+	for _, c := range comparisons {
+		result = append(result, *c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return result
+}
+
+// relativeNodeName strips the workflow name from the node name, so that the nodes of different runs can be matched
+func relativeNodeName(wf *wfv1.Workflow, node wfv1.NodeStatus) string {
+	name := strings.TrimPrefix(node.Name, wf.ObjectMeta.Name)
+	if name == "" {
+		return "(workflow)"
+	}
+	return name
+}
+
+func (c nodeComparison) changed() bool {
+	if c.node1 == nil || c.node2 == nil {
+		return true
+	}
+	return c.node1.Phase != c.node2.Phase || len(c.parameterChanges()) > 0 || len(c.artifactChanges()) > 0
+}
+
+// parameterChanges describes the input and output parameters whose values differ
+func (c nodeComparison) parameterChanges() []string {
+	if c.node1 == nil || c.node2 == nil {
+		return nil
+	}
+	var inputs1, inputs2, outputs1, outputs2 []wfv1.Parameter
+	if c.node1.Inputs != nil {
+		inputs1 = c.node1.Inputs.Parameters
+	}
+	if c.node2.Inputs != nil {
+		inputs2 = c.node2.Inputs.Parameters
+	}
+	if c.node1.Outputs != nil {
+		outputs1 = c.node1.Outputs.Parameters
+	}
+	if c.node2.Outputs != nil {
+		outputs2 = c.node2.Outputs.Parameters
+	}
+	return append(parameterChanges("inputs", inputs1, inputs2), parameterChanges("outputs", outputs1, outputs2)...)
+}
+
+// artifactChanges describes the output artifacts which only one of the nodes has, or which are stored differently
+func (c nodeComparison) artifactChanges() []string {
+	if c.node1 == nil || c.node2 == nil {
+		return nil
+	}
+	return valueChanges("outputs.artifacts", c.artifacts1, c.artifacts2)
+}
+
+// artifactLocations returns the locations of the output artifacts of a node, by name. The name of the workflow and the
+// ID of the node are left out of the locations, as they differ between runs, e.g. in the default keys of artifacts.
+func artifactLocations(wf *wfv1.Workflow, node wfv1.NodeStatus) map[string]string {
+	locations := make(map[string]string)
+	if node.Outputs == nil {
+		return locations
+	}
+	replacer := strings.NewReplacer(node.ID, "{{pod.name}}", wf.ObjectMeta.Name, "{{workflow.name}}")
+	for _, art := range node.Outputs.Artifacts {
+		locations[art.Name] = replacer.Replace(artifactLocation(art.ArtifactLocation))
+	}
+	return locations
+}
+
+// artifactLocation describes where an artifact is stored
+func artifactLocation(location wfv1.ArtifactLocation) string {
+	switch {
+	case location.S3 != nil:
+		return fmt.Sprintf("s3://%s/%s", location.S3.Bucket, location.S3.Key)
+	case location.Git != nil:
+		return fmt.Sprintf("%s@%s", location.Git.Repo, location.Git.Revision)
+	case location.HTTP != nil:
+		return location.HTTP.URL
+	case location.Artifactory != nil:
+		return location.Artifactory.URL
+	case location.HDFS != nil:
+		return "hdfs://" + location.HDFS.Path
+	case location.Raw != nil:
+		return fmt.Sprintf("raw (%d bytes, sha256 %x)", len(location.Raw.Data), sha256.Sum256([]byte(location.Raw.Data)))
+	}
+	return "<unknown>"
+}
+
+// parameterChanges describes the parameters whose values differ, ordered by name
+func parameterChanges(kind string, params1, params2 []wfv1.Parameter) []string {
+	return valueChanges(kind, parameterValues(params1), parameterValues(params2))
+}
+
+// valueChanges describes the values which differ, ordered by name
+func valueChanges(kind string, values1, values2 map[string]string) []string {
+	var names []string
+	for name := range values1 {
+		names = append(names, name)
+	}
+	for name := range values2 {
+		if _, ok := values1[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var changes []string
+	for _, name := range names {
+		value1, ok1 := values1[name]
+		value2, ok2 := values2[name]
+		if ok1 && ok2 && value1 == value2 {
+			continue
+		}
+		if !ok1 {
+			value1 = "<none>"
+		}
+		if !ok2 {
+			value2 = "<none>"
+		}
+		changes = append(changes, fmt.Sprintf("%s.%s: %s -> %s", kind, name, value1, value2))
+	}
+	return changes
+}
+
+func parameterValues(params []wfv1.Parameter) map[string]string {
+	values := make(map[string]string)
+	for _, param := range params {
+		if param.Value != nil {
+			values[param.Name] = *param.Value
+		} else {
+			values[param.Name] = "<none>"
+		}
+	}
+	return values
+}
+
+func nodeDuration(node *wfv1.NodeStatus) time.Duration {
+	finishedAt := node.FinishedAt.Time
+	if finishedAt.IsZero() {
+		finishedAt = time.Now()
+	}
+	return finishedAt.Sub(node.StartedAt.Time).Round(time.Second)
+}
+
+func printComparison(wf1, wf2 *wfv1.Workflow, comparisons []nodeComparison, onlyChanged bool) {
+	const fmtStr = "%-20s %v\n"
+	fmt.Printf(fmtStr, "Workflow 1:", wf1.ObjectMeta.Name)
+	fmt.Printf(fmtStr, "Workflow 2:", wf2.ObjectMeta.Name)
+	fmt.Println()
+
+	var changes []string
+	for _, change := range parameterChanges("arguments", wf1.Spec.Arguments.Parameters, wf2.Spec.Arguments.Parameters) {
+		changes = append(changes, "(workflow) "+change)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NODE\tPHASE 1\tPHASE 2\tDURATION 1\tDURATION 2\tDELTA\n")
+	for _, c := range comparisons {
+		if onlyChanged && !c.changed() {
+			continue
+		}
+		phase1, phase2, duration1, duration2, delta := "-", "-", "-", "-", "-"
+		if c.node1 != nil {
+			phase1 = string(c.node1.Phase)
+			duration1 = nodeDuration(c.node1).String()
+		}
+		if c.node2 != nil {
+			phase2 = string(c.node2.Phase)
+			duration2 = nodeDuration(c.node2).String()
+		}
+		if c.node1 != nil && c.node2 != nil {
+			diff := nodeDuration(c.node2) - nodeDuration(c.node1)
+			delta = diff.String()
+			if diff > 0 {
+				delta = "+" + delta
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", c.name, phase1, phase2, duration1, duration2, delta)
+		for _, change := range c.parameterChanges() {
+			changes = append(changes, c.name+" "+change)
+		}
+		for _, change := range c.artifactChanges() {
+			changes = append(changes, c.name+" "+change)
+		}
+	}
+	_ = w.Flush()
+
+	if len(changes) > 0 {
+		fmt.Println()
+		fmt.Println("Changes:")
+		for _, change := range changes {
+			fmt.Println("  " + change)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+var comparedWorkflow1 = `
+metadata:
+  name: my-wf-1
+status:
+  nodes:
+    my-wf-1:
+      id: my-wf-1
+      name: my-wf-1
+      phase: Succeeded
+    my-wf-1-123:
+      id: my-wf-1-123
+      name: my-wf-1.generate
+      phase: Succeeded
+      outputs:
+        parameters:
+        - name: count
+          value: "1"
+        artifacts:
+        - name: data
+          s3:
+            bucket: my-bucket
+            key: my-wf-1/my-wf-1-123/data.tgz
+        - name: report
+          s3:
+            bucket: my-bucket
+            key: my-wf-1/my-wf-1-123/report.tgz
+`
+
+var comparedWorkflow2 = `
+metadata:
+  name: my-wf-2
+status:
+  nodes:
+    my-wf-2:
+      id: my-wf-2
+      name: my-wf-2
+      phase: Failed
+    my-wf-2-456:
+      id: my-wf-2-456
+      name: my-wf-2.generate
+      phase: Succeeded
+      outputs:
+        parameters:
+        - name: count
+          value: "2"
+        artifacts:
+        - name: data
+          s3:
+            bucket: other-bucket
+            key: my-wf-2/my-wf-2-456/data.tgz
+        - name: logs
+          s3:
+            bucket: my-bucket
+            key: my-wf-2/my-wf-2-456/logs.tgz
+`
+
+func unmarshalComparedWorkflow(yamlStr string) *wfv1.Workflow {
+	var wf wfv1.Workflow
+	err := yaml.Unmarshal([]byte(yamlStr), &wf)
+	if err != nil {
+		panic(err)
+	}
+	return &wf
+}
+
+func TestCompareWorkflows(t *testing.T) {
+	comparisons := compareWorkflows(unmarshalComparedWorkflow(comparedWorkflow1), unmarshalComparedWorkflow(comparedWorkflow2))
+	if assert.Len(t, comparisons, 2) {
+		assert.Equal(t, "(workflow)", comparisons[0].name)
+		assert.True(t, comparisons[0].changed())
+
+		generate := comparisons[1]
+		assert.Equal(t, ".generate", generate.name)
+		assert.True(t, generate.changed())
+		assert.Equal(t, []string{"outputs.count: 1 -> 2"}, generate.parameterChanges())
+		assert.Equal(t, []string{
+			"outputs.artifacts.data: s3://my-bucket/{{workflow.name}}/{{pod.name}}/data.tgz -> s3://other-bucket/{{workflow.name}}/{{pod.name}}/data.tgz",
+			"outputs.artifacts.logs: <none> -> s3://my-bucket/{{workflow.name}}/{{pod.name}}/logs.tgz",
+			"outputs.artifacts.report: s3://my-bucket/{{workflow.name}}/{{pod.name}}/report.tgz -> <none>",
+		}, generate.artifactChanges())
+	}
+}
+
+func TestCompareWorkflowsUnchanged(t *testing.T) {
+	wf1 := unmarshalComparedWorkflow(comparedWorkflow1)
+	comparisons := compareWorkflows(wf1, wf1)
+	for _, c := range comparisons {
+		assert.False(t, c.changed(), c.name)
+		assert.Empty(t, c.artifactChanges(), c.name)
+	}
+}
@@ -16,8 +16,9 @@ import (
 )
 
 type cliCreateOpts struct {
-	output string // --output
-	strict bool   // --strict
+	output     string // --output
+	strict     bool   // --strict
+	instanceID string // --instanceid
 }
 
 func NewCreateCommand() *cobra.Command {
@@ -38,6 +39,7 @@ func NewCreateCommand() *cobra.Command {
 	}
 	command.Flags().StringVarP(&cliCreateOpts.output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
 	command.Flags().BoolVar(&cliCreateOpts.strict, "strict", true, "perform strict workflow validation")
+	command.Flags().StringVar(&cliCreateOpts.instanceID, "instanceid", "", "create with a specific controller's instance id label")
 	return command
 }
 
@@ -66,6 +68,14 @@ func CreateCronWorkflows(filePaths []string, cliOpts *cliCreateOpts) {
 		if err != nil {
 			log.Fatalf("Failed to validate cron workflow: %v", err)
 		}
+		if cliOpts.instanceID != "" {
+			labels := cronWf.GetLabels()
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+			labels[common.LabelKeyControllerInstanceID] = cliOpts.instanceID
+			cronWf.SetLabels(labels)
+		}
 		cronWfClient := defaultCronWfClient
 		if cronWf.Namespace != "" {
 			cronWfClient = InitCronWorkflowClient(cronWf.Namespace)
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/argoproj/pkg/humanize"
 	"github.com/spf13/cobra"
@@ -16,6 +18,7 @@ import (
 	"github.com/argoproj/argo/cmd/argo/commands/client"
 	"github.com/argoproj/argo/cmd/server/workflow"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/graph"
 	"github.com/argoproj/argo/workflow/packer"
 )
 
@@ -68,7 +71,7 @@ func NewGetCommand() *cobra.Command {
 		},
 	}
 
-	command.Flags().StringVarP(&getArgs.output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&getArgs.output, "output", "o", "", "Output format. One of: json|yaml|wide|dot|mermaid")
 	command.Flags().BoolVar(&noColor, "no-color", false, "Disable colorized output")
 	command.Flags().StringVar(&getArgs.status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error)")
 	return command
@@ -96,6 +99,10 @@ func printWorkflow(wf *wfv1.Workflow, output, status string) {
 	case "yaml":
 		outBytes, _ := yaml.Marshal(wf)
 		fmt.Print(string(outBytes))
+	case "dot":
+		fmt.Print(graph.Dot(wf))
+	case "mermaid":
+		fmt.Print(graph.Mermaid(wf))
 	case "wide", "":
 		printWorkflowHelper(wf, getArgs)
 	default:
@@ -103,6 +110,16 @@ func printWorkflow(wf *wfv1.Workflow, output, status string) {
 	}
 }
 
+// estimatedTimeLeft returns how much longer a running workflow is estimated to take, which is 0 once it overran its
+// estimated duration
+func estimatedTimeLeft(wf *wfv1.Workflow) time.Duration {
+	left := wf.Status.EstimatedDuration.Duration() - time.Since(wf.Status.StartedAt.Time)
+	if left < 0 {
+		return 0
+	}
+	return left.Round(time.Second)
+}
+
 func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) {
 	const fmtStr = "%-20s %v\n"
 	fmt.Printf(fmtStr, "Name:", wf.ObjectMeta.Name)
@@ -126,6 +143,18 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) {
 	if !wf.Status.StartedAt.IsZero() {
 		fmt.Printf(fmtStr, "Duration:", humanize.RelativeDuration(wf.Status.StartedAt.Time, wf.Status.FinishedAt.Time))
 	}
+	if wf.Status.EstimatedDuration > 0 {
+		fmt.Printf(fmtStr, "EstimatedDuration:", humanize.Duration(wf.Status.EstimatedDuration.Duration()))
+		if !wf.Status.StartedAt.IsZero() && wf.Status.FinishedAt.IsZero() {
+			fmt.Printf(fmtStr, "EstimatedTimeLeft:", humanize.Duration(estimatedTimeLeft(wf)))
+		}
+	}
+	if wf.Status.Progress != "" {
+		fmt.Printf(fmtStr, "Progress:", fmt.Sprintf("%s (%d%%)", wf.Status.Progress, wf.Status.Progress.Percent()))
+	}
+	if len(wf.Status.ResourcesDuration) > 0 {
+		fmt.Printf(fmtStr, "ResourcesDuration:", wf.Status.ResourcesDuration)
+	}
 
 	if len(wf.Spec.Arguments.Parameters) > 0 {
 		fmt.Printf(fmtStr, "Parameters:", "")
@@ -158,7 +187,7 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) {
 	printTree := true
 	if wf.Status.Nodes == nil {
 		printTree = false
-	} else if _, ok := wf.Status.Nodes[wf.ObjectMeta.Name]; !ok {
+	} else if _, ok := wf.Status.Nodes[wf.NodeID(wf.ObjectMeta.Name)]; !ok {
 		printTree = false
 	}
 	if printTree {
@@ -187,6 +216,50 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) {
 			onExitRoot.renderNodes(w, wf, 0, " ", " ", getArgs)
 		}
 		_ = w.Flush()
+		printDiagnostics(wf)
+	}
+}
+
+// printDiagnostics prints the diagnostics collected from the pods of failed nodes
+func printDiagnostics(wf *wfv1.Workflow) {
+	var nodes []wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		if node.Diagnostics != nil {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) == 0 {
+		return
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+	})
+	fmt.Println()
+	fmt.Println("Diagnostics:")
+	for _, node := range nodes {
+		fmt.Printf("  %s (%s):\n", node.DisplayName, node.ID)
+		for _, container := range node.Diagnostics.Containers {
+			state := container.Reason
+			if container.ExitCode != nil {
+				state = fmt.Sprintf("exit code %d %s", *container.ExitCode, container.Reason)
+			}
+			if container.Message != "" {
+				state += ": " + container.Message
+			}
+			fmt.Printf("    Container %s: %s\n", container.Name, strings.TrimSpace(state))
+		}
+		for _, event := range node.Diagnostics.Events {
+			fmt.Printf("    Event: %s\n", event)
+		}
+		if node.Diagnostics.Logs != "" {
+			fmt.Println("    Logs:")
+			for _, line := range strings.Split(node.Diagnostics.Logs, "\n") {
+				fmt.Printf("      %s\n", line)
+			}
+		}
+		if node.Diagnostics.LogsArtifact != "" {
+			fmt.Printf("    Logs: archived in artifact %s\n", node.Diagnostics.LogsArtifact)
+		}
 	}
 }
 
@@ -245,7 +318,7 @@ func isNonBoundaryParentNode(node wfv1.NodeType) bool {
 }
 
 func isExecutionNode(node wfv1.NodeType) bool {
-	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend)
+	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeHTTP) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend)
 }
 
 func insertSorted(wf *wfv1.Workflow, sortedArray []renderNode, item renderNode) []renderNode {
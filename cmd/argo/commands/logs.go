@@ -94,7 +94,7 @@ func NewLogsCommand() *cobra.Command {
 
 		},
 	}
-	command.Flags().StringVarP(&printer.container, "container", "c", "main", "Print the logs of this container")
+	command.Flags().StringVarP(&printer.container, "container", "c", "main", "Print the logs of this container, which is main by default, or a sidecar, init container or the wait container")
 	command.Flags().BoolVarP(&workflow, "workflow", "w", false, "Specify that whole workflow logs should be printed")
 	command.Flags().BoolVarP(&printer.follow, "follow", "f", false, "Specify if the logs should be streamed.")
 	command.Flags().StringVar(&since, "since", "", "Only return logs newer than a relative duration like 5s, 2m, or 3h. Defaults to all logs. Only one of since-time / since may be used.")
@@ -342,7 +342,7 @@ func (p *logPrinter) hasContainerStarted(podName string, podNamespace string, co
 		return false, err
 	}
 	var containerStatus *v1.ContainerStatus
-	for _, status := range pod.Status.ContainerStatuses {
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
 		if status.Name == container {
 			containerStatus = &status
 			break
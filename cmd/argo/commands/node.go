@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	"github.com/argoproj/argo/cmd/server/workflow"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+func NewNodeCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "node",
+		Short: "change the nodes of a workflow",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewNodeResetCommand())
+	command.AddCommand(NewNodeSetPhaseCommand())
+	return command
+}
+
+func NewNodeResetCommand() *cobra.Command {
+	var (
+		output string
+	)
+	var command = &cobra.Command{
+		Use:   "reset WORKFLOW SELECTOR",
+		Short: "run the nodes of a workflow matching the selector again, along with the nodes downstream of them",
+		Example: `# Run the build steps of the second step group of my-wf again:
+
+  argo node reset my-wf 'my-wf[1].build-*'
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			namespace, _, _ := client.Config.Namespace()
+			var wf *wfv1.Workflow
+			var err error
+			if client.ArgoServer != "" {
+				conn := client.GetClientConn()
+				defer conn.Close()
+				apiGRPCClient, ctx := GetWFApiServerGRPCClient(conn)
+				wf, err = apiGRPCClient.ResetWorkflowNodes(ctx, &workflow.WorkflowResetNodesRequest{
+					Name:      args[0],
+					Namespace: namespace,
+					Selector:  args[1],
+				})
+			} else {
+				kubeClient := InitKubeClient()
+				wfClient := InitWorkflowClient()
+				wf, err = wfClient.Get(args[0], metav1.GetOptions{})
+				if err == nil {
+					wf, err = util.ResetWorkflowNodes(util.NewPodsGetter(kubeClient), wfClient, wf, args[1])
+				}
+			}
+			if err != nil {
+				log.Fatalf("Failed to reset the nodes of %s: %+v", args[0], err)
+			}
+			printWorkflow(wf, output, DefaultStatus)
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
+	return command
+}
+
+func NewNodeSetPhaseCommand() *cobra.Command {
+	var (
+		phase   string
+		message string
+		output  string
+	)
+	var command = &cobra.Command{
+		Use:   "set-phase WORKFLOW SELECTOR",
+		Short: "set the phase of the nodes of a workflow matching the selector to Succeeded or Failed",
+		Example: `# Fail the daemon node of my-wf which is stuck:
+
+  argo node set-phase my-wf my-wf.db --phase Failed --message 'stuck'
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			namespace, _, _ := client.Config.Namespace()
+			var wf *wfv1.Workflow
+			var err error
+			if client.ArgoServer != "" {
+				conn := client.GetClientConn()
+				defer conn.Close()
+				apiGRPCClient, ctx := GetWFApiServerGRPCClient(conn)
+				wf, err = apiGRPCClient.SetWorkflowNodesPhase(ctx, &workflow.WorkflowSetNodesPhaseRequest{
+					Name:      args[0],
+					Namespace: namespace,
+					Selector:  args[1],
+					Phase:     phase,
+					Message:   message,
+				})
+			} else {
+				kubeClient := InitKubeClient()
+				wfClient := InitWorkflowClient()
+				wf, err = wfClient.Get(args[0], metav1.GetOptions{})
+				if err == nil {
+					wf, err = util.SetWorkflowNodesPhase(util.NewPodsGetter(kubeClient), wfClient, wf, args[1], wfv1.NodePhase(phase), message)
+				}
+			}
+			if err != nil {
+				log.Fatalf("Failed to set the phase of the nodes of %s: %+v", args[0], err)
+			}
+			printWorkflow(wf, output, DefaultStatus)
+		},
+	}
+	command.Flags().StringVar(&phase, "phase", "", "Phase of the nodes, either Succeeded or Failed")
+	command.Flags().StringVar(&message, "message", "", "Message of the nodes")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
+	return command
+}
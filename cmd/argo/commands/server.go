@@ -3,7 +3,6 @@ package commands
 import (
 	"time"
 
-	"github.com/argoproj/pkg/cli"
 	"github.com/argoproj/pkg/stats"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -14,11 +13,13 @@ import (
 	"github.com/argoproj/argo/cmd/argo/commands/client"
 	"github.com/argoproj/argo/cmd/server/apiserver"
 	wfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo/util/logging"
 )
 
 func NewServerCommand() *cobra.Command {
 	var (
 		logLevel         string // --loglevel
+		logFormat        string // --log-format
 		authMode         string
 		configMap        string
 		port             int
@@ -30,7 +31,10 @@ func NewServerCommand() *cobra.Command {
 		Use:   "server",
 		Short: "Start the server",
 		RunE: func(c *cobra.Command, args []string) error {
-			cli.SetLogLevel(logLevel)
+			err := logging.SetDefault(logLevel, logFormat)
+			if err != nil {
+				return err
+			}
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(5 * time.Minute)
 
@@ -83,6 +87,7 @@ func NewServerCommand() *cobra.Command {
 	command.Flags().StringVar(&authMode, "auth-mode", "server", "API server authentication mode. One of: client|server|hybrid")
 	command.Flags().StringVar(&configMap, "configmap", "workflow-controller-configmap", "Name of K8s configmap to retrieve workflow controller configuration")
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().StringVar(&logFormat, "log-format", "text", "Set the logging format. One of: text|json")
 	command.Flags().BoolVar(&namespaced, "namespaced", false, "run as namespaced mode")
 	command.Flags().StringVar(&managedNamespace, "managed-namespace", "", "namespace that watches, default to the installation namespace")
 	return &command
@@ -2,31 +2,45 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"os"
 	"strconv"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryversion "k8s.io/apimachinery/pkg/version"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/pkg/errors"
 	argoJson "github.com/argoproj/pkg/json"
 
 	"github.com/argoproj/argo/cmd/argo/commands/client"
+	"github.com/argoproj/argo/cmd/argo/commands/template"
 	apiwf "github.com/argoproj/argo/cmd/server/workflow"
+	apiwftmpl "github.com/argoproj/argo/cmd/server/workflowtemplate"
+	"github.com/argoproj/argo/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	apiUtil "github.com/argoproj/argo/util/api"
 	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/templateresolution"
 	"github.com/argoproj/argo/workflow/util"
+	"github.com/argoproj/argo/workflow/validate"
 )
 
 // cliSubmitOpts holds submition options specific to CLI submission (e.g. controlling output)
 type cliSubmitOpts struct {
-	output   string // --output
-	wait     bool   // --wait
-	watch    bool   // --watch
-	strict   bool   // --strict
-	priority *int32 // --priority
+	output    string // --output
+	wait      bool   // --wait
+	watch     bool   // --watch
+	strict    bool   // --strict
+	priority  *int32 // --priority
+	printPods bool   // --print-pods
 }
 
 func NewSubmitCommand() *cobra.Command {
@@ -58,9 +72,10 @@ func NewSubmitCommand() *cobra.Command {
 	command.Flags().StringVar(&submitOpts.InstanceID, "instanceid", "", "submit with a specific controller's instance id label")
 	command.Flags().BoolVar(&submitOpts.DryRun, "dry-run", false, "modify the workflow on the client-side without creating it")
 	command.Flags().BoolVar(&submitOpts.ServerDryRun, "server-dry-run", false, "send request to server with dry-run flag which will modify the workflow without creating it")
+	command.Flags().BoolVar(&cliSubmitOpts.printPods, "print-pods", false, "with --dry-run or --server-dry-run, also print the pods of the first steps of the workflow")
 	command.Flags().StringVarP(&cliSubmitOpts.output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
 	command.Flags().BoolVarP(&cliSubmitOpts.wait, "wait", "w", false, "wait for the workflow to complete")
-	command.Flags().BoolVar(&cliSubmitOpts.watch, "watch", false, "watch the workflow until it completes")
+	command.Flags().BoolVar(&cliSubmitOpts.watch, "watch", false, "watch the workflow(s) until they complete")
 	command.Flags().BoolVar(&cliSubmitOpts.strict, "strict", true, "perform strict workflow validation")
 	command.Flags().Int32Var(&priority, "priority", 0, "workflow priority")
 	command.Flags().StringVarP(&submitOpts.ParameterFile, "parameter-file", "f", "", "pass a file containing all input parameters")
@@ -94,15 +109,17 @@ func SubmitWorkflows(filePaths []string, submitOpts *util.SubmitOpts, cliOpts *c
 	}
 
 	var workflows []wfv1.Workflow
+	var workflowTemplates []wfv1.WorkflowTemplate
+	var configMaps []apiv1.ConfigMap
 	for _, body := range fileContents {
 		wfs := unmarshalWorkflows(body, cliOpts.strict)
 		workflows = append(workflows, wfs...)
+		wftmpls, cms := unmarshalDependencies(body, cliOpts.strict)
+		workflowTemplates = append(workflowTemplates, wftmpls...)
+		configMaps = append(configMaps, cms...)
 	}
 
 	if cliOpts.watch {
-		if len(workflows) > 1 {
-			log.Fatalf("Cannot watch more than one workflow")
-		}
 		if cliOpts.wait {
 			log.Fatalf("--wait cannot be combined with --watch")
 		}
@@ -149,11 +166,36 @@ func SubmitWorkflows(filePaths []string, submitOpts *util.SubmitOpts, cliOpts *c
 		}
 	}
 
+	if cliOpts.printPods && !submitOpts.DryRun && !submitOpts.ServerDryRun {
+		log.Fatalf("--print-pods requires --dry-run or --server-dry-run")
+	}
+
+	if cliOpts.printPods && client.ArgoServer == "" {
+		log.Fatalf("--print-pods requires the Argo Server, which builds the pods with the configuration of the controller")
+	}
+
 	if len(workflows) == 0 {
 		log.Println("No Workflow found in given files")
 		os.Exit(1)
 	}
 
+	if len(configMaps) > 0 && client.ArgoServer != "" {
+		log.Fatalf("ConfigMaps cannot be submitted via the Argo Server")
+	}
+
+	// Everything is validated before anything is created, so that an invalid document does not leave the documents
+	// before it created
+	validateSubmission(defaultNS, workflows, workflowTemplates, submitOpts)
+
+	// Dependencies are created first, so that the workflows referring to them pass validation
+	if submitOpts.DryRun || submitOpts.ServerDryRun {
+		if len(workflowTemplates)+len(configMaps) > 0 {
+			log.Printf("Skipping creation of %d workflow template(s) and %d config map(s) in dry-run", len(workflowTemplates), len(configMaps))
+		}
+	} else {
+		submitDependencies(defaultNS, workflowTemplates, configMaps)
+	}
+
 	var workflowNames []string
 	var created *wfv1.Workflow
 	var apiGRPCClient apiwf.WorkflowServiceClient
@@ -173,7 +215,8 @@ func SubmitWorkflows(filePaths []string, submitOpts *util.SubmitOpts, cliOpts *c
 		if client.ArgoServer != "" {
 			err = util.ApplySubmitOpts(&wf, submitOpts)
 			errors.CheckError(err)
-			created, err = apiUtil.SubmitWorkflowToAPIServer(apiGRPCClient, ctx, &wf, submitOpts.ServerDryRun)
+			// the Argo Server does not modify workflows on the client-side, so a dry-run is a server dry-run there
+			created, err = apiUtil.SubmitWorkflowToAPIServer(apiGRPCClient, ctx, &wf, submitOpts.DryRun || submitOpts.ServerDryRun)
 			errors.CheckError(err)
 		} else {
 			wf.Spec.Priority = cliOpts.priority
@@ -194,12 +237,38 @@ func SubmitWorkflows(filePaths []string, submitOpts *util.SubmitOpts, cliOpts *c
 			log.Fatalf("Failed to submit workflow: %v", err)
 		}
 		printWorkflow(created, cliOpts.output, DefaultStatus)
+		if cliOpts.printPods {
+			printPreviewPods(apiGRPCClient, ctx, created, workflowTemplates, cliOpts.output)
+		}
 		workflowNames = append(workflowNames, created.Name)
 	}
 
 	waitOrWatch(workflowNames, *cliOpts)
 }
 
+// printPreviewPods prints the pods the controller would create for the first steps of a workflow, as the Argo Server
+// builds them with the configuration of the controller. The workflow templates it refers to are looked up in the
+// submitted files, and then in the namespace of the workflow.
+func printPreviewPods(apiGRPCClient apiwf.WorkflowServiceClient, ctx context.Context, wf *wfv1.Workflow, workflowTemplates []wfv1.WorkflowTemplate, output string) {
+	req := &apiwf.WorkflowPreviewPodsRequest{Namespace: wf.Namespace, Workflow: wf}
+	for i := range workflowTemplates {
+		req.Templates = append(req.Templates, &workflowTemplates[i])
+	}
+	resp, err := apiGRPCClient.PreviewWorkflowPods(ctx, req)
+	if err != nil {
+		log.Fatalf("Failed to preview the pods of workflow %s: %v", wf.Name, err)
+	}
+	for _, pod := range resp.Pods {
+		if output == "json" {
+			outBytes, _ := json.MarshalIndent(pod, "", "    ")
+			fmt.Println(string(outBytes))
+		} else {
+			outBytes, _ := yaml.Marshal(pod)
+			fmt.Printf("---\n%s", string(outBytes))
+		}
+	}
+}
+
 // Checks whether the server has support for the dry-run option
 func checkServerVersionForDryRun(serverVersion *apimachineryversion.Info) (bool, error) {
 	majorVersion, err := strconv.Atoi(serverVersion.Major)
@@ -237,10 +306,188 @@ func unmarshalWorkflows(wfBytes []byte, strict bool) []wfv1.Workflow {
 	return nil
 }
 
+// unmarshalDependencies unmarshals the workflow templates and config maps of a multi document yaml.
+// Only documents which explicitly declare their kind are considered.
+func unmarshalDependencies(body []byte, strict bool) ([]wfv1.WorkflowTemplate, []apiv1.ConfigMap) {
+	var wftmpls []wfv1.WorkflowTemplate
+	var cms []apiv1.ConfigMap
+	for _, document := range common.SplitYAMLFile(body) {
+		var typeMeta metav1.TypeMeta
+		if err := yaml.Unmarshal(document, &typeMeta); err != nil {
+			// this is reported when unmarshalling the workflows
+			continue
+		}
+		switch typeMeta.Kind {
+		case workflow.WorkflowTemplateKind:
+			docWftmpls, err := common.SplitWorkflowTemplateYAMLFile(document, strict)
+			if err != nil {
+				log.Fatalf("Failed to parse workflow template: %v", err)
+			}
+			wftmpls = append(wftmpls, docWftmpls...)
+		case "ConfigMap":
+			docCms, err := common.SplitConfigMapYAMLFile(document, strict)
+			if err != nil {
+				log.Fatalf("Failed to parse config map: %v", err)
+			}
+			cms = append(cms, docCms...)
+		}
+	}
+	return wftmpls, cms
+}
+
+// submitDependencies creates (or updates) the workflow templates and config maps submitted alongside workflows
+func submitDependencies(defaultNS string, workflowTemplates []wfv1.WorkflowTemplate, configMaps []apiv1.ConfigMap) {
+	for _, cm := range configMaps {
+		if cm.Namespace == "" {
+			cm.Namespace = defaultNS
+		}
+		cmClient := InitKubeClient().CoreV1().ConfigMaps(cm.Namespace)
+		_, err := cmClient.Create(&cm)
+		if apierr.IsAlreadyExists(err) {
+			var existing *apiv1.ConfigMap
+			existing, err = cmClient.Get(cm.Name, metav1.GetOptions{})
+			if err == nil {
+				cm.ResourceVersion = existing.ResourceVersion
+				_, err = cmClient.Update(&cm)
+			}
+		}
+		if err != nil {
+			log.Fatalf("Failed to submit config map %s: %v", cm.Name, err)
+		}
+		log.Printf("ConfigMap %s/%s submitted", cm.Namespace, cm.Name)
+	}
+
+	for _, wftmpl := range workflowTemplates {
+		if wftmpl.Namespace == "" {
+			wftmpl.Namespace = defaultNS
+		}
+		var err error
+		if client.ArgoServer != "" {
+			conn := client.GetClientConn()
+			apiClient, ctx := template.GetWFtmplApiServerGRPCClient(conn)
+			_, err = apiClient.CreateWorkflowTemplate(ctx, &apiwftmpl.WorkflowTemplateCreateRequest{
+				Namespace: wftmpl.Namespace,
+				Template:  &wftmpl,
+			})
+			if status.Code(err) == codes.AlreadyExists {
+				var existing *wfv1.WorkflowTemplate
+				existing, err = apiClient.GetWorkflowTemplate(ctx, &apiwftmpl.WorkflowTemplateGetRequest{
+					Name:      wftmpl.Name,
+					Namespace: wftmpl.Namespace,
+				})
+				if err == nil {
+					wftmpl.ResourceVersion = existing.ResourceVersion
+					_, err = apiClient.UpdateWorkflowTemplate(ctx, &apiwftmpl.WorkflowTemplateUpdateRequest{
+						Name:      wftmpl.Name,
+						Namespace: wftmpl.Namespace,
+						Template:  &wftmpl,
+					})
+				}
+			}
+			_ = conn.Close()
+		} else {
+			wftmplClient := wfClientset.ArgoprojV1alpha1().WorkflowTemplates(wftmpl.Namespace)
+			_, err = wftmplClient.Create(&wftmpl)
+			if apierr.IsAlreadyExists(err) {
+				var existing *wfv1.WorkflowTemplate
+				existing, err = wftmplClient.Get(wftmpl.Name, metav1.GetOptions{})
+				if err == nil {
+					wftmpl.ResourceVersion = existing.ResourceVersion
+					_, err = wftmplClient.Update(&wftmpl)
+				}
+			}
+		}
+		if err != nil {
+			log.Fatalf("Failed to submit workflow template %s: %v", wftmpl.Name, err)
+		}
+		log.Printf("WorkflowTemplate %s/%s submitted", wftmpl.Namespace, wftmpl.Name)
+	}
+}
+
+// validateSubmission validates the workflow templates and the workflows submitted together. The workflow templates
+// they refer to are looked up in the submission, and then in the cluster, as they are created in that order.
+func validateSubmission(defaultNS string, workflows []wfv1.Workflow, workflowTemplates []wfv1.WorkflowTemplate, submitOpts *util.SubmitOpts) {
+	var apiClient apiwftmpl.WorkflowTemplateServiceClient
+	var ctx context.Context
+	if client.ArgoServer != "" {
+		conn := client.GetClientConn()
+		defer conn.Close()
+		apiClient, ctx = template.GetWFtmplApiServerGRPCClient(conn)
+	}
+	wftmplGetter := func(namespace string) templateresolution.WorkflowTemplateNamespacedGetter {
+		var fallback templateresolution.WorkflowTemplateNamespacedGetter
+		if apiClient != nil {
+			fallback = &apiServerWorkflowTemplateGetter{apiClient: apiClient, ctx: ctx, namespace: namespace}
+		} else {
+			fallback = templateresolution.WrapWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(namespace))
+		}
+		submitted := &submittedWorkflowTemplateGetter{namespace: namespace, defaultNS: defaultNS, workflowTemplates: workflowTemplates}
+		return templateresolution.WithFallback(submitted, fallback)
+	}
+
+	for _, wftmpl := range workflowTemplates {
+		namespace := wftmpl.Namespace
+		if namespace == "" {
+			namespace = defaultNS
+		}
+		err := validate.ValidateWorkflowTemplate(wftmplGetter(namespace), &wftmpl)
+		if err != nil {
+			log.Fatalf("Failed to validate workflow template %s: %v", wftmpl.Name, err)
+		}
+	}
+	for i := range workflows {
+		wf := workflows[i].DeepCopy()
+		namespace := wf.Namespace
+		if namespace == "" {
+			namespace = defaultNS
+		}
+		err := util.ApplySubmitOpts(wf, submitOpts)
+		if err == nil {
+			err = validate.ValidateWorkflow(wftmplGetter(namespace), wf, validate.ValidateOpts{})
+		}
+		if err != nil {
+			log.Fatalf("Failed to validate workflow: %v", err)
+		}
+	}
+}
+
+// submittedWorkflowTemplateGetter gets the workflow templates of a namespace among those submitted
+type submittedWorkflowTemplateGetter struct {
+	namespace         string
+	defaultNS         string
+	workflowTemplates []wfv1.WorkflowTemplate
+}
+
+// Get retrieves the submitted WorkflowTemplate of a given name.
+func (g *submittedWorkflowTemplateGetter) Get(name string) (*wfv1.WorkflowTemplate, error) {
+	for _, wftmpl := range g.workflowTemplates {
+		namespace := wftmpl.Namespace
+		if namespace == "" {
+			namespace = g.defaultNS
+		}
+		if namespace == g.namespace && wftmpl.Name == name {
+			return wftmpl.DeepCopy(), nil
+		}
+	}
+	return nil, apierr.NewNotFound(wfv1.Resource("workflowtemplates"), name)
+}
+
+// apiServerWorkflowTemplateGetter gets the workflow templates of a namespace from the Argo Server
+type apiServerWorkflowTemplateGetter struct {
+	apiClient apiwftmpl.WorkflowTemplateServiceClient
+	ctx       context.Context
+	namespace string
+}
+
+// Get retrieves the WorkflowTemplate of a given name.
+func (g *apiServerWorkflowTemplateGetter) Get(name string) (*wfv1.WorkflowTemplate, error) {
+	return g.apiClient.GetWorkflowTemplate(g.ctx, &apiwftmpl.WorkflowTemplateGetRequest{Name: name, Namespace: g.namespace})
+}
+
 func waitOrWatch(workflowNames []string, cliSubmitOpts cliSubmitOpts) {
 	if cliSubmitOpts.wait {
 		WaitWorkflows(workflowNames, false, !(cliSubmitOpts.output == "" || cliSubmitOpts.output == "wide"))
 	} else if cliSubmitOpts.watch {
-		watchWorkflow(workflowNames[0])
+		watchWorkflows(workflowNames)
 	}
 }
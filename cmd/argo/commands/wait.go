@@ -8,6 +8,8 @@ import (
 
 	"github.com/argoproj/pkg/errors"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -41,6 +43,7 @@ func NewWaitCommand() *cobra.Command {
 // WaitWorkflows waits for the given workflowNames.
 func WaitWorkflows(workflowNames []string, ignoreNotFound, quiet bool) {
 	var wg sync.WaitGroup
+	var mux sync.Mutex
 	wfSuccessStatus := true
 	var apiClient workflow.WorkflowServiceClient
 	var ctx context.Context
@@ -58,14 +61,18 @@ func WaitWorkflows(workflowNames []string, ignoreNotFound, quiet bool) {
 		if client.ArgoServer != "" {
 			go func(name string) {
 				if !apiServerWaitOnOne(apiClient, ctx, name, ns, ignoreNotFound, quiet) {
+					mux.Lock()
 					wfSuccessStatus = false
+					mux.Unlock()
 				}
 				wg.Done()
 			}(workflowName)
 		} else {
 			go func(name string) {
 				if !waitOnOne(name, ignoreNotFound, quiet) {
+					mux.Lock()
 					wfSuccessStatus = false
+					mux.Unlock()
 				}
 				wg.Done()
 			}(workflowName)
@@ -79,6 +86,13 @@ func WaitWorkflows(workflowNames []string, ignoreNotFound, quiet bool) {
 }
 
 func apiServerWaitOnOne(client workflow.WorkflowServiceClient, ctx context.Context, wfName string, namespace string, ignoreNotFound, quiet bool) bool {
+	_, err := client.GetWorkflow(ctx, &workflow.WorkflowGetRequest{Name: wfName, Namespace: namespace})
+	if err != nil {
+		if status.Code(err) == codes.NotFound && ignoreNotFound {
+			return true
+		}
+		errors.CheckError(err)
+	}
 	fieldSelector := fields.ParseSelectorOrDie(fmt.Sprintf("metadata.name=%s", wfName))
 	wfReq := workflow.WatchWorkflowsRequest{
 		Namespace: namespace,
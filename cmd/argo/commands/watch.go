@@ -77,6 +77,54 @@ func watchWorkflow(name string) {
 	}
 }
 
+// watchWorkflows watches several workflows at once until all of them complete
+func watchWorkflows(names []string) {
+	if len(names) == 1 {
+		watchWorkflow(names[0])
+		return
+	}
+	var getWorkflow func(name string) (*wfv1.Workflow, error)
+	if client.ArgoServer != "" {
+		conn := client.GetClientConn()
+		defer conn.Close()
+		apiClient, ctx := GetWFApiServerGRPCClient(conn)
+		getWorkflow = func(name string) (*wfv1.Workflow, error) {
+			return apiClient.GetWorkflow(ctx, &workflow.WorkflowGetRequest{Name: name, Namespace: namespace})
+		}
+	} else {
+		wfClient := InitWorkflowClient()
+		getWorkflow = func(name string) (*wfv1.Workflow, error) {
+			return wfClient.Get(name, metav1.GetOptions{})
+		}
+	}
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for ; true; <-ticker.C {
+		var wfs []*wfv1.Workflow
+		completed := 0
+		for _, name := range names {
+			wf, err := getWorkflow(name)
+			errors.CheckError(err)
+			err = packer.DecompressWorkflow(wf)
+			errors.CheckError(err)
+			wfs = append(wfs, wf)
+			if !wf.Status.FinishedAt.IsZero() {
+				completed++
+			}
+		}
+		print("\033[H\033[2J")
+		print("\033[0;0H")
+		for _, wf := range wfs {
+			printWorkflowHelper(wf, getFlags{})
+			fmt.Println()
+		}
+		fmt.Printf("%d/%d workflows completed\n", completed, len(names))
+		if completed == len(names) {
+			return
+		}
+	}
+}
+
 func k8sApiWatchWorkflow(name string) {
 	fieldSelector := fields.ParseSelectorOrDie(fmt.Sprintf("metadata.name=%s", name))
 	opts := metav1.ListOptions{
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"github.com/argoproj/pkg/stats"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewArtifactGCCommand() *cobra.Command {
+	var command = cobra.Command{
+		Use:   "artifact-gc",
+		Short: "Delete the artifacts of a workflow",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := deleteArtifacts()
+			if err != nil {
+				log.Fatalf("%+v", err)
+			}
+		},
+	}
+	return &command
+}
+
+func deleteArtifacts() error {
+	wfExecutor := initExecutor()
+	defer wfExecutor.HandleError()
+	defer stats.LogStats()
+
+	err := wfExecutor.DeleteArtifacts()
+	if err != nil {
+		wfExecutor.AddError(err)
+		return err
+	}
+	return nil
+}
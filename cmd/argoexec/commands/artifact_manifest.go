@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"github.com/argoproj/pkg/stats"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewArtifactManifestCommand() *cobra.Command {
+	var command = cobra.Command{
+		Use:   "artifact-manifest",
+		Short: "Save the artifact manifest of a workflow",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := saveArtifactManifest()
+			if err != nil {
+				log.Fatalf("%+v", err)
+			}
+		},
+	}
+	return &command
+}
+
+func saveArtifactManifest() error {
+	wfExecutor := initExecutor()
+	defer wfExecutor.HandleError()
+	defer stats.LogStats()
+
+	err := wfExecutor.SaveArtifactManifest()
+	if err != nil {
+		wfExecutor.AddError(err)
+		return err
+	}
+	return nil
+}
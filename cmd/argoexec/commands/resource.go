@@ -31,7 +31,11 @@ func NewResourceCommand() *cobra.Command {
 func execResource(action string) error {
 	wfExecutor := initExecutor()
 	defer wfExecutor.HandleError()
-	err := wfExecutor.StageFiles()
+	err := wfExecutor.AnnotateVersion()
+	if err != nil {
+		log.Warnf("Failed to annotate the pod with the executor version: %v", err)
+	}
+	err = wfExecutor.StageFiles()
 	if err != nil {
 		wfExecutor.AddError(err)
 		return err
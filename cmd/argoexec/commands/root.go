@@ -13,8 +13,10 @@ import (
 	kubecli "github.com/argoproj/pkg/kube/cli"
 
 	"github.com/argoproj/argo"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/util"
 	"github.com/argoproj/argo/util/cmd"
+	"github.com/argoproj/argo/util/logging"
 	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/executor"
 	"github.com/argoproj/argo/workflow/executor/docker"
@@ -33,6 +35,7 @@ var (
 	logLevel           string // --loglevel
 	glogLevel          int    // --gloglevel
 	podAnnotationsPath string // --pod-annotations
+	templatePath       string // --template
 )
 
 func init() {
@@ -53,6 +56,8 @@ func NewRootCommand() *cobra.Command {
 		},
 	}
 
+	command.AddCommand(NewArtifactGCCommand())
+	command.AddCommand(NewArtifactManifestCommand())
 	command.AddCommand(NewInitCommand())
 	command.AddCommand(NewResourceCommand())
 	command.AddCommand(NewWaitCommand())
@@ -60,6 +65,7 @@ func NewRootCommand() *cobra.Command {
 
 	clientConfig = kubecli.AddKubectlFlagsToCmd(&command)
 	command.PersistentFlags().StringVar(&podAnnotationsPath, "pod-annotations", common.PodMetadataAnnotationsPath, "Pod annotations file from k8s downward API")
+	command.PersistentFlags().StringVar(&templatePath, "template", "", "Template file, instead of the template in the pod annotations")
 	command.PersistentFlags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	command.PersistentFlags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
 
@@ -81,7 +87,12 @@ func initExecutor() *executor.WorkflowExecutor {
 		log.Fatalf("Unable to determine pod name from environment variable %s", common.EnvVarPodName)
 	}
 
-	tmpl, err := executor.LoadTemplate(podAnnotationsPath)
+	var tmpl *wfv1.Template
+	if templatePath != "" {
+		tmpl, err = executor.LoadTemplateFile(templatePath)
+	} else {
+		tmpl, err = executor.LoadTemplate(podAnnotationsPath)
+	}
 	checkErr(err)
 
 	var cre executor.ContainerRuntimeExecutor
@@ -97,10 +108,12 @@ func initExecutor() *executor.WorkflowExecutor {
 	}
 	checkErr(err)
 
-	wfExecutor := executor.NewExecutor(clientset, podName, namespace, podAnnotationsPath, cre, *tmpl)
+	logCtx := logging.WithWorkflow(os.Getenv(common.EnvVarWorkflowNamespace), os.Getenv(common.EnvVarWorkflowName), os.Getenv(common.EnvVarWorkflowUID)).
+		WithField(logging.FieldPod, podName)
+	wfExecutor := executor.NewExecutor(clientset, podName, namespace, podAnnotationsPath, cre, *tmpl, logCtx)
 	yamlBytes, _ := json.Marshal(&wfExecutor.Template)
 	vers := argo.GetVersion()
-	log.Infof("Executor (version: %s, build_date: %s) initialized (pod: %s/%s) with template:\n%s", vers, vers.BuildDate, namespace, podName, string(yamlBytes))
+	logCtx.Infof("Executor (version: %s, build_date: %s) initialized (pod: %s/%s) with template:\n%s", vers, vers.BuildDate, namespace, podName, string(yamlBytes))
 	return &wfExecutor
 }
 
@@ -28,6 +28,11 @@ func waitContainer() error {
 	defer stats.LogStats()
 	stats.StartStatsTicker(5 * time.Minute)
 
+	err := wfExecutor.AnnotateVersion()
+	if err != nil {
+		log.Warnf("Failed to annotate the pod with the executor version: %v", err)
+	}
+
 	defer func() {
 		// Killing sidecar containers
 		err := wfExecutor.KillSidecars()
@@ -44,7 +49,7 @@ func waitContainer() error {
 	}
 
 	// Saving logs
-	logArt, err := wfExecutor.SaveLogs()
+	logArts, err := wfExecutor.SaveLogs()
 	if err != nil {
 		wfExecutor.AddError(err)
 		return err
@@ -67,7 +72,7 @@ func waitContainer() error {
 		wfExecutor.AddError(err)
 		return err
 	}
-	err = wfExecutor.AnnotateOutputs(logArt)
+	err = wfExecutor.AnnotateOutputs(logArts)
 	if err != nil {
 		wfExecutor.AddError(err)
 		return err
@@ -17,16 +17,20 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo/cmd/server/artifacts"
 	"github.com/argoproj/argo/cmd/server/auth"
+	"github.com/argoproj/argo/cmd/server/conversion"
 	"github.com/argoproj/argo/cmd/server/cronworkflow"
+	"github.com/argoproj/argo/cmd/server/event"
 	"github.com/argoproj/argo/cmd/server/info"
 	"github.com/argoproj/argo/cmd/server/static"
 	"github.com/argoproj/argo/cmd/server/workflow"
 	"github.com/argoproj/argo/cmd/server/workflowarchive"
+	"github.com/argoproj/argo/cmd/server/workflowsubmission"
 	"github.com/argoproj/argo/cmd/server/workflowtemplate"
 	"github.com/argoproj/argo/errors"
 	"github.com/argoproj/argo/persist/sqldb"
@@ -36,6 +40,8 @@ import (
 	"github.com/argoproj/argo/util/json"
 	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/config"
+	wfconversion "github.com/argoproj/argo/workflow/conversion"
+	"github.com/argoproj/argo/workflow/util"
 )
 
 type argoServer struct {
@@ -101,7 +107,11 @@ func (as *argoServer) Run(ctx context.Context, port int) {
 	}
 	var offloadRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	var wfArchive = sqldb.NullWorkflowArchive
+	var clusterPods util.PodsGetter
 	if configMap != nil {
+		if len(configMap.Clusters) > 0 {
+			clusterPods = as.newClusterPodsGetter(configMap.Clusters)
+		}
 		persistence := configMap.Persistence
 		if persistence != nil {
 			session, tableName, err := sqldb.CreateDBSession(as.kubeClientset, as.namespace, persistence)
@@ -116,8 +126,10 @@ func (as *argoServer) Run(ctx context.Context, port int) {
 		}
 	}
 	artifactServer := artifacts.NewArtifactServer(as.authenticator, offloadRepo, wfArchive)
-	grpcServer := as.newGRPCServer(offloadRepo, wfArchive)
-	httpServer := as.newHTTPServer(ctx, port, artifactServer)
+	grpcServer := as.newGRPCServer(offloadRepo, wfArchive, clusterPods)
+	submissionServer := workflowsubmission.NewSubmissionServer(as.authenticator)
+	eventServer := event.NewEventServer(as.authenticator)
+	httpServer := as.newHTTPServer(ctx, port, artifactServer, submissionServer, eventServer)
 
 	// Start listener
 	var conn net.Listener
@@ -149,7 +161,7 @@ func (as *argoServer) Run(ctx context.Context, port int) {
 	<-as.stopCh
 }
 
-func (as *argoServer) newGRPCServer(offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive) *grpc.Server {
+func (as *argoServer) newGRPCServer(offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, clusterPods util.PodsGetter) *grpc.Server {
 	serverLog := log.NewEntry(log.StandardLogger())
 
 	sOpts := []grpc.ServerOption{
@@ -176,7 +188,10 @@ func (as *argoServer) newGRPCServer(offloadNodeStatusRepo sqldb.OffloadNodeStatu
 	grpcServer := grpc.NewServer(sOpts...)
 
 	info.RegisterInfoServiceServer(grpcServer, info.NewInfoServer(as.managedNamespace))
-	workflow.RegisterWorkflowServiceServer(grpcServer, workflow.NewWorkflowServer(offloadNodeStatusRepo))
+	controllerConfig := func() (*config.WorkflowControllerConfig, error) {
+		return as.RsyncConfig(as.namespace, as.kubeClientset)
+	}
+	workflow.RegisterWorkflowServiceServer(grpcServer, workflow.NewWorkflowServer(offloadNodeStatusRepo, clusterPods, controllerConfig))
 	workflowtemplate.RegisterWorkflowTemplateServiceServer(grpcServer, workflowtemplate.NewWorkflowTemplateServer())
 	cronworkflow.RegisterCronWorkflowServiceServer(grpcServer, cronworkflow.NewCronWorkflowServer())
 	workflowarchive.RegisterArchivedWorkflowServiceServer(grpcServer, workflowarchive.NewWorkflowArchiveServer(wfArchive))
@@ -186,7 +201,7 @@ func (as *argoServer) newGRPCServer(offloadNodeStatusRepo sqldb.OffloadNodeStatu
 
 // newHTTPServer returns the HTTP server to serve HTTP/HTTPS requests. This is implemented
 // using grpc-gateway as a proxy to the gRPC server.
-func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServer *artifacts.ArtifactServer) *http.Server {
+func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServer *artifacts.ArtifactServer, submissionServer *workflowsubmission.SubmissionServer, eventServer *event.EventServer) *http.Server {
 
 	endpoint := fmt.Sprintf("localhost:%d", port)
 
@@ -215,12 +230,33 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	mustRegisterGWHandler(cronworkflow.RegisterCronWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(workflowarchive.RegisterArchivedWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mux.Handle("/api/", gwmux)
+	mux.HandleFunc("/api/v1/workflow-submissions/", submissionServer.Submit)
+	mux.HandleFunc("/api/v1/events/", eventServer.Receive)
 	mux.HandleFunc("/artifacts/", artifactServer.GetArtifact)
 	mux.HandleFunc("/artifacts-by-uid/", artifactServer.GetArtifactByUID)
+	mux.HandleFunc("/convert", conversion.NewConversionServer(wfconversion.NewConverter()).Convert)
 	mux.HandleFunc("/", static.ServerFiles)
 	return &httpServer
 }
 
+// newClusterPodsGetter returns the getter of the pods of the other clusters the controller creates pods in, whose
+// kubeconfigs are in secrets in the namespace of the server
+func (as *argoServer) newClusterPodsGetter(clusters []config.ClusterConfig) util.PodsGetter {
+	return func(cluster, namespace string) (corev1.PodInterface, error) {
+		for _, clusterConfig := range clusters {
+			if clusterConfig.Name != cluster {
+				continue
+			}
+			_, kubeClient, err := clusterConfig.NewKubeClient(as.kubeClientset, as.namespace)
+			if err != nil {
+				return nil, err
+			}
+			return kubeClient.CoreV1().Pods(clusterConfig.GetPodNamespace(namespace)), nil
+		}
+		return nil, errors.Errorf(errors.CodeBadRequest, "cluster %s is not configured", cluster)
+	}
+}
+
 type registerFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
 
 // mustRegisterGWHandler is a convenience function to register a gateway handler
@@ -10,7 +10,6 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -33,7 +32,7 @@ func NewArtifactServer(authN auth.Gatekeeper, offloadNodeStatusRepo sqldb.Offloa
 
 func (a *ArtifactServer) GetArtifact(w http.ResponseWriter, r *http.Request) {
 
-	ctx, err := a.gateKeeping(r)
+	ctx, err := a.authN.HTTPContext(r, true)
 	if err != nil {
 		w.WriteHeader(401)
 		_, _ = w.Write([]byte(err.Error()))
@@ -63,7 +62,7 @@ func (a *ArtifactServer) GetArtifact(w http.ResponseWriter, r *http.Request) {
 }
 func (a *ArtifactServer) GetArtifactByUID(w http.ResponseWriter, r *http.Request) {
 
-	ctx, err := a.gateKeeping(r)
+	ctx, err := a.authN.HTTPContext(r, true)
 	if err != nil {
 		w.WriteHeader(401)
 		_, _ = w.Write([]byte(err.Error()))
@@ -90,18 +89,6 @@ func (a *ArtifactServer) GetArtifactByUID(w http.ResponseWriter, r *http.Request
 	}
 	a.ok(w, data)
 }
-func (a *ArtifactServer) gateKeeping(r *http.Request) (context.Context, error) {
-	token := r.Header.Get("Authorization")
-	if token == "" {
-		cookie, err := r.Cookie("authorization")
-		if err != nil {
-			return nil, err
-		}
-		token = cookie.Value
-	}
-	ctx := metadata.NewIncomingContext(r.Context(), metadata.MD{"authorization": []string{token}})
-	return a.authN.Context(ctx)
-}
 
 func (a *ArtifactServer) ok(w http.ResponseWriter, data []byte) {
 	w.WriteHeader(200)
@@ -72,6 +72,27 @@ func (s *Gatekeeper) Context(ctx context.Context) (context.Context, error) {
 	return context.WithValue(context.WithValue(ctx, WfKey, wfClient), KubeKey, kubeClient), nil
 }
 
+// HTTPContext authenticates a request to a plain HTTP handler, with the token of its Authorization header or of its
+// authorization cookie. Requests without a token are rejected if the token is required, and otherwise use the clients
+// of the server in server and hybrid auth mode.
+func (s *Gatekeeper) HTTPContext(r *http.Request, tokenRequired bool) (context.Context, error) {
+	token := r.Header.Get("Authorization")
+	if token == "" {
+		cookie, err := r.Cookie("authorization")
+		if err != nil && tokenRequired {
+			return nil, err
+		}
+		if err == nil {
+			token = cookie.Value
+		}
+	}
+	md := metadata.MD{}
+	if token != "" {
+		md.Set("authorization", token)
+	}
+	return s.Context(metadata.NewIncomingContext(r.Context(), md))
+}
+
 func GetWfClient(ctx context.Context) versioned.Interface {
 	return ctx.Value(WfKey).(versioned.Interface)
 }
@@ -3,6 +3,8 @@ package auth
 import (
 	"context"
 	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -65,6 +67,28 @@ func TestServer_GetWFClient(t *testing.T) {
 	})
 }
 
+func TestGatekeeper_HTTPContext(t *testing.T) {
+	wfClient := &fakewfclientset.Clientset{}
+	kubeClient := &fake.Clientset{}
+	s := NewGatekeeper("server", wfClient, kubeClient, nil)
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/events/my-ns", nil)
+	_, err := s.HTTPContext(r, true)
+	assert.Error(t, err)
+	ctx, err := s.HTTPContext(r, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfClient, GetWfClient(ctx))
+		assert.Equal(t, kubeClient, GetKubeClient(ctx))
+	}
+
+	r.AddCookie(&http.Cookie{Name: "authorization", Value: "Bearer anything"})
+	ctx, err = s.HTTPContext(r, true)
+	if assert.NoError(t, err) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		assert.Equal(t, "anything", getToken(md))
+	}
+}
+
 func authAndHandle(s Gatekeeper, ctx context.Context) (*context.Context, error) {
 	var usedCtx *context.Context
 	_, err := s.UnaryServerInterceptor()(ctx, nil, nil, func(ctx context.Context, req interface{}) (i interface{}, err error) {
@@ -0,0 +1,106 @@
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo/workflow/conversion"
+)
+
+// maxReviewBytes caps the size of the conversion reviews, which the API server sends in batches of resources
+const maxReviewBytes = 64 * 1024 * 1024
+
+// ConversionReview is the request the API server sends to the conversion webhook of a custom resource, and the response
+// of the webhook, as of apiextensions.k8s.io/v1beta1
+type ConversionReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *ConversionRequest  `json:"request,omitempty"`
+	Response        *ConversionResponse `json:"response,omitempty"`
+}
+
+// ConversionRequest are the resources to convert to the desired version
+type ConversionRequest struct {
+	UID               types.UID              `json:"uid"`
+	DesiredAPIVersion string                 `json:"desiredAPIVersion"`
+	Objects           []runtime.RawExtension `json:"objects"`
+}
+
+// ConversionResponse are the converted resources, in the order of the request, or the reason they were not converted
+type ConversionResponse struct {
+	UID              types.UID              `json:"uid"`
+	ConvertedObjects []runtime.RawExtension `json:"convertedObjects"`
+	Result           metav1.Status          `json:"result"`
+}
+
+type ConversionServer struct {
+	converter *conversion.Converter
+}
+
+func NewConversionServer(converter *conversion.Converter) *ConversionServer {
+	return &ConversionServer{converter}
+}
+
+// Convert handles `POST /convert` with a ConversionReview body from the API server, and responds with the review
+// completed with the converted resources
+func (s *ConversionServer) Convert(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.error(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	var review ConversionReview
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxReviewBytes)).Decode(&review)
+	if err != nil {
+		s.error(w, http.StatusBadRequest, err)
+		return
+	}
+	if review.Request == nil {
+		s.error(w, http.StatusBadRequest, fmt.Errorf("the conversion review has no request"))
+		return
+	}
+	review.Response = s.convert(review.Request)
+	review.Request = nil
+	data, err := json.Marshal(review)
+	if err != nil {
+		s.error(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// convert converts all the resources of the request, or none if any fails to convert
+func (s *ConversionServer) convert(req *ConversionRequest) *ConversionResponse {
+	resp := &ConversionResponse{UID: req.UID}
+	for _, raw := range req.Objects {
+		obj := &unstructured.Unstructured{}
+		err := obj.UnmarshalJSON(raw.Raw)
+		if err == nil {
+			err = s.converter.Convert(obj, req.DesiredAPIVersion)
+		}
+		var data []byte
+		if err == nil {
+			data, err = obj.MarshalJSON()
+		}
+		if err != nil {
+			log.WithFields(log.Fields{"uid": req.UID, "desiredAPIVersion": req.DesiredAPIVersion}).Warnf("Failed to convert resources: %v", err)
+			resp.ConvertedObjects = nil
+			resp.Result = metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+			return resp
+		}
+		resp.ConvertedObjects = append(resp.ConvertedObjects, runtime.RawExtension{Raw: data})
+	}
+	resp.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return resp
+}
+
+func (s *ConversionServer) error(w http.ResponseWriter, code int, err error) {
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(err.Error()))
+}
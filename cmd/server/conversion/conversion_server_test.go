@@ -0,0 +1,73 @@
+package conversion
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/workflow/conversion"
+)
+
+func newTestServer() *ConversionServer {
+	converter := conversion.NewConverter()
+	toHub, fromHub := conversion.RenameTemplateField("podSpecPatch", "podPatch")
+	converter.Register("argoproj.io/v1beta1", toHub, fromHub)
+	return NewConversionServer(converter)
+}
+
+func review(t *testing.T, server *ConversionServer, body string) (int, ConversionReview) {
+	w := httptest.NewRecorder()
+	server.Convert(w, httptest.NewRequest(http.MethodPost, "/convert", strings.NewReader(body)))
+	var review ConversionReview
+	if w.Code == http.StatusOK {
+		err := yaml.Unmarshal(w.Body.Bytes(), &review)
+		assert.NoError(t, err)
+	}
+	return w.Code, review
+}
+
+func TestConvert(t *testing.T) {
+	server := newTestServer()
+	code, resp := review(t, server, `{
+  "apiVersion": "apiextensions.k8s.io/v1beta1",
+  "kind": "ConversionReview",
+  "request": {
+    "uid": "1",
+    "desiredAPIVersion": "argoproj.io/v1beta1",
+    "objects": [{"apiVersion": "argoproj.io/v1alpha1", "kind": "Workflow", "metadata": {"name": "my-wf"}, "spec": {"templates": [{"name": "main", "podSpecPatch": "{}"}]}}]
+  }
+}`)
+	if assert.Equal(t, http.StatusOK, code) && assert.NotNil(t, resp.Response) {
+		assert.Equal(t, "ConversionReview", resp.Kind)
+		assert.Nil(t, resp.Request)
+		assert.Equal(t, "1", string(resp.Response.UID))
+		assert.Equal(t, metav1.StatusSuccess, resp.Response.Result.Status)
+		if assert.Len(t, resp.Response.ConvertedObjects, 1) {
+			assert.JSONEq(t, `{"apiVersion": "argoproj.io/v1beta1", "kind": "Workflow", "metadata": {"name": "my-wf"}, "spec": {"templates": [{"name": "main", "podPatch": "{}"}]}}`, string(resp.Response.ConvertedObjects[0].Raw))
+		}
+	}
+
+	// no resource is converted if any fails to
+	code, resp = review(t, server, `{
+  "request": {
+    "uid": "2",
+    "desiredAPIVersion": "argoproj.io/v2",
+    "objects": [{"apiVersion": "argoproj.io/v1alpha1", "kind": "Workflow", "metadata": {"name": "my-wf"}}]
+  }
+}`)
+	if assert.Equal(t, http.StatusOK, code) && assert.NotNil(t, resp.Response) {
+		assert.Equal(t, metav1.StatusFailure, resp.Response.Result.Status)
+		assert.Equal(t, "cannot convert Workflow my-wf to unknown version argoproj.io/v2", resp.Response.Result.Message)
+		assert.Empty(t, resp.Response.ConvertedObjects)
+	}
+
+	code, _ = review(t, server, `{}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, _ = review(t, server, `not json`)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
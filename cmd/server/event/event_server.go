@@ -0,0 +1,369 @@
+package event
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Knetic/govaluate"
+	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/cmd/server/auth"
+	"github.com/argoproj/argo/cmd/server/workflowsubmission"
+	argoerrs "github.com/argoproj/argo/errors"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+// WorkflowEventBinding submits a workflow for each event which matches its selector. The bindings of a namespace are
+// the keys of its ConfigMaps labeled workflows.argoproj.io/event-binding=true.
+type WorkflowEventBinding struct {
+	// Selector is an expression which must evaluate to true for the event to submit a workflow, e.g.
+	// `[header.X-Github-Event] == 'push' && [body.ref] == 'refs/heads/master'`. An empty selector matches every event.
+	Selector string `json:"selector,omitempty"`
+	// Submit is the workflow to submit
+	Submit Submit `json:"submit"`
+}
+
+// Submit runs a template of a WorkflowTemplate, with parameters which may be extracted from the event
+type Submit struct {
+	// WorkflowTemplate is the name of the WorkflowTemplate to run
+	WorkflowTemplate string `json:"workflowTemplate"`
+	// Entrypoint is the name of the template within the WorkflowTemplate to run
+	Entrypoint string `json:"entrypoint"`
+	// Parameters are passed as arguments to the workflow
+	Parameters []Parameter `json:"parameters,omitempty"`
+	// Labels are applied to the workflow
+	Labels map[string]string `json:"labels,omitempty"`
+	// GenerateName overrides the name prefix, which defaults to the WorkflowTemplate name. The workflows of events
+	// with an ID are named after the prefix and the ID, otherwise their names are generated.
+	GenerateName string `json:"generateName,omitempty"`
+}
+
+// Parameter is an argument of the submitted workflow
+type Parameter struct {
+	Name string `json:"name"`
+	// Value is the literal value of the parameter
+	Value string `json:"value,omitempty"`
+	// ValueFrom is the path of the value of the event, e.g. body.repository.name, which is used instead of Value
+	ValueFrom string `json:"valueFrom,omitempty"`
+}
+
+// Event is an event received by the server. Selectors and parameters refer to the JSON body with `body.<path>` and to
+// the headers with `header.<name>`.
+type Event struct {
+	Header http.Header
+	Body   []byte
+}
+
+// ID returns the ID of the event, which deliveries of the same event share, from the first of the EventIDHeaders it
+// has, or else an empty string
+func (e Event) ID() string {
+	for _, header := range EventIDHeaders {
+		if id := e.Header.Get(header); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// Get implements govaluate.Parameters. Values which are missing from the event are nil.
+func (e Event) Get(name string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(name, "body."):
+		return gjson.GetBytes(e.Body, strings.TrimPrefix(name, "body.")).Value(), nil
+	case strings.HasPrefix(name, "header."):
+		values, ok := e.Header[http.CanonicalHeaderKey(strings.TrimPrefix(name, "header."))]
+		if !ok || len(values) == 0 {
+			return nil, nil
+		}
+		return values[0], nil
+	}
+	return nil, fmt.Errorf("unknown variable '%s', expected body.<path> or header.<name>", name)
+}
+
+// Matches returns whether the event matches the selector of the binding
+func (b WorkflowEventBinding) Matches(event Event) (bool, error) {
+	if b.Selector == "" {
+		return true, nil
+	}
+	expression, err := govaluate.NewEvaluableExpression(b.Selector)
+	if err != nil {
+		return false, argoerrs.Errorf(argoerrs.CodeBadRequest, "invalid selector '%s': %v", b.Selector, err)
+	}
+	result, err := expression.Eval(event)
+	if err != nil {
+		return false, argoerrs.Errorf(argoerrs.CodeBadRequest, "failed to evaluate selector '%s': %v", b.Selector, err)
+	}
+	matches, ok := result.(bool)
+	if !ok {
+		return false, argoerrs.Errorf(argoerrs.CodeBadRequest, "selector '%s' does not evaluate to a boolean", b.Selector)
+	}
+	return matches, nil
+}
+
+// workflowName returns the name of the workflow the binding, keyed by key, submits for an event with an ID: the name
+// prefix followed by a hash of the key and the ID, so that the retried deliveries of the event do not submit it again.
+// The names of the workflows of events without an ID are generated.
+func (b WorkflowEventBinding) workflowName(key string, event Event) string {
+	id := event.ID()
+	if id == "" {
+		return ""
+	}
+	prefix := b.Submit.GenerateName
+	if prefix == "" {
+		prefix = b.Submit.WorkflowTemplate + "-"
+	}
+	hash := sha256.Sum256([]byte(key + "/" + id))
+	return prefix + hex.EncodeToString(hash[:])[:10]
+}
+
+// ToSubmission resolves the parameters of the binding, keyed by key, against the event
+func (b WorkflowEventBinding) ToSubmission(key string, event Event) (workflowsubmission.Submission, error) {
+	parameters := make(map[string]string)
+	for _, param := range b.Submit.Parameters {
+		if param.ValueFrom == "" {
+			parameters[param.Name] = param.Value
+			continue
+		}
+		value, err := event.Get(param.ValueFrom)
+		if err != nil {
+			return workflowsubmission.Submission{}, argoerrs.Errorf(argoerrs.CodeBadRequest, "parameter '%s': %v", param.Name, err)
+		}
+		if value == nil {
+			return workflowsubmission.Submission{}, argoerrs.Errorf(argoerrs.CodeBadRequest, "parameter '%s': the event has no value at '%s'", param.Name, param.ValueFrom)
+		}
+		switch value := value.(type) {
+		case string:
+			parameters[param.Name] = value
+		default:
+			data, err := json.Marshal(value)
+			if err != nil {
+				return workflowsubmission.Submission{}, argoerrs.InternalWrapError(err)
+			}
+			parameters[param.Name] = string(data)
+		}
+	}
+	return workflowsubmission.Submission{
+		Name:             b.workflowName(key, event),
+		WorkflowTemplate: b.Submit.WorkflowTemplate,
+		Entrypoint:       b.Submit.Entrypoint,
+		Parameters:       parameters,
+		Labels:           b.Submit.Labels,
+		GenerateName:     b.Submit.GenerateName,
+	}, nil
+}
+
+const (
+	// SecretName is the name of the Secret of a namespace which holds the key its events are signed with
+	SecretName = "argo-events"
+	// SecretKey is the key of the Secret which holds the signing key
+	SecretKey = "secret"
+	// SignatureHeader is the header of the signature of an event, `sha256=<hex HMAC-SHA256 of the body>`, as sent by
+	// GitHub webhooks
+	SignatureHeader = "X-Hub-Signature-256"
+	// maxEventBytes caps the size of the body of an event, which is read in memory to be verified. GitHub caps the
+	// payloads of its webhooks at 25 MB.
+	maxEventBytes = 25 * 1024 * 1024
+)
+
+// EventIDHeaders are the headers the ID of an event is read from, by precedence: the generic X-Event-Id, and the
+// delivery IDs of GitHub and GitLab, which are kept when a delivery is retried
+var EventIDHeaders = []string{"X-Event-Id", "X-GitHub-Delivery", "X-Gitlab-Event-UUID"}
+
+type EventServer struct {
+	authN auth.Gatekeeper
+}
+
+func NewEventServer(authN auth.Gatekeeper) *EventServer {
+	return &EventServer{authN}
+}
+
+// receiveResponse holds the names of the submitted workflows, and the errors of the bindings which failed, keyed by
+// binding
+type receiveResponse struct {
+	Workflows []string          `json:"workflows"`
+	Errors    map[string]string `json:"errors,omitempty"`
+}
+
+// Receive handles `POST /api/v1/events/{namespace}` with any payload, e.g. a webhook, submits a workflow for each
+// WorkflowEventBinding of the namespace which matches it, and responds with the names of the submitted workflows.
+// Events must be signed with the key of the namespace. The response is a success as soon as a workflow is submitted,
+// even if other bindings failed, so that senders which retry failed deliveries do not submit the workflow again. The
+// workflows of events with an ID are named after it, so that deliveries which are retried anyway do not either.
+func (s *EventServer) Receive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.error(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	// unlike other requests, events may come without a token, e.g. from webhooks which cannot send one, in which case
+	// the server uses its own service account unless it requires client auth
+	ctx, err := s.authN.HTTPContext(r, false)
+	if err != nil {
+		s.error(w, http.StatusUnauthorized, err)
+		return
+	}
+	namespace := strings.TrimPrefix(r.URL.Path, "/api/v1/events/")
+	if namespace == "" || strings.Contains(namespace, "/") {
+		s.error(w, http.StatusNotFound, fmt.Errorf("expected path /api/v1/events/{namespace}"))
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxEventBytes))
+	if err != nil {
+		s.error(w, http.StatusBadRequest, err)
+		return
+	}
+	err = s.verify(ctx, namespace, r.Header.Get(SignatureHeader), body)
+	if err != nil {
+		s.error(w, http.StatusForbidden, err)
+		return
+	}
+	names, errs, err := s.receive(ctx, namespace, Event{Header: r.Header, Body: body})
+	if err != nil {
+		s.error(w, http.StatusInternalServerError, err)
+		return
+	}
+	code := http.StatusOK
+	resp := receiveResponse{Workflows: names}
+	if len(errs) > 0 {
+		resp.Errors = make(map[string]string)
+		if len(names) == 0 {
+			code = http.StatusBadRequest
+		}
+	}
+	for key, err := range errs {
+		resp.Errors[key] = err.Error()
+		if argoErr, ok := err.(argoerrs.ArgoError); len(names) == 0 && (!ok || argoErr.Code() != argoerrs.CodeBadRequest) {
+			code = http.StatusInternalServerError
+		}
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		s.error(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
+
+// verify checks the signature of the body of an event against the key of the namespace
+func (s *EventServer) verify(ctx context.Context, namespace, signature string, body []byte) error {
+	secret, err := auth.GetKubeClient(ctx).CoreV1().Secrets(namespace).Get(SecretName, metav1.GetOptions{})
+	if err != nil {
+		log.WithField("namespace", namespace).Warnf("Failed to get the key events are signed with: %v", err)
+		return fmt.Errorf("events are not accepted in namespace %s", namespace)
+	}
+	key, ok := secret.Data[SecretKey]
+	if !ok || len(key) == 0 {
+		return fmt.Errorf("events are not accepted in namespace %s", namespace)
+	}
+	if !strings.HasPrefix(signature, "sha256=") {
+		return fmt.Errorf("missing %s header", SignatureHeader)
+	}
+	actual, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return fmt.Errorf("invalid signature")
+	}
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(body)
+	if !hmac.Equal(actual, mac.Sum(nil)) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// receive submits the workflows of the bindings matching the event, and returns their names. A binding which fails
+// does not prevent the others from submitting their workflows, and its error is returned keyed by binding.
+func (s *EventServer) receive(ctx context.Context, namespace string, event Event) ([]string, map[string]error, error) {
+	bindings, err := s.bindings(ctx, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	wfClient := auth.GetWfClient(ctx)
+	names := []string{}
+	errs := make(map[string]error)
+	for _, key := range sortedKeys(bindings) {
+		binding := bindings[key]
+		matches, err := binding.Matches(event)
+		if err != nil {
+			errs[key] = err
+			continue
+		}
+		if !matches {
+			continue
+		}
+		submission, err := binding.ToSubmission(key, event)
+		if err != nil {
+			errs[key] = err
+			continue
+		}
+		wf, err := submission.ToWorkflow(namespace)
+		if err != nil {
+			errs[key] = err
+			continue
+		}
+		log.WithFields(log.Fields{"namespace": namespace, "binding": key, "workflowTemplate": submission.WorkflowTemplate, "entrypoint": submission.Entrypoint}).Info("Submit workflow for event")
+		wf, err = util.SubmitWorkflow(wfClient.ArgoprojV1alpha1().Workflows(namespace), wfClient, namespace, wf, &util.SubmitOpts{})
+		if apierr.IsAlreadyExists(err) && submission.Name != "" {
+			log.WithFields(log.Fields{"namespace": namespace, "binding": key, "workflow": submission.Name}).Info("Workflow was already submitted for event")
+			names = append(names, submission.Name)
+			continue
+		}
+		if err != nil {
+			log.WithFields(log.Fields{"namespace": namespace, "binding": key}).Warnf("Failed to submit workflow for event: %v", err)
+			errs[key] = err
+			continue
+		}
+		names = append(names, wf.Name)
+	}
+	return names, errs, nil
+}
+
+// bindings returns the WorkflowEventBindings of the namespace, keyed by <configmap>/<key>
+func (s *EventServer) bindings(ctx context.Context, namespace string) (map[string]WorkflowEventBinding, error) {
+	cms, err := auth.GetKubeClient(ctx).CoreV1().ConfigMaps(namespace).List(metav1.ListOptions{
+		LabelSelector: common.LabelKeyEventBinding + "=true",
+	})
+	if err != nil {
+		return nil, argoerrs.InternalWrapError(err)
+	}
+	bindings := make(map[string]WorkflowEventBinding)
+	for _, cm := range cms.Items {
+		for key, value := range cm.Data {
+			var binding WorkflowEventBinding
+			err := yaml.UnmarshalStrict([]byte(value), &binding)
+			if err != nil {
+				log.WithFields(log.Fields{"namespace": namespace, "configMap": cm.Name, "key": key}).Warnf("Ignoring invalid event binding: %v", err)
+				continue
+			}
+			bindings[cm.Name+"/"+key] = binding
+		}
+	}
+	return bindings, nil
+}
+
+func sortedKeys(bindings map[string]WorkflowEventBinding) []string {
+	keys := make([]string, 0, len(bindings))
+	for key := range bindings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *EventServer) error(w http.ResponseWriter, code int, err error) {
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(err.Error()))
+}
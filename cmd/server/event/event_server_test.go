@@ -0,0 +1,207 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo/cmd/server/auth"
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	v1alpha "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+var pushEvent = Event{
+	Header: http.Header{"X-Github-Event": []string{"push"}},
+	Body:   []byte(`{"ref": "refs/heads/master", "repository": {"name": "argo", "stars": 9000}}`),
+}
+
+func TestMatches(t *testing.T) {
+	matches, err := WorkflowEventBinding{}.Matches(pushEvent)
+	if assert.NoError(t, err) {
+		assert.True(t, matches)
+	}
+	matches, err = WorkflowEventBinding{Selector: "[header.X-Github-Event] == 'push' && [body.ref] == 'refs/heads/master'"}.Matches(pushEvent)
+	if assert.NoError(t, err) {
+		assert.True(t, matches)
+	}
+	matches, err = WorkflowEventBinding{Selector: "[body.repository.stars] > 10000"}.Matches(pushEvent)
+	if assert.NoError(t, err) {
+		assert.False(t, matches)
+	}
+	matches, err = WorkflowEventBinding{Selector: "[body.missing] == 'x'"}.Matches(pushEvent)
+	if assert.NoError(t, err) {
+		assert.False(t, matches)
+	}
+	_, err = WorkflowEventBinding{Selector: "[body.ref]"}.Matches(pushEvent)
+	assert.EqualError(t, err, "selector '[body.ref]' does not evaluate to a boolean")
+	_, err = WorkflowEventBinding{Selector: "[query.ref] == 'x'"}.Matches(pushEvent)
+	assert.Error(t, err)
+}
+
+func TestToSubmission(t *testing.T) {
+	binding := WorkflowEventBinding{Submit: Submit{
+		WorkflowTemplate: "my-wftmpl",
+		Entrypoint:       "build",
+		Parameters: []Parameter{
+			{Name: "branch", ValueFrom: "body.ref"},
+			{Name: "repository", ValueFrom: "body.repository"},
+			{Name: "event", ValueFrom: "header.X-Github-Event"},
+			{Name: "mode", Value: "release"},
+		},
+	}}
+	submission, err := binding.ToSubmission("my-bindings/push", pushEvent)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "my-wftmpl", submission.WorkflowTemplate)
+		assert.Empty(t, submission.Name)
+		assert.Equal(t, map[string]string{
+			"branch":     "refs/heads/master",
+			"repository": `{"name":"argo","stars":9000}`,
+			"event":      "push",
+			"mode":       "release",
+		}, submission.Parameters)
+	}
+
+	// the workflows of events with an ID are named after it
+	delivery := Event{Header: http.Header{"X-Github-Delivery": []string{"my-id"}}, Body: pushEvent.Body}
+	submission, err = binding.ToSubmission("my-bindings/push", delivery)
+	if assert.NoError(t, err) {
+		assert.Regexp(t, "^my-wftmpl-[0-9a-f]{10}$", submission.Name)
+		other, err := binding.ToSubmission("my-bindings/other", delivery)
+		if assert.NoError(t, err) {
+			assert.NotEqual(t, submission.Name, other.Name)
+		}
+	}
+
+	binding.Submit.Parameters = []Parameter{{Name: "tag", ValueFrom: "body.tag"}}
+	_, err = binding.ToSubmission("my-bindings/push", pushEvent)
+	assert.EqualError(t, err, "parameter 'tag': the event has no value at 'body.tag'")
+}
+
+func TestReceive(t *testing.T) {
+	wftmpl := &v1alpha1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wftmpl", Namespace: "my-ns"},
+		Spec: v1alpha1.WorkflowTemplateSpec{
+			Templates: []v1alpha1.Template{{
+				Name:      "build",
+				Inputs:    v1alpha1.Inputs{Parameters: []v1alpha1.Parameter{{Name: "branch"}}},
+				Container: &corev1.Container{Image: "docker/whalesay:latest"},
+			}},
+		},
+	}
+	wfClient := v1alpha.NewSimpleClientset(wftmpl)
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: SecretName, Namespace: "my-ns"},
+		Data:       map[string][]byte{SecretKey: []byte("my-key")},
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-bindings",
+			Namespace: "my-ns",
+			Labels:    map[string]string{common.LabelKeyEventBinding: "true"},
+		},
+		Data: map[string]string{
+			"push": `
+selector: "[header.X-Github-Event] == 'push'"
+submit:
+  workflowTemplate: my-wftmpl
+  entrypoint: build
+  parameters:
+  - name: branch
+    valueFrom: body.ref
+`,
+			"release": `
+selector: "[header.X-Github-Event] == 'release'"
+submit:
+  workflowTemplate: my-wftmpl
+  entrypoint: build
+  parameters:
+  - name: branch
+    value: master
+`,
+			"tag": `
+selector: "[header.X-Github-Event] == 'push'"
+submit:
+  workflowTemplate: my-wftmpl
+  entrypoint: build
+  parameters:
+  - name: branch
+    valueFrom: body.tag
+`,
+			"invalid": "selector: [",
+		},
+	})
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, wfClient), auth.KubeKey, kubeClient)
+	server := NewEventServer(auth.Gatekeeper{})
+
+	names, errs, err := server.receive(ctx, "my-ns", pushEvent)
+	if assert.NoError(t, err) {
+		assert.Len(t, names, 1)
+		if assert.Len(t, errs, 1) {
+			assert.EqualError(t, errs["my-bindings/tag"], "parameter 'branch': the event has no value at 'body.tag'")
+		}
+		wfs, err := wfClient.ArgoprojV1alpha1().Workflows("my-ns").List(metav1.ListOptions{})
+		if assert.NoError(t, err) && assert.Len(t, wfs.Items, 1) {
+			assert.Equal(t, "refs/heads/master", *wfs.Items[0].Spec.Arguments.Parameters[0].Value)
+		}
+	}
+
+	// a retried delivery of an event with an ID returns the workflow submitted for the first one
+	delivery := Event{Header: http.Header{"X-Github-Event": []string{"push"}, "X-Event-Id": []string{"my-id"}}, Body: pushEvent.Body}
+	names, _, err = server.receive(ctx, "my-ns", delivery)
+	if assert.NoError(t, err) && assert.Len(t, names, 1) {
+		retried, _, err := server.receive(ctx, "my-ns", delivery)
+		if assert.NoError(t, err) {
+			assert.Equal(t, names, retried)
+		}
+		wfs, err := wfClient.ArgoprojV1alpha1().Workflows("my-ns").List(metav1.ListOptions{})
+		if assert.NoError(t, err) {
+			assert.Len(t, wfs.Items, 2)
+		}
+	}
+
+	names, errs, err = server.receive(ctx, "my-ns", Event{Header: http.Header{"X-Github-Event": []string{"issues"}}})
+	if assert.NoError(t, err) {
+		assert.Empty(t, names)
+		assert.Empty(t, errs)
+	}
+
+	// the fake clientset does not generate names, so the workflow is submitted to a new one
+	server = NewEventServer(auth.NewGatekeeper(auth.Server, v1alpha.NewSimpleClientset(wftmpl), kubeClient, nil))
+	post := func(signature string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/events/my-ns", bytes.NewReader(pushEvent.Body))
+		r.Header.Set("X-Github-Event", "push")
+		if signature != "" {
+			r.Header.Set(SignatureHeader, signature)
+		}
+		w := httptest.NewRecorder()
+		server.Receive(w, r)
+		return w
+	}
+	mac := hmac.New(sha256.New, []byte("my-key"))
+	_, _ = mac.Write(pushEvent.Body)
+	w := post("sha256=" + hex.EncodeToString(mac.Sum(nil)))
+	if assert.Equal(t, http.StatusOK, w.Code) {
+		var resp receiveResponse
+		if assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp)) {
+			assert.Len(t, resp.Workflows, 1)
+			assert.Contains(t, resp.Errors, "my-bindings/tag")
+		}
+	}
+	assert.Equal(t, http.StatusForbidden, post("").Code)
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/events/my-ns", bytes.NewReader(make([]byte, maxEventBytes+1)))
+	w = httptest.NewRecorder()
+	server.Receive(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, http.StatusForbidden, post("sha256=0123").Code)
+}
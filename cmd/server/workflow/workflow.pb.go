@@ -902,6 +902,262 @@ func (m *WorkflowLintRequest) GetWorkflow() *v1alpha1.Workflow {
 	return nil
 }
 
+type WorkflowPreviewPodsRequest struct {
+	Namespace string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Workflow  *v1alpha1.Workflow `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// the workflow templates the workflow refers to which are not created yet, e.g. submitted along with it
+	Templates            []*v1alpha1.WorkflowTemplate `protobuf:"bytes,3,rep,name=templates,proto3" json:"templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *WorkflowPreviewPodsRequest) Reset()         { *m = WorkflowPreviewPodsRequest{} }
+func (m *WorkflowPreviewPodsRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowPreviewPodsRequest) ProtoMessage()    {}
+func (*WorkflowPreviewPodsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192bc67c39cca05a, []int{15}
+}
+func (m *WorkflowPreviewPodsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowPreviewPodsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowPreviewPodsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowPreviewPodsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowPreviewPodsRequest.Merge(m, src)
+}
+func (m *WorkflowPreviewPodsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowPreviewPodsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowPreviewPodsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowPreviewPodsRequest proto.InternalMessageInfo
+
+func (m *WorkflowPreviewPodsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowPreviewPodsRequest) GetWorkflow() *v1alpha1.Workflow {
+	if m != nil {
+		return m.Workflow
+	}
+	return nil
+}
+
+func (m *WorkflowPreviewPodsRequest) GetTemplates() []*v1alpha1.WorkflowTemplate {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+type WorkflowPreviewPodsResponse struct {
+	Pods                 []*v11.Pod `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WorkflowPreviewPodsResponse) Reset()         { *m = WorkflowPreviewPodsResponse{} }
+func (m *WorkflowPreviewPodsResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowPreviewPodsResponse) ProtoMessage()    {}
+func (*WorkflowPreviewPodsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192bc67c39cca05a, []int{16}
+}
+func (m *WorkflowPreviewPodsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowPreviewPodsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowPreviewPodsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowPreviewPodsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowPreviewPodsResponse.Merge(m, src)
+}
+func (m *WorkflowPreviewPodsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowPreviewPodsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowPreviewPodsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowPreviewPodsResponse proto.InternalMessageInfo
+
+func (m *WorkflowPreviewPodsResponse) GetPods() []*v11.Pod {
+	if m != nil {
+		return m.Pods
+	}
+	return nil
+}
+
+type WorkflowResetNodesRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// the ID of a node, or a glob matching the names of the nodes, e.g. `my-wf[1].build-*`
+	Selector             string   `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowResetNodesRequest) Reset()         { *m = WorkflowResetNodesRequest{} }
+func (m *WorkflowResetNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowResetNodesRequest) ProtoMessage()    {}
+func (*WorkflowResetNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192bc67c39cca05a, []int{17}
+}
+func (m *WorkflowResetNodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowResetNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowResetNodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowResetNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowResetNodesRequest.Merge(m, src)
+}
+func (m *WorkflowResetNodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowResetNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowResetNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowResetNodesRequest proto.InternalMessageInfo
+
+func (m *WorkflowResetNodesRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowResetNodesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowResetNodesRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+type WorkflowSetNodesPhaseRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// the ID of a node, or a glob matching the names of the nodes, e.g. `my-wf[1].build-*`
+	Selector string `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	// either Succeeded or Failed
+	Phase                string   `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowSetNodesPhaseRequest) Reset()         { *m = WorkflowSetNodesPhaseRequest{} }
+func (m *WorkflowSetNodesPhaseRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowSetNodesPhaseRequest) ProtoMessage()    {}
+func (*WorkflowSetNodesPhaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192bc67c39cca05a, []int{18}
+}
+func (m *WorkflowSetNodesPhaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowSetNodesPhaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowSetNodesPhaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowSetNodesPhaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowSetNodesPhaseRequest.Merge(m, src)
+}
+func (m *WorkflowSetNodesPhaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowSetNodesPhaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowSetNodesPhaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowSetNodesPhaseRequest proto.InternalMessageInfo
+
+func (m *WorkflowSetNodesPhaseRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowSetNodesPhaseRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowSetNodesPhaseRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *WorkflowSetNodesPhaseRequest) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *WorkflowSetNodesPhaseRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*WorkflowCreateRequest)(nil), "workflow.WorkflowCreateRequest")
 	proto.RegisterType((*WorkflowGetRequest)(nil), "workflow.WorkflowGetRequest")
@@ -918,78 +1174,97 @@ func init() {
 	proto.RegisterType((*WorkflowWatchEvent)(nil), "workflow.WorkflowWatchEvent")
 	proto.RegisterType((*LogEntry)(nil), "workflow.LogEntry")
 	proto.RegisterType((*WorkflowLintRequest)(nil), "workflow.WorkflowLintRequest")
+	proto.RegisterType((*WorkflowPreviewPodsRequest)(nil), "workflow.WorkflowPreviewPodsRequest")
+	proto.RegisterType((*WorkflowPreviewPodsResponse)(nil), "workflow.WorkflowPreviewPodsResponse")
+	proto.RegisterType((*WorkflowResetNodesRequest)(nil), "workflow.WorkflowResetNodesRequest")
+	proto.RegisterType((*WorkflowSetNodesPhaseRequest)(nil), "workflow.WorkflowSetNodesPhaseRequest")
 }
 
 func init() { proto.RegisterFile("cmd/server/workflow/workflow.proto", fileDescriptor_192bc67c39cca05a) }
 
 var fileDescriptor_192bc67c39cca05a = []byte{
-	// 1055 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xc0, 0x35, 0x69, 0x68, 0xb7, 0x2f, 0xcd, 0x16, 0x86, 0x02, 0x2b, 0x2b, 0xdd, 0xa6, 0x43,
-	0x0b, 0x51, 0x9a, 0xd8, 0xd9, 0xa4, 0x40, 0x89, 0xc4, 0xa1, 0x24, 0x55, 0x28, 0x5a, 0x41, 0xe5,
-	0x82, 0x50, 0xb9, 0x39, 0xde, 0xc1, 0x71, 0xb3, 0xf6, 0x18, 0x7b, 0x76, 0xab, 0xa5, 0x0a, 0x08,
-	0x4e, 0x88, 0x03, 0x17, 0x2e, 0x15, 0x1c, 0x01, 0x09, 0x09, 0x09, 0xc4, 0xb7, 0xe0, 0x88, 0xc4,
-	0x17, 0x40, 0x11, 0x12, 0x5f, 0x03, 0xcd, 0xd8, 0x33, 0xb6, 0xb3, 0xdb, 0x95, 0x1b, 0x57, 0xca,
-	0x6d, 0xfe, 0xec, 0xbc, 0xf7, 0xdb, 0xf7, 0xdf, 0x40, 0xdc, 0xa0, 0x67, 0x25, 0x34, 0x1e, 0xd2,
-	0xd8, 0x7a, 0xc0, 0xe2, 0xfd, 0x4f, 0xfa, 0xec, 0x81, 0x5e, 0x98, 0x51, 0xcc, 0x38, 0xc3, 0x0d,
-	0xb5, 0x37, 0x2e, 0x78, 0xcc, 0x63, 0xf2, 0xd0, 0x12, 0xab, 0xf4, 0xde, 0x58, 0xf0, 0x18, 0xf3,
-	0xfa, 0xd4, 0x72, 0x22, 0xdf, 0x72, 0xc2, 0x90, 0x71, 0x87, 0xfb, 0x2c, 0x4c, 0xb2, 0xdb, 0xeb,
-	0xfb, 0x37, 0x12, 0xd3, 0x67, 0xe2, 0x36, 0x70, 0xdc, 0x3d, 0x3f, 0xa4, 0xf1, 0xc8, 0x8a, 0xf6,
-	0x3d, 0x71, 0x90, 0x58, 0x01, 0xe5, 0x8e, 0x35, 0xec, 0x58, 0x1e, 0x0d, 0x69, 0xec, 0x70, 0xda,
-	0xcb, 0x5e, 0x6d, 0x79, 0x3e, 0xdf, 0x1b, 0xec, 0x9a, 0x2e, 0x0b, 0x2c, 0x27, 0x96, 0x4a, 0xef,
-	0xcb, 0x45, 0xfe, 0x54, 0xe3, 0x0e, 0x3b, 0x4e, 0x3f, 0xda, 0x73, 0xc6, 0x85, 0x90, 0x5c, 0xb5,
-	0xe5, 0xb2, 0x98, 0x4e, 0x50, 0x44, 0x7e, 0x9f, 0x81, 0x17, 0x3e, 0xca, 0x24, 0x6d, 0xc5, 0xd4,
-	0xe1, 0xd4, 0xa6, 0x9f, 0x0e, 0x68, 0xc2, 0xf1, 0x02, 0x9c, 0x0d, 0x9d, 0x80, 0x26, 0x91, 0xe3,
-	0xd2, 0x16, 0x5a, 0x44, 0x4b, 0x67, 0xed, 0xfc, 0x00, 0xdf, 0x03, 0x6d, 0x96, 0xd6, 0xcc, 0x22,
-	0x5a, 0x9a, 0x5b, 0x7f, 0xcb, 0xcc, 0x99, 0x4d, 0xc5, 0x2c, 0x17, 0x66, 0xb4, 0xef, 0x99, 0x82,
-	0xd9, 0xd4, 0x96, 0x55, 0xcc, 0xa6, 0xd2, 0x6d, 0x6b, 0x71, 0xb8, 0x0d, 0xe0, 0x87, 0x09, 0x77,
-	0x42, 0x97, 0xde, 0xde, 0x6e, 0x9d, 0x92, 0x9a, 0x0b, 0x27, 0x98, 0xc0, 0xb9, 0xd4, 0x63, 0xdb,
-	0xf1, 0xc8, 0x1e, 0x84, 0xad, 0xd9, 0x45, 0xb4, 0xd4, 0xb0, 0x4b, 0x67, 0xf8, 0x1e, 0xcc, 0xbb,
-	0xf2, 0xdf, 0xbc, 0x1f, 0x49, 0x67, 0xb4, 0x9e, 0x91, 0x8c, 0x1b, 0x66, 0x6a, 0x12, 0xb3, 0xe8,
-	0x8d, 0x1c, 0x4f, 0x78, 0xc3, 0x1c, 0x76, 0xcc, 0xad, 0xe2, 0x53, 0xbb, 0x2c, 0x89, 0x3c, 0x42,
-	0x80, 0x15, 0xf5, 0x0e, 0xe5, 0xca, 0x5c, 0x18, 0x66, 0x85, 0x75, 0x32, 0x4b, 0xc9, 0x75, 0xd9,
-	0x84, 0x33, 0x47, 0x4d, 0x78, 0x07, 0xc0, 0xa3, 0x5c, 0x01, 0x9e, 0x92, 0x80, 0x6b, 0xd5, 0x00,
-	0x77, 0xf4, 0x3b, 0xbb, 0x20, 0x83, 0x7c, 0x8d, 0xe0, 0x79, 0x85, 0xd6, 0xf5, 0x13, 0x5e, 0xcd,
-	0x95, 0x77, 0x61, 0xae, 0xef, 0x27, 0x1a, 0x24, 0xf5, 0x66, 0xa7, 0x1a, 0x48, 0x37, 0x7f, 0x68,
-	0x17, 0xa5, 0x10, 0x0f, 0x5e, 0xd2, 0xae, 0xa5, 0xc9, 0x60, 0x37, 0xf0, 0x6b, 0x58, 0xca, 0x80,
-	0x46, 0x40, 0x03, 0xe6, 0x7f, 0x46, 0x7b, 0xd2, 0x4e, 0x0d, 0x5b, 0xef, 0xc9, 0x3b, 0x70, 0x21,
-	0x57, 0xc4, 0xe3, 0xd1, 0xb1, 0xb5, 0x90, 0xdb, 0x79, 0x26, 0x08, 0xe4, 0x80, 0x1e, 0x5f, 0x54,
-	0x17, 0x5a, 0x4a, 0xd4, 0x07, 0x34, 0x0e, 0xfc, 0xb0, 0x90, 0x57, 0x4f, 0x2e, 0xed, 0x5d, 0x78,
-	0x51, 0x49, 0xbb, 0x3b, 0x48, 0x22, 0x1a, 0xf6, 0x8e, 0x2f, 0xeb, 0xc7, 0x42, 0xf4, 0x76, 0x99,
-	0x77, 0x7c, 0x9f, 0xb4, 0xe0, 0x4c, 0xc4, 0x7a, 0xef, 0x89, 0x47, 0x69, 0x8a, 0xaa, 0x2d, 0xbe,
-	0x09, 0xd0, 0x67, 0x9e, 0x0a, 0xa7, 0x59, 0x19, 0x4e, 0x97, 0x0b, 0xe1, 0x64, 0x8a, 0x5a, 0x24,
-	0x82, 0xe7, 0x0e, 0xeb, 0x75, 0xf5, 0x0f, 0xed, 0xc2, 0x23, 0xf2, 0x13, 0xca, 0x7d, 0xb1, 0x4d,
-	0xfb, 0xb4, 0x86, 0xf5, 0x44, 0x29, 0xe8, 0x49, 0x11, 0xe5, 0x4c, 0xab, 0x58, 0x0a, 0xb6, 0x8b,
-	0x4f, 0xed, 0xb2, 0x24, 0xd2, 0xca, 0x1d, 0xa3, 0x28, 0x93, 0x88, 0x85, 0x09, 0x25, 0xdf, 0x88,
-	0x3f, 0xe0, 0x70, 0x77, 0x4f, 0xdd, 0x27, 0x27, 0x98, 0x8b, 0x5f, 0xe4, 0x2e, 0x97, 0x4c, 0xb7,
-	0x86, 0x34, 0x94, 0x96, 0xe4, 0xa3, 0x48, 0x5b, 0x52, 0xac, 0xf1, 0x87, 0x70, 0x9a, 0xed, 0xde,
-	0xa7, 0x2e, 0x7f, 0x3a, 0x35, 0x3d, 0x13, 0x46, 0xae, 0x40, 0xa3, 0xcb, 0xbc, 0x5b, 0x21, 0x8f,
-	0x47, 0x22, 0x6e, 0x5c, 0x16, 0x72, 0x1a, 0xf2, 0x4c, 0xb3, 0xda, 0x92, 0x6f, 0x4b, 0xd5, 0x2b,
-	0xe4, 0x27, 0xdd, 0x88, 0xd6, 0xff, 0x6b, 0xc2, 0x79, 0x9d, 0x78, 0x34, 0x1e, 0xfa, 0x2e, 0xc5,
-	0xdf, 0x23, 0x68, 0xa6, 0xed, 0x41, 0xdd, 0xe0, 0x4b, 0xb9, 0xb4, 0x89, 0x9d, 0xd4, 0xa8, 0x07,
-	0x44, 0x96, 0xbe, 0xfa, 0xfb, 0xdf, 0xef, 0x66, 0x08, 0xb9, 0x28, 0x1b, 0xf9, 0xb0, 0xa3, 0x3b,
-	0x7f, 0x62, 0x3d, 0xd4, 0x76, 0x38, 0xd8, 0x44, 0xcb, 0xf8, 0x11, 0x82, 0xb9, 0x1d, 0xca, 0x35,
-	0xd9, 0xc2, 0x38, 0x59, 0xde, 0xb1, 0xea, 0x62, 0xad, 0x48, 0xac, 0x57, 0xf0, 0x95, 0xa9, 0x58,
-	0xe9, 0xfa, 0x40, 0xa0, 0xcd, 0x8b, 0x00, 0xd5, 0xf9, 0x80, 0x2f, 0x8e, 0xc3, 0x15, 0x7a, 0x96,
-	0x71, 0xb3, 0x16, 0x9d, 0x90, 0x44, 0xae, 0x4a, 0xc2, 0x4b, 0x78, 0xba, 0xe1, 0xf0, 0xe7, 0xd0,
-	0x2c, 0xa7, 0x6a, 0xc9, 0xa3, 0x93, 0x92, 0xd8, 0x98, 0x60, 0xd8, 0x3c, 0xb3, 0xc8, 0x35, 0xa9,
-	0xf7, 0x2a, 0x7e, 0xf9, 0xa8, 0xde, 0x55, 0x2a, 0xee, 0x4b, 0xda, 0xd7, 0x10, 0xfe, 0x12, 0x41,
-	0x33, 0x2d, 0x1f, 0xd3, 0x42, 0xaa, 0x54, 0x06, 0x8d, 0xc5, 0xc7, 0xff, 0x20, 0xab, 0x40, 0x99,
-	0x7b, 0x96, 0xab, 0xb9, 0xe7, 0x67, 0x04, 0xf3, 0xb2, 0x7d, 0x6a, 0x84, 0xf6, 0xb8, 0x86, 0x62,
-	0x7f, 0xad, 0x1b, 0x3d, 0xaf, 0x49, 0x3c, 0xcb, 0x58, 0xae, 0x82, 0x67, 0xc5, 0x42, 0xb3, 0x88,
-	0xf0, 0xdf, 0x10, 0x3c, 0xab, 0xe6, 0x09, 0x8d, 0x7a, 0x79, 0x12, 0x6a, 0x69, 0xe6, 0xa8, 0x4b,
-	0x7b, 0x43, 0xd2, 0xae, 0x1b, 0xab, 0x15, 0x69, 0x53, 0xe5, 0x02, 0xf8, 0x17, 0x04, 0xcd, 0x74,
-	0x9a, 0x98, 0xe6, 0xdc, 0xd2, 0xbc, 0x51, 0x17, 0xf6, 0x75, 0x09, 0xbb, 0x66, 0x5c, 0xab, 0x0c,
-	0x1b, 0x50, 0x81, 0xfa, 0x2b, 0x82, 0xf3, 0xd9, 0x7c, 0xa1, 0x59, 0x27, 0xc4, 0x59, 0x79, 0x04,
-	0xa9, 0x0b, 0xfb, 0x86, 0x84, 0xed, 0x18, 0x2b, 0x95, 0x60, 0x93, 0x54, 0xb7, 0xa0, 0xfd, 0x03,
-	0xc1, 0x73, 0x7a, 0xb6, 0xd2, 0xbc, 0x64, 0x9c, 0xf7, 0xe8, 0x00, 0x56, 0x97, 0xf8, 0x4d, 0x49,
-	0xbc, 0x61, 0x98, 0x95, 0x88, 0xb9, 0xd2, 0x2e, 0x98, 0x7f, 0x40, 0x70, 0x4e, 0x74, 0x36, 0x8d,
-	0x3b, 0xb1, 0x06, 0x86, 0x4f, 0x2b, 0x6a, 0x57, 0x25, 0xe9, 0xab, 0x84, 0x4c, 0x27, 0xed, 0xfb,
-	0xa1, 0x0c, 0xd5, 0x11, 0x9c, 0x49, 0x27, 0xb2, 0x64, 0x52, 0xe3, 0xc8, 0x87, 0x45, 0x03, 0xe7,
-	0xb7, 0xaa, 0xad, 0x93, 0x4d, 0xa9, 0xeb, 0x3a, 0x5e, 0xaf, 0x64, 0x95, 0x87, 0xd9, 0xac, 0x78,
-	0x60, 0xf5, 0x99, 0xb7, 0x86, 0xde, 0xde, 0xfc, 0xf3, 0xb0, 0x8d, 0xfe, 0x3a, 0x6c, 0xa3, 0x7f,
-	0x0e, 0xdb, 0xe8, 0xe3, 0x95, 0xc7, 0x7e, 0xfc, 0x4e, 0xf8, 0x5a, 0xdf, 0x3d, 0x2d, 0x3f, 0x64,
-	0x37, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x97, 0x66, 0x05, 0xcb, 0x0f, 0x00, 0x00,
+	// 1294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x98, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x35, 0x69, 0xda, 0x26, 0x93, 0x36, 0x85, 0x69, 0x4b, 0x17, 0x93, 0xa6, 0xe9, 0xf4,
+	0x07, 0x51, 0xdb, 0xd8, 0xd9, 0x34, 0x85, 0x36, 0x08, 0xa1, 0x92, 0x44, 0xa1, 0xd5, 0xaa, 0x44,
+	0x4e, 0x11, 0x2a, 0x37, 0xc7, 0xfb, 0x70, 0xdc, 0xac, 0x3d, 0xc6, 0x33, 0xd9, 0x68, 0xa9, 0x02,
+	0x82, 0x13, 0xe2, 0xc0, 0x85, 0x4b, 0xf9, 0x71, 0x03, 0x24, 0x24, 0x24, 0x10, 0x07, 0xce, 0x5c,
+	0x39, 0x22, 0xf1, 0x0f, 0xa0, 0x88, 0xbf, 0x02, 0x09, 0x09, 0xcd, 0xf8, 0x77, 0xd6, 0x49, 0x4d,
+	0x5c, 0x94, 0xdb, 0x8c, 0x67, 0xe7, 0xbd, 0x8f, 0xdf, 0x7b, 0xf3, 0xe6, 0xeb, 0xc5, 0xd4, 0xf6,
+	0xda, 0x06, 0x87, 0xb0, 0x0b, 0xa1, 0xb1, 0xc9, 0xc2, 0xf5, 0x77, 0x3b, 0x6c, 0x33, 0x1d, 0xe8,
+	0x41, 0xc8, 0x04, 0x23, 0x43, 0xc9, 0x5c, 0x3b, 0xe5, 0x30, 0x87, 0xa9, 0x87, 0x86, 0x1c, 0x45,
+	0xeb, 0xda, 0x98, 0xc3, 0x98, 0xd3, 0x01, 0xc3, 0x0a, 0x5c, 0xc3, 0xf2, 0x7d, 0x26, 0x2c, 0xe1,
+	0x32, 0x9f, 0xc7, 0xab, 0xb3, 0xeb, 0x37, 0xb9, 0xee, 0x32, 0xb9, 0xea, 0x59, 0xf6, 0x9a, 0xeb,
+	0x43, 0xd8, 0x33, 0x82, 0x75, 0x47, 0x3e, 0xe0, 0x86, 0x07, 0xc2, 0x32, 0xba, 0x4d, 0xc3, 0x01,
+	0x1f, 0x42, 0x4b, 0x40, 0x3b, 0xde, 0x35, 0xef, 0xb8, 0x62, 0x6d, 0x63, 0x55, 0xb7, 0x99, 0x67,
+	0x58, 0xa1, 0x72, 0xfa, 0x50, 0x0d, 0xb2, 0xad, 0x29, 0x6e, 0xb7, 0x69, 0x75, 0x82, 0x35, 0xab,
+	0xdf, 0x08, 0xcd, 0x5c, 0x1b, 0x36, 0x0b, 0xa1, 0xc4, 0x11, 0xfd, 0x69, 0x00, 0x9f, 0x7e, 0x3b,
+	0xb6, 0x34, 0x1f, 0x82, 0x25, 0xc0, 0x84, 0xf7, 0x36, 0x80, 0x0b, 0x32, 0x86, 0x87, 0x7d, 0xcb,
+	0x03, 0x1e, 0x58, 0x36, 0x34, 0xd0, 0x04, 0x9a, 0x1c, 0x36, 0xb3, 0x07, 0xe4, 0x01, 0x4e, 0xc3,
+	0xd2, 0x18, 0x98, 0x40, 0x93, 0x23, 0x33, 0xaf, 0xea, 0x19, 0xb3, 0x9e, 0x30, 0xab, 0x81, 0x1e,
+	0xac, 0x3b, 0xba, 0x64, 0xd6, 0xd3, 0xc8, 0x26, 0xcc, 0x7a, 0xe2, 0xdb, 0x4c, 0xcd, 0x91, 0x71,
+	0x8c, 0x5d, 0x9f, 0x0b, 0xcb, 0xb7, 0xe1, 0xce, 0x42, 0xe3, 0x90, 0xf2, 0x9c, 0x7b, 0x42, 0x28,
+	0x3e, 0x16, 0x65, 0x6c, 0x21, 0xec, 0x99, 0x1b, 0x7e, 0x63, 0x70, 0x02, 0x4d, 0x0e, 0x99, 0x85,
+	0x67, 0xe4, 0x01, 0x3e, 0x6e, 0xab, 0xb7, 0x79, 0x33, 0x50, 0xc9, 0x68, 0x1c, 0x56, 0x8c, 0xd7,
+	0xf5, 0x28, 0x24, 0x7a, 0x3e, 0x1b, 0x19, 0x9e, 0xcc, 0x86, 0xde, 0x6d, 0xea, 0xf3, 0xf9, 0xad,
+	0x66, 0xd1, 0x12, 0x7d, 0x8c, 0x30, 0x49, 0xa8, 0x97, 0x40, 0x24, 0xe1, 0x22, 0x78, 0x50, 0x46,
+	0x27, 0x8e, 0x94, 0x1a, 0x17, 0x43, 0x38, 0xb0, 0x33, 0x84, 0xcb, 0x18, 0x3b, 0x20, 0x12, 0xc0,
+	0x43, 0x0a, 0x70, 0xba, 0x1a, 0xe0, 0x52, 0xba, 0xcf, 0xcc, 0xd9, 0xa0, 0x9f, 0x20, 0x7c, 0x32,
+	0x41, 0x6b, 0xb9, 0x5c, 0x54, 0x4b, 0xe5, 0x0a, 0x1e, 0xe9, 0xb8, 0x3c, 0x05, 0x89, 0xb2, 0xd9,
+	0xac, 0x06, 0xd2, 0xca, 0x36, 0x9a, 0x79, 0x2b, 0xd4, 0xc1, 0x67, 0xd2, 0xd4, 0x02, 0xdf, 0x58,
+	0xf5, 0xdc, 0x1a, 0x91, 0xd2, 0xf0, 0x90, 0x07, 0x1e, 0x73, 0xdf, 0x87, 0xb6, 0x8a, 0xd3, 0x90,
+	0x99, 0xce, 0xe9, 0x1b, 0xf8, 0x54, 0xe6, 0x48, 0x84, 0xbd, 0x7d, 0x7b, 0xa1, 0x77, 0xf0, 0xe9,
+	0x3c, 0xb2, 0x07, 0xfb, 0x37, 0xd5, 0xc2, 0x8d, 0xc4, 0xd4, 0x7d, 0x08, 0x3d, 0xd7, 0xb7, 0x44,
+	0x0d, 0x6b, 0x77, 0xf1, 0x73, 0x89, 0xb5, 0x95, 0x0d, 0x1e, 0x80, 0xdf, 0xde, 0xbf, 0xad, 0x6f,
+	0x72, 0xd5, 0xdb, 0x62, 0xce, 0xfe, 0x73, 0xd2, 0xc0, 0x47, 0x03, 0xd6, 0xbe, 0x27, 0x37, 0x45,
+	0x47, 0x34, 0x99, 0x92, 0xdb, 0x18, 0x77, 0x98, 0x93, 0x94, 0xd3, 0xa0, 0x2a, 0xa7, 0xf3, 0xb9,
+	0x72, 0xd2, 0x65, 0x2f, 0x92, 0xc5, 0xb3, 0xcc, 0xda, 0xad, 0xf4, 0x87, 0x66, 0x6e, 0x13, 0xfd,
+	0x16, 0x65, 0xb9, 0x58, 0x80, 0x0e, 0xd4, 0x88, 0x9e, 0x6c, 0x05, 0x6d, 0x65, 0xa2, 0x78, 0xd2,
+	0x2a, 0xb6, 0x82, 0x85, 0xfc, 0x56, 0xb3, 0x68, 0x89, 0x36, 0xb2, 0xc4, 0x24, 0x94, 0x3c, 0x60,
+	0x3e, 0x07, 0xfa, 0xa9, 0x7c, 0x01, 0x4b, 0xd8, 0x6b, 0xc9, 0x3a, 0x3f, 0xc0, 0xb3, 0xf8, 0x61,
+	0x96, 0x72, 0xc5, 0xb4, 0xd8, 0x05, 0x5f, 0x45, 0x52, 0xf4, 0x82, 0x34, 0x92, 0x72, 0x4c, 0xde,
+	0xc2, 0x47, 0xd8, 0xea, 0x43, 0xb0, 0xc5, 0xd3, 0xe9, 0xe9, 0xb1, 0x31, 0x7a, 0x11, 0x0f, 0xb5,
+	0x98, 0xb3, 0xe8, 0x8b, 0xb0, 0x27, 0xeb, 0xc6, 0x66, 0xbe, 0x00, 0x5f, 0xc4, 0x9e, 0x93, 0x29,
+	0xfd, 0xac, 0xd0, 0xbd, 0x7c, 0x71, 0xd0, 0x17, 0x11, 0xfd, 0x1b, 0x61, 0x2d, 0x79, 0xbc, 0x1c,
+	0x42, 0xd7, 0x85, 0xcd, 0x65, 0xd6, 0xe6, 0x07, 0x7e, 0x41, 0xda, 0x78, 0x58, 0x80, 0x17, 0x74,
+	0x2c, 0x01, 0xb2, 0x9a, 0x0f, 0x4d, 0x8e, 0xcc, 0x2c, 0xd6, 0xb2, 0x7d, 0x3f, 0xb6, 0x66, 0x66,
+	0x76, 0xe9, 0x5d, 0xfc, 0x42, 0xe9, 0xbb, 0x47, 0x05, 0x4e, 0xae, 0xe2, 0xc1, 0x80, 0xb5, 0x79,
+	0x03, 0x29, 0xf7, 0x67, 0x76, 0x39, 0xde, 0xa6, 0xfa, 0x11, 0x75, 0xf1, 0xf3, 0xb9, 0xce, 0x0a,
+	0xe2, 0x1e, 0x6b, 0x03, 0xaf, 0x75, 0x1d, 0x70, 0xe8, 0x80, 0x2d, 0x58, 0x18, 0xf7, 0x9e, 0x74,
+	0x4e, 0xbf, 0x46, 0x78, 0x2c, 0x6d, 0x96, 0xb1, 0xa7, 0xe5, 0x35, 0x8b, 0xc3, 0xff, 0xe2, 0x8e,
+	0x9c, 0xc2, 0x87, 0x03, 0x69, 0x5d, 0xb5, 0xb9, 0x61, 0x33, 0x9a, 0xc8, 0x1a, 0xf7, 0x80, 0x73,
+	0xcb, 0x01, 0xa5, 0x3b, 0x86, 0xcd, 0x64, 0x3a, 0xf3, 0x0f, 0xc1, 0x27, 0x32, 0xbc, 0xb0, 0xeb,
+	0xda, 0x40, 0xbe, 0x44, 0x78, 0x34, 0x52, 0x1c, 0xc9, 0x0a, 0x39, 0x97, 0x25, 0xab, 0x54, 0x9c,
+	0x69, 0xf5, 0x6a, 0x89, 0x4e, 0x7e, 0xfc, 0xc7, 0x5f, 0x9f, 0x0f, 0x50, 0x7a, 0x56, 0x69, 0xc3,
+	0x6e, 0x33, 0x15, 0x93, 0xdc, 0x78, 0x94, 0xbe, 0xf8, 0xd6, 0x1c, 0xba, 0x42, 0x1e, 0x23, 0x3c,
+	0xb2, 0x04, 0x22, 0x25, 0x1b, 0xeb, 0x27, 0xcb, 0x44, 0x50, 0x5d, 0xac, 0x6b, 0x0a, 0xeb, 0x32,
+	0xb9, 0xb8, 0x27, 0x56, 0x34, 0xde, 0x92, 0x68, 0xc7, 0x65, 0xcf, 0x4b, 0xb6, 0x73, 0x72, 0xb6,
+	0x1f, 0x2e, 0x27, 0x83, 0xb4, 0xdb, 0xb5, 0xe8, 0xa4, 0x25, 0x7a, 0x49, 0x11, 0x9e, 0x23, 0x7b,
+	0x07, 0x8e, 0x7c, 0x80, 0x47, 0x8b, 0xdd, 0xbf, 0x90, 0xd1, 0xb2, 0x7b, 0x41, 0x2b, 0x09, 0x6c,
+	0xd6, 0xac, 0xe9, 0x55, 0xe5, 0xf7, 0x12, 0xb9, 0xb0, 0xd3, 0xef, 0x14, 0xc8, 0xf5, 0x82, 0xf7,
+	0x69, 0x44, 0x3e, 0x42, 0x78, 0x34, 0xba, 0x91, 0xf6, 0x2a, 0xa9, 0xc2, 0xcd, 0xaa, 0x4d, 0xec,
+	0xfe, 0x83, 0xf8, 0x52, 0x8b, 0xd3, 0x73, 0xa5, 0x5a, 0x7a, 0xbe, 0x43, 0xf8, 0xb8, 0x52, 0x64,
+	0x29, 0xc2, 0x78, 0xbf, 0x87, 0xbc, 0x64, 0xab, 0x5b, 0x3d, 0x37, 0x14, 0x9e, 0xa1, 0x5d, 0xa9,
+	0x82, 0x67, 0x84, 0xd2, 0xb3, 0xac, 0xf0, 0x1f, 0x11, 0x7e, 0x26, 0x91, 0xa8, 0x29, 0xea, 0xf9,
+	0x32, 0xd4, 0x82, 0x8c, 0xad, 0x4b, 0x7b, 0x53, 0xd1, 0xce, 0x68, 0x53, 0x15, 0x69, 0x23, 0xe7,
+	0x12, 0xf8, 0x7b, 0x84, 0x47, 0x23, 0x81, 0xba, 0x57, 0x72, 0x0b, 0x12, 0xb6, 0x2e, 0xec, 0x4b,
+	0x0a, 0x76, 0x5a, 0xbb, 0x5a, 0x19, 0xd6, 0x03, 0x89, 0xfa, 0x03, 0xc2, 0x27, 0x62, 0xc9, 0x9a,
+	0xb2, 0x96, 0xd4, 0x59, 0x51, 0xd5, 0xd6, 0x85, 0x7d, 0x59, 0xc1, 0x36, 0xb5, 0x6b, 0x95, 0x60,
+	0x79, 0xe4, 0x5b, 0xd2, 0xfe, 0x8c, 0xf0, 0xb3, 0xa9, 0x5c, 0x4f, 0x79, 0x69, 0x3f, 0xef, 0x4e,
+	0x4d, 0x5f, 0x97, 0xf8, 0x96, 0x22, 0xbe, 0xae, 0xe9, 0x95, 0x88, 0x45, 0xe2, 0x5d, 0x32, 0x7f,
+	0x85, 0xf0, 0x31, 0x29, 0x96, 0x52, 0xdc, 0xd2, 0x1e, 0xe8, 0x3f, 0xad, 0xaa, 0x9d, 0x52, 0xa4,
+	0x2f, 0x52, 0xba, 0x37, 0x69, 0xc7, 0xf5, 0x55, 0xa9, 0x7e, 0x81, 0xf0, 0xc9, 0x58, 0x3d, 0xa4,
+	0x62, 0x82, 0xb5, 0x39, 0xb9, 0xd8, 0x0f, 0xd9, 0x2f, 0xb0, 0xb4, 0x4b, 0x4f, 0xf8, 0x55, 0xdc,
+	0x96, 0xe2, 0x73, 0x4f, 0x9f, 0x70, 0xee, 0x83, 0x68, 0xeb, 0x94, 0x54, 0x24, 0x92, 0xed, 0x17,
+	0x84, 0x89, 0x52, 0x23, 0x89, 0x6d, 0xa5, 0x15, 0xc8, 0x85, 0xd2, 0xa3, 0x54, 0xd4, 0x2c, 0x75,
+	0xa3, 0xf8, 0x8a, 0x22, 0xbe, 0xa1, 0x4d, 0x57, 0xca, 0xb7, 0x2f, 0x3d, 0xcb, 0x43, 0x05, 0x2a,
+	0xa6, 0xbf, 0x22, 0x7c, 0x7a, 0x65, 0x07, 0xb5, 0x52, 0x38, 0xe4, 0x72, 0xc9, 0xc9, 0x2a, 0x91,
+	0x40, 0x75, 0xe9, 0x5f, 0x53, 0xf4, 0xb7, 0xb4, 0xd9, 0xff, 0x40, 0xcf, 0x41, 0x4c, 0x29, 0x6d,
+	0x24, 0xdf, 0xa0, 0x87, 0x8f, 0x46, 0x9f, 0x7e, 0xbc, 0x4c, 0x4e, 0x64, 0x5f, 0xa5, 0x1a, 0xc9,
+	0x56, 0x93, 0xef, 0x07, 0x3a, 0xa7, 0xbc, 0xcf, 0x92, 0x99, 0x4a, 0xde, 0x1f, 0xc5, 0x1f, 0xa5,
+	0x5b, 0x46, 0x87, 0x39, 0xd3, 0xe8, 0xf5, 0xb9, 0xdf, 0xb6, 0xc7, 0xd1, 0xef, 0xdb, 0xe3, 0xe8,
+	0xcf, 0xed, 0x71, 0xf4, 0xce, 0xb5, 0x5d, 0xff, 0x65, 0x2b, 0xf9, 0x5b, 0x70, 0xf5, 0x88, 0xfa,
+	0xc7, 0xec, 0xfa, 0xbf, 0x03, 0x00, 0xe0, 0x33, 0xfd, 0x5c, 0x34, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1015,6 +1290,9 @@ type WorkflowServiceClient interface {
 	SuspendWorkflow(ctx context.Context, in *WorkflowSuspendRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	TerminateWorkflow(ctx context.Context, in *WorkflowTerminateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	PreviewWorkflowPods(ctx context.Context, in *WorkflowPreviewPodsRequest, opts ...grpc.CallOption) (*WorkflowPreviewPodsResponse, error)
+	ResetWorkflowNodes(ctx context.Context, in *WorkflowResetNodesRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	SetWorkflowNodesPhase(ctx context.Context, in *WorkflowSetNodesPhaseRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error)
 }
 
@@ -1148,6 +1426,33 @@ func (c *workflowServiceClient) LintWorkflow(ctx context.Context, in *WorkflowLi
 	return out, nil
 }
 
+func (c *workflowServiceClient) PreviewWorkflowPods(ctx context.Context, in *WorkflowPreviewPodsRequest, opts ...grpc.CallOption) (*WorkflowPreviewPodsResponse, error) {
+	out := new(WorkflowPreviewPodsResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/PreviewWorkflowPods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ResetWorkflowNodes(ctx context.Context, in *WorkflowResetNodesRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ResetWorkflowNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) SetWorkflowNodesPhase(ctx context.Context, in *WorkflowSetNodesPhaseRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/SetWorkflowNodesPhase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[1], "/workflow.WorkflowService/PodLogs", opts...)
 	if err != nil {
@@ -1193,6 +1498,9 @@ type WorkflowServiceServer interface {
 	SuspendWorkflow(context.Context, *WorkflowSuspendRequest) (*v1alpha1.Workflow, error)
 	TerminateWorkflow(context.Context, *WorkflowTerminateRequest) (*v1alpha1.Workflow, error)
 	LintWorkflow(context.Context, *WorkflowLintRequest) (*v1alpha1.Workflow, error)
+	PreviewWorkflowPods(context.Context, *WorkflowPreviewPodsRequest) (*WorkflowPreviewPodsResponse, error)
+	ResetWorkflowNodes(context.Context, *WorkflowResetNodesRequest) (*v1alpha1.Workflow, error)
+	SetWorkflowNodesPhase(context.Context, *WorkflowSetNodesPhaseRequest) (*v1alpha1.Workflow, error)
 	PodLogs(*WorkflowLogRequest, WorkflowService_PodLogsServer) error
 }
 
@@ -1233,6 +1541,15 @@ func (*UnimplementedWorkflowServiceServer) TerminateWorkflow(ctx context.Context
 func (*UnimplementedWorkflowServiceServer) LintWorkflow(ctx context.Context, req *WorkflowLintRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LintWorkflow not implemented")
 }
+func (*UnimplementedWorkflowServiceServer) PreviewWorkflowPods(ctx context.Context, req *WorkflowPreviewPodsRequest) (*WorkflowPreviewPodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewWorkflowPods not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) ResetWorkflowNodes(ctx context.Context, req *WorkflowResetNodesRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetWorkflowNodes not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) SetWorkflowNodesPhase(ctx context.Context, req *WorkflowSetNodesPhaseRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkflowNodesPhase not implemented")
+}
 func (*UnimplementedWorkflowServiceServer) PodLogs(req *WorkflowLogRequest, srv WorkflowService_PodLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method PodLogs not implemented")
 }
@@ -1442,6 +1759,60 @@ func _WorkflowService_LintWorkflow_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_PreviewWorkflowPods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowPreviewPodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).PreviewWorkflowPods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/PreviewWorkflowPods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).PreviewWorkflowPods(ctx, req.(*WorkflowPreviewPodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ResetWorkflowNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowResetNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ResetWorkflowNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ResetWorkflowNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ResetWorkflowNodes(ctx, req.(*WorkflowResetNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_SetWorkflowNodesPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowSetNodesPhaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SetWorkflowNodesPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/SetWorkflowNodesPhase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SetWorkflowNodesPhase(ctx, req.(*WorkflowSetNodesPhaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_PodLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(WorkflowLogRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1507,6 +1878,18 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LintWorkflow",
 			Handler:    _WorkflowService_LintWorkflow_Handler,
 		},
+		{
+			MethodName: "PreviewWorkflowPods",
+			Handler:    _WorkflowService_PreviewWorkflowPods_Handler,
+		},
+		{
+			MethodName: "ResetWorkflowNodes",
+			Handler:    _WorkflowService_ResetWorkflowNodes_Handler,
+		},
+		{
+			MethodName: "SetWorkflowNodesPhase",
+			Handler:    _WorkflowService_SetWorkflowNodesPhase_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2224,30 +2607,241 @@ func (m *WorkflowLintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflow(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WorkflowPreviewPodsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WorkflowCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *WorkflowPreviewPodsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowPreviewPodsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Workflow != nil {
-		l = m.Workflow.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowPreviewPodsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowPreviewPodsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowPreviewPodsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Pods) > 0 {
+		for iNdEx := len(m.Pods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowResetNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowResetNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowResetNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowSetNodesPhaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowSetNodesPhaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowSetNodesPhaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WorkflowCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
 	}
 	l = len(m.InstanceID)
 	if l > 0 {
@@ -2553,6 +3147,106 @@ func (m *WorkflowLintRequest) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowPreviewPodsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowPreviewPodsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pods) > 0 {
+		for _, e := range m.Pods {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowResetNodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowSetNodesPhaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkflow(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4501,6 +5195,614 @@ func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowPreviewPodsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowPreviewPodsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowPreviewPodsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Workflow == nil {
+				m.Workflow = &v1alpha1.Workflow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, &v1alpha1.WorkflowTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowPreviewPodsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowPreviewPodsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowPreviewPodsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pods = append(m.Pods, &v11.Pod{})
+			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowResetNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowResetNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowResetNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowSetNodesPhaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowSetNodesPhaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowSetNodesPhaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflow(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
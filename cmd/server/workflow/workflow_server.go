@@ -7,11 +7,15 @@ import (
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"github.com/argoproj/argo"
 	"github.com/argoproj/argo/cmd/server/auth"
 	"github.com/argoproj/argo/persist/sqldb"
 	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/controller"
 	"github.com/argoproj/argo/workflow/packer"
 	"github.com/argoproj/argo/workflow/templateresolution"
 	"github.com/argoproj/argo/workflow/util"
@@ -20,11 +24,20 @@ import (
 
 type workflowServer struct {
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
+	// clusterPods gets the pods of the other clusters the pods of templates run in
+	clusterPods util.PodsGetter
+	// controllerConfig gets the current configuration of the workflow controller
+	controllerConfig func() (*config.WorkflowControllerConfig, error)
 }
 
-func NewWorkflowServer(offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo) WorkflowServiceServer {
+// NewWorkflowServer returns the workflow server. The pods of the other clusters are got with clusterPods, which may be
+// nil if there is none. The configuration of the controller is got with controllerConfig whenever pods are previewed,
+// so that they are built like the controller builds them.
+func NewWorkflowServer(offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, clusterPods util.PodsGetter, controllerConfig func() (*config.WorkflowControllerConfig, error)) WorkflowServiceServer {
 	return &workflowServer{
 		offloadNodeStatusRepo: offloadNodeStatusRepo,
+		clusterPods:           clusterPods,
+		controllerConfig:      controllerConfig,
 	}
 }
 
@@ -275,6 +288,85 @@ func (s *workflowServer) LintWorkflow(ctx context.Context, req *WorkflowLintRequ
 	return req.Workflow, nil
 }
 
+// PreviewWorkflowPods returns the pods the controller would create for the first steps of a workflow, which is not
+// created. The workflow templates of the request take precedence over those of the namespace.
+func (s *workflowServer) PreviewWorkflowPods(ctx context.Context, req *WorkflowPreviewPodsRequest) (*WorkflowPreviewPodsResponse, error) {
+	if req.Workflow == nil {
+		return nil, fmt.Errorf("workflow body not specified")
+	}
+	wfClient := auth.GetWfClient(ctx)
+	if req.Workflow.Namespace == "" {
+		req.Workflow.Namespace = req.Namespace
+	}
+
+	var wftmpls []v1alpha1.WorkflowTemplate
+	submitted := make(map[string]bool)
+	for _, wftmpl := range req.Templates {
+		wftmpls = append(wftmpls, *wftmpl)
+		submitted[wftmpl.Name] = true
+	}
+	list, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Workflow.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, wftmpl := range list.Items {
+		if !submitted[wftmpl.Name] {
+			wftmpls = append(wftmpls, wftmpl)
+		}
+	}
+
+	controllerConfig, err := s.controllerConfig()
+	if err != nil {
+		return nil, err
+	}
+	if controllerConfig.ExecutorImage == "" {
+		// the controller takes its default executor image from its command line
+		controllerConfig.ExecutorImage = "argoproj/argoexec:" + argo.GetVersion().Version
+	}
+	pods, err := controller.PreviewPods(req.Workflow, wftmpls, *controllerConfig)
+	if err != nil {
+		return nil, err
+	}
+	resp := &WorkflowPreviewPodsResponse{}
+	for i := range pods {
+		pods[i].TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"}
+		resp.Pods = append(resp.Pods, &pods[i])
+	}
+	return resp, nil
+}
+
+func (s *workflowServer) ResetWorkflowNodes(ctx context.Context, req *WorkflowResetNodesRequest) (*v1alpha1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Get(req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return util.ResetWorkflowNodes(s.podsGetter(ctx), wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wf, req.Selector)
+}
+
+func (s *workflowServer) SetWorkflowNodesPhase(ctx context.Context, req *WorkflowSetNodesPhaseRequest) (*v1alpha1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Get(req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return util.SetWorkflowNodesPhase(s.podsGetter(ctx), wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wf, req.Selector, v1alpha1.NodePhase(req.Phase), req.Message)
+}
+
+// podsGetter returns the getter of the pods of the workflows, which are got with the client of the request in the
+// cluster of the server, and with the clients of the other clusters otherwise
+func (s *workflowServer) podsGetter(ctx context.Context) util.PodsGetter {
+	pods := util.NewPodsGetter(auth.GetKubeClient(ctx))
+	return func(cluster, namespace string) (corev1.PodInterface, error) {
+		if cluster == "" || s.clusterPods == nil {
+			return pods(cluster, namespace)
+		}
+		return s.clusterPods(cluster, namespace)
+	}
+}
+
 func (s *workflowServer) PodLogs(req *WorkflowLogRequest, ws WorkflowService_PodLogsServer) error {
 	kubeClient := auth.GetKubeClient(ws.Context())
 	stream, err := kubeClient.CoreV1().Pods(req.Namespace).GetLogs(req.PodName, req.LogOptions).Stream()
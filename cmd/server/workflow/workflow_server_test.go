@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/kubernetes/fake"
@@ -18,6 +20,7 @@ import (
 	"github.com/argoproj/argo/persist/sqldb/mocks"
 	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	v1alpha "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo/workflow/config"
 )
 
 const wf1 = `
@@ -367,7 +370,10 @@ func getWorkflowServer() (WorkflowServiceServer, context.Context) {
 	offloadNodeStatusRepo := &mocks.OffloadNodeStatusRepo{}
 	offloadNodeStatusRepo.On("IsEnabled", mock.Anything).Return(true)
 	offloadNodeStatusRepo.On("List", mock.Anything).Return(map[sqldb.UUIDVersion]v1alpha1.Nodes{}, nil)
-	server := NewWorkflowServer(offloadNodeStatusRepo)
+	controllerConfig := func() (*config.WorkflowControllerConfig, error) {
+		return &config.WorkflowControllerConfig{ExecutorImage: "executor:latest"}, nil
+	}
+	server := NewWorkflowServer(offloadNodeStatusRepo, nil, controllerConfig)
 	kubeClientSet := fake.NewSimpleClientset()
 	wfClientset := v1alpha.NewSimpleClientset(&wfObj1, &wfObj2, &wfObj3, &wfObj4, &wfObj5)
 	wfClientset.PrependReactor("create", "workflows", generateNameReactor)
@@ -552,3 +558,79 @@ func TestResubmitWorkflow(t *testing.T) {
 		assert.NotNil(t, wf)
 	}
 }
+
+func TestResetWorkflowNodes(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	wf, err := server.ResetWorkflowNodes(ctx, &WorkflowResetNodesRequest{
+		Name:      "hello-world-9tql2",
+		Namespace: "workflows",
+		Selector:  "hello-world-9tql2",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, v1alpha1.NodeRunning, wf.Status.Phase)
+		assert.Empty(t, wf.Status.Nodes)
+	}
+
+	_, err = server.ResetWorkflowNodes(ctx, &WorkflowResetNodesRequest{
+		Name:      "hello-world-9tql2",
+		Namespace: "workflows",
+		Selector:  "NotFound",
+	})
+	assert.Error(t, err)
+}
+
+func TestSetWorkflowNodesPhase(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	wf, err := server.SetWorkflowNodesPhase(ctx, &WorkflowSetNodesPhaseRequest{
+		Name:      "hello-world-9tql2",
+		Namespace: "workflows",
+		Selector:  "hello-world-9tql2",
+		Phase:     string(v1alpha1.NodeFailed),
+		Message:   "failed by hand",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, v1alpha1.NodeRunning, wf.Status.Phase)
+		assert.Equal(t, v1alpha1.NodeFailed, wf.Status.Nodes["hello-world-9tql2"].Phase)
+		assert.Equal(t, "failed by hand", wf.Status.Nodes["hello-world-9tql2"].Message)
+	}
+}
+
+func TestPreviewWorkflowPods(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	wf := &v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: "preview-"},
+		Spec: v1alpha1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates: []v1alpha1.Template{{
+				Name: "main",
+				Steps: [][]v1alpha1.WorkflowStep{{
+					{Name: "a", TemplateRef: &v1alpha1.TemplateRef{Name: "whalesay-template", Template: "whalesay"}},
+				}},
+			}},
+		},
+	}
+	wftmpl := &v1alpha1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "whalesay-template"},
+		Spec: v1alpha1.WorkflowTemplateSpec{Templates: []v1alpha1.Template{{
+			Name:      "whalesay",
+			Container: &apiv1.Container{Image: "docker/whalesay:latest"},
+		}}},
+	}
+
+	resp, err := server.PreviewWorkflowPods(ctx, &WorkflowPreviewPodsRequest{Namespace: "workflows", Workflow: wf, Templates: []*v1alpha1.WorkflowTemplate{wftmpl}})
+	if assert.NoError(t, err) && assert.Len(t, resp.Pods, 1) {
+		pod := resp.Pods[0]
+		assert.Equal(t, "workflows", pod.Namespace)
+		assert.Equal(t, "Pod", pod.Kind)
+		var images []string
+		for _, ctr := range pod.Spec.Containers {
+			images = append(images, ctr.Image)
+		}
+		assert.Contains(t, images, "docker/whalesay:latest")
+		assert.Contains(t, images, "executor:latest")
+	}
+
+	// the workflow template is neither submitted nor in the namespace
+	_, err = server.PreviewWorkflowPods(ctx, &WorkflowPreviewPodsRequest{Namespace: "workflows", Workflow: wf})
+	assert.Error(t, err)
+}
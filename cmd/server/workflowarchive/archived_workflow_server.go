@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/argoproj/argo/cmd/server/auth"
 	"github.com/argoproj/argo/persist/sqldb"
@@ -47,12 +48,16 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 	if strings.HasPrefix(options.FieldSelector, "metadata.namespace=") {
 		namespace = strings.TrimPrefix(options.FieldSelector, "metadata.namespace=")
 	}
+	requirements, err := labels.ParseToRequirements(options.LabelSelector)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	items := make(wfv1.Workflows, 0)
 	authorizer := auth.NewAuthorizer(ctx)
 	// keep trying until we have enough
 	for len(items) < limit {
-		moreItems, err := w.wfArchive.ListWorkflows(namespace, limit, offset)
+		moreItems, err := w.wfArchive.ListWorkflows(namespace, requirements, limit, offset)
 		if err != nil {
 			return nil, err
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	authorizationv1 "k8s.io/api/authorization/v1"
@@ -43,8 +44,8 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		}, nil
 	})
 	// two pages of results for limit 1
-	repo.On("ListWorkflows", "", 1, 0).Return(wfv1.Workflows{{}}, nil)
-	repo.On("ListWorkflows", "", 1, 1).Return(wfv1.Workflows{}, nil)
+	repo.On("ListWorkflows", "", mock.Anything, 1, 0).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", "", mock.Anything, 1, 1).Return(wfv1.Workflows{}, nil)
 	repo.On("GetWorkflow", "").Return(nil, nil)
 	repo.On("GetWorkflow", "my-uid").Return(&wfv1.Workflow{
 		ObjectMeta: metav1.ObjectMeta{Name: "my-name"},
@@ -80,6 +81,8 @@ func Test_archivedWorkflowServer(t *testing.T) {
 			assert.Len(t, resp.Items, 0)
 			assert.Empty(t, resp.Continue)
 		}
+		_, err = w.ListArchivedWorkflows(ctx, &ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{LabelSelector: "!!"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
 	})
 	t.Run("GetArchivedWorkflow", func(t *testing.T) {
 		allowed = false
@@ -0,0 +1,139 @@
+package workflowsubmission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo/cmd/server/auth"
+	argoerrs "github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+// Submission is a simplified request to run a template of a WorkflowTemplate, for clients which do not want to
+// construct a whole Workflow
+type Submission struct {
+	// WorkflowTemplate is the name of the WorkflowTemplate to run
+	WorkflowTemplate string `json:"workflowTemplate"`
+	// Entrypoint is the name of the template within the WorkflowTemplate to run
+	Entrypoint string `json:"entrypoint"`
+	// Parameters are passed as arguments to the workflow
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// Labels are applied to the workflow
+	Labels map[string]string `json:"labels,omitempty"`
+	// Name is the name of the workflow, which is otherwise generated
+	Name string `json:"name,omitempty"`
+	// GenerateName overrides the generated name prefix, which defaults to the WorkflowTemplate name
+	GenerateName string `json:"generateName,omitempty"`
+}
+
+// ToWorkflow expands the submission into a workflow referring to the WorkflowTemplate
+func (s Submission) ToWorkflow(namespace string) (*wfv1.Workflow, error) {
+	if s.WorkflowTemplate == "" {
+		return nil, argoerrs.New(argoerrs.CodeBadRequest, "workflowTemplate is required")
+	}
+	if s.Entrypoint == "" {
+		return nil, argoerrs.New(argoerrs.CodeBadRequest, "entrypoint is required")
+	}
+	generateName := s.GenerateName
+	if generateName == "" && s.Name == "" {
+		generateName = s.WorkflowTemplate + "-"
+	}
+	var params []wfv1.Parameter
+	for name, value := range s.Parameters {
+		value := value
+		params = append(params, wfv1.Parameter{Name: name, Value: &value})
+	}
+	// map iteration order is random, so sort the parameters to produce stable workflows
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Name < params[j].Name
+	})
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:         s.Name,
+			GenerateName: generateName,
+			Namespace:    namespace,
+			Labels:       s.Labels,
+		},
+		Spec: wfv1.WorkflowSpec{
+			Entrypoint: s.Entrypoint,
+			Arguments:  wfv1.Arguments{Parameters: params},
+			Templates: []wfv1.Template{{
+				Name:        s.Entrypoint,
+				TemplateRef: &wfv1.TemplateRef{Name: s.WorkflowTemplate, Template: s.Entrypoint},
+			}},
+		},
+	}, nil
+}
+
+type SubmissionServer struct {
+	authN auth.Gatekeeper
+}
+
+func NewSubmissionServer(authN auth.Gatekeeper) *SubmissionServer {
+	return &SubmissionServer{authN}
+}
+
+// Submit handles `POST /api/v1/workflow-submissions/{namespace}` with a Submission body, and responds with the
+// created workflow
+func (s *SubmissionServer) Submit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.error(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	ctx, err := s.authN.HTTPContext(r, true)
+	if err != nil {
+		s.error(w, http.StatusUnauthorized, err)
+		return
+	}
+	namespace := strings.TrimPrefix(r.URL.Path, "/api/v1/workflow-submissions/")
+	if namespace == "" || strings.Contains(namespace, "/") {
+		s.error(w, http.StatusNotFound, fmt.Errorf("expected path /api/v1/workflow-submissions/{namespace}"))
+		return
+	}
+	var submission Submission
+	err = json.NewDecoder(r.Body).Decode(&submission)
+	if err != nil {
+		s.error(w, http.StatusBadRequest, err)
+		return
+	}
+	wf, err := s.submit(ctx, namespace, submission)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if argoErr, ok := err.(argoerrs.ArgoError); ok && argoErr.Code() == argoerrs.CodeBadRequest {
+			code = http.StatusBadRequest
+		}
+		s.error(w, code, err)
+		return
+	}
+	data, err := json.Marshal(wf)
+	if err != nil {
+		s.error(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(data)
+}
+
+func (s *SubmissionServer) submit(ctx context.Context, namespace string, submission Submission) (*wfv1.Workflow, error) {
+	wf, err := submission.ToWorkflow(namespace)
+	if err != nil {
+		return nil, err
+	}
+	wfClient := auth.GetWfClient(ctx)
+	log.WithFields(log.Fields{"namespace": namespace, "workflowTemplate": submission.WorkflowTemplate, "entrypoint": submission.Entrypoint}).Info("Submit workflow")
+	return util.SubmitWorkflow(wfClient.ArgoprojV1alpha1().Workflows(namespace), wfClient, namespace, wf, &util.SubmitOpts{})
+}
+
+func (s *SubmissionServer) error(w http.ResponseWriter, code int, err error) {
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(err.Error()))
+}
@@ -0,0 +1,73 @@
+package workflowsubmission
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo/cmd/server/auth"
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	v1alpha "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+)
+
+func TestSubmissionToWorkflow(t *testing.T) {
+	wf, err := Submission{
+		WorkflowTemplate: "my-wftmpl",
+		Entrypoint:       "whalesay",
+		Parameters:       map[string]string{"b": "2", "a": "1"},
+		Labels:           map[string]string{"team": "data"},
+	}.ToWorkflow("my-ns")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "my-wftmpl-", wf.GenerateName)
+		assert.Equal(t, "my-ns", wf.Namespace)
+		assert.Equal(t, "data", wf.Labels["team"])
+		assert.Equal(t, "whalesay", wf.Spec.Entrypoint)
+		if assert.Len(t, wf.Spec.Arguments.Parameters, 2) {
+			assert.Equal(t, "a", wf.Spec.Arguments.Parameters[0].Name)
+			assert.Equal(t, "1", *wf.Spec.Arguments.Parameters[0].Value)
+			assert.Equal(t, "b", wf.Spec.Arguments.Parameters[1].Name)
+		}
+		if assert.Len(t, wf.Spec.Templates, 1) {
+			assert.Equal(t, &v1alpha1.TemplateRef{Name: "my-wftmpl", Template: "whalesay"}, wf.Spec.Templates[0].TemplateRef)
+		}
+	}
+
+	_, err = Submission{Entrypoint: "whalesay"}.ToWorkflow("my-ns")
+	assert.EqualError(t, err, "workflowTemplate is required")
+	_, err = Submission{WorkflowTemplate: "my-wftmpl"}.ToWorkflow("my-ns")
+	assert.EqualError(t, err, "entrypoint is required")
+}
+
+func TestSubmit(t *testing.T) {
+	wfClient := v1alpha.NewSimpleClientset(&v1alpha1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wftmpl", Namespace: "my-ns"},
+		Spec: v1alpha1.WorkflowTemplateSpec{
+			Templates: []v1alpha1.Template{{
+				Name:      "whalesay",
+				Inputs:    v1alpha1.Inputs{Parameters: []v1alpha1.Parameter{{Name: "message"}}},
+				Container: &corev1.Container{Image: "docker/whalesay:latest"},
+			}},
+		},
+	})
+	ctx := context.WithValue(context.Background(), auth.WfKey, wfClient)
+	server := NewSubmissionServer(auth.Gatekeeper{})
+
+	wf, err := server.submit(ctx, "my-ns", Submission{
+		WorkflowTemplate: "my-wftmpl",
+		Entrypoint:       "whalesay",
+		Parameters:       map[string]string{"message": "hello"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "my-ns", wf.Namespace)
+		wfs, err := wfClient.ArgoprojV1alpha1().Workflows("my-ns").List(metav1.ListOptions{})
+		if assert.NoError(t, err) {
+			assert.Len(t, wfs.Items, 1)
+		}
+	}
+
+	_, err = server.submit(ctx, "my-ns", Submission{WorkflowTemplate: "missing", Entrypoint: "whalesay"})
+	assert.Error(t, err)
+}
@@ -22,6 +22,7 @@ import (
 
 	wfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned"
 	cmdutil "github.com/argoproj/argo/util/cmd"
+	"github.com/argoproj/argo/util/logging"
 	"github.com/argoproj/argo/workflow/controller"
 )
 
@@ -39,18 +40,23 @@ func NewRootCommand() *cobra.Command {
 		executorImagePullPolicy  string // --executor-image-pull-policy
 		containerRuntimeExecutor string
 		logLevel                 string // --loglevel
+		logFormat                string // --log-format
 		glogLevel                int    // --gloglevel
 		workflowWorkers          int    // --workflow-workers
 		podWorkers               int    // --pod-workers
 		namespaced               bool   // --namespaced
 		managedNamespace         string // --managed-namespace
+		healthPort               int    // --health-port
 	)
 
 	var command = cobra.Command{
 		Use:   CLIName,
 		Short: "workflow-controller is the controller to operate on workflows",
 		RunE: func(c *cobra.Command, args []string) error {
-			cli.SetLogLevel(logLevel)
+			err := logging.SetDefault(logLevel, logFormat)
+			if err != nil {
+				return err
+			}
 			cli.SetGLogLevel(glogLevel)
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(5 * time.Minute)
@@ -94,13 +100,14 @@ func NewRootCommand() *cobra.Command {
 				fmt.Fprintf(os.Stderr, "-----------------------------------------------------------------\n\n")
 			}
 
-			cronController := cron.NewCronController(wfclientset, config, namespace, wfController.GetManagedNamespace())
+			cronController := cron.NewCronController(wfclientset, config, namespace, wfController.GetManagedNamespace(), wfController.Config.InstanceID)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 			go wfController.Run(ctx, workflowWorkers, podWorkers)
 			go wfController.MetricsServer(ctx)
 			go wfController.TelemetryServer(ctx)
+			go wfController.HealthServer(ctx, healthPort)
 			go wfController.RunTTLController(ctx)
 			go cronController.Run(ctx)
 
@@ -116,11 +123,13 @@ func NewRootCommand() *cobra.Command {
 	command.Flags().StringVar(&executorImagePullPolicy, "executor-image-pull-policy", "", "Executor imagePullPolicy to use (overrides value in configmap)")
 	command.Flags().StringVar(&containerRuntimeExecutor, "container-runtime-executor", "", "Container runtime executor to use (overrides value in configmap)")
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().StringVar(&logFormat, "log-format", "text", "Set the logging format. One of: text|json")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
 	command.Flags().IntVar(&workflowWorkers, "workflow-workers", 8, "Number of workflow workers")
 	command.Flags().IntVar(&podWorkers, "pod-workers", 8, "Number of pod workers")
 	command.Flags().BoolVar(&namespaced, "namespaced", false, "run workflow-controller as namespaced mode")
 	command.Flags().StringVar(&managedNamespace, "managed-namespace", "", "namespace that workflow-controller watches, default to the installation namespace")
+	command.Flags().IntVar(&healthPort, "health-port", 6060, "Port to serve the /healthz and /readyz endpoints on")
 	return &command
 }
 
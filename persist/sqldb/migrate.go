@@ -192,6 +192,16 @@ func (m migrate) Exec(ctx context.Context) error {
 		ansiSQLChange(`create index ` + m.tableName + `_i1 on ` + m.tableName + ` (clustername,namespace)`),
 		// argo_workflows now looks like:
 		//  clustername(not null) | uid(not null) | namespace(not null) | version(not null) | nodes(not null) | updatedat(not null)
+		ansiSQLChange(`create table if not exists argo_archived_workflows_labels (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    name varchar(317) not null,
+    value varchar(63) not null,
+    primary key (clustername, uid, name),
+    foreign key (clustername, uid) references argo_archived_workflows(clustername, uid) on delete cascade
+)`),
+		// label names are max 317 bytes (253 byte prefix, a slash and a 63 byte name), values are max 63 bytes
+		ansiSQLChange(`create index argo_archived_workflows_labels_i1 on argo_archived_workflows_labels (clustername, name, value)`),
 	} {
 		err := m.applyChange(ctx, changeSchemaVersion, change)
 		if err != nil {
@@ -1,12 +1,16 @@
 package sqldb
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"upper.io/db.v3"
 	"upper.io/db.v3/lib/sqlbuilder"
@@ -15,6 +19,7 @@ import (
 )
 
 const archiveTableName = "argo_archived_workflows"
+const archiveLabelsTableName = archiveTableName + "_labels"
 
 type archivedWorkflowMetadata struct {
 	ClusterName string         `db:"clustername"`
@@ -30,9 +35,19 @@ type archivedWorkflowRecord struct {
 	archivedWorkflowMetadata
 	Workflow string `db:"workflow"`
 }
+
+type archivedWorkflowLabelRecord struct {
+	ClusterName string `db:"clustername"`
+	UID         string `db:"uid"`
+	// Key is called name, as key is a reserved word in MySQL
+	Key   string `db:"name"`
+	Value string `db:"value"`
+}
+
 type WorkflowArchive interface {
 	ArchiveWorkflow(wf *wfv1.Workflow) error
-	ListWorkflows(namespace string, limit, offset int) (wfv1.Workflows, error)
+	// ListWorkflows lists the archived workflows in the namespace (or all namespaces if empty), which match the label requirements
+	ListWorkflows(namespace string, labelRequirements labels.Requirements, limit, offset int) (wfv1.Workflows, error)
 	GetWorkflow(uid string) (*wfv1.Workflow, error)
 	DeleteWorkflow(uid string) error
 }
@@ -78,7 +93,7 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 				Set("startedat", wf.Status.StartedAt.Time).
 				Set("finishedat", wf.Status.FinishedAt.Time).
 				Where(db.Cond{"clustername": r.clusterName}).
-				And(db.Cond{"uuid": wf.UID}).
+				And(db.Cond{"uid": wf.UID}).
 				Exec()
 			if err != nil {
 				return err
@@ -95,16 +110,45 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 		}
 	}
 
-	return nil
+	// the labels of a workflow are replaced rather than updated, as labels may have been removed, within a transaction,
+	// so that the workflow is never listed without its labels
+	return r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		_, err := sess.
+			DeleteFrom(archiveLabelsTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"uid": wf.UID}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		for key, value := range wf.GetLabels() {
+			_, err := sess.Collection(archiveLabelsTableName).
+				Insert(&archivedWorkflowLabelRecord{
+					ClusterName: r.clusterName,
+					UID:         string(wf.UID),
+					Key:         key,
+					Value:       value,
+				})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
-func (r *workflowArchive) ListWorkflows(namespace string, limit int, offset int) (wfv1.Workflows, error) {
+func (r *workflowArchive) ListWorkflows(namespace string, labelRequirements labels.Requirements, limit int, offset int) (wfv1.Workflows, error) {
+	labelSelector, err := labelsClause(r.clusterName, labelRequirements)
+	if err != nil {
+		return nil, err
+	}
 	var archivedWfs []archivedWorkflowMetadata
-	err := r.session.
+	err = r.session.
 		Select("name", "namespace", "uid", "phase", "startedat", "finishedat").
 		From(archiveTableName).
 		Where(db.Cond{"clustername": r.clusterName}).
 		And(namespaceEqual(namespace)).
+		And(labelSelector).
 		OrderBy("-startedat").
 		Limit(limit).
 		Offset(offset).
@@ -139,6 +183,27 @@ func namespaceEqual(namespace string) db.Cond {
 	}
 }
 
+// labelsClause selects the workflows which meet every label requirement
+func labelsClause(clusterName string, requirements labels.Requirements) (db.Compound, error) {
+	var conds []db.Compound
+	for _, r := range requirements {
+		values := r.Values().List()
+		switch r.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			conds = append(conds, db.Raw("uid in (select uid from "+archiveLabelsTableName+" where clustername = ? and name = ? and value in ?)", clusterName, r.Key(), values))
+		case selection.NotEquals, selection.NotIn:
+			conds = append(conds, db.Raw("uid not in (select uid from "+archiveLabelsTableName+" where clustername = ? and name = ? and value in ?)", clusterName, r.Key(), values))
+		case selection.Exists:
+			conds = append(conds, db.Raw("uid in (select uid from "+archiveLabelsTableName+" where clustername = ? and name = ?)", clusterName, r.Key()))
+		case selection.DoesNotExist:
+			conds = append(conds, db.Raw("uid not in (select uid from "+archiveLabelsTableName+" where clustername = ? and name = ?)", clusterName, r.Key()))
+		default:
+			return nil, fmt.Errorf("operator %v is not supported", r.Operator())
+		}
+	}
+	return db.And(conds...), nil
+}
+
 func (r *workflowArchive) GetWorkflow(uid string) (*wfv1.Workflow, error) {
 	archivedWf := &archivedWorkflowRecord{}
 	err := r.session.
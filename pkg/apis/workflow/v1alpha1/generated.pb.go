@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	encoding_binary "encoding/binary"
 	encoding_json "encoding/json"
 	fmt "fmt"
 
@@ -116,10 +117,38 @@ func (m *Artifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Artifact proto.InternalMessageInfo
 
+func (m *ArtifactGC) Reset()      { *m = ArtifactGC{} }
+func (*ArtifactGC) ProtoMessage() {}
+func (*ArtifactGC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{3}
+}
+func (m *ArtifactGC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactGC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactGC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactGC.Merge(m, src)
+}
+func (m *ArtifactGC) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactGC) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactGC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactGC proto.InternalMessageInfo
+
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{3}
+	return fileDescriptor_c23edafa7e7ea072, []int{4}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,7 +176,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{4}
+	return fileDescriptor_c23edafa7e7ea072, []int{5}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,7 +204,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{5}
+	return fileDescriptor_c23edafa7e7ea072, []int{6}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,7 +232,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{6}
+	return fileDescriptor_c23edafa7e7ea072, []int{7}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,7 +260,7 @@ var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{7}
+	return fileDescriptor_c23edafa7e7ea072, []int{8}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,10 +285,94 @@ func (m *Backoff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Backoff proto.InternalMessageInfo
 
+func (m *Cache) Reset()      { *m = Cache{} }
+func (*Cache) ProtoMessage() {}
+func (*Cache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{9}
+}
+func (m *Cache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Cache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Cache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cache.Merge(m, src)
+}
+func (m *Cache) XXX_Size() int {
+	return m.Size()
+}
+func (m *Cache) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cache proto.InternalMessageInfo
+
+func (m *ContainerDiagnostics) Reset()      { *m = ContainerDiagnostics{} }
+func (*ContainerDiagnostics) ProtoMessage() {}
+func (*ContainerDiagnostics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{10}
+}
+func (m *ContainerDiagnostics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerDiagnostics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerDiagnostics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerDiagnostics.Merge(m, src)
+}
+func (m *ContainerDiagnostics) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerDiagnostics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerDiagnostics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerDiagnostics proto.InternalMessageInfo
+
+func (m *ContainerEnvironment) Reset()      { *m = ContainerEnvironment{} }
+func (*ContainerEnvironment) ProtoMessage() {}
+func (*ContainerEnvironment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{11}
+}
+func (m *ContainerEnvironment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerEnvironment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerEnvironment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerEnvironment.Merge(m, src)
+}
+func (m *ContainerEnvironment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerEnvironment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerEnvironment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerEnvironment proto.InternalMessageInfo
+
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{8}
+	return fileDescriptor_c23edafa7e7ea072, []int{12}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,10 +397,38 @@ func (m *ContinueOn) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 
+func (m *Counter) Reset()      { *m = Counter{} }
+func (*Counter) ProtoMessage() {}
+func (*Counter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{13}
+}
+func (m *Counter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Counter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Counter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Counter.Merge(m, src)
+}
+func (m *Counter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Counter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Counter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Counter proto.InternalMessageInfo
+
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{9}
+	return fileDescriptor_c23edafa7e7ea072, []int{14}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,7 +456,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{10}
+	return fileDescriptor_c23edafa7e7ea072, []int{15}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +484,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{11}
+	return fileDescriptor_c23edafa7e7ea072, []int{16}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -371,7 +512,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{12}
+	return fileDescriptor_c23edafa7e7ea072, []int{17}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +540,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{13}
+	return fileDescriptor_c23edafa7e7ea072, []int{18}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +568,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{14}
+	return fileDescriptor_c23edafa7e7ea072, []int{19}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,10 +593,38 @@ func (m *DAGTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 
+func (m *ExecutionWindow) Reset()      { *m = ExecutionWindow{} }
+func (*ExecutionWindow) ProtoMessage() {}
+func (*ExecutionWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{20}
+}
+func (m *ExecutionWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ExecutionWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionWindow.Merge(m, src)
+}
+func (m *ExecutionWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionWindow proto.InternalMessageInfo
+
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{15}
+	return fileDescriptor_c23edafa7e7ea072, []int{21}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,10 +649,66 @@ func (m *ExecutorConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 
+func (m *FailureThreshold) Reset()      { *m = FailureThreshold{} }
+func (*FailureThreshold) ProtoMessage() {}
+func (*FailureThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{22}
+}
+func (m *FailureThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailureThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FailureThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailureThreshold.Merge(m, src)
+}
+func (m *FailureThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailureThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailureThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailureThreshold proto.InternalMessageInfo
+
+func (m *Gauge) Reset()      { *m = Gauge{} }
+func (*Gauge) ProtoMessage() {}
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{23}
+}
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
+}
+func (m *Gauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
+
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{16}
+	return fileDescriptor_c23edafa7e7ea072, []int{24}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,7 +736,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{17}
+	return fileDescriptor_c23edafa7e7ea072, []int{25}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +764,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{18}
+	return fileDescriptor_c23edafa7e7ea072, []int{26}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -567,7 +792,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{19}
+	return fileDescriptor_c23edafa7e7ea072, []int{27}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,10 +817,38 @@ func (m *HDFSKrbConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 
+func (m *HTTP) Reset()      { *m = HTTP{} }
+func (*HTTP) ProtoMessage() {}
+func (*HTTP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{28}
+}
+func (m *HTTP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTP.Merge(m, src)
+}
+func (m *HTTP) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTP) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTP proto.InternalMessageInfo
+
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{20}
+	return fileDescriptor_c23edafa7e7ea072, []int{29}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,10 +873,94 @@ func (m *HTTPArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 
+func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
+func (*HTTPHeader) ProtoMessage() {}
+func (*HTTPHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{30}
+}
+func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeader.Merge(m, src)
+}
+func (m *HTTPHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
+
+func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
+func (*HTTPHeaderSource) ProtoMessage() {}
+func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{31}
+}
+func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeaderSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeaderSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeaderSource.Merge(m, src)
+}
+func (m *HTTPHeaderSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeaderSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeaderSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
+
+func (m *Histogram) Reset()      { *m = Histogram{} }
+func (*Histogram) ProtoMessage() {}
+func (*Histogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{32}
+}
+func (m *Histogram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Histogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Histogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Histogram.Merge(m, src)
+}
+func (m *Histogram) XXX_Size() int {
+	return m.Size()
+}
+func (m *Histogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_Histogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Histogram proto.InternalMessageInfo
+
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{21}
+	return fileDescriptor_c23edafa7e7ea072, []int{33}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +988,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{22}
+	return fileDescriptor_c23edafa7e7ea072, []int{34}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -679,7 +1016,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *ItemValue) Reset()      { *m = ItemValue{} }
 func (*ItemValue) ProtoMessage() {}
 func (*ItemValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{23}
+	return fileDescriptor_c23edafa7e7ea072, []int{35}
 }
 func (m *ItemValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,15 +1041,15 @@ func (m *ItemValue) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ItemValue proto.InternalMessageInfo
 
-func (m *Metadata) Reset()      { *m = Metadata{} }
-func (*Metadata) ProtoMessage() {}
-func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{24}
+func (m *LockHolding) Reset()      { *m = LockHolding{} }
+func (*LockHolding) ProtoMessage() {}
+func (*LockHolding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{36}
 }
-func (m *Metadata) XXX_Unmarshal(b []byte) error {
+func (m *LockHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LockHolding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -720,10 +1057,94 @@ func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Metadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Metadata.Merge(m, src)
+func (m *LockHolding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockHolding.Merge(m, src)
 }
-func (m *Metadata) XXX_Size() int {
+func (m *LockHolding) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockHolding) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockHolding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockHolding proto.InternalMessageInfo
+
+func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
+func (*MemoizationStatus) ProtoMessage() {}
+func (*MemoizationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{37}
+}
+func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemoizationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MemoizationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoizationStatus.Merge(m, src)
+}
+func (m *MemoizationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemoizationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoizationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
+
+func (m *Memoize) Reset()      { *m = Memoize{} }
+func (*Memoize) ProtoMessage() {}
+func (*Memoize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{38}
+}
+func (m *Memoize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Memoize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Memoize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Memoize.Merge(m, src)
+}
+func (m *Memoize) XXX_Size() int {
+	return m.Size()
+}
+func (m *Memoize) XXX_DiscardUnknown() {
+	xxx_messageInfo_Memoize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Memoize proto.InternalMessageInfo
+
+func (m *Metadata) Reset()      { *m = Metadata{} }
+func (*Metadata) ProtoMessage() {}
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{39}
+}
+func (m *Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metadata.Merge(m, src)
+}
+func (m *Metadata) XXX_Size() int {
 	return m.Size()
 }
 func (m *Metadata) XXX_DiscardUnknown() {
@@ -732,10 +1153,150 @@ func (m *Metadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Metadata proto.InternalMessageInfo
 
+func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
+func (*MetricLabel) ProtoMessage() {}
+func (*MetricLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{40}
+}
+func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetricLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricLabel.Merge(m, src)
+}
+func (m *MetricLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricLabel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
+
+func (m *Metrics) Reset()      { *m = Metrics{} }
+func (*Metrics) ProtoMessage() {}
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{41}
+}
+func (m *Metrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Metrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Metrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metrics.Merge(m, src)
+}
+func (m *Metrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *Metrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metrics proto.InternalMessageInfo
+
+func (m *Mutex) Reset()      { *m = Mutex{} }
+func (*Mutex) ProtoMessage() {}
+func (*Mutex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{42}
+}
+func (m *Mutex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Mutex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Mutex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mutex.Merge(m, src)
+}
+func (m *Mutex) XXX_Size() int {
+	return m.Size()
+}
+func (m *Mutex) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mutex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mutex proto.InternalMessageInfo
+
+func (m *NodeDiagnostics) Reset()      { *m = NodeDiagnostics{} }
+func (*NodeDiagnostics) ProtoMessage() {}
+func (*NodeDiagnostics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{43}
+}
+func (m *NodeDiagnostics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDiagnostics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeDiagnostics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDiagnostics.Merge(m, src)
+}
+func (m *NodeDiagnostics) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDiagnostics) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDiagnostics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDiagnostics proto.InternalMessageInfo
+
+func (m *NodeEnvironment) Reset()      { *m = NodeEnvironment{} }
+func (*NodeEnvironment) ProtoMessage() {}
+func (*NodeEnvironment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{44}
+}
+func (m *NodeEnvironment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeEnvironment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeEnvironment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeEnvironment.Merge(m, src)
+}
+func (m *NodeEnvironment) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeEnvironment) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeEnvironment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeEnvironment proto.InternalMessageInfo
+
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{25}
+	return fileDescriptor_c23edafa7e7ea072, []int{45}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,10 +1321,38 @@ func (m *NodeStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 
+func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
+func (*NodeSynchronizationStatus) ProtoMessage() {}
+func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{46}
+}
+func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeSynchronizationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeSynchronizationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSynchronizationStatus.Merge(m, src)
+}
+func (m *NodeSynchronizationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeSynchronizationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSynchronizationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
+
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{26}
+	return fileDescriptor_c23edafa7e7ea072, []int{47}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +1380,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{27}
+	return fileDescriptor_c23edafa7e7ea072, []int{48}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,7 +1408,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{28}
+	return fileDescriptor_c23edafa7e7ea072, []int{49}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -847,7 +1436,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{29}
+	return fileDescriptor_c23edafa7e7ea072, []int{50}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1464,7 @@ var xxx_messageInfo_Parameter proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{30}
+	return fileDescriptor_c23edafa7e7ea072, []int{51}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,10 +1489,38 @@ func (m *PodGC) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodGC proto.InternalMessageInfo
 
+func (m *Prometheus) Reset()      { *m = Prometheus{} }
+func (*Prometheus) ProtoMessage() {}
+func (*Prometheus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{52}
+}
+func (m *Prometheus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Prometheus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Prometheus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Prometheus.Merge(m, src)
+}
+func (m *Prometheus) XXX_Size() int {
+	return m.Size()
+}
+func (m *Prometheus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Prometheus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Prometheus proto.InternalMessageInfo
+
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{31}
+	return fileDescriptor_c23edafa7e7ea072, []int{53}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -931,7 +1548,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{32}
+	return fileDescriptor_c23edafa7e7ea072, []int{54}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,7 +1576,7 @@ var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{33}
+	return fileDescriptor_c23edafa7e7ea072, []int{55}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,7 +1604,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{34}
+	return fileDescriptor_c23edafa7e7ea072, []int{56}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,7 +1632,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{35}
+	return fileDescriptor_c23edafa7e7ea072, []int{57}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1043,7 +1660,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{36}
+	return fileDescriptor_c23edafa7e7ea072, []int{58}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,10 +1685,38 @@ func (m *ScriptTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 
+func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
+func (*SemaphoreRef) ProtoMessage() {}
+func (*SemaphoreRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{59}
+}
+func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SemaphoreRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SemaphoreRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SemaphoreRef.Merge(m, src)
+}
+func (m *SemaphoreRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *SemaphoreRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SemaphoreRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
+
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{37}
+	return fileDescriptor_c23edafa7e7ea072, []int{60}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,10 +1741,38 @@ func (m *Sequence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sequence proto.InternalMessageInfo
 
+func (m *Stream) Reset()      { *m = Stream{} }
+func (*Stream) ProtoMessage() {}
+func (*Stream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{61}
+}
+func (m *Stream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Stream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stream.Merge(m, src)
+}
+func (m *Stream) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stream) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stream proto.InternalMessageInfo
+
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{38}
+	return fileDescriptor_c23edafa7e7ea072, []int{62}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1124,15 +1797,15 @@ func (m *SuspendTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 
-func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
-func (*TTLStrategy) ProtoMessage() {}
-func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{39}
+func (m *Synchronization) Reset()      { *m = Synchronization{} }
+func (*Synchronization) ProtoMessage() {}
+func (*Synchronization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{63}
 }
-func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
+func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TTLStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Synchronization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1140,27 +1813,27 @@ func (m *TTLStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *TTLStrategy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TTLStrategy.Merge(m, src)
+func (m *Synchronization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Synchronization.Merge(m, src)
 }
-func (m *TTLStrategy) XXX_Size() int {
+func (m *Synchronization) XXX_Size() int {
 	return m.Size()
 }
-func (m *TTLStrategy) XXX_DiscardUnknown() {
-	xxx_messageInfo_TTLStrategy.DiscardUnknown(m)
+func (m *Synchronization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Synchronization.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
+var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 
-func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
-func (*TarStrategy) ProtoMessage() {}
-func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{40}
+func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
+func (*SynchronizationStatus) ProtoMessage() {}
+func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{64}
 }
-func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
+func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TarStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SynchronizationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1168,22 +1841,78 @@ func (m *TarStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *TarStrategy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TarStrategy.Merge(m, src)
+func (m *SynchronizationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SynchronizationStatus.Merge(m, src)
 }
-func (m *TarStrategy) XXX_Size() int {
+func (m *SynchronizationStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *TarStrategy) XXX_DiscardUnknown() {
-	xxx_messageInfo_TarStrategy.DiscardUnknown(m)
+func (m *SynchronizationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SynchronizationStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
+var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 
-func (m *Template) Reset()      { *m = Template{} }
-func (*Template) ProtoMessage() {}
+func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
+func (*TTLStrategy) ProtoMessage() {}
+func (*TTLStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{65}
+}
+func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TTLStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TTLStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TTLStrategy.Merge(m, src)
+}
+func (m *TTLStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TTLStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TTLStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
+
+func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
+func (*TarStrategy) ProtoMessage() {}
+func (*TarStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{66}
+}
+func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TarStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TarStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TarStrategy.Merge(m, src)
+}
+func (m *TarStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TarStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TarStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
+
+func (m *Template) Reset()      { *m = Template{} }
+func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{41}
+	return fileDescriptor_c23edafa7e7ea072, []int{67}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1211,7 +1940,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{42}
+	return fileDescriptor_c23edafa7e7ea072, []int{68}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1239,7 +1968,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{43}
+	return fileDescriptor_c23edafa7e7ea072, []int{69}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1267,7 +1996,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{44}
+	return fileDescriptor_c23edafa7e7ea072, []int{70}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1292,10 +2021,38 @@ func (m *ValueFrom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 
+func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
+func (*VolumeClaimGC) ProtoMessage() {}
+func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{71}
+}
+func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeClaimGC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VolumeClaimGC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeClaimGC.Merge(m, src)
+}
+func (m *VolumeClaimGC) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeClaimGC) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeClaimGC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
+
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{45}
+	return fileDescriptor_c23edafa7e7ea072, []int{72}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1320,10 +2077,38 @@ func (m *Workflow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Workflow proto.InternalMessageInfo
 
+func (m *WorkflowEnvironment) Reset()      { *m = WorkflowEnvironment{} }
+func (*WorkflowEnvironment) ProtoMessage() {}
+func (*WorkflowEnvironment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{73}
+}
+func (m *WorkflowEnvironment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowEnvironment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowEnvironment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowEnvironment.Merge(m, src)
+}
+func (m *WorkflowEnvironment) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowEnvironment) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowEnvironment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowEnvironment proto.InternalMessageInfo
+
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{46}
+	return fileDescriptor_c23edafa7e7ea072, []int{74}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1351,7 +2136,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{47}
+	return fileDescriptor_c23edafa7e7ea072, []int{75}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1379,7 +2164,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{48}
+	return fileDescriptor_c23edafa7e7ea072, []int{76}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1407,7 +2192,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{49}
+	return fileDescriptor_c23edafa7e7ea072, []int{77}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1435,7 +2220,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{50}
+	return fileDescriptor_c23edafa7e7ea072, []int{78}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1463,7 +2248,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{51}
+	return fileDescriptor_c23edafa7e7ea072, []int{79}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1491,7 +2276,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateSpec) Reset()      { *m = WorkflowTemplateSpec{} }
 func (*WorkflowTemplateSpec) ProtoMessage() {}
 func (*WorkflowTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{52}
+	return fileDescriptor_c23edafa7e7ea072, []int{80}
 }
 func (m *WorkflowTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1520,46 +2305,73 @@ func init() {
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Arguments")
 	proto.RegisterType((*Artifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Artifact")
+	proto.RegisterType((*ArtifactGC)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactGC")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*ArtifactRepositoryRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRef")
 	proto.RegisterType((*ArtifactoryArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactoryArtifact")
 	proto.RegisterType((*ArtifactoryAuth)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactoryAuth")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Backoff")
+	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Cache")
+	proto.RegisterType((*ContainerDiagnostics)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ContainerDiagnostics")
+	proto.RegisterType((*ContainerEnvironment)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ContainerEnvironment")
 	proto.RegisterType((*ContinueOn)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ContinueOn")
+	proto.RegisterType((*Counter)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Counter")
 	proto.RegisterType((*CronWorkflow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflow")
 	proto.RegisterType((*CronWorkflowList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflowList")
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.DAGTask")
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.DAGTemplate")
+	proto.RegisterType((*ExecutionWindow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ExecutionWindow")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ExecutorConfig")
+	proto.RegisterType((*FailureThreshold)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.FailureThreshold")
+	proto.RegisterType((*Gauge)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Gauge")
 	proto.RegisterType((*GitArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.GitArtifact")
 	proto.RegisterType((*HDFSArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HDFSArtifact")
 	proto.RegisterType((*HDFSConfig)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HDFSConfig")
 	proto.RegisterType((*HDFSKrbConfig)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HDFSKrbConfig")
+	proto.RegisterType((*HTTP)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTP")
 	proto.RegisterType((*HTTPArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTPArtifact")
+	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTPHeader")
+	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
+	proto.RegisterType((*Histogram)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Histogram")
 	proto.RegisterType((*Inputs)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Inputs")
 	proto.RegisterType((*Item)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Item")
 	proto.RegisterMapType((map[string]ItemValue)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Item.MapValEntry")
 	proto.RegisterType((*ItemValue)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ItemValue")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ItemValue.MapValEntry")
+	proto.RegisterType((*LockHolding)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.LockHolding")
+	proto.RegisterType((*MemoizationStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.MemoizationStatus")
+	proto.RegisterType((*Memoize)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Memoize")
 	proto.RegisterType((*Metadata)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Metadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Metadata.LabelsEntry")
+	proto.RegisterType((*MetricLabel)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.MetricLabel")
+	proto.RegisterType((*Metrics)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Metrics")
+	proto.RegisterType((*Mutex)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Mutex")
+	proto.RegisterType((*NodeDiagnostics)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NodeDiagnostics")
+	proto.RegisterType((*NodeEnvironment)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NodeEnvironment")
 	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NodeStatus")
+	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NodeStatus.ResourcesDurationEntry")
+	proto.RegisterType((*NodeSynchronizationStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NodeSynchronizationStatus")
 	proto.RegisterType((*NoneStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NoneStrategy")
 	proto.RegisterType((*Outputs)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Outputs")
 	proto.RegisterType((*ParallelSteps)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ParallelSteps")
 	proto.RegisterType((*Parameter)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Parameter")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.PodGC")
+	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ResourceTemplate")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RetryStrategy")
 	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.S3Artifact")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.S3Bucket")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ScriptTemplate")
+	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SemaphoreRef")
 	proto.RegisterType((*Sequence)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Sequence")
+	proto.RegisterType((*Stream)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Stream")
 	proto.RegisterType((*SuspendTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SuspendTemplate")
+	proto.RegisterType((*Synchronization)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Synchronization")
+	proto.RegisterType((*SynchronizationStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SynchronizationStatus")
 	proto.RegisterType((*TTLStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.TTLStrategy")
 	proto.RegisterType((*TarStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.TarStrategy")
 	proto.RegisterType((*Template)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Template")
@@ -1567,12 +2379,15 @@ func init() {
 	proto.RegisterType((*TemplateRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.TemplateRef")
 	proto.RegisterType((*UserContainer)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.UserContainer")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ValueFrom")
+	proto.RegisterType((*VolumeClaimGC)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.VolumeClaimGC")
 	proto.RegisterType((*Workflow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Workflow")
+	proto.RegisterType((*WorkflowEnvironment)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowEnvironment")
 	proto.RegisterType((*WorkflowList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowList")
 	proto.RegisterType((*WorkflowSpec)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowSpec")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowSpec.NodeSelectorEntry")
 	proto.RegisterType((*WorkflowStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus")
 	proto.RegisterMapType((Nodes)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.NodesEntry")
+	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.ResourcesDurationEntry")
 	proto.RegisterMapType((map[string]Template)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.StoredTemplatesEntry")
 	proto.RegisterType((*WorkflowStep)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStep")
 	proto.RegisterType((*WorkflowTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowTemplate")
@@ -1585,332 +2400,449 @@ func init() {
 }
 
 var fileDescriptor_c23edafa7e7ea072 = []byte{
-	// 5187 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4d, 0x6c, 0x1c, 0xc9,
-	0x75, 0xbf, 0x86, 0xe4, 0x0c, 0x67, 0xde, 0x90, 0x22, 0x55, 0xa2, 0xa4, 0x59, 0x5a, 0xcb, 0x91,
-	0x7b, 0xff, 0xde, 0xbf, 0x9c, 0xec, 0x0e, 0xbd, 0x92, 0x9d, 0xac, 0xd7, 0xd9, 0x5d, 0x73, 0x48,
-	0x51, 0xa2, 0x24, 0x52, 0xcc, 0x1b, 0x4a, 0x8a, 0xb3, 0x0b, 0x3b, 0xcd, 0xee, 0x9a, 0x99, 0x16,
-	0x67, 0xba, 0x7b, 0xbb, 0x7a, 0xc4, 0x65, 0x36, 0x40, 0x36, 0x41, 0x82, 0x24, 0x08, 0x0c, 0xd8,
-	0x17, 0xc7, 0x80, 0x2f, 0x41, 0x0e, 0xc9, 0x25, 0x97, 0x00, 0x39, 0xe5, 0xe0, 0x00, 0x46, 0x0e,
-	0x0b, 0x5f, 0xb2, 0xc8, 0x25, 0x7b, 0x08, 0x08, 0x2f, 0x03, 0x04, 0x01, 0x12, 0x20, 0x40, 0x2e,
-	0x46, 0x74, 0x0a, 0xea, 0xa3, 0xab, 0x3f, 0xa6, 0xa9, 0x95, 0x66, 0x28, 0x25, 0x81, 0x7d, 0xe2,
-	0xf4, 0x7b, 0xaf, 0x7e, 0xaf, 0x3e, 0x5f, 0xd5, 0x7b, 0xf5, 0x8a, 0xb0, 0xda, 0x71, 0xc2, 0xee,
-	0x60, 0xb7, 0x61, 0x79, 0xfd, 0x65, 0x33, 0xe8, 0x78, 0x7e, 0xe0, 0x3d, 0x10, 0x3f, 0x96, 0xfd,
-	0xbd, 0xce, 0xb2, 0xe9, 0x3b, 0x6c, 0x79, 0xdf, 0x0b, 0xf6, 0xda, 0x3d, 0x6f, 0x7f, 0xf9, 0xe1,
-	0x6b, 0x66, 0xcf, 0xef, 0x9a, 0xaf, 0x2d, 0x77, 0xa8, 0x4b, 0x03, 0x33, 0xa4, 0x76, 0xc3, 0x0f,
-	0xbc, 0xd0, 0x23, 0x57, 0x63, 0x90, 0x46, 0x04, 0x22, 0x7e, 0x34, 0xfc, 0xbd, 0x4e, 0x83, 0x83,
-	0x34, 0x22, 0x90, 0x46, 0x04, 0xb2, 0xf8, 0x6a, 0x42, 0x73, 0xc7, 0xe3, 0x0a, 0x39, 0xd6, 0xee,
-	0xa0, 0x2d, 0xbe, 0xc4, 0x87, 0xf8, 0x25, 0x75, 0x2c, 0x1a, 0x7b, 0xaf, 0xb3, 0x86, 0xe3, 0xf1,
-	0x2a, 0x2d, 0x5b, 0x5e, 0x40, 0x97, 0x1f, 0x0e, 0xd5, 0x63, 0xf1, 0xcb, 0xb1, 0x4c, 0xdf, 0xb4,
-	0xba, 0x8e, 0x4b, 0x83, 0x83, 0xb8, 0x1d, 0x7d, 0x1a, 0x9a, 0x79, 0xa5, 0x96, 0x8f, 0x2b, 0x15,
-	0x0c, 0xdc, 0xd0, 0xe9, 0xd3, 0xa1, 0x02, 0xbf, 0xf4, 0x59, 0x05, 0x98, 0xd5, 0xa5, 0x7d, 0x33,
-	0x5b, 0xce, 0xf8, 0xfb, 0x02, 0xcc, 0xad, 0x04, 0x56, 0xd7, 0x79, 0x48, 0x5b, 0x21, 0x67, 0x74,
-	0x0e, 0xc8, 0x3b, 0x30, 0x19, 0x9a, 0x41, 0xad, 0x70, 0xa9, 0x70, 0xb9, 0x7a, 0xe5, 0xeb, 0x8d,
-	0x11, 0x3a, 0xb2, 0xb1, 0x63, 0x06, 0x11, 0x5c, 0x73, 0xfa, 0xe8, 0xb0, 0x3e, 0xb9, 0x63, 0x06,
-	0xc8, 0x51, 0xc9, 0xb7, 0x60, 0xca, 0xf5, 0x5c, 0x5a, 0x9b, 0x10, 0xe8, 0x2b, 0x23, 0xa1, 0x6f,
-	0x79, 0xae, 0xae, 0x6d, 0xb3, 0x7c, 0x74, 0x58, 0x9f, 0xe2, 0x14, 0x14, 0xc0, 0xc6, 0x7f, 0x14,
-	0xa0, 0xb2, 0x12, 0x74, 0x06, 0x7d, 0xea, 0x86, 0x8c, 0x04, 0x00, 0xbe, 0x19, 0x98, 0x7d, 0x1a,
-	0xd2, 0x80, 0xd5, 0x0a, 0x97, 0x26, 0x2f, 0x57, 0xaf, 0xbc, 0x35, 0x92, 0xd2, 0xed, 0x08, 0xa6,
-	0x49, 0x3e, 0x3a, 0xac, 0x9f, 0x3a, 0x3a, 0xac, 0x83, 0x26, 0x31, 0x4c, 0x68, 0x21, 0x2e, 0x54,
-	0xcc, 0x20, 0x74, 0xda, 0xa6, 0x15, 0xb2, 0xda, 0x84, 0x50, 0xf9, 0xe6, 0x48, 0x2a, 0x57, 0x14,
-	0x4a, 0xf3, 0x8c, 0xd2, 0x58, 0x89, 0x28, 0x0c, 0x63, 0x15, 0xc6, 0xbf, 0x4d, 0x42, 0x39, 0x62,
-	0x90, 0x4b, 0x30, 0xe5, 0x9a, 0x7d, 0x2a, 0x46, 0xaf, 0xd2, 0x9c, 0x51, 0x05, 0xa7, 0xb6, 0xcc,
-	0x3e, 0xef, 0x20, 0xb3, 0x4f, 0xb9, 0x84, 0x6f, 0x86, 0x5d, 0x31, 0x02, 0x09, 0x89, 0x6d, 0x33,
-	0xec, 0xa2, 0xe0, 0x90, 0x8b, 0x30, 0xd5, 0xf7, 0x6c, 0x5a, 0x9b, 0xbc, 0x54, 0xb8, 0x5c, 0x94,
-	0x1d, 0xbc, 0xe9, 0xd9, 0x14, 0x05, 0x95, 0x97, 0x6f, 0x07, 0x5e, 0xbf, 0x36, 0x95, 0x2e, 0xbf,
-	0x1e, 0x78, 0x7d, 0x14, 0x1c, 0xf2, 0xc7, 0x05, 0x98, 0x8f, 0xaa, 0x77, 0xdb, 0xb3, 0xcc, 0xd0,
-	0xf1, 0xdc, 0x5a, 0x51, 0x0c, 0xf8, 0xb5, 0xb1, 0x3a, 0x22, 0x02, 0x6b, 0xd6, 0x94, 0xd6, 0xf9,
-	0x2c, 0x07, 0x87, 0x14, 0x93, 0x2b, 0x00, 0x9d, 0x9e, 0xb7, 0x6b, 0xf6, 0x78, 0x1f, 0xd4, 0x4a,
-	0xa2, 0xd6, 0x7a, 0x08, 0xaf, 0x6b, 0x0e, 0x26, 0xa4, 0xc8, 0x1e, 0x4c, 0x9b, 0x72, 0x55, 0xd4,
-	0xa6, 0x45, 0xbd, 0xd7, 0x46, 0xac, 0x77, 0x6a, 0x65, 0x35, 0xab, 0x47, 0x87, 0xf5, 0x69, 0x45,
-	0xc4, 0x48, 0x03, 0x79, 0x05, 0xca, 0x9e, 0xcf, 0xab, 0x6a, 0xf6, 0x6a, 0xe5, 0x4b, 0x85, 0xcb,
-	0xe5, 0xe6, 0xbc, 0xaa, 0x5e, 0xf9, 0x8e, 0xa2, 0xa3, 0x96, 0x30, 0xfe, 0xa4, 0x08, 0x43, 0xad,
-	0x26, 0xaf, 0x41, 0x55, 0xa1, 0xdd, 0xf6, 0x3a, 0x4c, 0x0c, 0x7e, 0xb9, 0x39, 0x77, 0x74, 0x58,
-	0xaf, 0xae, 0xc4, 0x64, 0x4c, 0xca, 0x90, 0xfb, 0x30, 0xc1, 0xae, 0xaa, 0x65, 0xf8, 0xf6, 0x48,
-	0xad, 0x6b, 0x5d, 0xd5, 0x13, 0xb4, 0x74, 0x74, 0x58, 0x9f, 0x68, 0x5d, 0xc5, 0x09, 0x76, 0x95,
-	0x9b, 0x8f, 0x8e, 0x13, 0x8a, 0xc9, 0x33, 0xaa, 0xf9, 0xb8, 0xee, 0x84, 0x1a, 0x5a, 0x98, 0x8f,
-	0xeb, 0x4e, 0x88, 0x1c, 0x95, 0x9b, 0x8f, 0x6e, 0x18, 0xfa, 0x62, 0xf2, 0x8d, 0x6a, 0x3e, 0x6e,
-	0xec, 0xec, 0x6c, 0x6b, 0x78, 0x31, 0xbb, 0x39, 0x05, 0x05, 0x30, 0xf9, 0x80, 0xf7, 0xa4, 0xe4,
-	0x79, 0xc1, 0x81, 0x9a, 0xb5, 0x37, 0xc6, 0x9a, 0xb5, 0x5e, 0x70, 0xa0, 0xd5, 0xa9, 0x31, 0xd1,
-	0x0c, 0x4c, 0x6a, 0x13, 0xad, 0xb3, 0xdb, 0x4c, 0x4c, 0xd2, 0x91, 0x5b, 0xb7, 0xb6, 0xde, 0xca,
-	0xb4, 0x6e, 0x6d, 0xbd, 0x85, 0x02, 0x98, 0x8f, 0x4d, 0x60, 0xee, 0xab, 0x39, 0x3d, 0xda, 0xd8,
-	0xa0, 0xb9, 0x9f, 0x1e, 0x1b, 0x34, 0xf7, 0x91, 0xa3, 0x1a, 0x1d, 0x38, 0x17, 0x71, 0x90, 0xfa,
-	0x1e, 0x73, 0x44, 0x03, 0x69, 0x9b, 0x2c, 0x43, 0xc5, 0xf2, 0xdc, 0xb6, 0xd3, 0xd9, 0x34, 0x7d,
-	0x65, 0x98, 0xb4, 0x45, 0x5b, 0x8d, 0x18, 0x18, 0xcb, 0x90, 0x17, 0x61, 0x72, 0x8f, 0x1e, 0x28,
-	0x0b, 0x55, 0x55, 0xa2, 0x93, 0xb7, 0xe8, 0x01, 0x72, 0xba, 0xf1, 0xc3, 0x02, 0x9c, 0xcd, 0xe9,
-	0x5c, 0x5e, 0x6c, 0x10, 0xf4, 0x94, 0x06, 0x5d, 0xec, 0x2e, 0xde, 0x46, 0x4e, 0x27, 0x7f, 0x50,
-	0x80, 0xb9, 0x44, 0x6f, 0xaf, 0x0c, 0x94, 0x11, 0x1c, 0x7d, 0x75, 0xa7, 0xb0, 0x9a, 0x17, 0x94,
-	0xc6, 0xb9, 0x0c, 0x03, 0xb3, 0x5a, 0x8d, 0x7f, 0x14, 0xbb, 0x6e, 0x8a, 0x46, 0x4c, 0x38, 0x3d,
-	0x60, 0x34, 0xe0, 0x26, 0xba, 0x45, 0xad, 0x80, 0x86, 0x6a, 0x03, 0xfe, 0x42, 0x43, 0x6e, 0xed,
-	0xbc, 0x16, 0x0d, 0x7e, 0xca, 0x68, 0x3c, 0x7c, 0xad, 0x21, 0x25, 0x6e, 0xd1, 0x83, 0x16, 0xed,
-	0x51, 0x8e, 0xd1, 0x24, 0x47, 0x87, 0xf5, 0xd3, 0x77, 0x53, 0x00, 0x98, 0x01, 0xe4, 0x2a, 0x7c,
-	0x93, 0xb1, 0x7d, 0x2f, 0xb0, 0x95, 0x8a, 0x89, 0xa7, 0x56, 0xb1, 0x9d, 0x02, 0xc0, 0x0c, 0xa0,
-	0xf1, 0xbd, 0x02, 0x4c, 0x37, 0x4d, 0x6b, 0xcf, 0x6b, 0xb7, 0xb9, 0x5d, 0xb3, 0x07, 0x81, 0xb4,
-	0xfe, 0x72, 0x4c, 0xb4, 0x5d, 0x5b, 0x53, 0x74, 0xd4, 0x12, 0xe4, 0x65, 0x28, 0xc9, 0xee, 0x10,
-	0x95, 0x2a, 0x36, 0x4f, 0x2b, 0xd9, 0xd2, 0xba, 0xa0, 0xa2, 0xe2, 0x92, 0xaf, 0x40, 0xb5, 0x6f,
-	0xbe, 0x1f, 0x01, 0x08, 0x33, 0x53, 0x69, 0x9e, 0x55, 0xc2, 0xd5, 0xcd, 0x98, 0x85, 0x49, 0x39,
-	0xe3, 0x1b, 0x00, 0xab, 0x9e, 0x1b, 0x3a, 0xee, 0x80, 0xde, 0x71, 0xc9, 0x4b, 0x50, 0xa4, 0x41,
-	0xe0, 0x05, 0xca, 0x52, 0xce, 0xaa, 0xe2, 0xc5, 0x6b, 0x9c, 0x88, 0x92, 0x27, 0x6b, 0xe4, 0xf4,
-	0xa8, 0x2d, 0x6a, 0x54, 0x4e, 0xd6, 0x88, 0x53, 0x51, 0x71, 0x8d, 0x1f, 0x4f, 0xc0, 0xcc, 0x6a,
-	0xe0, 0xb9, 0xf7, 0xd5, 0x0c, 0x21, 0xbf, 0x01, 0x65, 0x7e, 0xb0, 0xb3, 0xcd, 0xd0, 0x54, 0x83,
-	0xf8, 0xa5, 0x44, 0x0f, 0xeb, 0xf3, 0x59, 0x3c, 0xb7, 0xb8, 0x34, 0xef, 0xf3, 0x3b, 0xbb, 0x0f,
-	0xa8, 0x15, 0x6e, 0xd2, 0xd0, 0x8c, 0x77, 0xa8, 0x98, 0x86, 0x1a, 0x95, 0x74, 0x60, 0x8a, 0xf9,
-	0xd4, 0x52, 0xe3, 0x37, 0xda, 0xa6, 0x9a, 0xac, 0x72, 0xcb, 0xa7, 0x56, 0xbc, 0x95, 0xf3, 0x2f,
-	0x14, 0x0a, 0x88, 0x07, 0x25, 0x16, 0x9a, 0xe1, 0x80, 0x29, 0x7b, 0x7e, 0x7d, 0x7c, 0x55, 0x02,
-	0x2e, 0xee, 0x4c, 0xf9, 0x8d, 0x4a, 0x8d, 0xf1, 0x49, 0x01, 0xe6, 0x93, 0xe2, 0xb7, 0x1d, 0x16,
-	0x92, 0x77, 0x87, 0x3a, 0xb4, 0xf1, 0x64, 0x1d, 0xca, 0x4b, 0x8b, 0xee, 0xd4, 0x33, 0x2f, 0xa2,
-	0x24, 0x3a, 0xb3, 0x0d, 0x45, 0x27, 0xa4, 0xfd, 0xe8, 0xac, 0xb6, 0x32, 0x76, 0x13, 0xe3, 0xf9,
-	0xb4, 0xc1, 0x71, 0x51, 0xc2, 0x1b, 0xdf, 0x29, 0xa6, 0x9b, 0xc6, 0xbb, 0x99, 0x9f, 0x95, 0x66,
-	0xf6, 0x13, 0x04, 0xd5, 0xbe, 0xd1, 0x2a, 0x91, 0x1a, 0xce, 0xff, 0xa7, 0x2a, 0x31, 0x93, 0xa4,
-	0x3e, 0xca, 0x7c, 0x63, 0x4a, 0x39, 0x5f, 0xb2, 0xdc, 0x51, 0xb0, 0x07, 0x3d, 0xaa, 0xac, 0xaf,
-	0xee, 0xb8, 0x96, 0xa2, 0xa3, 0x96, 0x20, 0xef, 0xc2, 0x19, 0xcb, 0x73, 0xad, 0x41, 0x10, 0x50,
-	0xd7, 0x3a, 0xd8, 0xf6, 0x7a, 0x8e, 0x75, 0xa0, 0x16, 0x64, 0x43, 0x15, 0x3b, 0xb3, 0x9a, 0x15,
-	0x78, 0x94, 0x47, 0xc4, 0x61, 0x20, 0xf2, 0x45, 0x98, 0x66, 0x03, 0xe6, 0x53, 0xd7, 0x16, 0xbb,
-	0x7d, 0xb9, 0x39, 0xa7, 0x30, 0xa7, 0x5b, 0x92, 0x8c, 0x11, 0x9f, 0xdc, 0x85, 0x0b, 0x2c, 0xe4,
-	0x46, 0xd6, 0xed, 0xac, 0x51, 0xd3, 0xee, 0x39, 0x2e, 0x37, 0x79, 0x9e, 0x6b, 0x33, 0xb1, 0x81,
-	0x4f, 0x36, 0x3f, 0x77, 0x74, 0x58, 0xbf, 0xd0, 0xca, 0x17, 0xc1, 0xe3, 0xca, 0x92, 0x6f, 0xc2,
-	0x22, 0x1b, 0x58, 0x16, 0x65, 0xac, 0x3d, 0xe8, 0xdd, 0xf4, 0x76, 0xd9, 0x0d, 0x87, 0x71, 0x7b,
-	0x7d, 0xdb, 0xe9, 0x3b, 0xa1, 0xd8, 0xa4, 0x8b, 0xcd, 0xa5, 0xa3, 0xc3, 0xfa, 0x62, 0xeb, 0x58,
-	0x29, 0x7c, 0x0c, 0x02, 0x41, 0x38, 0x2f, 0x4d, 0xc8, 0x10, 0xf6, 0xb4, 0xc0, 0x5e, 0x3c, 0x3a,
-	0xac, 0x9f, 0x5f, 0xcf, 0x95, 0xc0, 0x63, 0x4a, 0xf2, 0x11, 0xe4, 0xfe, 0xde, 0x6f, 0x72, 0x1f,
-	0xab, 0x9c, 0x1e, 0xc1, 0x1d, 0x45, 0x47, 0x2d, 0x61, 0xfc, 0x43, 0x01, 0xc8, 0xf0, 0xe2, 0x24,
-	0xb7, 0xa0, 0x64, 0x5a, 0x21, 0x3f, 0xfd, 0x4a, 0x8f, 0xe9, 0xa5, 0xbc, 0x0d, 0x42, 0x1a, 0x26,
-	0xa4, 0x6d, 0xca, 0x47, 0x8d, 0xc6, 0x2b, 0x7a, 0x45, 0x14, 0x45, 0x05, 0x41, 0x3c, 0x38, 0xd3,
-	0x33, 0x59, 0x18, 0xcd, 0x1f, 0x9b, 0x57, 0x43, 0x19, 0xae, 0x5f, 0x78, 0xb2, 0x55, 0xcc, 0x4b,
-	0x34, 0xcf, 0xf1, 0xd9, 0x74, 0x3b, 0x0b, 0x84, 0xc3, 0xd8, 0xc6, 0x8f, 0x4a, 0x30, 0xbd, 0xb6,
-	0x72, 0x7d, 0xc7, 0x64, 0x7b, 0x4f, 0xe0, 0x0e, 0xf1, 0x0e, 0xa3, 0x7d, 0xbf, 0x67, 0x86, 0x43,
-	0x53, 0x7e, 0x47, 0xd1, 0x51, 0x4b, 0x10, 0x8f, 0xfb, 0x76, 0xca, 0xb9, 0x54, 0x26, 0xf1, 0xad,
-	0x11, 0x0f, 0x0f, 0x0a, 0x25, 0xe9, 0xdc, 0x29, 0x12, 0xc6, 0x3a, 0x08, 0x83, 0x6a, 0xa4, 0x1c,
-	0x69, 0x5b, 0x9d, 0x7b, 0x47, 0x74, 0xca, 0x63, 0x1c, 0x79, 0x0e, 0x4d, 0x10, 0x30, 0xa9, 0x85,
-	0x7c, 0x19, 0x66, 0x6c, 0xca, 0x57, 0x16, 0x75, 0x2d, 0x87, 0xf2, 0x45, 0x34, 0xc9, 0xfb, 0x85,
-	0x1b, 0x93, 0xb5, 0x04, 0x1d, 0x53, 0x52, 0xe4, 0x01, 0x54, 0xf6, 0x9d, 0xb0, 0x2b, 0x6c, 0x5e,
-	0xad, 0x24, 0x26, 0xce, 0x57, 0x47, 0xaa, 0x28, 0x47, 0x88, 0xbb, 0xe5, 0x7e, 0x84, 0x89, 0x31,
-	0x3c, 0x3f, 0x52, 0xf2, 0x0f, 0xe1, 0x81, 0x8b, 0xd5, 0x52, 0x49, 0x17, 0x10, 0x0c, 0x8c, 0x65,
-	0x08, 0x83, 0x19, 0xfe, 0xd1, 0xa2, 0xef, 0x0d, 0xf8, 0x6c, 0x15, 0x6b, 0x63, 0x54, 0xbf, 0x3c,
-	0x02, 0x91, 0x3d, 0x72, 0x3f, 0x01, 0x8b, 0x29, 0x25, 0x7c, 0xf6, 0xed, 0x77, 0xa9, 0x5b, 0xab,
-	0xa4, 0x67, 0xdf, 0xfd, 0x2e, 0x75, 0x51, 0x70, 0x88, 0x07, 0x60, 0xe9, 0x63, 0x49, 0x0d, 0xc6,
-	0xf0, 0xc6, 0xe2, 0xd3, 0x4d, 0xf3, 0x34, 0x3f, 0x37, 0xc4, 0xdf, 0x98, 0x50, 0xc1, 0x0f, 0x35,
-	0x9e, 0x7b, 0xed, 0x7d, 0x27, 0xac, 0x55, 0x45, 0xa5, 0xf4, 0xaa, 0xbd, 0x23, 0xa8, 0xa8, 0xb8,
-	0xc6, 0x8f, 0x0a, 0x50, 0xe5, 0x8b, 0x28, 0x9a, 0xf8, 0x2f, 0x43, 0x29, 0x34, 0x83, 0x8e, 0x3a,
-	0x96, 0x26, 0xca, 0xed, 0x08, 0x2a, 0x2a, 0x2e, 0x31, 0xa1, 0x18, 0x9a, 0x6c, 0x2f, 0xda, 0x4c,
-	0x7f, 0x65, 0xa4, 0xb6, 0xa8, 0xd5, 0x1b, 0xef, 0xa3, 0xfc, 0x8b, 0xa1, 0x44, 0x26, 0x97, 0xa1,
-	0xcc, 0x8d, 0xdf, 0xba, 0xc9, 0xa4, 0x97, 0x59, 0x6e, 0xce, 0xf0, 0xd5, 0xba, 0xae, 0x68, 0xa8,
-	0xb9, 0xc6, 0xbb, 0x70, 0xfa, 0xda, 0xfb, 0xd4, 0x1a, 0x84, 0x5e, 0x20, 0xfd, 0x0c, 0x72, 0x13,
-	0x08, 0xa3, 0xc1, 0x43, 0xc7, 0xa2, 0x2b, 0x96, 0xe5, 0x0d, 0xdc, 0x70, 0x2b, 0xb6, 0x0e, 0x8b,
-	0x4a, 0x1b, 0x69, 0x0d, 0x49, 0x60, 0x4e, 0x29, 0xe3, 0x2f, 0xa7, 0xa0, 0x9a, 0xf0, 0x54, 0xf9,
-	0x68, 0x07, 0xd4, 0xf7, 0xb2, 0xb6, 0x86, 0xfb, 0x42, 0x28, 0x38, 0xdc, 0xd6, 0x04, 0xf4, 0xa1,
-	0xc3, 0xf8, 0xc1, 0x35, 0x63, 0x6b, 0x50, 0xd1, 0x51, 0x4b, 0x90, 0x3a, 0x14, 0x6d, 0xea, 0x87,
-	0x5d, 0xd1, 0xc8, 0xa9, 0x66, 0x85, 0x77, 0xc4, 0x1a, 0x27, 0xa0, 0xa4, 0x73, 0x81, 0x36, 0x0d,
-	0xad, 0x6e, 0x6d, 0x4a, 0xac, 0x4f, 0x21, 0xb0, 0xce, 0x09, 0x28, 0xe9, 0x39, 0x3e, 0x45, 0xf1,
-	0xd9, 0xfb, 0x14, 0xa5, 0x13, 0xf6, 0x29, 0x88, 0x0f, 0x67, 0x19, 0xeb, 0x6e, 0x07, 0xce, 0x43,
-	0x33, 0xa4, 0xa2, 0xb0, 0xd0, 0x33, 0xfd, 0x34, 0x7a, 0x2e, 0x1c, 0x1d, 0xd6, 0xcf, 0xb6, 0x5a,
-	0x37, 0xb2, 0x28, 0x98, 0x07, 0x4d, 0x5a, 0x70, 0xce, 0x71, 0x19, 0xb5, 0x06, 0x01, 0xdd, 0xe8,
-	0xb8, 0x5e, 0x40, 0x6f, 0x78, 0x8c, 0xc3, 0xa9, 0xf0, 0xcc, 0x8b, 0x6a, 0xd0, 0xce, 0x6d, 0xe4,
-	0x09, 0x61, 0x7e, 0x59, 0xe3, 0xc7, 0x05, 0x98, 0x49, 0x3a, 0xe7, 0x84, 0x01, 0x74, 0xd7, 0xd6,
-	0x5b, 0x72, 0x66, 0xaa, 0x73, 0xdf, 0xdb, 0x23, 0xfb, 0xfc, 0x12, 0x26, 0xf6, 0x1b, 0x62, 0x1a,
-	0x26, 0xd4, 0x3c, 0x41, 0xf4, 0xef, 0x25, 0x28, 0xb6, 0xbd, 0xc0, 0xa2, 0x6a, 0x6d, 0xe9, 0x35,
-	0xb8, 0xce, 0x89, 0x28, 0x79, 0xc6, 0xbf, 0x16, 0x20, 0xa1, 0x81, 0xfc, 0x36, 0xcc, 0x72, 0x1d,
-	0xb7, 0x82, 0xdd, 0x54, 0x6b, 0x9a, 0x23, 0xb7, 0x46, 0x23, 0x35, 0xcf, 0x29, 0xfd, 0xb3, 0x29,
-	0x32, 0xa6, 0xf5, 0x91, 0x5f, 0x84, 0x8a, 0x69, 0xdb, 0x01, 0x65, 0x8c, 0x4a, 0xd3, 0x53, 0x69,
-	0xce, 0x8a, 0x3d, 0x35, 0x22, 0x62, 0xcc, 0xe7, 0xcb, 0xb0, 0x6b, 0xb7, 0x19, 0x9f, 0xd9, 0xea,
-	0xb8, 0xaa, 0x97, 0x21, 0x57, 0xc2, 0xe9, 0xa8, 0x25, 0x8c, 0x6f, 0x4f, 0x41, 0x5a, 0x37, 0xb1,
-	0x61, 0x6e, 0x2f, 0xd8, 0x5d, 0x5d, 0x35, 0xad, 0xee, 0x48, 0xbe, 0xfa, 0xd9, 0xa3, 0xc3, 0xfa,
-	0xdc, 0xad, 0x34, 0x02, 0x66, 0x21, 0x95, 0x96, 0x5b, 0xf4, 0x20, 0x34, 0x77, 0x47, 0x71, 0xd7,
-	0x23, 0x2d, 0x49, 0x04, 0xcc, 0x42, 0x72, 0x77, 0x7a, 0x2f, 0xd8, 0x8d, 0x16, 0x79, 0xd6, 0x9d,
-	0xbe, 0x15, 0xb3, 0x30, 0x29, 0xc7, 0xbb, 0x70, 0x2f, 0xd8, 0x45, 0x6a, 0xf6, 0xa2, 0x40, 0xb0,
-	0xee, 0xc2, 0x5b, 0x8a, 0x8e, 0x5a, 0x82, 0xf8, 0x40, 0xf6, 0xa2, 0xde, 0xd3, 0x01, 0x1f, 0x65,
-	0x8b, 0x2e, 0xe7, 0xb5, 0x46, 0x0b, 0x25, 0x1b, 0x74, 0x9e, 0xdb, 0xe6, 0x5b, 0x43, 0x38, 0x98,
-	0x83, 0x4d, 0xbe, 0x01, 0x17, 0xf6, 0x82, 0x5d, 0x65, 0xc8, 0xb7, 0x03, 0xc7, 0xb5, 0x1c, 0x3f,
-	0x15, 0x01, 0xae, 0xab, 0xea, 0x5e, 0xb8, 0x95, 0x2f, 0x86, 0xc7, 0x95, 0x37, 0x5e, 0x85, 0x99,
-	0x64, 0x04, 0xf1, 0x33, 0xa2, 0x4e, 0xc6, 0xbf, 0x17, 0xa0, 0xb4, 0xe1, 0xfa, 0x83, 0x9f, 0x91,
-	0xcb, 0x88, 0x3f, 0x9b, 0x82, 0x29, 0x7e, 0x44, 0x23, 0x97, 0x61, 0x2a, 0x3c, 0xf0, 0xe5, 0xde,
-	0x3a, 0xd9, 0x5c, 0x88, 0x0c, 0xcd, 0xce, 0x81, 0x4f, 0x1f, 0xa9, 0xbf, 0x28, 0x24, 0xc8, 0x5b,
-	0x50, 0x72, 0x07, 0xfd, 0x7b, 0x66, 0x4f, 0x19, 0xa5, 0x97, 0xa3, 0xa3, 0xc5, 0x96, 0xa0, 0x3e,
-	0x3a, 0xac, 0x2f, 0x50, 0xd7, 0xf2, 0x6c, 0xc7, 0xed, 0x2c, 0x3f, 0x60, 0x9e, 0xdb, 0xd8, 0x1a,
-	0xf4, 0x77, 0x69, 0x80, 0xaa, 0x14, 0x77, 0x14, 0x77, 0x3d, 0xaf, 0xc7, 0x01, 0x26, 0xd3, 0x8e,
-	0x62, 0x53, 0x92, 0x31, 0xe2, 0xf3, 0x53, 0x0c, 0x0b, 0x03, 0x2e, 0x39, 0x95, 0x3e, 0xc5, 0xb4,
-	0x04, 0x15, 0x15, 0x97, 0xf4, 0xa1, 0xd4, 0x37, 0x7d, 0x2e, 0x57, 0x14, 0x5d, 0x76, 0x6d, 0xe4,
-	0x73, 0x6c, 0x63, 0x53, 0xe0, 0x5c, 0x73, 0xc3, 0xe0, 0x20, 0x56, 0x27, 0x89, 0xa8, 0x94, 0x10,
-	0x07, 0xa6, 0x7b, 0x0e, 0x0b, 0xb9, 0xbe, 0xd2, 0x18, 0xb3, 0x82, 0xeb, 0xbb, 0x67, 0xf6, 0x06,
-	0x34, 0xee, 0x81, 0xdb, 0x12, 0x16, 0x23, 0xfc, 0xc5, 0x03, 0xa8, 0x26, 0x6a, 0x44, 0xe6, 0x65,
-	0xa4, 0x55, 0x4c, 0x5e, 0x11, 0x5c, 0x25, 0x3b, 0x50, 0x7c, 0xc8, 0x31, 0x94, 0xb1, 0x19, 0xb3,
-	0x26, 0x28, 0xc1, 0xde, 0x98, 0x78, 0xbd, 0xf0, 0x46, 0xf9, 0xfb, 0x7f, 0x5a, 0x3f, 0xf5, 0xe1,
-	0x3f, 0x5d, 0x3a, 0x65, 0xfc, 0xdd, 0x24, 0x54, 0xb4, 0xc8, 0xff, 0xed, 0x99, 0x12, 0x64, 0x66,
-	0xca, 0xcd, 0xf1, 0xfa, 0xeb, 0x89, 0xa6, 0xcb, 0x4a, 0x7a, 0xba, 0xcc, 0x34, 0xff, 0x7f, 0x62,
-	0xa8, 0x1f, 0x1d, 0xd6, 0x6b, 0xe9, 0x4e, 0x40, 0x73, 0x7f, 0x93, 0x32, 0x66, 0x76, 0x68, 0x3c,
-	0x0d, 0xbe, 0xfa, 0x59, 0xd3, 0x60, 0x21, 0x39, 0x0d, 0x2a, 0xf9, 0xc3, 0xf8, 0xe1, 0x24, 0x94,
-	0x37, 0xa3, 0x28, 0xda, 0xef, 0x17, 0xa0, 0x6a, 0xba, 0xae, 0x17, 0x8a, 0x78, 0x6b, 0x64, 0xde,
-	0xb6, 0x46, 0xea, 0x8e, 0x08, 0xb4, 0xb1, 0x12, 0x03, 0xca, 0x2e, 0xd1, 0x3b, 0x53, 0x82, 0x83,
-	0x49, 0xbd, 0xe4, 0x3d, 0x28, 0xf5, 0xcc, 0x5d, 0xda, 0x8b, 0xac, 0xdd, 0xc6, 0x78, 0x35, 0xb8,
-	0x2d, 0xb0, 0x32, 0xe3, 0x21, 0x89, 0xa8, 0x14, 0x2d, 0xbe, 0x05, 0xf3, 0xd9, 0x8a, 0x3e, 0x4d,
-	0x8f, 0xf2, 0xc1, 0x48, 0xa8, 0x79, 0x9a, 0xa2, 0xc6, 0x7f, 0x55, 0x00, 0xb6, 0x3c, 0x9b, 0xaa,
-	0xc0, 0xcd, 0x22, 0x4c, 0x38, 0xb6, 0xda, 0x8a, 0x40, 0xd5, 0x76, 0x62, 0x63, 0x0d, 0x27, 0x1c,
-	0x5b, 0x87, 0x42, 0x26, 0x8e, 0x0d, 0x85, 0x7c, 0x05, 0xaa, 0xb6, 0xc3, 0xfc, 0x9e, 0x79, 0xb0,
-	0x95, 0x73, 0x16, 0x58, 0x8b, 0x59, 0x98, 0x94, 0x23, 0xaf, 0xa8, 0xf5, 0x2b, 0x17, 0x4a, 0x2d,
-	0xb3, 0x7e, 0xcb, 0xbc, 0x7a, 0x89, 0x35, 0xfc, 0x3a, 0xcc, 0x44, 0xa1, 0x06, 0xa1, 0xa5, 0x28,
-	0x4a, 0x45, 0xab, 0x7e, 0x66, 0x27, 0xc1, 0xc3, 0x94, 0x64, 0x36, 0x14, 0x52, 0x7a, 0x2e, 0xa1,
-	0x90, 0x35, 0x98, 0x67, 0xa1, 0x17, 0x50, 0x3b, 0x92, 0xd8, 0x58, 0xab, 0x91, 0x54, 0x43, 0xe7,
-	0x5b, 0x19, 0x3e, 0x0e, 0x95, 0x20, 0xdb, 0xb0, 0x10, 0x55, 0x22, 0xd9, 0xc0, 0xda, 0x59, 0x81,
-	0x74, 0x51, 0x21, 0x2d, 0xdc, 0xcf, 0x91, 0xc1, 0xdc, 0x92, 0xe4, 0x6b, 0x30, 0x1b, 0x55, 0xb3,
-	0x65, 0x79, 0x3e, 0xad, 0x2d, 0x08, 0x28, 0x7d, 0x5a, 0xde, 0x49, 0x32, 0x31, 0x2d, 0x4b, 0xbe,
-	0x04, 0x45, 0xbf, 0x6b, 0x32, 0xaa, 0x22, 0x27, 0x91, 0xe3, 0x5b, 0xdc, 0xe6, 0xc4, 0x47, 0x87,
-	0xf5, 0x0a, 0x1f, 0x33, 0xf1, 0x81, 0x52, 0x90, 0x5c, 0x01, 0xd8, 0xf5, 0x06, 0xae, 0x6d, 0x06,
-	0x07, 0x1b, 0x6b, 0x2a, 0xb0, 0xa8, 0x8f, 0x1e, 0x4d, 0xcd, 0xc1, 0x84, 0x14, 0xb7, 0xb6, 0x7d,
-	0x69, 0x77, 0x54, 0x00, 0x44, 0x5b, 0x5b, 0x6d, 0x8e, 0x14, 0x9f, 0xbc, 0x03, 0x15, 0x11, 0x84,
-	0xa5, 0xf6, 0x4a, 0xa8, 0xa2, 0x20, 0x4f, 0x13, 0x1b, 0xd4, 0x47, 0x92, 0x56, 0x04, 0x82, 0x31,
-	0x1e, 0xf9, 0x26, 0x40, 0xdb, 0x71, 0x1d, 0xd6, 0x15, 0xe8, 0xd5, 0xa7, 0x46, 0xd7, 0xed, 0x5c,
-	0xd7, 0x28, 0x98, 0x40, 0xe4, 0x0e, 0x93, 0xef, 0xd9, 0x1b, 0xdb, 0xb5, 0x19, 0xd1, 0x4a, 0xed,
-	0x30, 0x6d, 0x73, 0x22, 0x4a, 0x1e, 0xb9, 0x0c, 0x65, 0xdb, 0xa4, 0x7d, 0xcf, 0xa5, 0x76, 0x6d,
-	0x36, 0x0e, 0x5a, 0xac, 0x29, 0x1a, 0x6a, 0x2e, 0xf9, 0x16, 0x94, 0x1c, 0x71, 0x5e, 0xac, 0x9d,
-	0x16, 0x55, 0xfd, 0xda, 0x68, 0x3b, 0x8a, 0x80, 0x68, 0x02, 0x37, 0x57, 0xf2, 0x37, 0x2a, 0x58,
-	0x62, 0xc1, 0xb4, 0x37, 0x08, 0x85, 0x86, 0x39, 0xa1, 0x61, 0xb4, 0x20, 0xcd, 0x1d, 0x89, 0x21,
-	0x93, 0x1a, 0xd4, 0x07, 0x46, 0xc8, 0xbc, 0xbd, 0x56, 0xd7, 0xe9, 0xd9, 0x01, 0x75, 0x6b, 0xf3,
-	0xc2, 0x1f, 0x13, 0xed, 0x5d, 0x55, 0x34, 0xd4, 0x5c, 0xf2, 0xcb, 0x30, 0xeb, 0x0d, 0x42, 0x31,
-	0x6f, 0xf8, 0xb4, 0x63, 0xb5, 0x33, 0x42, 0xfc, 0x0c, 0x9f, 0xc5, 0x77, 0x92, 0x0c, 0x4c, 0xcb,
-	0x19, 0xa7, 0x61, 0x26, 0x99, 0x09, 0x64, 0x7c, 0x77, 0x02, 0xa2, 0x7a, 0xfc, 0x2c, 0x1c, 0xb5,
-	0x89, 0x01, 0xa5, 0x80, 0xb2, 0x41, 0x2f, 0x54, 0x96, 0x5a, 0x8c, 0x35, 0x0a, 0x0a, 0x2a, 0x8e,
-	0xb1, 0x0f, 0xb3, 0xbc, 0xb6, 0xbd, 0x1e, 0xed, 0xb5, 0x42, 0xea, 0x33, 0xd2, 0x86, 0x22, 0xe3,
-	0x3f, 0x54, 0x9f, 0x8c, 0x79, 0xcf, 0x14, 0x52, 0x3f, 0x9e, 0xef, 0x42, 0x01, 0x4a, 0x78, 0xe3,
-	0x7b, 0x13, 0x50, 0xd1, 0xfd, 0xf4, 0x04, 0x61, 0xf8, 0x2f, 0xc0, 0xb4, 0x4d, 0xdb, 0x26, 0x6f,
-	0x8d, 0xba, 0xf6, 0xe7, 0xd3, 0x6a, 0x4d, 0x92, 0x30, 0xe2, 0x91, 0x7a, 0xb4, 0x13, 0xca, 0x26,
-	0x8b, 0x90, 0x57, 0xf2, 0xa0, 0x49, 0xf6, 0xa0, 0x22, 0x7e, 0xac, 0x47, 0x29, 0x4a, 0xa3, 0x8e,
-	0xfb, 0xbd, 0x08, 0x45, 0x06, 0x12, 0xf4, 0x27, 0xc6, 0xf8, 0x99, 0xd4, 0xa2, 0xe2, 0x93, 0xa4,
-	0x16, 0x19, 0xeb, 0xc0, 0x0d, 0xc3, 0xf5, 0x55, 0xf2, 0x26, 0x94, 0x99, 0x9a, 0xba, 0xaa, 0x5f,
-	0x3e, 0xaf, 0xef, 0xda, 0x14, 0xfd, 0xd1, 0x61, 0x7d, 0x56, 0x08, 0x47, 0x04, 0xd4, 0x45, 0x8c,
-	0x65, 0xa8, 0x26, 0x52, 0x31, 0x78, 0x0f, 0xeb, 0xeb, 0xd1, 0x44, 0x0f, 0xaf, 0x99, 0xa1, 0x89,
-	0x82, 0x63, 0x3c, 0x9a, 0x80, 0x79, 0xa4, 0xcc, 0x1b, 0x04, 0x16, 0x4d, 0x86, 0x75, 0x4d, 0x2b,
-	0x71, 0x43, 0x9f, 0xba, 0xc4, 0xf1, 0x5c, 0x54, 0x5c, 0xbe, 0xdd, 0xf4, 0x69, 0xd0, 0xd1, 0x8b,
-	0x4d, 0x0d, 0x92, 0xde, 0x6e, 0x36, 0x93, 0x4c, 0x4c, 0xcb, 0x92, 0x57, 0xa0, 0xdc, 0x37, 0x5d,
-	0xa7, 0x4d, 0x59, 0x98, 0x8d, 0xb7, 0x6c, 0x2a, 0x3a, 0x6a, 0x09, 0x72, 0x1d, 0xce, 0x30, 0x1a,
-	0xde, 0xd9, 0x77, 0x69, 0xa0, 0x2f, 0x97, 0xd4, 0x0d, 0xe0, 0x0b, 0xd1, 0xad, 0x62, 0x2b, 0x2b,
-	0x80, 0xc3, 0x65, 0xc4, 0xd6, 0x2d, 0x2f, 0xdf, 0x56, 0x3d, 0xd7, 0x76, 0x74, 0x16, 0x5a, 0x72,
-	0xeb, 0xce, 0xf0, 0x71, 0xa8, 0x04, 0x47, 0x69, 0x9b, 0x4e, 0x6f, 0x10, 0xd0, 0x18, 0xa5, 0x94,
-	0x46, 0x59, 0xcf, 0xf0, 0x71, 0xa8, 0x84, 0xf1, 0x2f, 0x05, 0x98, 0x45, 0x1a, 0x06, 0x07, 0xba,
-	0x53, 0xea, 0x50, 0xec, 0x89, 0xbb, 0xbe, 0x82, 0xb8, 0xeb, 0x13, 0x33, 0x59, 0x5e, 0xed, 0x49,
-	0x3a, 0x59, 0x83, 0x6a, 0xc0, 0x4b, 0xa8, 0x7b, 0x55, 0xd9, 0xe1, 0x46, 0x74, 0x1a, 0xc3, 0x98,
-	0xf5, 0x28, 0xfd, 0x89, 0xc9, 0x62, 0xc4, 0x85, 0xe9, 0x5d, 0x99, 0x8f, 0xa1, 0xae, 0xab, 0x46,
-	0x33, 0xf6, 0x2a, 0xa7, 0x43, 0xc4, 0x60, 0xa2, 0x04, 0x8f, 0x47, 0xf1, 0x4f, 0x8c, 0x94, 0x18,
-	0xdf, 0x2f, 0x00, 0xc4, 0x89, 0x61, 0x64, 0x0f, 0xca, 0xec, 0x6a, 0x73, 0x60, 0xed, 0xe9, 0x18,
-	0xd9, 0x88, 0x57, 0x2e, 0x0a, 0x24, 0x71, 0x1f, 0xad, 0x28, 0xa8, 0x15, 0x7c, 0x56, 0xda, 0xd0,
-	0x5f, 0x4d, 0x82, 0x2e, 0xc5, 0xe7, 0x24, 0x75, 0x6d, 0xdf, 0x73, 0xdc, 0x30, 0x9b, 0x9c, 0x72,
-	0x4d, 0xd1, 0x51, 0x4b, 0xf0, 0x65, 0xb2, 0x2b, 0x1b, 0x31, 0x91, 0x5e, 0x26, 0xaa, 0x0e, 0x8a,
-	0xcb, 0xe5, 0x02, 0xda, 0x89, 0xf3, 0x52, 0xb4, 0x1c, 0x0a, 0x2a, 0x2a, 0x2e, 0xdf, 0x1d, 0xa3,
-	0x20, 0xb1, 0x9a, 0xda, 0x62, 0x77, 0x8c, 0xe2, 0xc9, 0xa8, 0xb9, 0xa4, 0x0b, 0x73, 0xa6, 0x98,
-	0x91, 0x71, 0xe0, 0xfb, 0xa9, 0x62, 0xf8, 0x71, 0x52, 0x52, 0x1a, 0x05, 0xb3, 0xb0, 0x5c, 0x13,
-	0x8b, 0x8b, 0x3f, 0x7d, 0x28, 0x5f, 0x6b, 0x6a, 0xa5, 0x51, 0x30, 0x0b, 0xcb, 0x0f, 0x86, 0x81,
-	0xd7, 0xa3, 0x2b, 0xb8, 0xa5, 0x0e, 0xa0, 0xfa, 0x60, 0x88, 0x92, 0x8c, 0x11, 0xdf, 0xf8, 0xc3,
-	0x02, 0x9c, 0x6e, 0x59, 0x81, 0xe3, 0x87, 0xda, 0x64, 0x6d, 0x89, 0x6c, 0xb2, 0xd0, 0xe4, 0x47,
-	0x36, 0x35, 0xa7, 0x5e, 0x3c, 0x26, 0x86, 0x28, 0x85, 0x52, 0xc9, 0x66, 0x92, 0x84, 0x31, 0x84,
-	0xf0, 0xf4, 0x85, 0x51, 0xcc, 0x8e, 0x6d, 0x4b, 0x50, 0x51, 0x71, 0x8d, 0x1f, 0x14, 0xa0, 0xac,
-	0x6f, 0xf6, 0x5e, 0x82, 0xa2, 0xb8, 0x08, 0x52, 0x73, 0x47, 0xef, 0x81, 0xab, 0x9c, 0x88, 0x92,
-	0xc7, 0x85, 0xc4, 0x29, 0x54, 0x01, 0x27, 0x36, 0x4a, 0x33, 0x08, 0x51, 0xf2, 0xf8, 0xa4, 0xa5,
-	0xae, 0xad, 0xe6, 0x8b, 0x9e, 0xb4, 0xd7, 0x5c, 0x1b, 0x39, 0x5d, 0x24, 0x21, 0x79, 0x41, 0xdf,
-	0x0c, 0xb3, 0x71, 0x88, 0x75, 0x41, 0x45, 0xc5, 0x35, 0xde, 0x86, 0x39, 0x95, 0x16, 0xa1, 0x3b,
-	0xea, 0xa9, 0xf2, 0xaf, 0x8c, 0x9f, 0x16, 0xa0, 0xba, 0xb3, 0x73, 0x5b, 0xdb, 0x27, 0x84, 0xf3,
-	0x4c, 0xe6, 0x41, 0xac, 0xb4, 0x43, 0x1a, 0xac, 0x7a, 0x7d, 0xbf, 0x47, 0x35, 0x96, 0x4a, 0x4e,
-	0x68, 0xe5, 0x4a, 0xe0, 0x31, 0x25, 0xc9, 0x06, 0x9c, 0x4d, 0x72, 0x94, 0xf5, 0x55, 0x09, 0x5f,
-	0xf2, 0x8a, 0x66, 0x98, 0x8d, 0x79, 0x65, 0xb2, 0x50, 0xca, 0x04, 0xab, 0x94, 0xe5, 0x21, 0x28,
-	0xc5, 0xc6, 0xbc, 0x32, 0xc6, 0x2c, 0x54, 0x13, 0xf9, 0xea, 0xc6, 0x7f, 0x5e, 0x00, 0x7d, 0xf3,
-	0xff, 0xf3, 0xfc, 0x81, 0x91, 0x9c, 0x66, 0x4b, 0xbb, 0x30, 0xc5, 0xf1, 0x5d, 0x18, 0x3d, 0xe3,
-	0x33, 0x6e, 0x4c, 0x27, 0x76, 0x63, 0x4a, 0x27, 0xe0, 0xc6, 0x68, 0x1b, 0x34, 0xe4, 0xca, 0xfc,
-	0x51, 0x01, 0x66, 0x5c, 0xcf, 0xa6, 0x91, 0xa5, 0xab, 0x4d, 0x8b, 0xa3, 0xf3, 0x9d, 0xb1, 0x3a,
-	0xb1, 0xb1, 0x95, 0x40, 0x94, 0xe1, 0x25, 0x1d, 0x03, 0x49, 0xb2, 0x30, 0xa5, 0x9a, 0xac, 0x43,
-	0xd9, 0x6c, 0x73, 0xdf, 0x33, 0x3c, 0x50, 0x29, 0x0c, 0x17, 0xf3, 0x6c, 0xdf, 0x8a, 0x92, 0x91,
-	0xdb, 0x4a, 0xf4, 0x85, 0xba, 0x2c, 0xdf, 0x97, 0x75, 0x46, 0x5d, 0x65, 0x8c, 0x7d, 0x39, 0x8a,
-	0x93, 0x25, 0x4e, 0x74, 0x8a, 0x92, 0x48, 0xb0, 0x33, 0xa0, 0x24, 0xbd, 0x5b, 0xe1, 0xda, 0x97,
-	0xa5, 0xa3, 0x22, 0x3d, 0x5f, 0x54, 0x1c, 0xd2, 0x89, 0xfc, 0x92, 0xaa, 0xe8, 0xdc, 0xe6, 0xc8,
-	0xbe, 0x9a, 0x76, 0x75, 0xf2, 0x1d, 0x13, 0x72, 0x33, 0xb9, 0x7d, 0xcc, 0x3c, 0xc9, 0xf6, 0x31,
-	0x7b, 0xec, 0xd6, 0xd1, 0x81, 0x12, 0x13, 0x9b, 0x93, 0x70, 0xe9, 0xab, 0x57, 0x56, 0x47, 0x3b,
-	0xdb, 0xa4, 0xf6, 0x37, 0xd9, 0x3b, 0x92, 0x86, 0x0a, 0x9e, 0x78, 0x50, 0x0e, 0xd4, 0xd1, 0x5d,
-	0x45, 0x05, 0x46, 0xbb, 0x91, 0xc8, 0x9e, 0xff, 0xe5, 0xfc, 0x88, 0xa8, 0xa8, 0x95, 0x90, 0x77,
-	0x60, 0xd2, 0x36, 0x3b, 0x2a, 0x3e, 0xf0, 0xf5, 0x91, 0x93, 0x38, 0x22, 0x35, 0x22, 0x51, 0x7c,
-	0x6d, 0xe5, 0x3a, 0x72, 0x54, 0xb2, 0x17, 0x67, 0xf6, 0xcd, 0x8f, 0x91, 0x7f, 0x9d, 0xd9, 0xef,
-	0xa4, 0xc7, 0x38, 0x94, 0x1b, 0x78, 0x0d, 0xa6, 0x1f, 0x7a, 0xbd, 0x41, 0x5f, 0x05, 0x16, 0xaa,
-	0x57, 0x16, 0xf3, 0x46, 0xfb, 0x9e, 0x10, 0x89, 0x8d, 0x80, 0xfc, 0x66, 0x18, 0x95, 0x25, 0xbf,
-	0x5b, 0x80, 0xd3, 0x7c, 0xe9, 0xe8, 0x79, 0xc0, 0x6a, 0x64, 0x8c, 0x99, 0x7a, 0x97, 0xf1, 0x8d,
-	0x31, 0x9a, 0x61, 0xe7, 0x95, 0xda, 0xd3, 0x1b, 0x29, 0x0d, 0x98, 0xd1, 0x48, 0x7c, 0x28, 0x33,
-	0xc7, 0xa6, 0x96, 0x19, 0xb0, 0xda, 0xd9, 0x13, 0xd3, 0x1e, 0x1f, 0xa9, 0x15, 0x36, 0x6a, 0x2d,
-	0xe4, 0xf7, 0x44, 0xce, 0xbc, 0x7a, 0x35, 0xa2, 0x5e, 0xf2, 0x2c, 0x9c, 0xe4, 0x4b, 0x9e, 0xb3,
-	0x32, 0x61, 0x3e, 0xa5, 0x01, 0xb3, 0x2a, 0xc9, 0x1d, 0x38, 0x27, 0xb3, 0x09, 0xb3, 0xe9, 0x9d,
-	0xe7, 0xc4, 0x9d, 0xd1, 0x0b, 0x47, 0x87, 0xf5, 0x73, 0x2b, 0x79, 0x02, 0x98, 0x5f, 0x8e, 0x7c,
-	0x00, 0xb3, 0x41, 0xd2, 0x1d, 0xab, 0x9d, 0x1f, 0x23, 0x61, 0x21, 0xe5, 0xd8, 0xc9, 0xc0, 0x55,
-	0x8a, 0x84, 0x69, 0x5d, 0xe4, 0x35, 0xa8, 0xfa, 0xca, 0x52, 0x39, 0xac, 0x5f, 0xbb, 0x20, 0xda,
-	0x20, 0x76, 0xd4, 0xed, 0x98, 0x8c, 0x49, 0x19, 0x72, 0x17, 0xaa, 0xa1, 0xd7, 0xa3, 0x81, 0xba,
-	0x5c, 0xa9, 0x89, 0xc1, 0x5f, 0xca, 0x9b, 0xc9, 0x3b, 0x5a, 0x2c, 0x0e, 0xdd, 0xc7, 0x34, 0x86,
-	0x49, 0x1c, 0xee, 0xd6, 0x47, 0xd9, 0xbc, 0x81, 0x88, 0x61, 0xbc, 0x90, 0x76, 0xeb, 0x5b, 0x49,
-	0x26, 0xa6, 0x65, 0xb9, 0xa3, 0xee, 0x07, 0x8e, 0x17, 0x38, 0xe1, 0xc1, 0x6a, 0xcf, 0x64, 0x4c,
-	0x00, 0x2c, 0x0a, 0x00, 0xed, 0xa8, 0x6f, 0x67, 0x05, 0x70, 0xb8, 0x0c, 0xf7, 0x86, 0x22, 0x62,
-	0xed, 0x73, 0xe2, 0x00, 0x27, 0xcc, 0x52, 0x54, 0x16, 0x35, 0xf7, 0x98, 0xf4, 0xad, 0x8b, 0xa3,
-	0xa4, 0x6f, 0x11, 0x1b, 0x2e, 0x9a, 0x83, 0xd0, 0xeb, 0x73, 0x42, 0xba, 0xc8, 0x8e, 0xb7, 0x47,
-	0xdd, 0xda, 0x25, 0xb1, 0x57, 0x5d, 0x3a, 0x3a, 0xac, 0x5f, 0x5c, 0x79, 0x8c, 0x1c, 0x3e, 0x16,
-	0x85, 0xf4, 0xa1, 0x4c, 0x55, 0x0a, 0x5a, 0xed, 0xf3, 0x63, 0x6c, 0x12, 0xe9, 0x3c, 0x36, 0xd9,
-	0x41, 0x11, 0x0d, 0xb5, 0x0a, 0xb2, 0x03, 0xd5, 0xae, 0xc7, 0xc2, 0x95, 0x9e, 0x63, 0x32, 0xca,
-	0x6a, 0x2f, 0x8a, 0x79, 0x92, 0xbb, 0xbf, 0xdd, 0x88, 0xc4, 0xe2, 0x69, 0x72, 0x23, 0x2e, 0x89,
-	0x49, 0x18, 0x42, 0x85, 0x6b, 0x38, 0x10, 0xa3, 0xe6, 0xb9, 0x21, 0x7d, 0x3f, 0xac, 0x2d, 0x89,
-	0xb6, 0xbc, 0x9c, 0x87, 0xbc, 0xed, 0xd9, 0xad, 0xb4, 0xb4, 0x5c, 0xe5, 0x19, 0x22, 0x66, 0x31,
-	0xc9, 0xeb, 0x30, 0xe3, 0x7b, 0x76, 0xcb, 0xa7, 0xd6, 0xb6, 0x19, 0x5a, 0xdd, 0x5a, 0x3d, 0x7d,
-	0x35, 0xb4, 0x9d, 0xe0, 0x61, 0x4a, 0x72, 0xf1, 0x6d, 0x38, 0x33, 0x74, 0x9e, 0x7a, 0xaa, 0x7b,
-	0xb4, 0x3f, 0xe7, 0xde, 0x4f, 0xe2, 0x04, 0x7b, 0xd2, 0xe7, 0xfe, 0xeb, 0x70, 0x46, 0xbd, 0xc4,
-	0xe5, 0x9b, 0x6d, 0x6f, 0xa0, 0xdf, 0xae, 0x24, 0x82, 0x5a, 0x98, 0x15, 0xc0, 0xe1, 0x32, 0xc6,
-	0x5f, 0x14, 0x60, 0x36, 0x65, 0xbe, 0x4f, 0xdc, 0x1f, 0x5e, 0x07, 0xd2, 0x77, 0x82, 0xc0, 0x0b,
-	0xe4, 0x1e, 0xb8, 0xc9, 0xe7, 0x32, 0x53, 0x4f, 0x60, 0x44, 0x0a, 0xce, 0xe6, 0x10, 0x17, 0x73,
-	0x4a, 0x18, 0x7f, 0x53, 0x80, 0x38, 0x6a, 0xaa, 0xf3, 0xce, 0x0a, 0xc7, 0xe6, 0x9d, 0xbd, 0x02,
-	0xe5, 0x07, 0xcc, 0x73, 0xb7, 0xe3, 0xec, 0x34, 0xdd, 0xa1, 0x37, 0x5b, 0x77, 0xb6, 0x84, 0xa4,
-	0x96, 0x10, 0xd2, 0xef, 0xad, 0x3b, 0xbd, 0x70, 0x38, 0x87, 0xeb, 0xe6, 0xaf, 0x4a, 0x3a, 0x6a,
-	0x09, 0xb2, 0x0c, 0x15, 0x1d, 0xa8, 0x57, 0x8e, 0xb4, 0xee, 0x04, 0x1d, 0xa5, 0xc6, 0x58, 0xc6,
-	0xf8, 0xe1, 0x04, 0x94, 0x9f, 0xe3, 0x7b, 0x1e, 0x2b, 0xf5, 0x9e, 0xe7, 0x04, 0x1e, 0x7f, 0xe4,
-	0xbd, 0xe5, 0xd9, 0xcb, 0xbc, 0xe5, 0x59, 0x1d, 0x33, 0xf6, 0xff, 0xd8, 0x77, 0x3c, 0x1f, 0x17,
-	0x60, 0xe6, 0x39, 0xbe, 0xe1, 0xd9, 0x4d, 0xbf, 0xe1, 0x79, 0x73, 0xac, 0xa6, 0x1d, 0xf3, 0x7e,
-	0xe7, 0xaf, 0x17, 0x20, 0xf5, 0x76, 0x86, 0xb8, 0x50, 0x89, 0x16, 0x78, 0x74, 0x9f, 0xf2, 0xe6,
-	0x58, 0x4e, 0x61, 0x3c, 0x29, 0x23, 0x0a, 0xc3, 0x58, 0x05, 0xb9, 0x02, 0x40, 0xb9, 0x65, 0x93,
-	0x51, 0xcb, 0x89, 0xf4, 0x75, 0xc3, 0x35, 0xcd, 0xc1, 0x84, 0xd4, 0xf3, 0x0f, 0x38, 0xe4, 0x6f,
-	0xd1, 0x53, 0xcf, 0x64, 0x8b, 0xbe, 0x78, 0xe2, 0x5b, 0xf4, 0x8b, 0xcf, 0x7e, 0x8b, 0x4e, 0x38,
-	0x24, 0xc5, 0x31, 0x1c, 0x92, 0x0f, 0x60, 0x41, 0xfe, 0x5c, 0xed, 0x99, 0x4e, 0x5f, 0xcf, 0x17,
-	0x95, 0x40, 0xf6, 0xc5, 0xdc, 0x8d, 0x99, 0x06, 0xcc, 0x61, 0x21, 0x75, 0xc3, 0x7b, 0x71, 0xc9,
-	0x38, 0xfb, 0xe0, 0x5e, 0x0e, 0x1c, 0xe6, 0x2a, 0xc9, 0x9e, 0x60, 0xa7, 0x9f, 0xe0, 0x04, 0xfb,
-	0x83, 0x02, 0x9c, 0x33, 0xf3, 0x9e, 0x07, 0xab, 0x38, 0xc6, 0xcd, 0xb1, 0xfc, 0x89, 0x14, 0xa2,
-	0xf2, 0x07, 0xf2, 0x58, 0x98, 0x5f, 0x07, 0xf2, 0x85, 0xd8, 0x25, 0xad, 0x88, 0x49, 0x95, 0xef,
-	0x4c, 0x7e, 0x3b, 0x1b, 0x0a, 0x02, 0xd1, 0xdb, 0xad, 0xb1, 0x0d, 0xf6, 0x09, 0x84, 0x83, 0xaa,
-	0x63, 0x84, 0x83, 0x32, 0xee, 0xc5, 0xcc, 0x09, 0xb9, 0x17, 0x2e, 0xcc, 0x3b, 0x7d, 0xb3, 0x43,
-	0xb7, 0x07, 0xbd, 0x9e, 0x8c, 0xfd, 0xb3, 0xda, 0xac, 0xc0, 0xce, 0xcd, 0xfa, 0xe5, 0xee, 0x5e,
-	0x2f, 0xfb, 0xac, 0x4c, 0xdf, 0xb2, 0x6d, 0x64, 0x90, 0x70, 0x08, 0x9b, 0x4f, 0x4b, 0x7e, 0x6c,
-	0xdd, 0xa2, 0x21, 0xef, 0x6d, 0x11, 0x29, 0x51, 0xff, 0x06, 0xe1, 0x46, 0x4c, 0xc6, 0xa4, 0x0c,
-	0xb9, 0x05, 0x15, 0xdb, 0x65, 0xea, 0x8e, 0x6d, 0x4e, 0x58, 0xa9, 0x57, 0xb9, 0x6d, 0x5b, 0xdb,
-	0x6a, 0xe9, 0xdb, 0xb5, 0x8b, 0xc3, 0xff, 0xe7, 0xa5, 0xa1, 0xf9, 0x18, 0x97, 0x27, 0x9b, 0x02,
-	0x4c, 0xa5, 0xc0, 0xcb, 0xd0, 0xc6, 0xa5, 0x63, 0x4e, 0xc8, 0x6b, 0x5b, 0x51, 0xc6, 0xfe, 0xac,
-	0x52, 0xa7, 0x12, 0xdb, 0x63, 0x84, 0xc4, 0x5b, 0x9d, 0x33, 0x8f, 0x7b, 0xab, 0x43, 0xee, 0xc2,
-	0x85, 0x30, 0xec, 0xa5, 0xe2, 0xdd, 0x2a, 0x3b, 0x45, 0xa4, 0x2a, 0x15, 0xe5, 0xf3, 0xc7, 0x9d,
-	0x9d, 0xdb, 0x79, 0x22, 0x78, 0x5c, 0x59, 0x11, 0x3a, 0x0e, 0x7b, 0xda, 0x43, 0x5e, 0x1a, 0x27,
-	0x74, 0x1c, 0x5f, 0x2c, 0xa8, 0xd0, 0x71, 0x4c, 0xc0, 0xa4, 0x96, 0xe3, 0x3d, 0xfd, 0xb3, 0x23,
-	0x7a, 0xfa, 0x49, 0xe7, 0x72, 0xe1, 0xb1, 0xce, 0xe5, 0x90, 0x33, 0x7c, 0xee, 0x29, 0x9c, 0xe1,
-	0x77, 0x44, 0x12, 0xd0, 0xf5, 0x55, 0x15, 0x48, 0x78, 0x63, 0xb4, 0xf8, 0x25, 0x47, 0x90, 0x57,
-	0xc1, 0xe2, 0x27, 0x4a, 0x4c, 0xb2, 0x0d, 0x0b, 0xbe, 0x67, 0x0f, 0xf9, 0xd2, 0x22, 0x72, 0x90,
-	0x48, 0x1f, 0xdb, 0xce, 0x91, 0xc1, 0xdc, 0x92, 0xc2, 0x80, 0xc7, 0xf4, 0x5a, 0x4d, 0x74, 0x8c,
-	0x34, 0xe0, 0x31, 0x19, 0x93, 0x32, 0x59, 0xd7, 0xf2, 0x85, 0x67, 0xe6, 0x5a, 0x2e, 0x3e, 0x07,
-	0xd7, 0xf2, 0x73, 0xcf, 0xcf, 0xb5, 0xfc, 0xdb, 0x0a, 0x9c, 0xce, 0xbc, 0xaf, 0xd5, 0xf9, 0x77,
-	0x85, 0x27, 0xcd, 0xbf, 0x4b, 0x25, 0xc8, 0x4d, 0x3c, 0xd3, 0x04, 0xb9, 0xc9, 0x13, 0x4f, 0x90,
-	0x4b, 0x24, 0x02, 0x4e, 0x7d, 0x46, 0x22, 0xe0, 0x0a, 0xcc, 0x59, 0x5e, 0xdf, 0x17, 0x0f, 0x75,
-	0x54, 0x3a, 0x98, 0x4c, 0xd9, 0xd0, 0xb7, 0xcb, 0xab, 0x69, 0x36, 0x66, 0xe5, 0xc9, 0x6f, 0x41,
-	0xd1, 0x15, 0x05, 0x4b, 0x63, 0x64, 0x20, 0xa7, 0x07, 0x4c, 0xec, 0xce, 0x2a, 0x09, 0x38, 0x8a,
-	0xd5, 0x16, 0x05, 0xed, 0x51, 0xf4, 0x03, 0xa5, 0x52, 0xf2, 0x2e, 0xd4, 0xbc, 0x76, 0xbb, 0xe7,
-	0x99, 0x76, 0x9c, 0x96, 0x7b, 0x8f, 0x9f, 0xbb, 0xd4, 0xed, 0x47, 0xa5, 0x79, 0x49, 0x01, 0xd4,
-	0xee, 0x1c, 0x23, 0x87, 0xc7, 0x22, 0xf0, 0x43, 0xd4, 0x5c, 0x3a, 0xb9, 0x94, 0xd5, 0x2a, 0xa2,
-	0x99, 0xbf, 0x76, 0x12, 0xcd, 0x4c, 0x67, 0xb2, 0xaa, 0x06, 0xc7, 0xf7, 0xfa, 0x69, 0x2e, 0x66,
-	0x6b, 0x42, 0x02, 0x38, 0xef, 0xe7, 0x1d, 0x31, 0x99, 0xba, 0x31, 0x7b, 0xdc, 0x41, 0x77, 0x49,
-	0x69, 0x39, 0x9f, 0x7b, 0x48, 0x65, 0x78, 0x0c, 0x72, 0x32, 0x99, 0xb1, 0xfc, 0xac, 0x92, 0x19,
-	0x17, 0x0f, 0x64, 0x92, 0xf5, 0xb1, 0xf9, 0xd9, 0x77, 0xd3, 0x6f, 0x26, 0xde, 0x1e, 0xf1, 0xbf,
-	0x9a, 0x45, 0xa3, 0x9d, 0xcc, 0x0d, 0xff, 0x9d, 0x02, 0x2c, 0xe4, 0x0d, 0x4b, 0x4e, 0x2d, 0x5a,
-	0xe9, 0x5a, 0x8c, 0xe7, 0x8a, 0x26, 0x2d, 0xd8, 0x77, 0x4b, 0x09, 0xc7, 0x37, 0xa4, 0xfe, 0xcf,
-	0x6f, 0xc5, 0x47, 0xba, 0x15, 0x4f, 0xbd, 0x8f, 0x2f, 0x3e, 0xc7, 0xf7, 0xf1, 0xa5, 0x11, 0xde,
-	0xc7, 0x4f, 0x3f, 0xcf, 0xf7, 0xf1, 0xe5, 0x27, 0x7c, 0x1f, 0x5f, 0xf9, 0xdf, 0xf3, 0x3e, 0xfe,
-	0xd3, 0x02, 0xcc, 0x67, 0xd3, 0xf5, 0x9f, 0x43, 0xa0, 0x70, 0x2f, 0x15, 0x28, 0xdc, 0x18, 0xcb,
-	0xe8, 0xeb, 0x27, 0x02, 0xc7, 0x04, 0x0c, 0x8d, 0x9f, 0x14, 0x60, 0xe8, 0x49, 0xc2, 0x73, 0x88,
-	0xe5, 0x3d, 0x48, 0xc7, 0xf2, 0xae, 0x9d, 0x48, 0x23, 0x8f, 0x89, 0xe9, 0xfd, 0x34, 0xa7, 0x89,
-	0xff, 0x23, 0xb1, 0xbd, 0xe7, 0x6d, 0x02, 0x9b, 0x8d, 0x8f, 0x3e, 0x5d, 0x3a, 0xf5, 0xf1, 0xa7,
-	0x4b, 0xa7, 0x3e, 0xf9, 0x74, 0xe9, 0xd4, 0x87, 0x47, 0x4b, 0x85, 0x8f, 0x8e, 0x96, 0x0a, 0x1f,
-	0x1f, 0x2d, 0x15, 0x3e, 0x39, 0x5a, 0x2a, 0xfc, 0xe4, 0x68, 0xa9, 0xf0, 0x9d, 0x7f, 0x5e, 0x3a,
-	0xf5, 0xeb, 0xe5, 0x08, 0xf7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x21, 0xdc, 0xa7, 0x02, 0x96,
-	0x55, 0x00, 0x00,
+	// 7062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0xd7,
+	0x75, 0xa0, 0x8a, 0xcd, 0x26, 0x9b, 0xb7, 0xc9, 0x21, 0xe7, 0xce, 0xab, 0x44, 0xcd, 0x0c, 0xa9,
+	0x92, 0x25, 0x8f, 0x6c, 0x99, 0x63, 0x49, 0xf6, 0xae, 0x2c, 0xaf, 0x24, 0xb3, 0xf9, 0x1a, 0x6a,
+	0x86, 0x1c, 0xfa, 0x34, 0x35, 0xb3, 0xb6, 0x04, 0x7b, 0x8b, 0xdd, 0x97, 0xdd, 0x25, 0x76, 0x57,
+	0xb5, 0xab, 0xaa, 0x49, 0x51, 0xde, 0x5d, 0x7b, 0xbd, 0x5e, 0xec, 0xda, 0x0b, 0x03, 0xce, 0x8f,
+	0x63, 0xc0, 0x01, 0xf2, 0xf8, 0xc9, 0x57, 0x3e, 0xf2, 0x11, 0x04, 0x08, 0x02, 0x07, 0x08, 0x02,
+	0xc4, 0x30, 0x02, 0xc4, 0xc8, 0x4f, 0x0c, 0x24, 0xa1, 0x2d, 0x06, 0x08, 0x12, 0x24, 0x80, 0xbf,
+	0x02, 0x03, 0xf3, 0x93, 0xe0, 0xdc, 0x57, 0xdd, 0xaa, 0xae, 0x9e, 0xe1, 0x74, 0x73, 0x26, 0x09,
+	0xec, 0x2f, 0x76, 0x9d, 0x73, 0xee, 0x39, 0xb7, 0x6e, 0xdd, 0x7b, 0xee, 0xb9, 0xe7, 0x71, 0x49,
+	0x96, 0x1a, 0x5e, 0xdc, 0xec, 0xee, 0x2c, 0xd4, 0x82, 0xf6, 0x75, 0x37, 0x6c, 0x04, 0x9d, 0x30,
+	0x78, 0x97, 0xff, 0xb8, 0xde, 0xd9, 0x6b, 0x5c, 0x77, 0x3b, 0x5e, 0x74, 0xfd, 0x20, 0x08, 0xf7,
+	0x76, 0x5b, 0xc1, 0xc1, 0xf5, 0xfd, 0x17, 0xdd, 0x56, 0xa7, 0xe9, 0xbe, 0x78, 0xbd, 0xc1, 0x7c,
+	0x16, 0xba, 0x31, 0xab, 0x2f, 0x74, 0xc2, 0x20, 0x0e, 0xe8, 0xcb, 0x09, 0x93, 0x05, 0xc5, 0x84,
+	0xff, 0x58, 0xe8, 0xec, 0x35, 0x16, 0x90, 0xc9, 0x82, 0x62, 0xb2, 0xa0, 0x98, 0xcc, 0x7e, 0xcc,
+	0x90, 0xdc, 0x08, 0x50, 0x20, 0xf2, 0xda, 0xe9, 0xee, 0xf2, 0x27, 0xfe, 0xc0, 0x7f, 0x09, 0x19,
+	0xb3, 0xce, 0xde, 0x2b, 0xd1, 0x82, 0x17, 0x60, 0x97, 0xae, 0xd7, 0x82, 0x90, 0x5d, 0xdf, 0xef,
+	0xe9, 0xc7, 0xec, 0x27, 0x12, 0x9a, 0xb6, 0x5b, 0x6b, 0x7a, 0x3e, 0x0b, 0x0f, 0x93, 0xf7, 0x68,
+	0xb3, 0xd8, 0xcd, 0x6b, 0x75, 0xbd, 0x5f, 0xab, 0xb0, 0xeb, 0xc7, 0x5e, 0x9b, 0xf5, 0x34, 0xf8,
+	0x4f, 0x0f, 0x6a, 0x10, 0xd5, 0x9a, 0xac, 0xed, 0x66, 0xdb, 0x39, 0x7f, 0x6e, 0x91, 0xe9, 0xc5,
+	0xb0, 0xd6, 0xf4, 0xf6, 0x59, 0x35, 0x46, 0x44, 0xe3, 0x90, 0xbe, 0x4d, 0x0a, 0xb1, 0x1b, 0xda,
+	0xd6, 0xbc, 0x75, 0xad, 0xfc, 0xd2, 0x67, 0x16, 0x06, 0x18, 0xc8, 0x85, 0x6d, 0x37, 0x54, 0xec,
+	0x2a, 0xe3, 0xc7, 0x47, 0x73, 0x85, 0x6d, 0x37, 0x04, 0xe4, 0x4a, 0xbf, 0x48, 0x46, 0xfd, 0xc0,
+	0x67, 0xf6, 0x08, 0xe7, 0xbe, 0x38, 0x10, 0xf7, 0xcd, 0xc0, 0xd7, 0xbd, 0xad, 0x94, 0x8e, 0x8f,
+	0xe6, 0x46, 0x11, 0x02, 0x9c, 0xb1, 0xf3, 0x33, 0x8b, 0x4c, 0x2c, 0x86, 0x8d, 0x6e, 0x9b, 0xf9,
+	0x71, 0x44, 0x43, 0x42, 0x3a, 0x6e, 0xe8, 0xb6, 0x59, 0xcc, 0xc2, 0xc8, 0xb6, 0xe6, 0x0b, 0xd7,
+	0xca, 0x2f, 0xbd, 0x3e, 0x90, 0xd0, 0x2d, 0xc5, 0xa6, 0x42, 0x7f, 0x70, 0x34, 0xf7, 0xc4, 0xf1,
+	0xd1, 0x1c, 0xd1, 0xa0, 0x08, 0x0c, 0x29, 0xd4, 0x27, 0x13, 0x6e, 0x18, 0x7b, 0xbb, 0x6e, 0x2d,
+	0x8e, 0xec, 0x11, 0x2e, 0xf2, 0xb5, 0x81, 0x44, 0x2e, 0x4a, 0x2e, 0x95, 0xb3, 0x52, 0xe2, 0x84,
+	0x82, 0x44, 0x90, 0x88, 0x70, 0xfe, 0x70, 0x94, 0x94, 0x14, 0x82, 0xce, 0x93, 0x51, 0xdf, 0x6d,
+	0x33, 0xfe, 0xf5, 0x26, 0x2a, 0x93, 0xb2, 0xe1, 0xe8, 0xa6, 0xdb, 0xc6, 0x01, 0x72, 0xdb, 0x0c,
+	0x29, 0x3a, 0x6e, 0xdc, 0xb4, 0x47, 0xd2, 0x14, 0x5b, 0x6e, 0xdc, 0x04, 0x8e, 0xa1, 0x97, 0xc9,
+	0x68, 0x3b, 0xa8, 0x33, 0xbb, 0x30, 0x6f, 0x5d, 0x2b, 0x8a, 0x01, 0xde, 0x08, 0xea, 0x0c, 0x38,
+	0x14, 0xdb, 0xef, 0x86, 0x41, 0xdb, 0x1e, 0x4d, 0xb7, 0x5f, 0x0d, 0x83, 0x36, 0x70, 0x0c, 0xfd,
+	0xff, 0x16, 0x99, 0x51, 0xdd, 0xbb, 0x15, 0xd4, 0xdc, 0xd8, 0x0b, 0x7c, 0xbb, 0xc8, 0x3f, 0xf8,
+	0xca, 0x50, 0x03, 0xa1, 0x98, 0x55, 0x6c, 0x29, 0x75, 0x26, 0x8b, 0x81, 0x1e, 0xc1, 0xf4, 0x25,
+	0x42, 0x1a, 0xad, 0x60, 0xc7, 0x6d, 0xe1, 0x18, 0xd8, 0x63, 0xbc, 0xd7, 0xfa, 0x13, 0xae, 0x69,
+	0x0c, 0x18, 0x54, 0x74, 0x8f, 0x8c, 0xbb, 0x62, 0x55, 0xd8, 0xe3, 0xbc, 0xdf, 0xcb, 0x03, 0xf6,
+	0x3b, 0xb5, 0xb2, 0x2a, 0xe5, 0xe3, 0xa3, 0xb9, 0x71, 0x09, 0x04, 0x25, 0x81, 0xbe, 0x40, 0x4a,
+	0x41, 0x07, 0xbb, 0xea, 0xb6, 0xec, 0xd2, 0xbc, 0x75, 0xad, 0x54, 0x99, 0x91, 0xdd, 0x2b, 0xdd,
+	0x96, 0x70, 0xd0, 0x14, 0xf4, 0x69, 0x32, 0x1a, 0x79, 0xef, 0x33, 0x7b, 0x62, 0xde, 0xba, 0x56,
+	0xa8, 0x4c, 0xe1, 0xac, 0xa8, 0x7a, 0xef, 0xb3, 0xca, 0x61, 0xcc, 0x22, 0xe0, 0x28, 0x64, 0x58,
+	0x6b, 0xb2, 0xda, 0x5e, 0xd4, 0x6d, 0xdb, 0x84, 0xbf, 0xaf, 0x66, 0xb8, 0x24, 0xe1, 0xa0, 0x29,
+	0x9c, 0x2d, 0x42, 0xd4, 0x28, 0xae, 0x2d, 0xd1, 0x0a, 0x29, 0x45, 0xb2, 0xbb, 0x72, 0x0e, 0x3d,
+	0xa7, 0xda, 0xaa, 0xd7, 0xb8, 0x77, 0x34, 0x47, 0x93, 0x16, 0x0a, 0x0a, 0xba, 0x9d, 0xf3, 0xab,
+	0x45, 0xd2, 0xf3, 0x61, 0xe8, 0x8b, 0xa4, 0x2c, 0x5f, 0xf8, 0x56, 0xd0, 0x88, 0x38, 0xef, 0x52,
+	0x65, 0xfa, 0xf8, 0x68, 0xae, 0xbc, 0x98, 0x80, 0xc1, 0xa4, 0xa1, 0x77, 0xc9, 0x48, 0xf4, 0xb2,
+	0xd4, 0x14, 0x6f, 0x0c, 0xf4, 0x01, 0xaa, 0x2f, 0xeb, 0x35, 0x34, 0x76, 0x7c, 0x34, 0x37, 0x52,
+	0x7d, 0x19, 0x46, 0xa2, 0x97, 0x51, 0xc3, 0x35, 0xbc, 0xd8, 0x2e, 0x0c, 0xa1, 0xe1, 0xd6, 0xbc,
+	0x58, 0xb3, 0xe6, 0x1a, 0x6e, 0xcd, 0x8b, 0x01, 0xb9, 0xa2, 0x86, 0x6b, 0xc6, 0x71, 0xc7, 0x1e,
+	0x1d, 0x42, 0xc3, 0xdd, 0xd8, 0xde, 0xde, 0xd2, 0xec, 0xf9, 0x02, 0x44, 0x08, 0x70, 0xc6, 0xf4,
+	0xcb, 0x38, 0x92, 0x02, 0x17, 0x84, 0x87, 0x72, 0x61, 0xdd, 0x18, 0x6a, 0x61, 0x05, 0xe1, 0xa1,
+	0x16, 0x27, 0xbf, 0x89, 0x46, 0x80, 0x29, 0x8d, 0xbf, 0x5d, 0x7d, 0x37, 0xb2, 0xc7, 0x86, 0x79,
+	0xbb, 0xe5, 0xd5, 0x6a, 0xe6, 0xed, 0x96, 0x57, 0xab, 0xc0, 0x19, 0xe3, 0xb7, 0x09, 0xdd, 0x03,
+	0x7b, 0x7c, 0x88, 0x6f, 0x03, 0xee, 0x41, 0xfa, 0xdb, 0x80, 0x7b, 0x00, 0xc8, 0xd5, 0x69, 0x90,
+	0x0b, 0x0a, 0x03, 0xac, 0x13, 0x44, 0x1e, 0x7f, 0x41, 0xb6, 0x4b, 0xaf, 0x93, 0x89, 0x5a, 0xe0,
+	0xef, 0x7a, 0x8d, 0x0d, 0xb7, 0x23, 0xe7, 0xbd, 0x56, 0xba, 0x4b, 0x0a, 0x01, 0x09, 0x0d, 0xbd,
+	0x42, 0x0a, 0x7b, 0xec, 0x50, 0x2a, 0xd1, 0xb2, 0x24, 0x2d, 0xdc, 0x64, 0x87, 0x80, 0x70, 0xe7,
+	0xfb, 0x16, 0x39, 0x97, 0x33, 0xb8, 0xd8, 0xac, 0x1b, 0xb6, 0x6c, 0x2b, 0xdd, 0xec, 0x2d, 0xb8,
+	0x05, 0x08, 0xa7, 0xff, 0xd7, 0x22, 0xd3, 0xc6, 0x68, 0x2f, 0x76, 0xa5, 0x9e, 0x1e, 0x5c, 0x01,
+	0xa5, 0x78, 0x55, 0x2e, 0x49, 0x89, 0xd3, 0x19, 0x04, 0x64, 0xa5, 0x3a, 0x7f, 0xc9, 0x0d, 0x83,
+	0x14, 0x8c, 0xba, 0xe4, 0x4c, 0x37, 0x62, 0x21, 0xee, 0x22, 0x55, 0x56, 0x0b, 0x59, 0x2c, 0x6d,
+	0x84, 0x67, 0x17, 0x84, 0xf5, 0x81, 0xbd, 0x58, 0xa8, 0x05, 0x21, 0x5b, 0xd8, 0x7f, 0x71, 0x41,
+	0x50, 0xdc, 0x64, 0x87, 0x55, 0xd6, 0x62, 0xc8, 0xa3, 0x42, 0x8f, 0x8f, 0xe6, 0xce, 0xbc, 0x95,
+	0x62, 0x00, 0x19, 0x86, 0x28, 0xa2, 0xe3, 0x46, 0xd1, 0x41, 0x10, 0xd6, 0xa5, 0x88, 0x91, 0x87,
+	0x16, 0xb1, 0x95, 0x62, 0x00, 0x19, 0x86, 0xce, 0x77, 0x2c, 0x32, 0x5e, 0x71, 0x6b, 0x7b, 0xc1,
+	0xee, 0x2e, 0x6a, 0xca, 0x7a, 0x37, 0x14, 0x1b, 0x94, 0x95, 0xd6, 0x94, 0xcb, 0x12, 0x0e, 0x9a,
+	0x82, 0x3e, 0x47, 0xc6, 0xc4, 0x70, 0xf0, 0x4e, 0x15, 0x2b, 0x67, 0x24, 0xed, 0xd8, 0x2a, 0x87,
+	0x82, 0xc4, 0xd2, 0x4f, 0x92, 0x72, 0xdb, 0x7d, 0x4f, 0x31, 0xe0, 0x6a, 0x66, 0xa2, 0x72, 0x4e,
+	0x12, 0x97, 0x37, 0x12, 0x14, 0x98, 0x74, 0xce, 0x17, 0x48, 0x71, 0xc9, 0xad, 0x35, 0x19, 0x7d,
+	0x2b, 0x3b, 0x19, 0xcb, 0x2f, 0x5d, 0xcb, 0x7b, 0x7f, 0xd4, 0xad, 0xad, 0xdb, 0x3b, 0xef, 0x32,
+	0x9c, 0xcd, 0xbb, 0x2c, 0x64, 0x7e, 0x8d, 0x55, 0xa6, 0xfa, 0x4d, 0x59, 0xe7, 0xf7, 0x2c, 0x72,
+	0x7e, 0x29, 0xf0, 0x63, 0x17, 0xad, 0xc3, 0x65, 0xcf, 0x6d, 0xf8, 0x41, 0x14, 0x7b, 0xb5, 0xe8,
+	0x04, 0x36, 0xc3, 0x35, 0x52, 0x62, 0xef, 0x79, 0xf1, 0x12, 0x5a, 0x05, 0xe2, 0xdd, 0x27, 0x71,
+	0x8c, 0x56, 0x24, 0x0c, 0x34, 0x16, 0xc7, 0x28, 0x64, 0x6e, 0xa4, 0x5f, 0x5b, 0x8f, 0x11, 0x70,
+	0x28, 0x48, 0x2c, 0x7d, 0x9e, 0x8c, 0xb7, 0x59, 0x14, 0xb9, 0x0d, 0x26, 0x0d, 0x89, 0x69, 0x49,
+	0x38, 0xbe, 0x21, 0xc0, 0xa0, 0xf0, 0xce, 0xff, 0x33, 0xfb, 0xbd, 0xe2, 0xef, 0x7b, 0x61, 0xe0,
+	0xa3, 0x75, 0x77, 0x82, 0x7e, 0x3f, 0x43, 0x8a, 0x5e, 0xdb, 0x6d, 0x88, 0x4e, 0x4f, 0x54, 0xa6,
+	0x24, 0x49, 0x71, 0x1d, 0x81, 0x20, 0x70, 0xd8, 0x15, 0xfe, 0x63, 0x7d, 0xd9, 0x2e, 0xa4, 0xbb,
+	0xb2, 0x2e, 0xc0, 0xa0, 0xf0, 0xce, 0xe7, 0x08, 0xc1, 0x9e, 0x78, 0x7e, 0x97, 0xdd, 0xf6, 0x91,
+	0x3b, 0x0b, 0xc3, 0x20, 0x94, 0x9b, 0x99, 0xe6, 0xbe, 0x82, 0x40, 0x10, 0x38, 0x31, 0x69, 0xbc,
+	0x16, 0xab, 0xf3, 0x3e, 0x94, 0xcc, 0x49, 0x83, 0x50, 0x90, 0x58, 0x67, 0x81, 0x8c, 0x2f, 0x05,
+	0x5d, 0x3f, 0x66, 0x21, 0xf2, 0xdd, 0x77, 0x5b, 0x5d, 0xf5, 0x62, 0x9a, 0xef, 0x1d, 0x04, 0x82,
+	0xc0, 0x39, 0x3f, 0x1c, 0x21, 0x93, 0x4b, 0x61, 0xe0, 0xdf, 0x95, 0x8b, 0x9e, 0xfe, 0x37, 0x52,
+	0xc2, 0xe3, 0x44, 0xdd, 0x8d, 0x5d, 0x39, 0x69, 0x3e, 0x6e, 0x4c, 0x1a, 0x7d, 0x2a, 0x48, 0xd4,
+	0x05, 0x52, 0xe3, 0x34, 0x12, 0x33, 0x68, 0x83, 0xc5, 0x6e, 0x62, 0x17, 0x25, 0x30, 0xd0, 0x5c,
+	0x69, 0x83, 0x8c, 0x46, 0x1d, 0x56, 0xb3, 0x47, 0x86, 0x30, 0xe5, 0xcc, 0x2e, 0x57, 0x3b, 0xac,
+	0x96, 0x7c, 0x36, 0x7c, 0x02, 0x2e, 0x80, 0x06, 0x64, 0x2c, 0x8a, 0xdd, 0xb8, 0x1b, 0xc9, 0x2d,
+	0x7a, 0x6d, 0x78, 0x51, 0x9c, 0x5d, 0x32, 0xf8, 0xe2, 0x19, 0xa4, 0x18, 0xe7, 0xc7, 0x16, 0x99,
+	0x31, 0xc9, 0x6f, 0x79, 0x51, 0x4c, 0xdf, 0xe9, 0x19, 0xd0, 0x85, 0x93, 0x0d, 0x28, 0xb6, 0xe6,
+	0xc3, 0xa9, 0x95, 0x89, 0x82, 0x18, 0x83, 0xb9, 0x4b, 0x8a, 0x5e, 0xcc, 0xda, 0xea, 0x84, 0xb0,
+	0x38, 0xf4, 0x2b, 0x1a, 0xb3, 0x1b, 0xf9, 0x82, 0x60, 0xef, 0x7c, 0xbb, 0x98, 0x7e, 0x35, 0x1c,
+	0x66, 0xb4, 0xd0, 0x27, 0x0f, 0x0c, 0x80, 0x7c, 0xbf, 0xc1, 0x3a, 0x91, 0xfa, 0x9c, 0x1f, 0x92,
+	0x9d, 0x98, 0x34, 0xa1, 0xf7, 0x32, 0xcf, 0x90, 0x12, 0x8e, 0x5a, 0x18, 0x8f, 0xa7, 0xf5, 0x6e,
+	0x4b, 0x2d, 0x54, 0x3d, 0x70, 0x55, 0x09, 0x07, 0x4d, 0x41, 0xdf, 0x21, 0x67, 0x6b, 0x81, 0x5f,
+	0xeb, 0x86, 0xa8, 0xef, 0x0e, 0xb7, 0x82, 0x96, 0x57, 0x3b, 0x94, 0x0b, 0x77, 0x41, 0x36, 0x3b,
+	0xbb, 0x94, 0x25, 0xb8, 0x97, 0x07, 0x84, 0x5e, 0x46, 0xa8, 0x0c, 0xa2, 0x6e, 0xd4, 0x61, 0x7e,
+	0x9d, 0xeb, 0xa5, 0x52, 0xa2, 0x0c, 0xaa, 0x02, 0x0c, 0x0a, 0x4f, 0xdf, 0x22, 0x97, 0xa2, 0x18,
+	0xf7, 0x4d, 0xbf, 0xb1, 0xcc, 0xdc, 0x7a, 0xcb, 0xf3, 0x71, 0x17, 0x0b, 0xfc, 0x7a, 0xc4, 0x6d,
+	0xb2, 0x42, 0xe5, 0xa9, 0xe3, 0xa3, 0xb9, 0x4b, 0xd5, 0x7c, 0x12, 0xe8, 0xd7, 0x96, 0x7e, 0x81,
+	0xcc, 0x46, 0xdd, 0x5a, 0x8d, 0x45, 0xd1, 0x6e, 0xb7, 0xf5, 0x66, 0xb0, 0x13, 0xdd, 0xf0, 0x22,
+	0xdc, 0x82, 0x6f, 0x79, 0x6d, 0x2f, 0xe6, 0x76, 0x57, 0xb1, 0x72, 0xf5, 0xf8, 0x68, 0x6e, 0xb6,
+	0xda, 0x97, 0x0a, 0xee, 0xc3, 0x81, 0x02, 0xb9, 0x28, 0x54, 0x4e, 0x0f, 0xef, 0x71, 0xce, 0x7b,
+	0xf6, 0xf8, 0x68, 0xee, 0xe2, 0x6a, 0x2e, 0x05, 0xf4, 0x69, 0x89, 0x5f, 0x10, 0xbd, 0x0c, 0xef,
+	0xe3, 0xc9, 0xbe, 0x94, 0xfe, 0x82, 0xdb, 0x12, 0x0e, 0x9a, 0xc2, 0xf9, 0x0b, 0x8b, 0xd0, 0xde,
+	0xc5, 0x49, 0x6f, 0x92, 0x31, 0xb7, 0x16, 0xe3, 0x99, 0x4b, 0x9c, 0xd3, 0x9f, 0xc9, 0xdb, 0xf3,
+	0xb2, 0xdb, 0x9d, 0x5e, 0xd1, 0x8b, 0xbc, 0x29, 0x48, 0x16, 0x34, 0x20, 0x67, 0x5b, 0x6e, 0x14,
+	0xab, 0xf9, 0x53, 0xc7, 0x6e, 0x48, 0xc5, 0xf5, 0x91, 0x93, 0xad, 0x62, 0x6c, 0x51, 0xb9, 0x80,
+	0xb3, 0xe9, 0x56, 0x96, 0x11, 0xf4, 0xf2, 0x76, 0xfe, 0x6c, 0x9c, 0x8c, 0x2f, 0x2f, 0xae, 0x6d,
+	0xbb, 0xd1, 0xde, 0x09, 0x36, 0x26, 0x1c, 0x30, 0xd6, 0xee, 0xb4, 0xdc, 0xb8, 0x67, 0xca, 0x6f,
+	0x4b, 0x38, 0x68, 0x0a, 0x1a, 0xa0, 0x47, 0x41, 0xba, 0x34, 0xa4, 0x4a, 0x7c, 0x7d, 0x40, 0x7b,
+	0x50, 0x72, 0x31, 0x5d, 0x0a, 0x12, 0x04, 0x89, 0x0c, 0x1a, 0x91, 0xb2, 0x12, 0x0e, 0x6c, 0xd7,
+	0x1e, 0x1d, 0xc2, 0x18, 0xdf, 0x4e, 0xf8, 0x88, 0xa3, 0x85, 0x01, 0x00, 0x53, 0x0a, 0xfd, 0x04,
+	0x99, 0xac, 0x33, 0x5c, 0x59, 0xcc, 0xaf, 0x79, 0x0c, 0x17, 0x51, 0x01, 0xc7, 0x05, 0x95, 0xc9,
+	0xb2, 0x01, 0x87, 0x14, 0x15, 0x7d, 0x97, 0x4c, 0x1c, 0x78, 0x71, 0x93, 0xeb, 0x3c, 0x7b, 0x8c,
+	0x4f, 0x9c, 0x4f, 0x0d, 0xd4, 0x51, 0xe4, 0x90, 0x0c, 0xcb, 0x5d, 0xc5, 0x13, 0x12, 0xf6, 0x78,
+	0x4a, 0xc0, 0x07, 0xee, 0xf7, 0xb1, 0xc7, 0xd3, 0xa7, 0x84, 0xbb, 0x0a, 0x01, 0x09, 0x0d, 0x8d,
+	0xc8, 0x24, 0x3e, 0x54, 0xd9, 0x97, 0xba, 0x38, 0x5b, 0xf9, 0xda, 0x18, 0xd4, 0x1b, 0xa4, 0x98,
+	0x88, 0x11, 0xb9, 0x6b, 0xb0, 0x85, 0x94, 0x10, 0x9c, 0x7d, 0x07, 0x4d, 0xe6, 0xdb, 0x13, 0xe9,
+	0xd9, 0x77, 0xb7, 0xc9, 0x7c, 0xe0, 0x18, 0x1a, 0x10, 0x52, 0xd3, 0x66, 0x8c, 0x4d, 0x86, 0x38,
+	0x60, 0x27, 0xd6, 0x50, 0xe5, 0x0c, 0xda, 0x0d, 0xc9, 0x33, 0x18, 0x22, 0xd0, 0x08, 0x0a, 0x7c,
+	0xb4, 0x16, 0xed, 0x72, 0xda, 0x2a, 0xbc, 0xcd, 0xa1, 0x20, 0xb1, 0x78, 0xfe, 0x99, 0x41, 0x15,
+	0xd3, 0x0d, 0xd9, 0x76, 0x33, 0x64, 0x51, 0x33, 0x68, 0xd5, 0xed, 0xc9, 0x21, 0xcc, 0x8d, 0xd5,
+	0x0c, 0xb3, 0xca, 0x79, 0xf4, 0x1a, 0x65, 0xa1, 0xd0, 0x23, 0xd4, 0xf9, 0x63, 0x8b, 0x94, 0x71,
+	0x39, 0xab, 0x25, 0xf8, 0x1c, 0x19, 0x8b, 0xdd, 0xb0, 0x21, 0xcf, 0x3c, 0xc6, 0x1b, 0x6c, 0x73,
+	0x28, 0x48, 0x2c, 0x75, 0x49, 0x31, 0x76, 0xa3, 0x3d, 0xb5, 0xad, 0xff, 0x97, 0x81, 0x7a, 0x2d,
+	0xf5, 0x48, 0xb2, 0xa3, 0xe3, 0x53, 0x04, 0x82, 0x33, 0x1a, 0xe3, 0xd8, 0xdd, 0x55, 0x37, 0x12,
+	0x2e, 0x8c, 0x92, 0x30, 0xc6, 0x57, 0x25, 0x0c, 0x34, 0xd6, 0xf9, 0x9e, 0x45, 0xa6, 0x57, 0xde,
+	0x63, 0xb5, 0x2e, 0x9e, 0x2f, 0xee, 0x7a, 0x7e, 0x3d, 0x38, 0x48, 0x6d, 0xb6, 0xd6, 0x03, 0x37,
+	0x5b, 0xf3, 0x80, 0x34, 0xf2, 0xc0, 0x03, 0x92, 0xb9, 0x0d, 0x14, 0x1e, 0xb8, 0x0d, 0xbc, 0x43,
+	0xce, 0x88, 0xce, 0x05, 0xa1, 0x38, 0xaf, 0xd0, 0x37, 0x09, 0x8d, 0x58, 0xb8, 0xef, 0xd5, 0xd8,
+	0x62, 0xad, 0x86, 0xc6, 0xf0, 0x66, 0xa2, 0x45, 0x67, 0x25, 0x27, 0x5a, 0xed, 0xa1, 0x80, 0x9c,
+	0x56, 0xce, 0x01, 0xe9, 0xf9, 0xcc, 0xb8, 0xb9, 0x77, 0x58, 0x58, 0x63, 0xbe, 0xf8, 0x8a, 0xc5,
+	0x64, 0x73, 0xdf, 0x12, 0x60, 0x50, 0x78, 0xfa, 0x0a, 0x99, 0x6c, 0x7b, 0xfe, 0x52, 0xd0, 0xee,
+	0xb4, 0x58, 0x2c, 0x8d, 0xf7, 0x62, 0xe5, 0xbc, 0xb2, 0x6e, 0x36, 0x0c, 0x1c, 0xa4, 0x28, 0x9d,
+	0x17, 0x48, 0x71, 0xcd, 0xed, 0x36, 0xd8, 0xc9, 0xcc, 0xf8, 0x7f, 0x1e, 0x25, 0x65, 0xc3, 0x97,
+	0x84, 0x8b, 0x37, 0x64, 0x9d, 0x20, 0xbb, 0x75, 0xa0, 0xb7, 0x02, 0x38, 0x06, 0x07, 0x39, 0x64,
+	0xfb, 0x5e, 0x94, 0xf3, 0x49, 0x40, 0xc2, 0x41, 0x53, 0xd0, 0x39, 0x52, 0xac, 0xb3, 0x4e, 0xdc,
+	0xe4, 0xdf, 0x63, 0xb4, 0x32, 0x81, 0x1d, 0x58, 0x46, 0x00, 0x08, 0x38, 0x12, 0xec, 0xb2, 0xb8,
+	0xd6, 0xb4, 0x47, 0xb9, 0xba, 0xe5, 0x04, 0xab, 0x08, 0x00, 0x01, 0xcf, 0x39, 0xf5, 0x17, 0x1f,
+	0xfd, 0xa9, 0x7f, 0xec, 0x94, 0x4f, 0xfd, 0xb4, 0x43, 0xce, 0x45, 0x51, 0x73, 0x2b, 0xf4, 0xf6,
+	0xdd, 0x98, 0xf1, 0xc6, 0x5c, 0xce, 0xf8, 0xc3, 0xc8, 0xb9, 0x74, 0x7c, 0x34, 0x77, 0xae, 0x5a,
+	0xbd, 0x91, 0xe5, 0x02, 0x79, 0xac, 0x69, 0x95, 0x5c, 0xf0, 0xfc, 0x88, 0xd5, 0xba, 0x21, 0x5b,
+	0x6f, 0xf8, 0x41, 0xc8, 0x6e, 0x04, 0x11, 0xb2, 0x93, 0x3e, 0xde, 0x2b, 0xf2, 0xa3, 0x5d, 0x58,
+	0xcf, 0x23, 0x82, 0xfc, 0xb6, 0x74, 0x8d, 0x9c, 0xad, 0x7b, 0x91, 0xbb, 0xd3, 0x62, 0xd5, 0xee,
+	0x4e, 0x3b, 0xc0, 0x35, 0x1a, 0x71, 0x45, 0x5f, 0xaa, 0x3c, 0xa9, 0x8c, 0xdf, 0xe5, 0x2c, 0x01,
+	0xf4, 0xb6, 0x71, 0x7e, 0x68, 0x91, 0x49, 0xd3, 0x0f, 0x47, 0x23, 0x42, 0x9a, 0xcb, 0xab, 0x55,
+	0xb1, 0x12, 0x6d, 0x6b, 0x88, 0x3d, 0xe1, 0x86, 0x66, 0x93, 0x9c, 0x27, 0x13, 0x18, 0x18, 0x62,
+	0x4e, 0x10, 0x8b, 0x78, 0x86, 0x14, 0x77, 0x83, 0xb0, 0xc6, 0xa4, 0xa6, 0xd3, 0x8b, 0x68, 0x15,
+	0x81, 0x20, 0x70, 0xce, 0xdf, 0x5b, 0xc4, 0x90, 0x40, 0xbf, 0x42, 0xa6, 0x50, 0xc6, 0xcd, 0x70,
+	0x27, 0xf5, 0x36, 0x95, 0x81, 0xdf, 0x46, 0x73, 0xaa, 0x5c, 0x90, 0xf2, 0xa7, 0x52, 0x60, 0x48,
+	0xcb, 0xa3, 0x1f, 0x25, 0x13, 0x6e, 0xbd, 0x1e, 0xb2, 0x28, 0x62, 0x62, 0x23, 0x98, 0x10, 0x6e,
+	0x99, 0x45, 0x05, 0x84, 0x04, 0x8f, 0xeb, 0x19, 0x1d, 0x9f, 0xb8, 0x44, 0xb2, 0x4a, 0x13, 0x85,
+	0x20, 0x1c, 0x34, 0x85, 0xf3, 0xad, 0x51, 0x92, 0x96, 0x4d, 0xeb, 0x64, 0x7a, 0x2f, 0xdc, 0x59,
+	0xe2, 0xae, 0xa3, 0x41, 0xdc, 0x72, 0xe7, 0xd0, 0x1f, 0x78, 0x33, 0xcd, 0x01, 0xb2, 0x2c, 0xa5,
+	0x94, 0x9b, 0xec, 0x30, 0x76, 0x77, 0x06, 0xf1, 0xcc, 0x29, 0x29, 0x26, 0x07, 0xc8, 0xb2, 0x44,
+	0xcf, 0xd9, 0x5e, 0xb8, 0xa3, 0xb4, 0x45, 0xd6, 0x73, 0x76, 0x33, 0x41, 0x81, 0x49, 0x87, 0x43,
+	0xb8, 0x17, 0xee, 0x00, 0x73, 0x5b, 0x2a, 0x2c, 0xa5, 0x87, 0xf0, 0xa6, 0x84, 0x83, 0xa6, 0xa0,
+	0x1d, 0x42, 0xf7, 0xd4, 0xe8, 0x69, 0x47, 0x99, 0x5d, 0xec, 0xef, 0x67, 0xd3, 0x44, 0xe6, 0x0b,
+	0x5d, 0xc4, 0xbd, 0xe8, 0x66, 0x0f, 0x1f, 0xc8, 0xe1, 0x4d, 0x3f, 0x47, 0x2e, 0xed, 0x85, 0x3b,
+	0x72, 0xe3, 0xda, 0x0a, 0x3d, 0xbf, 0xe6, 0x75, 0x52, 0xf1, 0xa8, 0x39, 0xd9, 0xdd, 0x4b, 0x37,
+	0xf3, 0xc9, 0xa0, 0x5f, 0x7b, 0xe7, 0x6f, 0x46, 0x08, 0x8f, 0x0d, 0xa0, 0x81, 0xd2, 0x66, 0x71,
+	0x33, 0xa8, 0x67, 0x0d, 0x94, 0x0d, 0x0e, 0x05, 0x89, 0x55, 0x1e, 0xe8, 0x91, 0x3e, 0x1e, 0xe8,
+	0x77, 0xc9, 0x78, 0x93, 0xb9, 0x75, 0x8c, 0x96, 0x16, 0xe6, 0x0b, 0x83, 0xeb, 0x80, 0xed, 0xed,
+	0xad, 0x1b, 0x9c, 0x4f, 0xb2, 0xc7, 0x8a, 0xe7, 0x08, 0x94, 0x00, 0x5c, 0xfd, 0x3b, 0x41, 0xfd,
+	0x30, 0x1b, 0x49, 0xac, 0x04, 0xf5, 0x43, 0xe0, 0x18, 0xfa, 0x2a, 0x39, 0x83, 0xe6, 0x42, 0xd0,
+	0x8d, 0xd3, 0x27, 0x6b, 0xae, 0xf1, 0xb7, 0x53, 0x18, 0xc8, 0x50, 0xd2, 0x65, 0x32, 0x23, 0x4f,
+	0xc1, 0x4b, 0x81, 0x5f, 0xf7, 0xb8, 0x09, 0x23, 0x46, 0x5b, 0x47, 0x0f, 0xab, 0x19, 0x3c, 0xf4,
+	0xb4, 0x70, 0x3e, 0x46, 0x26, 0xcd, 0x60, 0xcc, 0x03, 0x1c, 0xf8, 0xce, 0x9f, 0xa2, 0x26, 0xd2,
+	0xef, 0x7e, 0x32, 0x0f, 0xa5, 0x30, 0x12, 0x46, 0xfa, 0x1b, 0x09, 0x34, 0x24, 0x13, 0xfc, 0x07,
+	0xc6, 0x58, 0xed, 0xc2, 0x10, 0xe6, 0x70, 0xd2, 0xb5, 0x6a, 0xd0, 0x0d, 0x95, 0xb7, 0xf8, 0x8e,
+	0xe2, 0x0d, 0x89, 0x18, 0x27, 0x20, 0x33, 0x59, 0x6a, 0xfa, 0x36, 0x99, 0x8c, 0xd4, 0xca, 0xc6,
+	0x73, 0xe1, 0x43, 0xe9, 0x19, 0x7e, 0x6c, 0xa9, 0x1a, 0xcd, 0x21, 0xc5, 0xcc, 0xb9, 0x4b, 0x26,
+	0xb8, 0x4f, 0xa1, 0x81, 0x07, 0xa7, 0x93, 0xd8, 0x4e, 0xf4, 0x59, 0x32, 0xbe, 0xd3, 0xad, 0xed,
+	0x31, 0x19, 0x66, 0xb7, 0x44, 0x7c, 0xb5, 0x22, 0x40, 0xa0, 0x70, 0xce, 0x3f, 0x59, 0x64, 0x6c,
+	0xdd, 0xef, 0x74, 0x7f, 0x41, 0xd2, 0x01, 0x7e, 0x6b, 0x94, 0x8c, 0xe2, 0x71, 0x95, 0x5e, 0x23,
+	0xa3, 0xf1, 0x61, 0x47, 0x0c, 0x61, 0x41, 0x9b, 0xae, 0xa3, 0xdb, 0x87, 0x1d, 0x76, 0x4f, 0xfe,
+	0x05, 0x4e, 0x41, 0x5f, 0x27, 0x63, 0x7e, 0xb7, 0x7d, 0xc7, 0x55, 0x6a, 0x41, 0x85, 0x7c, 0xc7,
+	0x36, 0x39, 0xf4, 0xde, 0xd1, 0xdc, 0x79, 0xe6, 0xd7, 0x82, 0xba, 0xe7, 0x37, 0xae, 0xbf, 0x1b,
+	0x05, 0xfe, 0xc2, 0x66, 0xb7, 0xbd, 0xc3, 0x42, 0x90, 0xad, 0xd0, 0xae, 0xde, 0x09, 0x82, 0x16,
+	0x32, 0x28, 0xa4, 0x9d, 0x66, 0x15, 0x01, 0x06, 0x85, 0x47, 0x35, 0x15, 0xc5, 0x21, 0x52, 0x8e,
+	0xa6, 0xd5, 0x54, 0x95, 0x43, 0x41, 0x62, 0x69, 0x9b, 0x8c, 0xb5, 0xdd, 0x0e, 0xd2, 0x15, 0xe7,
+	0x0b, 0x03, 0xcf, 0x77, 0x1c, 0x87, 0x85, 0x0d, 0xce, 0x67, 0xc5, 0x8f, 0xc3, 0x43, 0x43, 0x2b,
+	0x72, 0x20, 0x48, 0x21, 0xd4, 0x23, 0xe3, 0x2d, 0x2f, 0x8a, 0x51, 0xde, 0xd8, 0x10, 0xb3, 0x02,
+	0xe5, 0xf1, 0x29, 0x9a, 0x8c, 0xc0, 0x2d, 0xc1, 0x16, 0x14, 0xff, 0xd9, 0x43, 0x52, 0x36, 0x7a,
+	0x44, 0x67, 0x44, 0x20, 0x91, 0xcf, 0x73, 0x1e, 0x3b, 0xa4, 0xdb, 0xa6, 0x4a, 0x18, 0xba, 0x27,
+	0x72, 0xb1, 0xbc, 0x3a, 0xf2, 0x8a, 0xf5, 0x6a, 0xe9, 0xbb, 0xbf, 0x31, 0xf7, 0xc4, 0x57, 0xff,
+	0x7a, 0xfe, 0x09, 0xe7, 0x4f, 0x0a, 0x64, 0x42, 0x93, 0xfc, 0xc7, 0x9e, 0x29, 0x61, 0x66, 0xa6,
+	0xbc, 0x39, 0xdc, 0x78, 0x9d, 0x68, 0xba, 0x2c, 0xa6, 0xa7, 0xcb, 0x64, 0xe5, 0xc3, 0xc6, 0xa7,
+	0xbe, 0x77, 0x34, 0x67, 0xa7, 0x07, 0x01, 0xdc, 0x03, 0x1d, 0xd5, 0x52, 0xd3, 0xe0, 0x53, 0x0f,
+	0x9a, 0x06, 0xe7, 0x53, 0x3b, 0x43, 0xfe, 0x67, 0xbc, 0x4b, 0xca, 0xb7, 0x82, 0xda, 0xde, 0x8d,
+	0xa0, 0x85, 0xc2, 0x70, 0xbb, 0x69, 0x05, 0xb5, 0xbd, 0xec, 0x76, 0x83, 0x24, 0xc0, 0x31, 0x38,
+	0xa8, 0x78, 0x12, 0x66, 0xa1, 0xfc, 0x7e, 0xfa, 0x05, 0x6f, 0x70, 0x28, 0x48, 0xac, 0xf3, 0x35,
+	0x8b, 0x9c, 0xdd, 0x60, 0xed, 0xc0, 0x7b, 0x9f, 0x9f, 0xec, 0xa5, 0x87, 0xf6, 0x0a, 0x29, 0x34,
+	0xbd, 0x58, 0x86, 0xbb, 0xf4, 0xe6, 0x77, 0x03, 0x33, 0x1f, 0x9a, 0x5e, 0xfc, 0x80, 0x98, 0x38,
+	0x8f, 0xb1, 0xa3, 0x45, 0xb9, 0x99, 0x98, 0x76, 0x49, 0x8c, 0x5d, 0x21, 0x20, 0xa1, 0x71, 0x7e,
+	0xc7, 0x22, 0xe3, 0xa2, 0x13, 0x4c, 0xf1, 0xb6, 0xfa, 0xf0, 0x7e, 0x9b, 0x14, 0x79, 0x3b, 0xb9,
+	0x66, 0x5e, 0x1d, 0xcc, 0x99, 0x85, 0x1c, 0xc4, 0x09, 0x98, 0xff, 0x04, 0xc1, 0x93, 0x9b, 0x56,
+	0xee, 0x7b, 0x8b, 0x0d, 0x96, 0x8d, 0x69, 0x6e, 0x70, 0x28, 0x48, 0xac, 0xf3, 0xd5, 0x02, 0x29,
+	0x6d, 0xa8, 0xf8, 0xce, 0xff, 0xb1, 0x48, 0xd9, 0xf5, 0xfd, 0x20, 0xe6, 0x03, 0xa8, 0x36, 0x9b,
+	0xcd, 0x81, 0x3a, 0xa6, 0x98, 0x2e, 0x2c, 0x26, 0x0c, 0xc5, 0x04, 0xd5, 0xb6, 0xb1, 0x81, 0x01,
+	0x53, 0x2e, 0xfd, 0x12, 0x19, 0x6b, 0xb9, 0x3b, 0xac, 0xa5, 0xf6, 0x9e, 0xf5, 0xe1, 0x7a, 0x70,
+	0x8b, 0xf3, 0xca, 0xac, 0x0e, 0x01, 0x04, 0x29, 0x68, 0xf6, 0x75, 0x32, 0x93, 0xed, 0xe8, 0xc3,
+	0xcc, 0x6f, 0x5c, 0x1a, 0x86, 0x98, 0x87, 0x69, 0xea, 0x7c, 0x96, 0x94, 0x37, 0x58, 0x1c, 0x7a,
+	0x35, 0xce, 0xe0, 0x41, 0xb3, 0xe6, 0x24, 0xc6, 0x97, 0xf3, 0x3f, 0xc9, 0xb8, 0x60, 0x89, 0x6e,
+	0x71, 0xd2, 0x09, 0x03, 0x34, 0xa4, 0x59, 0x57, 0x7d, 0xd1, 0xc1, 0xec, 0xe3, 0x2d, 0xcd, 0xc6,
+	0xb0, 0x1f, 0x34, 0x0c, 0x0c, 0x31, 0xce, 0xf3, 0xa4, 0xb8, 0xd1, 0x8d, 0xd9, 0x7b, 0x0f, 0x36,
+	0x26, 0x9d, 0x6f, 0x8f, 0x90, 0xe9, 0xcd, 0xa0, 0xce, 0xcc, 0xe0, 0xfe, 0xff, 0x10, 0xbe, 0x5e,
+	0x1e, 0x3c, 0x57, 0x7d, 0x5e, 0x1f, 0xd8, 0xd7, 0x9b, 0xcd, 0x1d, 0x48, 0x7a, 0xaf, 0xb1, 0x11,
+	0x18, 0x02, 0xa9, 0x43, 0xc6, 0xd8, 0x3e, 0x8f, 0x5b, 0x88, 0x73, 0x30, 0xc1, 0xf9, 0xb2, 0xc2,
+	0x21, 0x20, 0x31, 0x42, 0x6d, 0x35, 0x22, 0xbb, 0x90, 0x7e, 0x31, 0x9e, 0x10, 0xc6, 0x31, 0xe8,
+	0x8d, 0xc3, 0xbf, 0xca, 0xde, 0x91, 0x3b, 0x82, 0xf6, 0xc6, 0xdd, 0x32, 0x70, 0x90, 0xa2, 0x74,
+	0x7e, 0x4d, 0x0e, 0x89, 0x99, 0x37, 0xf0, 0x08, 0x86, 0xc4, 0x60, 0xff, 0xc0, 0x21, 0x59, 0xe3,
+	0x01, 0xcc, 0x38, 0x0c, 0x5a, 0x2d, 0x16, 0xde, 0x61, 0xa1, 0xe1, 0xc9, 0x7b, 0xd2, 0x08, 0x60,
+	0xa6, 0x09, 0xa0, 0xb7, 0x0d, 0x5d, 0x24, 0xd3, 0x4c, 0x3a, 0x50, 0x15, 0x1b, 0x31, 0x84, 0x3a,
+	0xcd, 0x67, 0x25, 0x8d, 0x86, 0x2c, 0xbd, 0xf3, 0x57, 0x94, 0x10, 0x1c, 0x1e, 0xa9, 0xe0, 0x67,
+	0xc9, 0x88, 0xa7, 0x0e, 0x90, 0x44, 0x32, 0x19, 0x59, 0x5f, 0x86, 0x11, 0xaf, 0xae, 0xa7, 0xdf,
+	0x48, 0xdf, 0xb3, 0xcc, 0x27, 0x49, 0xb9, 0xee, 0x45, 0x9d, 0x96, 0x7b, 0xb8, 0x99, 0x73, 0x7a,
+	0x5f, 0x4e, 0x50, 0x60, 0xd2, 0xd1, 0x17, 0xa4, 0xf5, 0x31, 0x9a, 0x3a, 0x9c, 0x29, 0xeb, 0xa3,
+	0x84, 0xdd, 0x33, 0x2c, 0x90, 0x57, 0xc8, 0xa4, 0x0a, 0x1a, 0x71, 0x29, 0xc5, 0xf4, 0x54, 0xd8,
+	0x36, 0x70, 0x90, 0xa2, 0xcc, 0x06, 0xb5, 0xc6, 0x1e, 0x4b, 0x50, 0x0b, 0x4f, 0xa1, 0x71, 0x10,
+	0xb2, 0xba, 0xa2, 0x58, 0x5f, 0xb6, 0x69, 0xe6, 0x14, 0x9a, 0xc1, 0x43, 0x4f, 0x0b, 0xba, 0x45,
+	0xce, 0xab, 0x4e, 0x98, 0x2f, 0x68, 0x9f, 0xe3, 0x9c, 0x2e, 0x4b, 0x4e, 0xe7, 0xef, 0xe6, 0xd0,
+	0x40, 0x6e, 0x4b, 0xfa, 0x69, 0x32, 0xa5, 0xba, 0x59, 0xad, 0x05, 0x1d, 0x66, 0x9f, 0xe7, 0xac,
+	0xb4, 0x7f, 0x6b, 0xdb, 0x44, 0x42, 0x9a, 0x96, 0x7e, 0x9c, 0x14, 0x3b, 0x4d, 0x37, 0x62, 0xf6,
+	0x78, 0xca, 0x35, 0x5f, 0xdc, 0x42, 0xe0, 0xbd, 0xa3, 0xb9, 0x09, 0xfc, 0x66, 0xfc, 0x01, 0x04,
+	0x21, 0x26, 0xe1, 0xee, 0x04, 0x5d, 0xbf, 0xee, 0x86, 0x87, 0xeb, 0xcb, 0x32, 0x44, 0xac, 0xd7,
+	0x49, 0x45, 0x63, 0xc0, 0xa0, 0x32, 0x53, 0x84, 0x26, 0xee, 0x9f, 0x22, 0x44, 0xdf, 0x26, 0x13,
+	0x3c, 0x9c, 0xce, 0xea, 0x8b, 0xb1, 0x4d, 0x1e, 0x3a, 0xca, 0xab, 0xcd, 0x90, 0xaa, 0x62, 0x02,
+	0x09, 0x3f, 0xfa, 0x05, 0x42, 0x76, 0x3d, 0xdf, 0x8b, 0x9a, 0x9c, 0x7b, 0xf9, 0xa1, 0xb9, 0xeb,
+	0xf7, 0x5c, 0xd5, 0x5c, 0xc0, 0xe0, 0x88, 0xbb, 0x50, 0x27, 0xa8, 0xaf, 0x6f, 0xd9, 0x93, 0xe9,
+	0x5d, 0x68, 0x0b, 0x81, 0x20, 0x70, 0x18, 0xf4, 0xa9, 0xbb, 0xac, 0x1d, 0xf8, 0xac, 0x6e, 0x4f,
+	0x25, 0x41, 0x9f, 0x65, 0x09, 0x03, 0x8d, 0xa5, 0x5f, 0x24, 0x63, 0x1e, 0x3f, 0xed, 0xda, 0x67,
+	0x78, 0x57, 0x3f, 0x3d, 0x98, 0x3d, 0xcc, 0x59, 0x08, 0x75, 0x2d, 0x7e, 0x83, 0x64, 0x4b, 0x6b,
+	0x64, 0x3c, 0xe8, 0xc6, 0x5c, 0xc2, 0xf4, 0xbc, 0x35, 0x70, 0x90, 0xeb, 0xb6, 0xe0, 0x21, 0x0e,
+	0xed, 0xf2, 0x01, 0x14, 0x67, 0x7c, 0xdf, 0x5a, 0xd3, 0x6b, 0xd5, 0x43, 0xe6, 0xdb, 0x33, 0x7c,
+	0xe7, 0x98, 0x14, 0xf9, 0xcb, 0x02, 0x06, 0x1a, 0x4b, 0xff, 0x33, 0x99, 0x0a, 0xba, 0x31, 0x9f,
+	0x37, 0x38, 0xed, 0x22, 0xfb, 0x2c, 0x27, 0x3f, 0x8b, 0xb3, 0xf8, 0xb6, 0x89, 0x80, 0x34, 0x1d,
+	0x26, 0xc1, 0x9c, 0x6d, 0x67, 0x6d, 0x5c, 0xfb, 0x02, 0x7f, 0xa5, 0xd5, 0x01, 0xad, 0xa4, 0x0c,
+	0x37, 0x91, 0x3f, 0xd0, 0x03, 0x86, 0x5e, 0xb9, 0xf4, 0xd7, 0x2d, 0x72, 0x21, 0x3a, 0xf4, 0x6b,
+	0xcd, 0x30, 0xf0, 0xd3, 0x3d, 0xba, 0x38, 0x6f, 0x0d, 0x6c, 0x39, 0x72, 0xdd, 0x9e, 0xc7, 0xb5,
+	0xf2, 0x24, 0xc6, 0x1e, 0x72, 0x51, 0x90, 0xdf, 0x0f, 0x7a, 0x80, 0xea, 0x5d, 0xef, 0xfc, 0xf6,
+	0xa5, 0x21, 0xf2, 0x52, 0x33, 0x46, 0x8a, 0xd0, 0xa1, 0x06, 0x00, 0x4c, 0x49, 0xf4, 0x1f, 0x2d,
+	0x72, 0x36, 0x64, 0x11, 0xf7, 0x41, 0x45, 0x3a, 0xad, 0xd2, 0xe6, 0xfb, 0xf6, 0x9d, 0xc1, 0x87,
+	0x85, 0xbf, 0xd5, 0x02, 0x64, 0x19, 0x0b, 0xdb, 0x96, 0xa9, 0x9d, 0xb8, 0x07, 0x7f, 0x2f, 0x0f,
+	0xf8, 0xb5, 0x9f, 0xcc, 0xcd, 0xf5, 0x56, 0x03, 0x69, 0xe6, 0xa8, 0x72, 0xbf, 0xf9, 0x93, 0xb9,
+	0x19, 0xf5, 0xac, 0x9a, 0x41, 0xef, 0x7b, 0xe1, 0x30, 0xb3, 0xc4, 0x9a, 0xb0, 0x9f, 0x1c, 0x72,
+	0x98, 0x4d, 0xcb, 0x84, 0x0f, 0xb3, 0x01, 0x00, 0x53, 0x12, 0x26, 0x56, 0xb1, 0x28, 0xf6, 0xda,
+	0x6e, 0xcc, 0xea, 0x7a, 0x94, 0x67, 0xb9, 0x4b, 0x40, 0x27, 0x56, 0xad, 0x64, 0x09, 0xee, 0xe5,
+	0x01, 0xa1, 0x97, 0x11, 0x7d, 0x85, 0x94, 0x3a, 0x61, 0xd0, 0x08, 0x59, 0x14, 0xd9, 0x4f, 0xa5,
+	0xb6, 0xad, 0xd2, 0x96, 0x84, 0xdf, 0x33, 0x7e, 0x83, 0xa6, 0xc6, 0x7d, 0xa0, 0xd6, 0xea, 0x46,
+	0x31, 0x0b, 0xed, 0xcb, 0xe9, 0x7d, 0x60, 0x49, 0x80, 0x41, 0xe1, 0xe9, 0x1a, 0x21, 0x07, 0xae,
+	0x87, 0x59, 0x55, 0xab, 0x41, 0x68, 0x5f, 0xe1, 0xd4, 0x1f, 0x56, 0xea, 0xf7, 0xae, 0xc6, 0x60,
+	0xa7, 0x71, 0x6c, 0x24, 0x44, 0xa6, 0xa6, 0x1a, 0x4d, 0x67, 0x97, 0xc9, 0xc5, 0xfc, 0x89, 0xf1,
+	0xa0, 0xd3, 0x48, 0xc1, 0x3c, 0x8d, 0xac, 0x92, 0x27, 0xfb, 0x2e, 0x40, 0x7c, 0x2d, 0x29, 0xd0,
+	0xb6, 0xd2, 0xaf, 0xa5, 0xba, 0xa5, 0xf0, 0xce, 0x19, 0x32, 0x69, 0xd6, 0x3c, 0x39, 0xbf, 0x32,
+	0x42, 0x94, 0xc6, 0xfc, 0x45, 0x70, 0x69, 0xe2, 0x21, 0x22, 0x64, 0x51, 0xb7, 0x15, 0x4b, 0x9b,
+	0x92, 0x88, 0x84, 0x62, 0x84, 0x80, 0xc4, 0x38, 0x07, 0x64, 0x0a, 0x7b, 0xdb, 0x6a, 0xb1, 0x56,
+	0x35, 0x66, 0x9d, 0x08, 0x13, 0x2c, 0x23, 0xfc, 0x21, 0xc7, 0x64, 0xc8, 0xdc, 0xc6, 0x98, 0x75,
+	0x92, 0x9d, 0x99, 0x0b, 0x00, 0xc1, 0xde, 0xf9, 0xce, 0x08, 0x99, 0xd0, 0xe3, 0x74, 0x02, 0x8f,
+	0xff, 0xb3, 0x64, 0xbc, 0xce, 0x76, 0x5d, 0x7c, 0x1b, 0xe9, 0x29, 0xc1, 0x6f, 0xbe, 0x2c, 0x40,
+	0xa0, 0x70, 0x18, 0x97, 0x17, 0xb3, 0x4a, 0xbc, 0xf2, 0x44, 0x8f, 0xf7, 0x7b, 0xcf, 0x0c, 0x0a,
+	0x8c, 0x0e, 0xe1, 0x2a, 0xd4, 0xee, 0xff, 0xfe, 0xd1, 0x80, 0x4c, 0x11, 0x55, 0xf1, 0x24, 0x45,
+	0x54, 0xce, 0x2a, 0x41, 0x13, 0x66, 0x6d, 0x89, 0xbe, 0xd6, 0x53, 0x53, 0xf4, 0x74, 0x4e, 0x4d,
+	0xd1, 0x14, 0x27, 0xce, 0x29, 0x27, 0xfa, 0x87, 0x02, 0x31, 0xce, 0xc6, 0x27, 0xab, 0x70, 0x6b,
+	0xb2, 0x56, 0x27, 0x7b, 0x52, 0xb9, 0xc1, 0x5a, 0x1d, 0xe0, 0x18, 0xda, 0xd4, 0x4e, 0x11, 0x11,
+	0xe4, 0xfa, 0xcc, 0xa0, 0x4e, 0x11, 0xe5, 0x69, 0xe8, 0xe7, 0x0b, 0x41, 0xc7, 0x54, 0x03, 0xb3,
+	0x41, 0xec, 0xd1, 0x21, 0x1c, 0x53, 0x3c, 0x9f, 0x44, 0x4c, 0x01, 0xfe, 0x13, 0x04, 0x4f, 0xb4,
+	0xc4, 0x6a, 0x22, 0x67, 0xdc, 0x2e, 0x0e, 0x61, 0x89, 0xc9, 0xbc, 0x73, 0x31, 0x11, 0xe5, 0x03,
+	0x28, 0xce, 0x38, 0xcf, 0x9a, 0x2a, 0x2e, 0x63, 0x8f, 0x0d, 0x31, 0xcf, 0x74, 0x74, 0x47, 0xcc,
+	0x33, 0xfd, 0x08, 0x09, 0x7f, 0xe7, 0x3a, 0x29, 0x1b, 0xd5, 0x3b, 0xf8, 0x25, 0x75, 0xfa, 0xb5,
+	0xf1, 0x25, 0x97, 0xdd, 0xd8, 0x05, 0x8e, 0x71, 0xfe, 0xa8, 0x40, 0xf4, 0xae, 0x6a, 0x26, 0x6b,
+	0xb9, 0x35, 0xa3, 0xa8, 0x23, 0x95, 0x24, 0x8a, 0x45, 0x08, 0x02, 0x8b, 0x87, 0xa0, 0x36, 0x0b,
+	0x1b, 0x5a, 0xb1, 0xda, 0x23, 0xe9, 0x43, 0xd0, 0x86, 0x89, 0x84, 0x34, 0x2d, 0x06, 0x9d, 0xdb,
+	0xae, 0xef, 0xed, 0xb2, 0x28, 0xce, 0xc6, 0xed, 0x37, 0x24, 0x1c, 0x34, 0x05, 0x1e, 0xfa, 0x23,
+	0x16, 0xdf, 0x3e, 0xf0, 0x59, 0xa8, 0x93, 0x57, 0x65, 0x86, 0xb1, 0x3e, 0xf4, 0x57, 0xb3, 0x04,
+	0xd0, 0xdb, 0x26, 0x37, 0xac, 0x59, 0x7c, 0xd8, 0xb0, 0x26, 0x72, 0x91, 0x29, 0x6f, 0x7d, 0x83,
+	0xa3, 0xab, 0x19, 0x3c, 0xf4, 0xb4, 0xa0, 0x4b, 0xfc, 0x64, 0xe4, 0xb6, 0xbc, 0xf7, 0x71, 0xef,
+	0x19, 0xe7, 0x76, 0xf7, 0x33, 0xf2, 0xa4, 0x23, 0xa1, 0xa6, 0xb5, 0xa4, 0xa1, 0x60, 0x34, 0x73,
+	0xfe, 0xce, 0x22, 0x53, 0xc0, 0xe2, 0xf0, 0x50, 0x8f, 0xec, 0x1c, 0x29, 0xb6, 0x78, 0x42, 0xb2,
+	0x48, 0xd2, 0xe2, 0xf3, 0x5e, 0xe4, 0x1f, 0x0b, 0x38, 0x5d, 0x26, 0xe5, 0x10, 0x5b, 0xc8, 0xe4,
+	0x6f, 0xf1, 0xd5, 0x1c, 0xe5, 0x68, 0x80, 0x04, 0x75, 0x2f, 0xfd, 0x08, 0x66, 0x33, 0xea, 0x93,
+	0xf1, 0x1d, 0x51, 0x07, 0x64, 0x17, 0x86, 0x58, 0x3d, 0xb2, 0x96, 0x88, 0x27, 0x04, 0xa8, 0xc2,
+	0xa2, 0x7b, 0xc9, 0x4f, 0x50, 0x42, 0x9c, 0xef, 0x5a, 0x84, 0x24, 0x05, 0x89, 0x74, 0x8f, 0x94,
+	0xa2, 0x97, 0x45, 0xb0, 0x52, 0x06, 0x52, 0x07, 0xcc, 0x0b, 0x95, 0x4c, 0x8c, 0x3c, 0x3e, 0x09,
+	0x01, 0x2d, 0xe0, 0x41, 0xe5, 0x6a, 0xbf, 0x5b, 0x20, 0xba, 0x15, 0x4e, 0x6c, 0xe6, 0xd7, 0x3b,
+	0x81, 0xe7, 0xc7, 0xd9, 0x0c, 0xc1, 0x15, 0x09, 0x07, 0x4d, 0x81, 0x6b, 0x4d, 0x04, 0x5a, 0xb3,
+	0x11, 0x05, 0xd9, 0x07, 0x89, 0xa5, 0xbc, 0x30, 0xa8, 0xe1, 0xe5, 0x15, 0x06, 0x35, 0x3c, 0x51,
+	0x18, 0x84, 0x7f, 0xf1, 0xe0, 0xa7, 0x52, 0x9f, 0xe4, 0xfa, 0xe0, 0x07, 0x3f, 0x95, 0x25, 0x05,
+	0x1a, 0x4b, 0x9b, 0x64, 0xda, 0xe5, 0xd3, 0x3a, 0x49, 0xe7, 0x7a, 0xa8, 0xcc, 0xb4, 0xa4, 0x18,
+	0x2e, 0xcd, 0x05, 0xb2, 0x6c, 0x51, 0x52, 0x94, 0x34, 0x7f, 0xf8, 0x04, 0x35, 0x2d, 0xa9, 0x9a,
+	0xe6, 0x02, 0x59, 0xb6, 0x68, 0x14, 0x86, 0x41, 0x8b, 0x2d, 0xc2, 0xa6, 0x3d, 0x9e, 0x36, 0x0a,
+	0x41, 0x80, 0x41, 0xe1, 0xb1, 0x2c, 0xea, 0x4c, 0xb5, 0x16, 0x7a, 0x9d, 0x58, 0xeb, 0xbd, 0x4d,
+	0x32, 0xa1, 0xfd, 0x8c, 0x72, 0x4e, 0x5d, 0xe9, 0x93, 0xd0, 0x22, 0x88, 0x52, 0x45, 0x8e, 0x02,
+	0x04, 0x09, 0x0b, 0x1e, 0x82, 0xe3, 0x2b, 0x37, 0xfb, 0x6d, 0x45, 0x3e, 0x00, 0x48, 0xac, 0x73,
+	0x40, 0x26, 0xab, 0xac, 0xed, 0x76, 0x9a, 0x41, 0xc8, 0x9d, 0x5e, 0x0d, 0x32, 0x5d, 0x33, 0x72,
+	0x66, 0x92, 0x54, 0x81, 0x93, 0xa7, 0xd7, 0xf0, 0x7c, 0xa1, 0xa5, 0x34, 0x13, 0xc8, 0x72, 0xc5,
+	0x04, 0xd7, 0x92, 0xce, 0x7b, 0x7e, 0x86, 0x14, 0xf9, 0x9e, 0x95, 0xcd, 0x19, 0xe0, 0x3b, 0x1a,
+	0x08, 0x1c, 0x12, 0x71, 0xcf, 0x4e, 0xd6, 0xe5, 0xcf, 0x3d, 0x3f, 0x20, 0x70, 0xb8, 0x5a, 0xb0,
+	0x00, 0xa4, 0x90, 0x5e, 0x2d, 0x2b, 0x7e, 0x1d, 0x10, 0xce, 0x4b, 0xba, 0x82, 0xb0, 0xed, 0xc6,
+	0xd9, 0xc8, 0xe4, 0x2a, 0x87, 0x82, 0xc4, 0x3a, 0x1f, 0x21, 0x18, 0xab, 0x64, 0x6e, 0x9b, 0xe7,
+	0xb9, 0x05, 0xa1, 0x52, 0x68, 0x49, 0x9e, 0x5b, 0x10, 0xc6, 0xc0, 0x31, 0xce, 0x1b, 0x64, 0x5a,
+	0x16, 0x98, 0xe8, 0xaf, 0xf9, 0x50, 0xc5, 0x89, 0xce, 0x91, 0x45, 0xa6, 0x33, 0x07, 0x0d, 0xb4,
+	0xd3, 0x23, 0xf5, 0x5d, 0x86, 0x2a, 0xf1, 0x31, 0xbf, 0xae, 0xac, 0x39, 0xd7, 0x90, 0x44, 0x04,
+	0x1a, 0x3b, 0x6d, 0x0c, 0x55, 0x0c, 0x15, 0x85, 0xe3, 0xc1, 0x0e, 0xa1, 0xf4, 0xf9, 0x4f, 0x10,
+	0x3c, 0x9d, 0xaf, 0x5b, 0x24, 0xdf, 0x5f, 0x81, 0xd5, 0xfa, 0x4d, 0x11, 0x01, 0xb5, 0xad, 0x21,
+	0xcc, 0x39, 0x23, 0x92, 0x6a, 0x24, 0x2d, 0x09, 0x00, 0x28, 0x09, 0xce, 0xcf, 0x2d, 0x52, 0xde,
+	0xde, 0xbe, 0xa5, 0x37, 0x2b, 0x20, 0x17, 0x23, 0x91, 0x71, 0xb4, 0xb8, 0x1b, 0xb3, 0x50, 0xe6,
+	0x01, 0xab, 0x6f, 0x26, 0xcb, 0x69, 0xaa, 0xb9, 0x14, 0xd0, 0xa7, 0x25, 0x5d, 0x27, 0xe7, 0x4c,
+	0x8c, 0xdc, 0xcf, 0x65, 0x0e, 0xb2, 0xc8, 0x42, 0xed, 0x45, 0x43, 0x5e, 0x9b, 0x2c, 0x2b, 0xb9,
+	0xa9, 0xdb, 0x85, 0x7c, 0x56, 0x12, 0x0d, 0x79, 0x6d, 0x9c, 0x29, 0x52, 0x36, 0xee, 0xf5, 0x70,
+	0xfe, 0xe5, 0x2a, 0xd1, 0xb5, 0x2a, 0xbf, 0xac, 0x78, 0x19, 0x28, 0x38, 0x50, 0xd3, 0xae, 0xda,
+	0xe2, 0xf0, 0xae, 0x5a, 0xad, 0x85, 0x32, 0xee, 0xda, 0x46, 0xe2, 0xae, 0x1d, 0x3b, 0x05, 0x77,
+	0xad, 0x5e, 0x19, 0x3d, 0x2e, 0xdb, 0x6f, 0x58, 0x64, 0xd2, 0x47, 0x77, 0x87, 0xd4, 0xe1, 0xdc,
+	0x20, 0x2c, 0xbf, 0x74, 0x7b, 0xa8, 0x41, 0x5c, 0xd8, 0x34, 0x38, 0x0a, 0xd7, 0x9c, 0x8e, 0xf5,
+	0x98, 0x28, 0x48, 0x89, 0xa6, 0xab, 0xa4, 0xe4, 0xee, 0xa2, 0x8f, 0x3d, 0x3e, 0x94, 0x45, 0x37,
+	0x97, 0xf3, 0xb6, 0x9e, 0x45, 0x49, 0x23, 0x6c, 0x0c, 0xf5, 0x04, 0xba, 0x2d, 0x1a, 0x69, 0xba,
+	0x06, 0x74, 0x62, 0x08, 0x23, 0x4d, 0xc5, 0xcf, 0x8d, 0x33, 0x82, 0x84, 0x18, 0x25, 0xa1, 0x0e,
+	0x19, 0x13, 0x5e, 0x7c, 0x1e, 0xc2, 0x28, 0x09, 0x37, 0x87, 0xf0, 0xf0, 0x83, 0xc4, 0xa0, 0x77,
+	0x3f, 0xe2, 0x7b, 0x8a, 0xfd, 0xd1, 0x21, 0xa6, 0x8c, 0xd8, 0x96, 0x84, 0x00, 0xf1, 0x1b, 0x24,
+	0x5b, 0xda, 0x50, 0x6e, 0x93, 0xf2, 0x7c, 0x61, 0xe0, 0xa4, 0xe9, 0x94, 0x27, 0x26, 0xdf, 0x6f,
+	0x42, 0xdf, 0x34, 0x8d, 0x95, 0xc9, 0x93, 0x18, 0x2b, 0x53, 0x7d, 0x0d, 0x95, 0x06, 0x19, 0x8b,
+	0xb8, 0x29, 0xc4, 0x63, 0x23, 0xe5, 0x97, 0x96, 0x06, 0x1b, 0x95, 0x94, 0x35, 0x25, 0x47, 0x87,
+	0xc3, 0x40, 0xb2, 0xa7, 0x01, 0x16, 0x5f, 0x48, 0x9b, 0xe8, 0xcc, 0x10, 0x89, 0x98, 0xd9, 0x23,
+	0xab, 0x98, 0x80, 0x0a, 0x0a, 0x5a, 0x08, 0x5e, 0x87, 0x51, 0x77, 0x1b, 0xf6, 0xf4, 0x10, 0xfa,
+	0xc8, 0x28, 0x63, 0x12, 0xd7, 0x61, 0x2c, 0x2f, 0xae, 0x01, 0x72, 0xc5, 0x8d, 0x53, 0x15, 0xbb,
+	0xce, 0x0c, 0xe1, 0x66, 0xce, 0x18, 0x2e, 0xc2, 0x8f, 0xd0, 0x53, 0x2e, 0x7b, 0x57, 0xde, 0x8b,
+	0xf2, 0xfc, 0xbc, 0x35, 0x70, 0x8d, 0x1e, 0x66, 0xa4, 0xf6, 0xdc, 0x87, 0xb2, 0x42, 0xc6, 0xf7,
+	0x83, 0x56, 0xb7, 0x2d, 0x43, 0x3f, 0xe5, 0x97, 0x66, 0xf3, 0xa6, 0xd1, 0x1d, 0x4e, 0x92, 0xa8,
+	0x2f, 0xf1, 0x1c, 0x81, 0x6a, 0x4b, 0xbf, 0x66, 0x91, 0x33, 0xb8, 0xe8, 0x93, 0xa8, 0xbd, 0x4d,
+	0x87, 0x58, 0x02, 0x98, 0x9c, 0x9e, 0x4c, 0xdd, 0x8b, 0x52, 0xec, 0x99, 0xf5, 0x94, 0x04, 0xc8,
+	0x48, 0xa4, 0x1d, 0x52, 0x8a, 0xbc, 0x3a, 0xab, 0xb9, 0x61, 0x64, 0x9f, 0x3b, 0x35, 0xe9, 0xc9,
+	0xc9, 0x50, 0xf2, 0x06, 0x2d, 0x85, 0x7e, 0x9d, 0x5f, 0x39, 0x22, 0x2f, 0xdd, 0x91, 0x77, 0x35,
+	0x9d, 0x3f, 0xcd, 0xbb, 0x9a, 0xce, 0x89, 0xfb, 0x46, 0x52, 0x12, 0x20, 0x2b, 0x92, 0xde, 0x26,
+	0x17, 0x44, 0xe5, 0x6e, 0xb6, 0x94, 0xfa, 0x02, 0x0f, 0x40, 0xf0, 0x68, 0xd5, 0x62, 0x1e, 0x01,
+	0xe4, 0xb7, 0xa3, 0x5f, 0x26, 0x53, 0xa1, 0xe9, 0x55, 0x90, 0x61, 0xb4, 0xca, 0x80, 0xcb, 0xd5,
+	0xe0, 0x24, 0x42, 0x8b, 0x29, 0x10, 0xa4, 0x65, 0xe1, 0x65, 0x47, 0x1d, 0xa9, 0x02, 0xbd, 0xa8,
+	0xcd, 0x43, 0x65, 0x05, 0x61, 0x0b, 0x6c, 0x25, 0x60, 0x30, 0x69, 0xe8, 0x5b, 0xa4, 0x1c, 0x07,
+	0x2d, 0x16, 0xca, 0x74, 0x31, 0x11, 0xdd, 0xba, 0x9a, 0x37, 0x93, 0xb7, 0x35, 0x59, 0x92, 0x5c,
+	0x91, 0xc0, 0x22, 0x30, 0xf9, 0xa0, 0x8b, 0x4b, 0x15, 0xf3, 0x85, 0xdc, 0x77, 0xfb, 0x64, 0xda,
+	0xc5, 0x55, 0x35, 0x91, 0x90, 0xa6, 0x45, 0xa7, 0x55, 0x27, 0xf4, 0x82, 0xd0, 0x8b, 0x0f, 0x97,
+	0x5a, 0x6e, 0x14, 0x71, 0x06, 0xb3, 0xe9, 0x4c, 0x95, 0xad, 0x2c, 0x01, 0xf4, 0xb6, 0xc1, 0x43,
+	0xbd, 0x02, 0xda, 0x4f, 0x25, 0xf7, 0x87, 0xa8, 0xb6, 0xa0, 0xb1, 0x7d, 0x4a, 0x00, 0x2f, 0x0f,
+	0x52, 0x02, 0x48, 0xeb, 0xe4, 0xb2, 0xdb, 0x8d, 0x83, 0x36, 0x02, 0xd2, 0x4d, 0xb6, 0x83, 0x3d,
+	0xe6, 0xdb, 0xf3, 0x7c, 0x97, 0x9d, 0x3f, 0x3e, 0x9a, 0xbb, 0xbc, 0x78, 0x1f, 0x3a, 0xb8, 0x2f,
+	0x17, 0xda, 0x26, 0x25, 0x95, 0x55, 0x63, 0x3f, 0x3d, 0xc4, 0xee, 0x93, 0xae, 0x85, 0x54, 0x17,
+	0xac, 0x08, 0x18, 0x68, 0x11, 0x74, 0x9b, 0x94, 0x9b, 0x41, 0x14, 0x2f, 0xb6, 0x3c, 0x17, 0xab,
+	0x8b, 0xae, 0xcc, 0x17, 0xfa, 0x6d, 0x9c, 0x37, 0x14, 0x59, 0x32, 0x4d, 0x6e, 0x24, 0x2d, 0xc1,
+	0x64, 0x43, 0x19, 0xf7, 0x70, 0x74, 0xf9, 0x57, 0x0b, 0xfc, 0x98, 0xbd, 0x17, 0xdb, 0x57, 0xf9,
+	0xbb, 0x3c, 0x97, 0xc7, 0x79, 0x2b, 0xa8, 0x57, 0xd3, 0xd4, 0x62, 0x95, 0x67, 0x80, 0x90, 0xe5,
+	0x89, 0xc9, 0x3b, 0x9d, 0xa0, 0x8e, 0x97, 0x3e, 0x6c, 0xb9, 0x58, 0x73, 0x38, 0x97, 0x4e, 0xde,
+	0xd9, 0x32, 0x70, 0x90, 0xa2, 0xa4, 0xdf, 0xb4, 0xc8, 0x0c, 0x4b, 0x97, 0xb2, 0x46, 0xb6, 0x33,
+	0x5f, 0x18, 0x78, 0xd3, 0xca, 0xd4, 0xc5, 0x26, 0x7e, 0xcf, 0x0c, 0x22, 0x82, 0x1e, 0xb9, 0x18,
+	0x0d, 0x89, 0xe2, 0xa0, 0x53, 0xf5, 0x1a, 0xbe, 0xdb, 0xb2, 0x9f, 0x49, 0x47, 0x43, 0xaa, 0x1a,
+	0x03, 0x06, 0x15, 0x6d, 0x90, 0x2b, 0x31, 0x0b, 0xdb, 0x9e, 0xcf, 0x17, 0xe6, 0x5a, 0xe8, 0xd6,
+	0xd8, 0x16, 0x0b, 0xbd, 0xa0, 0x2e, 0x15, 0x96, 0xfd, 0x21, 0xae, 0x24, 0x9e, 0x3e, 0x3e, 0x9a,
+	0xbb, 0xb2, 0x7d, 0x3f, 0x42, 0xb8, 0x3f, 0x1f, 0x0c, 0x0a, 0xb4, 0x45, 0xbe, 0xa2, 0xfd, 0xec,
+	0x10, 0xf6, 0xbe, 0xcc, 0x79, 0x14, 0x9b, 0xb9, 0x7c, 0x00, 0xc5, 0x59, 0x08, 0xe1, 0x99, 0xb9,
+	0xf6, 0x73, 0x43, 0x09, 0xe1, 0x3c, 0x94, 0x10, 0xfe, 0x00, 0x8a, 0x33, 0xfd, 0xdf, 0x16, 0x99,
+	0xce, 0xa4, 0x22, 0xd8, 0x1f, 0x1e, 0xc6, 0x4e, 0x49, 0xf3, 0x92, 0x73, 0x36, 0x0d, 0x84, 0xac,
+	0x44, 0x3c, 0xb8, 0xea, 0x72, 0xeb, 0x6b, 0xe9, 0xeb, 0xf9, 0x7a, 0x4b, 0xae, 0xcd, 0x60, 0xf5,
+	0x47, 0xee, 0x1f, 0xac, 0x9e, 0x7d, 0x83, 0x9c, 0xed, 0x39, 0xdc, 0x3c, 0x54, 0xb2, 0xeb, 0x4f,
+	0xd1, 0x15, 0x61, 0x1c, 0x27, 0x4f, 0xfb, 0x10, 0xbe, 0x46, 0xce, 0xca, 0xeb, 0x43, 0xd1, 0x30,
+	0x6d, 0x75, 0xf5, 0x6d, 0x56, 0x46, 0xcc, 0x02, 0xb2, 0x04, 0xd0, 0xdb, 0x06, 0x97, 0xbd, 0xe9,
+	0xb9, 0xcb, 0xa6, 0x6f, 0xa6, 0xdc, 0x7c, 0x29, 0x4a, 0xe7, 0xb7, 0x2d, 0x32, 0x95, 0xb2, 0x65,
+	0x4e, 0xdd, 0xc7, 0xb9, 0x4a, 0x68, 0xdb, 0x0b, 0xc3, 0x20, 0x14, 0x06, 0xe1, 0x06, 0x2a, 0xf6,
+	0x48, 0xde, 0xd5, 0xc4, 0x6b, 0xfc, 0x36, 0x7a, 0xb0, 0x90, 0xd3, 0xc2, 0xf9, 0x03, 0x8b, 0x24,
+	0xa1, 0x53, 0x5d, 0xd8, 0x6a, 0xf5, 0x2d, 0x6c, 0x7d, 0x81, 0x94, 0xb0, 0x36, 0x60, 0x2b, 0x29,
+	0x7f, 0xd5, 0x9f, 0xe2, 0xcd, 0xea, 0xed, 0x4d, 0x4e, 0xa9, 0x29, 0x38, 0xf5, 0x97, 0x56, 0xbd,
+	0x56, 0xdc, 0x5b, 0x24, 0xfa, 0xe6, 0x67, 0x05, 0x1c, 0x34, 0x05, 0x66, 0xda, 0xeb, 0x68, 0xbd,
+	0x1c, 0x6c, 0x3d, 0x08, 0x3a, 0x54, 0x0d, 0x09, 0x8d, 0x73, 0x87, 0x4c, 0x89, 0x97, 0x59, 0x6a,
+	0xb9, 0x5e, 0x7b, 0x6d, 0x89, 0xae, 0xf4, 0x84, 0x6c, 0x9f, 0xcf, 0x09, 0xd9, 0x5e, 0x48, 0x35,
+	0xca, 0x09, 0xdd, 0x7e, 0x7f, 0x84, 0x94, 0x1e, 0xe3, 0x05, 0x55, 0xb5, 0xd4, 0x05, 0x55, 0xa7,
+	0x70, 0x9b, 0x51, 0xde, 0xe5, 0x54, 0x7b, 0x99, 0xcb, 0xa9, 0x96, 0x86, 0x13, 0x73, 0xff, 0x8b,
+	0xa9, 0x7e, 0xd3, 0x22, 0xe7, 0x14, 0xa9, 0x99, 0xc2, 0x9c, 0x9b, 0x43, 0x6c, 0x9d, 0x4e, 0x0e,
+	0xf1, 0xc8, 0x43, 0xe6, 0x10, 0xff, 0xc8, 0x22, 0x93, 0x8f, 0xf1, 0xe2, 0xac, 0x9d, 0xf4, 0xc5,
+	0x59, 0xaf, 0x0d, 0x35, 0xfc, 0x7d, 0x2e, 0xcd, 0xfa, 0xb9, 0x4d, 0x52, 0x17, 0x56, 0xa1, 0x27,
+	0x5d, 0xa9, 0x45, 0x95, 0x50, 0xf2, 0xda, 0x50, 0x7e, 0xad, 0x64, 0x41, 0x2a, 0x48, 0x04, 0x89,
+	0x08, 0xb4, 0x30, 0x18, 0xee, 0x07, 0x22, 0x0a, 0x37, 0x92, 0xb6, 0x30, 0x56, 0x34, 0x06, 0x0c,
+	0xaa, 0xc7, 0xef, 0x33, 0xcd, 0xb7, 0xd5, 0x47, 0x1f, 0x89, 0xad, 0x7e, 0xf9, 0xd4, 0x6d, 0xf5,
+	0x2b, 0x8f, 0xde, 0x56, 0x37, 0x3c, 0x13, 0xc5, 0x21, 0x3c, 0x13, 0x5f, 0x26, 0xe7, 0xf7, 0x13,
+	0x45, 0xab, 0xe7, 0x8b, 0xac, 0x54, 0x7c, 0x3e, 0xd7, 0x42, 0xc7, 0xb5, 0x19, 0xc5, 0xcc, 0x8f,
+	0x0d, 0x15, 0x9d, 0x24, 0x8a, 0xdf, 0xc9, 0x61, 0x07, 0xb9, 0x42, 0xb2, 0x47, 0xd9, 0xf1, 0x13,
+	0x1c, 0x65, 0xbf, 0x67, 0x91, 0x0b, 0x6e, 0xde, 0x35, 0xab, 0xd2, 0x15, 0xfb, 0xe6, 0x50, 0x8e,
+	0x85, 0x14, 0x47, 0xe9, 0x18, 0xc8, 0x43, 0x41, 0x7e, 0x1f, 0x30, 0xff, 0x4a, 0x39, 0xbd, 0xc4,
+	0xc5, 0x19, 0xf9, 0xee, 0xaa, 0x6f, 0x65, 0xbd, 0xd9, 0x84, 0x8f, 0x76, 0x75, 0xe8, 0x4d, 0xe5,
+	0x14, 0x3c, 0xda, 0xe5, 0x21, 0x3c, 0xda, 0x19, 0x3f, 0xc3, 0xe4, 0x29, 0xf9, 0x19, 0x7c, 0x32,
+	0xc3, 0x2f, 0xc9, 0xdc, 0xea, 0xb6, 0x5a, 0x22, 0x96, 0x1d, 0xd9, 0x53, 0xf3, 0x85, 0x7e, 0x31,
+	0xdf, 0xdc, 0xab, 0x4b, 0xf5, 0x11, 0x6c, 0x3d, 0xc3, 0x09, 0x7a, 0x78, 0xe3, 0xb4, 0xc4, 0xf3,
+	0xeb, 0x26, 0x8b, 0x71, 0xb4, 0xed, 0x33, 0xc9, 0x75, 0xd2, 0x37, 0x12, 0x30, 0x98, 0x34, 0xf4,
+	0x26, 0x99, 0xa8, 0xfb, 0x91, 0xcc, 0x19, 0x99, 0xe6, 0x5a, 0xea, 0x63, 0xa8, 0xdb, 0x96, 0x37,
+	0xab, 0x3a, 0x5b, 0xe4, 0x72, 0x4e, 0x12, 0xaf, 0xc6, 0x43, 0xd2, 0x9e, 0x6e, 0x70, 0x66, 0xf2,
+	0x7e, 0x11, 0xe1, 0x3c, 0x9d, 0xef, 0x73, 0x54, 0x5e, 0xde, 0x54, 0xd7, 0xa1, 0x4c, 0x49, 0x71,
+	0xe2, 0x11, 0x12, 0x0e, 0xc6, 0x05, 0x59, 0x67, 0xef, 0x7b, 0x41, 0xd6, 0x5b, 0xe4, 0x52, 0x1c,
+	0xb7, 0x52, 0x21, 0x3b, 0x59, 0x48, 0xc0, 0xab, 0x4a, 0x8a, 0xe2, 0xce, 0x41, 0x8c, 0x4f, 0xe6,
+	0x90, 0x40, 0xbf, 0xb6, 0x3c, 0xfa, 0x15, 0xb7, 0xb4, 0xab, 0xec, 0xea, 0x30, 0xd1, 0xaf, 0x24,
+	0x36, 0x2a, 0xa3, 0x5f, 0x09, 0x00, 0x4c, 0x29, 0xfd, 0x5d, 0x7e, 0xe7, 0x06, 0x74, 0xf9, 0x99,
+	0x5e, 0xa6, 0xf3, 0xf7, 0xf5, 0x32, 0xf5, 0x78, 0xc5, 0x2e, 0x3c, 0x84, 0x57, 0xec, 0x6d, 0x5e,
+	0xaf, 0xb1, 0xb6, 0x64, 0x5f, 0x1c, 0x22, 0xca, 0xcd, 0x93, 0x1d, 0x45, 0x94, 0x9b, 0xff, 0x04,
+	0xc1, 0x13, 0xdd, 0x96, 0xfb, 0xa6, 0x51, 0x6d, 0xcf, 0x0d, 0xe1, 0xb6, 0x4c, 0x99, 0xe7, 0xc2,
+	0x6d, 0x99, 0x02, 0x41, 0x5a, 0x16, 0xde, 0x0b, 0xe7, 0xea, 0x8b, 0xdd, 0xb9, 0x5f, 0x63, 0xd0,
+	0xfa, 0xc6, 0xe4, 0x7e, 0x78, 0x71, 0x2f, 0x5c, 0xf2, 0x0c, 0x86, 0x08, 0x4c, 0x43, 0x53, 0x4f,
+	0x2a, 0x67, 0x8e, 0xfb, 0x41, 0x4a, 0xbd, 0x37, 0xfc, 0x2b, 0x3c, 0xf4, 0xb4, 0xc0, 0xea, 0xa8,
+	0x4e, 0x50, 0xef, 0x71, 0x44, 0xda, 0x97, 0x52, 0x69, 0xe6, 0xe7, 0xb7, 0x72, 0x68, 0x20, 0xb7,
+	0x25, 0xdf, 0xf4, 0x12, 0xb8, 0x6d, 0x8b, 0xcb, 0xc2, 0xf8, 0xa6, 0x97, 0x80, 0xc1, 0xa4, 0xc9,
+	0xfa, 0xe5, 0x9e, 0x7c, 0x64, 0x7e, 0xb9, 0xd9, 0xc7, 0xe0, 0x97, 0x7b, 0xea, 0xc4, 0x7e, 0xb9,
+	0x4f, 0x61, 0xaa, 0xcc, 0xbe, 0x3d, 0xdf, 0xdf, 0xbc, 0x59, 0xf1, 0xf7, 0xef, 0xb8, 0xa1, 0x99,
+	0x46, 0xb3, 0x8f, 0x69, 0x34, 0xfb, 0xf4, 0x16, 0x19, 0x67, 0xfe, 0x3e, 0x4f, 0x5f, 0x7e, 0x9a,
+	0x37, 0x7f, 0xba, 0x4f, 0x73, 0x24, 0x91, 0xf7, 0x95, 0x68, 0x23, 0x49, 0x82, 0x41, 0xb1, 0xc8,
+	0x75, 0x16, 0x39, 0x8f, 0xdb, 0x59, 0x34, 0xbc, 0x4f, 0xe7, 0xf7, 0x29, 0x39, 0x93, 0xb9, 0x17,
+	0x55, 0x57, 0xdb, 0x59, 0x27, 0xad, 0xb6, 0x4b, 0x95, 0xc3, 0x8d, 0x3c, 0xd2, 0x72, 0xb8, 0xc2,
+	0xa9, 0x97, 0xc3, 0x9d, 0xfc, 0x66, 0x70, 0x3c, 0xbc, 0xd6, 0x82, 0x76, 0x87, 0x5f, 0xa4, 0x25,
+	0x8b, 0xbf, 0x8a, 0xe9, 0xc3, 0xeb, 0x52, 0x1a, 0x0d, 0x59, 0x7a, 0xfa, 0xdf, 0x49, 0xd1, 0x0f,
+	0xea, 0xda, 0x98, 0xde, 0x3c, 0x85, 0xc3, 0x3c, 0x37, 0xf0, 0x64, 0x89, 0xbc, 0x8a, 0xfb, 0x15,
+	0x39, 0xec, 0x9e, 0xfa, 0x01, 0x42, 0x28, 0x7d, 0x87, 0xd8, 0xc1, 0xee, 0x6e, 0x2b, 0x70, 0xeb,
+	0x49, 0x45, 0x92, 0x3a, 0x86, 0x8b, 0xff, 0xdc, 0x31, 0x2f, 0x19, 0xd8, 0xb7, 0xfb, 0xd0, 0x41,
+	0x5f, 0x0e, 0x68, 0x87, 0x4f, 0xa7, 0x4b, 0x49, 0xf1, 0xae, 0x38, 0x7c, 0xcd, 0xff, 0x7a, 0x1a,
+	0xaf, 0x99, 0xae, 0x5b, 0x95, 0x2f, 0x9c, 0xa4, 0x3a, 0xa6, 0xb1, 0x90, 0xed, 0x09, 0x0d, 0xc9,
+	0xc5, 0x4e, 0xde, 0x29, 0x25, 0xb2, 0xc7, 0xfb, 0x2b, 0x13, 0x41, 0x57, 0xb9, 0x2a, 0xa5, 0x5c,
+	0xcc, 0x3d, 0xe7, 0x44, 0xd0, 0x87, 0xb3, 0x59, 0xba, 0x58, 0x7a, 0x64, 0xa5, 0x8b, 0xdf, 0xc8,
+	0xd1, 0x44, 0xe5, 0x21, 0x0e, 0x3e, 0xf9, 0xf5, 0x7b, 0x27, 0x73, 0x5e, 0x2f, 0x19, 0x95, 0x73,
+	0xdb, 0xc1, 0x32, 0x6b, 0xb1, 0x98, 0x71, 0x9b, 0x7f, 0x42, 0x94, 0x26, 0x42, 0x16, 0x09, 0xbd,
+	0xf4, 0xf4, 0x2b, 0x39, 0xbb, 0xf4, 0xd4, 0x10, 0xc9, 0x30, 0xba, 0xea, 0xe7, 0xfc, 0x09, 0x37,
+	0xf8, 0xcd, 0xe4, 0xbf, 0x62, 0xac, 0x2d, 0x71, 0x4d, 0x27, 0xcd, 0xe4, 0x0f, 0x65, 0xff, 0x9f,
+	0xc5, 0xda, 0x52, 0x8e, 0x56, 0xcc, 0x36, 0xa6, 0x3f, 0xcb, 0x2d, 0x28, 0x3c, 0xc3, 0xa7, 0xdd,
+	0xe7, 0x4f, 0x63, 0x69, 0xfc, 0xbb, 0x2b, 0x2a, 0xcc, 0xad, 0xed, 0x9b, 0x7e, 0x14, 0xb5, 0x7d,
+	0x33, 0x0f, 0x55, 0xdb, 0xb7, 0x48, 0xa6, 0x51, 0x13, 0xae, 0x2f, 0x6f, 0xb8, 0xef, 0xdd, 0x62,
+	0x7e, 0x23, 0x6e, 0xca, 0x73, 0x8c, 0xd6, 0x23, 0x9b, 0x69, 0x34, 0x64, 0xe9, 0xd1, 0x86, 0x14,
+	0x20, 0x1c, 0x8f, 0xa8, 0xe3, 0xd6, 0x58, 0xdd, 0x3e, 0x9f, 0xb6, 0x21, 0x37, 0x33, 0x78, 0xe8,
+	0x69, 0x81, 0xff, 0x54, 0xc7, 0xac, 0xba, 0x3c, 0x37, 0xc4, 0x3f, 0xd5, 0xc9, 0xf1, 0xd7, 0xde,
+	0xbf, 0xf2, 0x72, 0xf6, 0x50, 0x5c, 0xc2, 0xd0, 0xf7, 0xbe, 0x93, 0xb7, 0xd2, 0x37, 0x42, 0xbd,
+	0x31, 0x64, 0xcd, 0xab, 0x79, 0xd7, 0xca, 0xff, 0xb2, 0xc8, 0xf9, 0x3c, 0x45, 0x9e, 0xd3, 0x8b,
+	0x6a, 0xba, 0x17, 0xc3, 0xf9, 0x3f, 0xcd, 0x3e, 0x9c, 0x4e, 0xb1, 0xe5, 0xf7, 0xc6, 0x0d, 0x9f,
+	0x6d, 0xcc, 0x3a, 0xbf, 0xcc, 0x49, 0x1d, 0x28, 0x27, 0x35, 0x75, 0x9f, 0x7a, 0xf1, 0x31, 0xde,
+	0xa7, 0x3e, 0x36, 0xc0, 0x7d, 0xea, 0xe3, 0x8f, 0xf3, 0x3e, 0xf5, 0xd2, 0x09, 0xef, 0x53, 0x9f,
+	0xf8, 0xe5, 0x7d, 0xea, 0xbd, 0xf7, 0xa9, 0x7f, 0x60, 0x91, 0x99, 0xec, 0xf5, 0x24, 0x8f, 0x21,
+	0x22, 0xb8, 0x97, 0x8a, 0x08, 0xae, 0x0f, 0xa5, 0xcf, 0x55, 0xb7, 0xfb, 0x45, 0x06, 0x31, 0x1e,
+	0xdf, 0x73, 0x05, 0xcb, 0x63, 0x08, 0x88, 0xbd, 0x9b, 0x0e, 0x88, 0xad, 0x9c, 0xca, 0x4b, 0xf6,
+	0x0b, 0x8c, 0xe5, 0xbc, 0xe2, 0xbf, 0x49, 0x80, 0xec, 0x71, 0x2b, 0xe3, 0xca, 0xc2, 0x0f, 0x3e,
+	0xb8, 0xfa, 0xc4, 0x8f, 0x3e, 0xb8, 0xfa, 0xc4, 0x8f, 0x3f, 0xb8, 0xfa, 0xc4, 0x57, 0x8f, 0xaf,
+	0x5a, 0x3f, 0x38, 0xbe, 0x6a, 0xfd, 0xe8, 0xf8, 0xaa, 0xf5, 0xe3, 0xe3, 0xab, 0xd6, 0x4f, 0x8f,
+	0xaf, 0x5a, 0xdf, 0xfe, 0xdb, 0xab, 0x4f, 0x7c, 0xbe, 0xa4, 0xf8, 0xfe, 0xeb, 0x00, 0x76, 0x6f,
+	0xa2, 0x3e, 0xc6, 0x76, 0x00, 0x00,
 }
 
 func (m *ArchiveStrategy) Marshal() (dAtA []byte, err error) {
@@ -2031,6 +2963,16 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Checksum)
+	copy(dAtA[i:], m.Checksum)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Checksum)))
+	i--
+	dAtA[i] = 0x52
+	if m.SizeBytes != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SizeBytes))
+		i--
+		dAtA[i] = 0x48
+	}
 	i--
 	if m.Optional {
 		dAtA[i] = 1
@@ -2089,6 +3031,34 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactGC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactGC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactLocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2348,6 +3318,122 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Cache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfigMap != nil {
+		{
+			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContainerDiagnostics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerDiagnostics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerDiagnostics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x1a
+	if m.ExitCode != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ExitCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContainerEnvironment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerEnvironment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerEnvironment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ImageID)
+	copy(dAtA[i:], m.ImageID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ImageID)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2387,6 +3473,34 @@ func (m *ContinueOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Counter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Counter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2627,6 +3741,18 @@ func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FailureThreshold != nil {
+		{
+			size, err := m.FailureThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	i -= len(m.OnExit)
 	copy(dAtA[i:], m.OnExit)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
@@ -2776,6 +3902,44 @@ func (m *DAGTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutionWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timezone)
+	copy(dAtA[i:], m.Timezone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2804,6 +3968,63 @@ func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FailureThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailureThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailureThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MinCompleted))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Percent))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2825,6 +4046,14 @@ func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	i--
+	if m.DisableSubmodules {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	i--
 	if m.InsecureIgnoreHostKey {
 		dAtA[i] = 1
 	} else {
@@ -3062,6 +4291,68 @@ func (m *HDFSKrbConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	i--
+	dAtA[i] = 0x32
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3090,6 +4381,123 @@ func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValueFrom != nil {
+		{
+			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPHeaderSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeaderSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeaderSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecretKeyRef != nil {
+		{
+			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			f36 := math.Float64bits(float64(m.Buckets[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f36))
+			i--
+			dAtA[i] = 0x11
+		}
+	}
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Inputs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3305,7 +4713,7 @@ func (m *ItemValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
+func (m *LockHolding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3315,68 +4723,30 @@ func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockHolding) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		keysForLabels := make([]string, 0, len(m.Labels))
-		for k := range m.Labels {
-			keysForLabels = append(keysForLabels, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Labels[string(keysForLabels[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForLabels[iNdEx])
-			copy(dAtA[i:], keysForLabels[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Annotations) > 0 {
-		keysForAnnotations := make([]string, 0, len(m.Annotations))
-		for k := range m.Annotations {
-			keysForAnnotations = append(keysForAnnotations, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Annotations[string(keysForAnnotations[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForAnnotations[iNdEx])
-			copy(dAtA[i:], keysForAnnotations[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	i -= len(m.Holder)
+	copy(dAtA[i:], m.Holder)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Holder)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Lock)
+	copy(dAtA[i:], m.Lock)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Lock)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeStatus) Marshal() (dAtA []byte, err error) {
+func (m *MemoizationStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3386,136 +4756,65 @@ func (m *NodeStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *MemoizationStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.TemplateScope)
-	copy(dAtA[i:], m.TemplateScope)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateScope)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xa2
-	i -= len(m.WorkflowTemplateName)
-	copy(dAtA[i:], m.WorkflowTemplateName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WorkflowTemplateName)))
+	i -= len(m.CacheName)
+	copy(dAtA[i:], m.CacheName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
 	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x9a
-	i -= len(m.StoredTemplateID)
-	copy(dAtA[i:], m.StoredTemplateID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StoredTemplateID)))
-	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x1a
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
-	dAtA[i] = 0x92
-	if len(m.OutboundNodes) > 0 {
-		for iNdEx := len(m.OutboundNodes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OutboundNodes[iNdEx])
-			copy(dAtA[i:], m.OutboundNodes[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.OutboundNodes[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-	}
-	if len(m.Children) > 0 {
-		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Children[iNdEx])
-			copy(dAtA[i:], m.Children[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Children[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
-	}
-	if m.Outputs != nil {
-		{
-			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
-	}
-	if m.Inputs != nil {
-		{
-			size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Daemoned != nil {
-		i--
-		if *m.Daemoned {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	i -= len(m.PodIP)
-	copy(dAtA[i:], m.PodIP)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodIP)))
+	dAtA[i] = 0x12
 	i--
-	dAtA[i] = 0x62
-	{
-		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Hit {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Memoize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Memoize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Memoize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.MaxAge)
+	copy(dAtA[i:], m.MaxAge)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxAge)))
 	i--
-	dAtA[i] = 0x52
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x4a
-	i -= len(m.BoundaryID)
-	copy(dAtA[i:], m.BoundaryID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BoundaryID)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0x3a
-	if m.TemplateRef != nil {
+	dAtA[i] = 0x1a
+	if m.Cache != nil {
 		{
-			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Cache.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3523,37 +4822,17 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	i -= len(m.TemplateName)
-	copy(dAtA[i:], m.TemplateName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateName)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Type)
-	copy(dAtA[i:], m.Type)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.ID)
-	copy(dAtA[i:], m.ID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *NoneStrategy) Marshal() (dAtA []byte, err error) {
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3563,20 +4842,68 @@ func (m *NoneStrategy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NoneStrategy) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NoneStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Outputs) Marshal() (dAtA []byte, err error) {
+func (m *MetricLabel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3586,55 +4913,30 @@ func (m *Outputs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Outputs) MarshalTo(dAtA []byte) (int, error) {
+func (m *MetricLabel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Outputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MetricLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != nil {
-		i -= len(*m.Result)
-		copy(dAtA[i:], *m.Result)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Result)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Artifacts) > 0 {
-		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ParallelSteps) Marshal() (dAtA []byte, err error) {
+func (m *Metrics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3644,20 +4946,20 @@ func (m *ParallelSteps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParallelSteps) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParallelSteps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Steps) > 0 {
-		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Prometheus) > 0 {
+		for iNdEx := len(m.Prometheus) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Prometheus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3671,7 +4973,7 @@ func (m *ParallelSteps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Parameter) Marshal() (dAtA []byte, err error) {
+func (m *Mutex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3681,47 +4983,16 @@ func (m *Parameter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
+func (m *Mutex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Mutex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.GlobalName)
-	copy(dAtA[i:], m.GlobalName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GlobalName)))
-	i--
-	dAtA[i] = 0x2a
-	if m.ValueFrom != nil {
-		{
-			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Value != nil {
-		i -= len(*m.Value)
-		copy(dAtA[i:], *m.Value)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Value)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Default != nil {
-		i -= len(*m.Default)
-		copy(dAtA[i:], *m.Default)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Default)))
-		i--
-		dAtA[i] = 0x12
-	}
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -3730,7 +5001,7 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PodGC) Marshal() (dAtA []byte, err error) {
+func (m *NodeDiagnostics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3740,25 +5011,53 @@ func (m *PodGC) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodGC) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeDiagnostics) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeDiagnostics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Strategy)
-	copy(dAtA[i:], m.Strategy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i -= len(m.LogsArtifact)
+	copy(dAtA[i:], m.LogsArtifact)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogsArtifact)))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x22
+	i -= len(m.Logs)
+	copy(dAtA[i:], m.Logs)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Logs)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RawArtifact) Marshal() (dAtA []byte, err error) {
+func (m *NodeEnvironment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3768,25 +5067,44 @@ func (m *RawArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RawArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeEnvironment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RawArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeEnvironment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Data)
-	copy(dAtA[i:], m.Data)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+	i -= len(m.ExecutorVersion)
+	copy(dAtA[i:], m.ExecutorVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExecutorVersion)))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	i -= len(m.ControllerVersion)
+	copy(dAtA[i:], m.ControllerVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ControllerVersion)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourceTemplate) Marshal() (dAtA []byte, err error) {
+func (m *NodeStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3796,75 +5114,45 @@ func (m *ResourceTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.FailureCondition)
-	copy(dAtA[i:], m.FailureCondition)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureCondition)))
+	i -= len(m.WaitingFor)
+	copy(dAtA[i:], m.WaitingFor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WaitingFor)))
 	i--
-	dAtA[i] = 0x32
-	i -= len(m.SuccessCondition)
-	copy(dAtA[i:], m.SuccessCondition)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0xea
+	i -= len(m.Cluster)
+	copy(dAtA[i:], m.Cluster)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cluster)))
 	i--
-	if m.SetOwnerReference {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
+	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x20
-	i -= len(m.Manifest)
-	copy(dAtA[i:], m.Manifest)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Manifest)))
+	dAtA[i] = 0xe2
+	i -= len(m.Progress)
+	copy(dAtA[i:], m.Progress)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
 	i--
-	dAtA[i] = 0x1a
-	i -= len(m.MergeStrategy)
-	copy(dAtA[i:], m.MergeStrategy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MergeStrategy)))
+	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.Action)
-	copy(dAtA[i:], m.Action)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	dAtA[i] = 0xda
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EstimatedDuration))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Backoff != nil {
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd0
+	if m.Environment != nil {
 		{
-			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Environment.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3872,86 +5160,160 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	i -= len(m.RetryPolicy)
-	copy(dAtA[i:], m.RetryPolicy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryPolicy)))
-	i--
-	dAtA[i] = 0x12
-	if m.Limit != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Limit))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xca
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ResourcesDuration) > 0 {
+		keysForResourcesDuration := make([]string, 0, len(m.ResourcesDuration))
+		for k := range m.ResourcesDuration {
+			keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
+		for iNdEx := len(keysForResourcesDuration) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(keysForResourcesDuration[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForResourcesDuration[iNdEx])
+			copy(dAtA[i:], keysForResourcesDuration[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForResourcesDuration[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *S3Artifact) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *S3Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.S3Bucket.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Diagnostics != nil {
+		{
+			size, err := m.Diagnostics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
+	if m.SynchronizationStatus != nil {
+		{
+			size, err := m.SynchronizationStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.MemoizationStatus != nil {
+		{
+			size, err := m.MemoizationStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	i -= len(m.TemplateScope)
+	copy(dAtA[i:], m.TemplateScope)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateScope)))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *S3Bucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	i -= len(m.WorkflowTemplateName)
+	copy(dAtA[i:], m.WorkflowTemplateName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WorkflowTemplateName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	i -= len(m.StoredTemplateID)
+	copy(dAtA[i:], m.StoredTemplateID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StoredTemplateID)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.OutboundNodes) > 0 {
+		for iNdEx := len(m.OutboundNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OutboundNodes[iNdEx])
+			copy(dAtA[i:], m.OutboundNodes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.OutboundNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *S3Bucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *S3Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.RoleARN)
-	copy(dAtA[i:], m.RoleARN)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Children[iNdEx])
+			copy(dAtA[i:], m.Children[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Children[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Outputs != nil {
+		{
+			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Inputs != nil {
+		{
+			size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Daemoned != nil {
+		i--
+		if *m.Daemoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	i -= len(m.PodIP)
+	copy(dAtA[i:], m.PodIP)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodIP)))
 	i--
-	dAtA[i] = 0x3a
+	dAtA[i] = 0x62
 	{
-		size, err := m.SecretKeySecret.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3959,9 +5321,9 @@ func (m *S3Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x5a
 	{
-		size, err := m.AccessKeySecret.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3969,36 +5331,63 @@ func (m *S3Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if m.Insecure != nil {
-		i--
-		if *m.Insecure {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	dAtA[i] = 0x52
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.BoundaryID)
+	copy(dAtA[i:], m.BoundaryID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BoundaryID)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x3a
+	if m.TemplateRef != nil {
+		{
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x32
 	}
-	i -= len(m.Region)
-	copy(dAtA[i:], m.Region)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i -= len(m.TemplateName)
+	copy(dAtA[i:], m.TemplateName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateName)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Bucket)
-	copy(dAtA[i:], m.Bucket)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Endpoint)
-	copy(dAtA[i:], m.Endpoint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
+func (m *NodeSynchronizationStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4008,35 +5397,25 @@ func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScriptTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeSynchronizationStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScriptTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeSynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Source)
-	copy(dAtA[i:], m.Source)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Waiting)
+	copy(dAtA[i:], m.Waiting)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Waiting)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Sequence) Marshal() (dAtA []byte, err error) {
+func (m *NoneStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4046,40 +5425,20 @@ func (m *Sequence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Sequence) MarshalTo(dAtA []byte) (int, error) {
+func (m *NoneStrategy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NoneStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Format)
-	copy(dAtA[i:], m.Format)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.End)
-	copy(dAtA[i:], m.End)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Start)
-	copy(dAtA[i:], m.Start)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Count)
-	copy(dAtA[i:], m.Count)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Count)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SuspendTemplate) Marshal() (dAtA []byte, err error) {
+func (m *Outputs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4089,63 +5448,151 @@ func (m *SuspendTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SuspendTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Outputs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuspendTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Outputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Duration)
-	copy(dAtA[i:], m.Duration)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *TTLStrategy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if m.Result != nil {
+		i -= len(*m.Result)
+		copy(dAtA[i:], *m.Result)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Result)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Artifacts) > 0 {
+		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParallelSteps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *TTLStrategy) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParallelSteps) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TTLStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParallelSteps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SecondsAfterFailure != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterFailure))
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Parameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.GlobalName)
+	copy(dAtA[i:], m.GlobalName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GlobalName)))
+	i--
+	dAtA[i] = 0x2a
+	if m.ValueFrom != nil {
+		{
+			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
-	if m.SecondsAfterSuccess != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterSuccess))
+	if m.Value != nil {
+		i -= len(*m.Value)
+		copy(dAtA[i:], *m.Value)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Value)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.SecondsAfterCompletion != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterCompletion))
+	if m.Default != nil {
+		i -= len(*m.Default)
+		copy(dAtA[i:], *m.Default)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Default)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TarStrategy) Marshal() (dAtA []byte, err error) {
+func (m *PodGC) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4155,20 +5602,25 @@ func (m *TarStrategy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TarStrategy) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodGC) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TarStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PodGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Template) Marshal() (dAtA []byte, err error) {
+func (m *Prometheus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4178,19 +5630,19 @@ func (m *Template) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Template) MarshalTo(dAtA []byte) (int, error) {
+func (m *Prometheus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Executor != nil {
+	if m.Histogram != nil {
 		{
-			size, err := m.Executor.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Histogram.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4198,32 +5650,23 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x32
 	}
-	if m.AutomountServiceAccountToken != nil {
-		i--
-		if *m.AutomountServiceAccountToken {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Counter != nil {
+		{
+			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x2a
 	}
-	i -= len(m.PodSpecPatch)
-	copy(dAtA[i:], m.PodSpecPatch)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodSpecPatch)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xfa
-	if m.SecurityContext != nil {
+	if m.Gauge != nil {
 		{
-			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4231,14 +5674,12 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
+		dAtA[i] = 0x22
 	}
-	if len(m.HostAliases) > 0 {
-		for iNdEx := len(m.HostAliases) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.HostAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Labels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4246,65 +5687,138 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xea
+			dAtA[i] = 0x1a
 		}
 	}
-	i -= len(m.ServiceAccountName)
-	copy(dAtA[i:], m.ServiceAccountName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i -= len(m.Help)
+	copy(dAtA[i:], m.Help)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Help)))
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0xe2
-	if m.Priority != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd8
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RawArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i -= len(m.PriorityClassName)
-	copy(dAtA[i:], m.PriorityClassName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClassName)))
+	return dAtA[:n], nil
+}
+
+func (m *RawArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Data)
+	copy(dAtA[i:], m.Data)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Finalizers) > 0 {
+		for iNdEx := len(m.Finalizers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Finalizers[iNdEx])
+			copy(dAtA[i:], m.Finalizers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Finalizers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i -= len(m.FailureCondition)
+	copy(dAtA[i:], m.FailureCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureCondition)))
 	i--
-	dAtA[i] = 0xd2
-	i -= len(m.SchedulerName)
-	copy(dAtA[i:], m.SchedulerName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SchedulerName)))
+	dAtA[i] = 0x32
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x2a
 	i--
-	dAtA[i] = 0xca
-	if len(m.Tolerations) > 0 {
-		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc2
-		}
+	if m.SetOwnerReference {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if m.Parallelism != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Manifest)
+	copy(dAtA[i:], m.Manifest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Manifest)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.MergeStrategy)
+	copy(dAtA[i:], m.MergeStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MergeStrategy)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.RetryStrategy != nil {
+	return dAtA[:n], nil
+}
+
+func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Backoff != nil {
 		{
-			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4312,267 +5826,133 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0x1a
 	}
-	if m.ActiveDeadlineSeconds != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.ActiveDeadlineSeconds))
-		i--
-		dAtA[i] = 0x1
+	i -= len(m.RetryPolicy)
+	copy(dAtA[i:], m.RetryPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryPolicy)))
+	i--
+	dAtA[i] = 0x12
+	if m.Limit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Limit))
 		i--
-		dAtA[i] = 0xa8
+		dAtA[i] = 0x8
 	}
-	if m.ArchiveLocation != nil {
-		{
-			size, err := m.ArchiveLocation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return len(dAtA) - i, nil
+}
+
+func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sidecars) > 0 {
-		for iNdEx := len(m.Sidecars) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sidecars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
+	return dAtA[:n], nil
+}
+
+func (m *S3Artifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *S3Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.S3Bucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.InitContainers) > 0 {
-		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InitContainers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *S3Bucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Volumes) > 0 {
-		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
+	return dAtA[:n], nil
+}
+
+func (m *S3Bucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *S3Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.RoleARN)
+	copy(dAtA[i:], m.RoleARN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.SecretKeySecret.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Suspend != nil {
-		{
-			size, err := m.Suspend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.AccessKeySecret.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.DAG != nil {
-		{
-			size, err := m.DAG.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x2a
+	if m.Insecure != nil {
+		i--
+		if *m.Insecure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x7a
-	}
-	if m.Resource != nil {
-		{
-			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Script != nil {
-		{
-			size, err := m.Script.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.Container != nil {
-		{
-			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Steps) > 0 {
-		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.Daemon != nil {
-		i--
-		if *m.Daemon {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Affinity != nil {
-		{
-			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.NodeSelector) > 0 {
-		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
-		for k := range m.NodeSelector {
-			keysForNodeSelector = append(keysForNodeSelector, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForNodeSelector[iNdEx])
-			copy(dAtA[i:], keysForNodeSelector[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	{
-		size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.TemplateRef != nil {
-		{
-			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		dAtA[i] = 0x20
 	}
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Template)
-	copy(dAtA[i:], m.Template)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TemplateRef) Marshal() (dAtA []byte, err error) {
+func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4582,38 +5962,35 @@ func (m *TemplateRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TemplateRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScriptTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScriptTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.RuntimeResolution {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x18
-	i -= len(m.Template)
-	copy(dAtA[i:], m.Template)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i -= len(m.Source)
+	copy(dAtA[i:], m.Source)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	{
+		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UserContainer) Marshal() (dAtA []byte, err error) {
+func (m *SemaphoreRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4623,40 +6000,32 @@ func (m *UserContainer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserContainer) MarshalTo(dAtA []byte) (int, error) {
+func (m *SemaphoreRef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MirrorVolumeMounts != nil {
-		i--
-		if *m.MirrorVolumeMounts {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ValueFrom) Marshal() (dAtA []byte, err error) {
+func (m *Sequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4666,40 +6035,40 @@ func (m *ValueFrom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValueFrom) MarshalTo(dAtA []byte) (int, error) {
+func (m *Sequence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Parameter)
-	copy(dAtA[i:], m.Parameter)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameter)))
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
 	i--
 	dAtA[i] = 0x22
-	i -= len(m.JQFilter)
-	copy(dAtA[i:], m.JQFilter)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JQFilter)))
+	i -= len(m.End)
+	copy(dAtA[i:], m.End)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.JSONPath)
-	copy(dAtA[i:], m.JSONPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i -= len(m.Start)
+	copy(dAtA[i:], m.Start)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Path)
-	copy(dAtA[i:], m.Path)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i -= len(m.Count)
+	copy(dAtA[i:], m.Count)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Count)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Workflow) Marshal() (dAtA []byte, err error) {
+func (m *Stream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4709,50 +6078,98 @@ func (m *Workflow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Workflow) MarshalTo(dAtA []byte) (int, error) {
+func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Workflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Port))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *SuspendTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspendTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspendTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
 	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Synchronization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Synchronization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mutex != nil {
+		{
+			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Semaphore != nil {
+		{
+			size, err := m.Semaphore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowList) Marshal() (dAtA []byte, err error) {
+func (m *SynchronizationStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4762,20 +6179,20 @@ func (m *WorkflowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *SynchronizationStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Holding) > 0 {
+		for iNdEx := len(m.Holding) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Holding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4783,23 +6200,51 @@ func (m *WorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *TTLStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TTLStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TTLStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecondsAfterFailure != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterFailure))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SecondsAfterSuccess != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterSuccess))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SecondsAfterCompletion != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterCompletion))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowSpec) Marshal() (dAtA []byte, err error) {
+func (m *TarStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4809,19 +6254,42 @@ func (m *WorkflowSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *TarStrategy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TarStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TTLStrategy != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *Template) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Template) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stream != nil {
 		{
-			size, err := m.TTLStrategy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4829,13 +6297,20 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xf2
+		dAtA[i] = 0xda
 	}
-	if m.Executor != nil {
+	i -= len(m.Cluster)
+	copy(dAtA[i:], m.Cluster)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cluster)))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	if m.HTTP != nil {
 		{
-			size, err := m.Executor.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4843,32 +6318,23 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0xca
 	}
-	if m.AutomountServiceAccountToken != nil {
-		i--
-		if *m.AutomountServiceAccountToken {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xe0
+	i--
+	if m.FailFast {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	i -= len(m.PodSpecPatch)
-	copy(dAtA[i:], m.PodSpecPatch)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodSpecPatch)))
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x2
 	i--
-	dAtA[i] = 0xda
-	if m.SecurityContext != nil {
+	dAtA[i] = 0xc0
+	if m.Synchronization != nil {
 		{
-			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Synchronization.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4876,43 +6342,27 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0xba
 	}
-	if len(m.HostAliases) > 0 {
-		for iNdEx := len(m.HostAliases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Memoize != nil {
+		{
+			size, err := m.Memoize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xca
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-	}
-	if m.PodPriority != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.PodPriority))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xc0
+		dAtA[i] = 0xb2
 	}
-	i -= len(m.PodPriorityClassName)
-	copy(dAtA[i:], m.PodPriorityClassName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodPriorityClassName)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xba
-	if m.PodGC != nil {
+	if m.Metrics != nil {
 		{
-			size, err := m.PodGC.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4920,48 +6370,76 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0xaa
 	}
-	i -= len(m.SchedulerName)
-	copy(dAtA[i:], m.SchedulerName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SchedulerName)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	if m.Priority != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
+	if m.TerminationGracePeriodSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TerminationGracePeriodSeconds))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0xa0
 	}
-	if m.ActiveDeadlineSeconds != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.ActiveDeadlineSeconds))
+	i -= len(m.StopSignal)
+	copy(dAtA[i:], m.StopSignal)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StopSignal)))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x9a
+	if len(m.ExecutionWindows) > 0 {
+		for iNdEx := len(m.ExecutionWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Executor != nil {
+		{
+			size, err := m.Executor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x98
+		dAtA[i] = 0x8a
 	}
-	if m.TTLSecondsAfterFinished != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSecondsAfterFinished))
+	if m.AutomountServiceAccountToken != nil {
 		i--
-		dAtA[i] = 0x1
+		if *m.AutomountServiceAccountToken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
 	}
-	i -= len(m.OnExit)
-	copy(dAtA[i:], m.OnExit)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
+	i -= len(m.PodSpecPatch)
+	copy(dAtA[i:], m.PodSpecPatch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodSpecPatch)))
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x8a
-	if m.DNSConfig != nil {
+	dAtA[i] = 0xfa
+	if m.SecurityContext != nil {
 		{
-			size, err := m.DNSConfig.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4971,29 +6449,12 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x82
-	}
-	if m.DNSPolicy != nil {
-		i -= len(*m.DNSPolicy)
-		copy(dAtA[i:], *m.DNSPolicy)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.DNSPolicy)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if m.HostNetwork != nil {
-		i--
-		if *m.HostNetwork {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+		dAtA[i] = 0xf2
 	}
-	if len(m.ImagePullSecrets) > 0 {
-		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HostAliases) > 0 {
+		for iNdEx := len(m.HostAliases) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ImagePullSecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HostAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5001,9 +6462,39 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x6a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
 	}
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	if m.Priority != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	i -= len(m.PriorityClassName)
+	copy(dAtA[i:], m.PriorityClassName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClassName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	i -= len(m.SchedulerName)
+	copy(dAtA[i:], m.SchedulerName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SchedulerName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
 	if len(m.Tolerations) > 0 {
 		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5015,12 +6506,21 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if m.Affinity != nil {
+	if m.Parallelism != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.RetryStrategy != nil {
 		{
-			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5028,45 +6528,20 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.NodeSelector) > 0 {
-		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
-		for k := range m.NodeSelector {
-			keysForNodeSelector = append(keysForNodeSelector, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForNodeSelector[iNdEx])
-			copy(dAtA[i:], keysForNodeSelector[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x52
-		}
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if m.Suspend != nil {
+	if m.ActiveDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ActiveDeadlineSeconds))
 		i--
-		if *m.Suspend {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0xa8
 	}
-	if m.ArtifactRepositoryRef != nil {
+	if m.ArchiveLocation != nil {
 		{
-			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ArchiveLocation.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5074,17 +6549,14 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
-	}
-	if m.Parallelism != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0xa2
 	}
-	if len(m.VolumeClaimTemplates) > 0 {
-		for iNdEx := len(m.VolumeClaimTemplates) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Sidecars) > 0 {
+		for iNdEx := len(m.Sidecars) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.VolumeClaimTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Sidecars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5092,13 +6564,15 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.Volumes) > 0 {
-		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InitContainers) > 0 {
+		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InitContainers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5106,33 +6580,15 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	i -= len(m.ServiceAccountName)
-	copy(dAtA[i:], m.ServiceAccountName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Entrypoint)
-	copy(dAtA[i:], m.Entrypoint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Entrypoint)))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Templates) > 0 {
-		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5140,69 +6596,40 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *WorkflowStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Suspend != nil {
+		{
+			size, err := m.Suspend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return dAtA[:n], nil
-}
-
-func (m *WorkflowStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.OffloadNodeStatusVersion)
-	copy(dAtA[i:], m.OffloadNodeStatusVersion)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OffloadNodeStatusVersion)))
-	i--
-	dAtA[i] = 0x52
-	if len(m.StoredTemplates) > 0 {
-		keysForStoredTemplates := make([]string, 0, len(m.StoredTemplates))
-		for k := range m.StoredTemplates {
-			keysForStoredTemplates = append(keysForStoredTemplates, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForStoredTemplates)
-		for iNdEx := len(keysForStoredTemplates) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.StoredTemplates[string(keysForStoredTemplates[iNdEx])]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.DAG != nil {
+		{
+			size, err := m.DAG.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForStoredTemplates[iNdEx])
-			copy(dAtA[i:], keysForStoredTemplates[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForStoredTemplates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.Outputs != nil {
+	if m.Resource != nil {
 		{
-			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5210,33 +6637,36 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x72
 	}
-	if len(m.PersistentVolumeClaims) > 0 {
-		for iNdEx := len(m.PersistentVolumeClaims) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PersistentVolumeClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Script != nil {
+		{
+			size, err := m.Script.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x3a
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if len(m.Nodes) > 0 {
-		keysForNodes := make([]string, 0, len(m.Nodes))
-		for k := range m.Nodes {
-			keysForNodes = append(keysForNodes, string(k))
+	if m.Container != nil {
+		{
+			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
-		for iNdEx := len(keysForNodes) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Nodes[string(keysForNodes[iNdEx])]
-			baseI := i
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5244,39 +6674,21 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
-			i -= len(keysForNodes[iNdEx])
-			copy(dAtA[i:], keysForNodes[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodes[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x5a
 		}
 	}
-	i -= len(m.CompressedNodes)
-	copy(dAtA[i:], m.CompressedNodes)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CompressedNodes)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Daemon != nil {
+		i--
+		if *m.Daemon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x50
 	}
-	i--
-	dAtA[i] = 0x1a
 	{
-		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5284,43 +6696,10 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *WorkflowStep) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *WorkflowStep) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.OnExit)
-	copy(dAtA[i:], m.OnExit)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
-	i--
-	dAtA[i] = 0x5a
-	if m.ContinueOn != nil {
+	dAtA[i] = 0x4a
+	if m.Affinity != nil {
 		{
-			size, err := m.ContinueOn.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5328,44 +6707,52 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x42
 	}
-	i -= len(m.When)
-	copy(dAtA[i:], m.When)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
-	i--
-	dAtA[i] = 0x42
-	if m.WithSequence != nil {
-		{
-			size, err := m.WithSequence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if len(m.NodeSelector) > 0 {
+		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
+		for k := range m.NodeSelector {
+			keysForNodeSelector = append(keysForNodeSelector, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodeSelector[iNdEx])
+			copy(dAtA[i:], keysForNodeSelector[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	i -= len(m.WithParam)
-	copy(dAtA[i:], m.WithParam)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithParam)))
+	{
+		size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x32
-	if len(m.WithItems) > 0 {
-		for iNdEx := len(m.WithItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	{
+		size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x2a
 	if m.TemplateRef != nil {
 		{
 			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
@@ -5401,7 +6788,7 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowTemplate) Marshal() (dAtA []byte, err error) {
+func (m *TemplateRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5411,26 +6798,196 @@ func (m *WorkflowTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *TemplateRef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.ConfigMapKey)
+	copy(dAtA[i:], m.ConfigMapKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMapKey)))
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x22
+	i--
+	if m.RuntimeResolution {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserContainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MirrorVolumeMounts != nil {
+		i--
+		if *m.MirrorVolumeMounts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValueFrom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueFrom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Parameter)
+	copy(dAtA[i:], m.Parameter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameter)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.JQFilter)
+	copy(dAtA[i:], m.JQFilter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JQFilter)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeClaimGC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeClaimGC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeClaimGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Workflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Workflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Workflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -5444,7 +7001,7 @@ func (m *WorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowTemplateList) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowEnvironment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5454,12 +7011,45 @@ func (m *WorkflowTemplateList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowTemplateList) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowEnvironment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowEnvironment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ExecutorVersion)
+	copy(dAtA[i:], m.ExecutorVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExecutorVersion)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ControllerVersion)
+	copy(dAtA[i:], m.ControllerVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ControllerVersion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5491,7 +7081,7 @@ func (m *WorkflowTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowTemplateSpec) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5501,30 +7091,58 @@ func (m *WorkflowTemplateSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	if m.ArtifactManifest {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Templates) > 0 {
-		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xa0
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Synchronization != nil {
+		{
+			size, err := m.Synchronization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.EnvFrom) > 0 {
+		for iNdEx := len(m.EnvFrom) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.EnvFrom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5532,631 +7150,902 @@ func (m *WorkflowTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ArchiveStrategy) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.VolumeClaimGC != nil {
+		{
+			size, err := m.VolumeClaimGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	var l int
-	_ = l
-	if m.Tar != nil {
-		l = m.Tar.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.TTLStrategy != nil {
+		{
+			size, err := m.TTLStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	if m.None != nil {
-		l = m.None.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Executor != nil {
+		{
+			size, err := m.Executor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	return n
-}
-
-func (m *Arguments) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.AutomountServiceAccountToken != nil {
+		i--
+		if *m.AutomountServiceAccountToken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
 	}
-	var l int
-	_ = l
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.PodSpecPatch)
+	copy(dAtA[i:], m.PodSpecPatch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodSpecPatch)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.SecurityContext != nil {
+		{
+			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	if len(m.Artifacts) > 0 {
-		for _, e := range m.Artifacts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.HostAliases) > 0 {
+		for iNdEx := len(m.HostAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	return n
-}
-
-func (m *Artifact) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PodPriority != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.PodPriority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Mode != nil {
-		n += 1 + sovGenerated(uint64(*m.Mode))
+	i -= len(m.PodPriorityClassName)
+	copy(dAtA[i:], m.PodPriorityClassName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodPriorityClassName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.PodGC != nil {
+		{
+			size, err := m.PodGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	l = len(m.From)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ArtifactLocation.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GlobalName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Archive != nil {
-		l = m.Archive.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.SchedulerName)
+	copy(dAtA[i:], m.SchedulerName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SchedulerName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.Priority != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	n += 2
-	return n
-}
-
-func (m *ArtifactLocation) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ActiveDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ActiveDeadlineSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	var l int
-	_ = l
-	if m.ArchiveLogs != nil {
-		n += 2
+	if m.TTLSecondsAfterFinished != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSecondsAfterFinished))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.S3 != nil {
-		l = m.S3.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.OnExit)
+	copy(dAtA[i:], m.OnExit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.DNSConfig != nil {
+		{
+			size, err := m.DNSConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.Git != nil {
-		l = m.Git.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.DNSPolicy != nil {
+		i -= len(*m.DNSPolicy)
+		copy(dAtA[i:], *m.DNSPolicy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.DNSPolicy)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.HTTP != nil {
-		l = m.HTTP.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.HostNetwork != nil {
+		i--
+		if *m.HostNetwork {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.Artifactory != nil {
-		l = m.Artifactory.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ImagePullSecrets) > 0 {
+		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImagePullSecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	if m.HDFS != nil {
-		l = m.HDFS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	if m.Raw != nil {
-		l = m.Raw.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Affinity != nil {
+		{
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func (m *ArtifactRepositoryRef) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.NodeSelector) > 0 {
+		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
+		for k := range m.NodeSelector {
+			keysForNodeSelector = append(keysForNodeSelector, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodeSelector[iNdEx])
+			copy(dAtA[i:], keysForNodeSelector[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ConfigMap)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Key)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ArtifactoryArtifact) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Suspend != nil {
+		i--
+		if *m.Suspend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ArtifactoryAuth.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ArtifactoryAuth) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ArtifactRepositoryRef != nil {
+		{
+			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	if m.UsernameSecret != nil {
-		l = m.UsernameSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Parallelism != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.PasswordSecret != nil {
-		l = m.PasswordSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.VolumeClaimTemplates) > 0 {
+		for iNdEx := len(m.VolumeClaimTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeClaimTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return n
-}
-
-func (m *Backoff) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Duration)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Factor))
-	l = len(m.MaxDuration)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Entrypoint)
+	copy(dAtA[i:], m.Entrypoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Entrypoint)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContinueOn) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 2
-	n += 2
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CronWorkflow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WorkflowStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CronWorkflowList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i--
+	if m.NodeIDNamespaced {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return n
-}
-
-func (m *CronWorkflowSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.WorkflowSpec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Schedule)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ConcurrencyPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.StartingDeadlineSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.StartingDeadlineSeconds))
-	}
-	if m.SuccessfulJobsHistoryLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.SuccessfulJobsHistoryLimit))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa0
+	if m.Environment != nil {
+		{
+			size, err := m.Environment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.FailedJobsHistoryLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.FailedJobsHistoryLimit))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.NodeIDMaxLength))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x90
+	i -= len(m.ArtifactGCPhase)
+	copy(dAtA[i:], m.ArtifactGCPhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArtifactGCPhase)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	i -= len(m.Progress)
+	copy(dAtA[i:], m.Progress)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EstimatedDuration))
+	i--
+	dAtA[i] = 0x78
+	if len(m.ResourcesDuration) > 0 {
+		keysForResourcesDuration := make([]string, 0, len(m.ResourcesDuration))
+		for k := range m.ResourcesDuration {
+			keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
+		for iNdEx := len(keysForResourcesDuration) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(keysForResourcesDuration[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForResourcesDuration[iNdEx])
+			copy(dAtA[i:], keysForResourcesDuration[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForResourcesDuration[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	l = len(m.Timezone)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *CronWorkflowStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ArtifactManifest != nil {
+		{
+			size, err := m.ArtifactManifest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	var l int
-	_ = l
-	if len(m.Active) > 0 {
-		for _, e := range m.Active {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ResourcesToDelete) > 0 {
+		for iNdEx := len(m.ResourcesToDelete) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResourcesToDelete[iNdEx])
+			copy(dAtA[i:], m.ResourcesToDelete[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourcesToDelete[iNdEx])))
+			i--
+			dAtA[i] = 0x62
 		}
 	}
-	if m.LastScheduledTime != nil {
-		l = m.LastScheduledTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Synchronization != nil {
+		{
+			size, err := m.Synchronization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func (m *DAGTask) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= len(m.OffloadNodeStatusVersion)
+	copy(dAtA[i:], m.OffloadNodeStatusVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OffloadNodeStatusVersion)))
+	i--
+	dAtA[i] = 0x52
+	if len(m.StoredTemplates) > 0 {
+		keysForStoredTemplates := make([]string, 0, len(m.StoredTemplates))
+		for k := range m.StoredTemplates {
+			keysForStoredTemplates = append(keysForStoredTemplates, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForStoredTemplates)
+		for iNdEx := len(keysForStoredTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.StoredTemplates[string(keysForStoredTemplates[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForStoredTemplates[iNdEx])
+			copy(dAtA[i:], keysForStoredTemplates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForStoredTemplates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Template)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Arguments.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.TemplateRef != nil {
-		l = m.TemplateRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Outputs != nil {
+		{
+			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.Dependencies) > 0 {
-		for _, s := range m.Dependencies {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.PersistentVolumeClaims) > 0 {
+		for iNdEx := len(m.PersistentVolumeClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersistentVolumeClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.WithItems) > 0 {
-		for _, e := range m.WithItems {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Nodes) > 0 {
+		keysForNodes := make([]string, 0, len(m.Nodes))
+		for k := range m.Nodes {
+			keysForNodes = append(keysForNodes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
+		for iNdEx := len(keysForNodes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Nodes[string(keysForNodes[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodes[iNdEx])
+			copy(dAtA[i:], keysForNodes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	l = len(m.WithParam)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.WithSequence != nil {
-		l = m.WithSequence.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.CompressedNodes)
+	copy(dAtA[i:], m.CompressedNodes)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CompressedNodes)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	l = len(m.When)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.ContinueOn != nil {
-		l = m.ContinueOn.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	l = len(m.OnExit)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DAGTemplate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Target)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.FailFast != nil {
-		n += 2
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ExecutorConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ServiceAccountName)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WorkflowStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Repo)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Revision)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Depth != nil {
-		n += 1 + sovGenerated(uint64(*m.Depth))
-	}
-	if len(m.Fetch) > 0 {
-		for _, s := range m.Fetch {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.FailureThreshold != nil {
+		{
+			size, err := m.FailureThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.UsernameSecret != nil {
-		l = m.UsernameSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.PasswordSecret != nil {
-		l = m.PasswordSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.OnExit)
+	copy(dAtA[i:], m.OnExit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
+	i--
+	dAtA[i] = 0x5a
+	if m.ContinueOn != nil {
+		{
+			size, err := m.ContinueOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.SSHPrivateKeySecret != nil {
-		l = m.SSHPrivateKeySecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x42
+	if m.WithSequence != nil {
+		{
+			size, err := m.WithSequence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	n += 2
-	return n
-}
-
-func (m *HDFSArtifact) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= len(m.WithParam)
+	copy(dAtA[i:], m.WithParam)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithParam)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.WithItems) > 0 {
+		for iNdEx := len(m.WithItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	l = m.HDFSConfig.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	return n
-}
-
-func (m *HDFSConfig) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TemplateRef != nil {
+		{
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = m.HDFSKrbConfig.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	l = len(m.HDFSUser)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *HDFSKrbConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.KrbCCacheSecret != nil {
-		l = m.KrbCCacheSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.KrbKeytabSecret != nil {
-		l = m.KrbKeytabSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.KrbUsername)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.KrbRealm)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.KrbConfigConfigMap != nil {
-		l = m.KrbConfigConfigMap.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *WorkflowTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.KrbServicePrincipalName)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HTTPArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Inputs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Artifacts) > 0 {
-		for _, e := range m.Artifacts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Item) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowTemplateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 1 + sovGenerated(uint64(m.Type))
-	l = len(m.NumVal)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	l = len(m.StrVal)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.MapVal) > 0 {
-		for k, v := range m.MapVal {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.ListVal) > 0 {
-		for _, e := range m.ListVal {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ItemValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowTemplateList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.Type))
-	l = len(m.NumVal)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	l = len(m.StrVal)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.MapVal) > 0 {
-		for k, v := range m.MapVal {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.ListVal) > 0 {
-		for _, b := range m.ListVal {
-			l = len(b)
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowTemplateSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NodeStatus) Size() (n int) {
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchiveStrategy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TemplateName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.TemplateRef != nil {
-		l = m.TemplateRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.BoundaryID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.StartedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.FinishedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.PodIP)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Daemoned != nil {
-		n += 2
-	}
-	if m.Inputs != nil {
-		l = m.Inputs.Size()
+	if m.Tar != nil {
+		l = m.Tar.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Outputs != nil {
-		l = m.Outputs.Size()
+	if m.None != nil {
+		l = m.None.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Children) > 0 {
-		for _, s := range m.Children {
-			l = len(s)
-			n += 2 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.OutboundNodes) > 0 {
-		for _, s := range m.OutboundNodes {
-			l = len(s)
-			n += 2 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.StoredTemplateID)
-	n += 2 + l + sovGenerated(uint64(l))
-	l = len(m.WorkflowTemplateName)
-	n += 2 + l + sovGenerated(uint64(l))
-	l = len(m.TemplateScope)
-	n += 2 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *NoneStrategy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
 	return n
 }
 
-func (m *Outputs) Size() (n int) {
+func (m *Arguments) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6174,29 +8063,10 @@ func (m *Outputs) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Result != nil {
-		l = len(*m.Result)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *ParallelSteps) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *Parameter) Size() (n int) {
+func (m *Artifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6204,24 +8074,31 @@ func (m *Parameter) Size() (n int) {
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Default != nil {
-		l = len(*m.Default)
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Mode != nil {
+		n += 1 + sovGenerated(uint64(*m.Mode))
 	}
-	if m.Value != nil {
-		l = len(*m.Value)
+	l = len(m.From)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ArtifactLocation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GlobalName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Archive != nil {
+		l = m.Archive.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.ValueFrom != nil {
-		l = m.ValueFrom.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.SizeBytes != nil {
+		n += 1 + sovGenerated(uint64(*m.SizeBytes))
 	}
-	l = len(m.GlobalName)
+	l = len(m.Checksum)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PodGC) Size() (n int) {
+func (m *ArtifactGC) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6232,161 +8109,246 @@ func (m *PodGC) Size() (n int) {
 	return n
 }
 
-func (m *RawArtifact) Size() (n int) {
+func (m *ArtifactLocation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	if m.ArchiveLogs != nil {
+		n += 2
+	}
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifactory != nil {
+		l = m.Artifactory.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HDFS != nil {
+		l = m.HDFS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Raw != nil {
+		l = m.Raw.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
 
-func (m *ResourceTemplate) Size() (n int) {
+func (m *ArtifactRepositoryRef) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Action)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MergeStrategy)
+	l = len(m.ConfigMap)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Manifest)
+	l = len(m.Key)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	l = len(m.SuccessCondition)
+	return n
+}
+
+func (m *ArtifactoryArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FailureCondition)
+	l = m.ArtifactoryAuth.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RetryStrategy) Size() (n int) {
+func (m *ArtifactoryAuth) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Limit != nil {
-		n += 1 + sovGenerated(uint64(*m.Limit))
+	if m.UsernameSecret != nil {
+		l = m.UsernameSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.RetryPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Backoff != nil {
-		l = m.Backoff.Size()
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *S3Artifact) Size() (n int) {
+func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.S3Bucket.Size()
+	l = len(m.Duration)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Key)
+	n += 1 + sovGenerated(uint64(m.Factor))
+	l = len(m.MaxDuration)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *S3Bucket) Size() (n int) {
+func (m *Cache) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Endpoint)
+	if m.ConfigMap != nil {
+		l = m.ConfigMap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ContainerDiagnostics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Bucket)
+	if m.ExitCode != nil {
+		n += 1 + sovGenerated(uint64(*m.ExitCode))
+	}
+	l = len(m.Reason)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Region)
+	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Insecure != nil {
-		n += 2
+	return n
+}
+
+func (m *ContainerEnvironment) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = m.AccessKeySecret.Size()
+	var l int
+	_ = l
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SecretKeySecret.Size()
+	l = len(m.Image)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.RoleARN)
+	l = len(m.ImageID)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ScriptTemplate) Size() (n int) {
+func (m *ContinueOn) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Container.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Source)
-	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
 	return n
 }
 
-func (m *Sequence) Size() (n int) {
+func (m *Counter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Count)
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Start)
+	return n
+}
+
+func (m *CronWorkflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.End)
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Format)
+	l = m.Status.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *SuspendTemplate) Size() (n int) {
+func (m *CronWorkflowList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Duration)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *TTLStrategy) Size() (n int) {
+func (m *CronWorkflowSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SecondsAfterCompletion != nil {
-		n += 1 + sovGenerated(uint64(*m.SecondsAfterCompletion))
+	l = m.WorkflowSpec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConcurrencyPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.StartingDeadlineSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.StartingDeadlineSeconds))
 	}
-	if m.SecondsAfterSuccess != nil {
-		n += 1 + sovGenerated(uint64(*m.SecondsAfterSuccess))
+	if m.SuccessfulJobsHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.SuccessfulJobsHistoryLimit))
 	}
-	if m.SecondsAfterFailure != nil {
-		n += 1 + sovGenerated(uint64(*m.SecondsAfterFailure))
+	if m.FailedJobsHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.FailedJobsHistoryLimit))
 	}
+	l = len(m.Timezone)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *TarStrategy) Size() (n int) {
+func (m *CronWorkflowStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Active) > 0 {
+		for _, e := range m.Active {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LastScheduledTime != nil {
+		l = m.LastScheduledTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *Template) Size() (n int) {
+func (m *DAGTask) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6402,1409 +8364,6774 @@ func (m *Template) Size() (n int) {
 		l = m.TemplateRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.Inputs.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Outputs.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.NodeSelector) > 0 {
-		for k, v := range m.NodeSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Dependencies) > 0 {
+		for _, s := range m.Dependencies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Affinity != nil {
-		l = m.Affinity.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Daemon != nil {
-		n += 2
-	}
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
+	if len(m.WithItems) > 0 {
+		for _, e := range m.WithItems {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Container != nil {
-		l = m.Container.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Script != nil {
-		l = m.Script.Size()
+	l = len(m.WithParam)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.WithSequence != nil {
+		l = m.WithSequence.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Resource != nil {
-		l = m.Resource.Size()
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ContinueOn != nil {
+		l = m.ContinueOn.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.DAG != nil {
-		l = m.DAG.Size()
+	l = len(m.OnExit)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FailureThreshold != nil {
+		l = m.FailureThreshold.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Suspend != nil {
-		l = m.Suspend.Size()
-		n += 2 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *DAGTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Volumes) > 0 {
-		for _, e := range m.Volumes {
+	var l int
+	_ = l
+	l = len(m.Target)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
 			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.InitContainers) > 0 {
-		for _, e := range m.InitContainers {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
-		}
+	if m.FailFast != nil {
+		n += 2
 	}
-	if len(m.Sidecars) > 0 {
-		for _, e := range m.Sidecars {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
-		}
+	return n
+}
+
+func (m *ExecutionWindow) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.ArchiveLocation != nil {
-		l = m.ArchiveLocation.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.ActiveDeadlineSeconds != nil {
-		n += 2 + sovGenerated(uint64(*m.ActiveDeadlineSeconds))
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timezone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ExecutorConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.RetryStrategy != nil {
-		l = m.RetryStrategy.Size()
-		n += 2 + l + sovGenerated(uint64(l))
+	var l int
+	_ = l
+	l = len(m.ServiceAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FailureThreshold) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Parallelism != nil {
-		n += 2 + sovGenerated(uint64(*m.Parallelism))
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Percent))
+	n += 1 + sovGenerated(uint64(m.MinCompleted))
+	return n
+}
+
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Tolerations) > 0 {
-		for _, e := range m.Tolerations {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
-		}
+	var l int
+	_ = l
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GitArtifact) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.SchedulerName)
-	n += 2 + l + sovGenerated(uint64(l))
-	l = len(m.PriorityClassName)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.Priority != nil {
-		n += 2 + sovGenerated(uint64(*m.Priority))
+	var l int
+	_ = l
+	l = len(m.Repo)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Depth != nil {
+		n += 1 + sovGenerated(uint64(*m.Depth))
 	}
-	l = len(m.ServiceAccountName)
-	n += 2 + l + sovGenerated(uint64(l))
-	if len(m.HostAliases) > 0 {
-		for _, e := range m.HostAliases {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
+	if len(m.Fetch) > 0 {
+		for _, s := range m.Fetch {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
-		n += 2 + l + sovGenerated(uint64(l))
+	if m.UsernameSecret != nil {
+		l = m.UsernameSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.PodSpecPatch)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.AutomountServiceAccountToken != nil {
-		n += 3
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Executor != nil {
-		l = m.Executor.Size()
-		n += 2 + l + sovGenerated(uint64(l))
+	if m.SSHPrivateKeySecret != nil {
+		l = m.SSHPrivateKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
+	n += 2
 	return n
 }
 
-func (m *TemplateRef) Size() (n int) {
+func (m *HDFSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = m.HDFSConfig.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Template)
+	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
 	return n
 }
 
-func (m *UserContainer) Size() (n int) {
+func (m *HDFSConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Container.Size()
+	l = m.HDFSKrbConfig.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.MirrorVolumeMounts != nil {
-		n += 2
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
+	l = len(m.HDFSUser)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ValueFrom) Size() (n int) {
+func (m *HDFSKrbConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.JSONPath)
+	if m.KrbCCacheSecret != nil {
+		l = m.KrbCCacheSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KrbKeytabSecret != nil {
+		l = m.KrbKeytabSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.KrbUsername)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.JQFilter)
+	l = len(m.KrbRealm)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Parameter)
+	if m.KrbConfigConfigMap != nil {
+		l = m.KrbConfigConfigMap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.KrbServicePrincipalName)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Workflow) Size() (n int) {
+func (m *HTTP) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.Method)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	l = len(m.SuccessCondition)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowList) Size() (n int) {
+func (m *HTTPArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *WorkflowSpec) Size() (n int) {
+func (m *HTTPHeader) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Templates) > 0 {
-		for _, e := range m.Templates {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.Entrypoint)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Arguments.Size()
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ServiceAccountName)
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HTTPHeaderSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecretKeyRef != nil {
+		l = m.SecretKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *Histogram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Volumes) > 0 {
-		for _, e := range m.Volumes {
+	if len(m.Buckets) > 0 {
+		n += 9 * len(m.Buckets)
+	}
+	return n
+}
+
+func (m *Inputs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.VolumeClaimTemplates) > 0 {
-		for _, e := range m.VolumeClaimTemplates {
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Parallelism != nil {
-		n += 1 + sovGenerated(uint64(*m.Parallelism))
-	}
-	if m.ArtifactRepositoryRef != nil {
-		l = m.ArtifactRepositoryRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Suspend != nil {
-		n += 2
+	return n
+}
+
+func (m *Item) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.NodeSelector) > 0 {
-		for k, v := range m.NodeSelector {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Type))
+	l = len(m.NumVal)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.StrVal)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.MapVal) > 0 {
+		for k, v := range m.MapVal {
 			_ = k
 			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if m.Affinity != nil {
-		l = m.Affinity.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Tolerations) > 0 {
-		for _, e := range m.Tolerations {
+	if len(m.ListVal) > 0 {
+		for _, e := range m.ListVal {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.ImagePullSecrets) > 0 {
-		for _, e := range m.ImagePullSecrets {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.HostNetwork != nil {
-		n += 2
-	}
-	if m.DNSPolicy != nil {
-		l = len(*m.DNSPolicy)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.DNSConfig != nil {
-		l = m.DNSConfig.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.OnExit)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.TTLSecondsAfterFinished != nil {
-		n += 2 + sovGenerated(uint64(*m.TTLSecondsAfterFinished))
-	}
-	if m.ActiveDeadlineSeconds != nil {
-		n += 2 + sovGenerated(uint64(*m.ActiveDeadlineSeconds))
-	}
-	if m.Priority != nil {
-		n += 2 + sovGenerated(uint64(*m.Priority))
-	}
-	l = len(m.SchedulerName)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.PodGC != nil {
-		l = m.PodGC.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.PodPriorityClassName)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.PodPriority != nil {
-		n += 2 + sovGenerated(uint64(*m.PodPriority))
+	return n
+}
+
+func (m *ItemValue) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.HostAliases) > 0 {
-		for _, e := range m.HostAliases {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Type))
+	l = len(m.NumVal)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.StrVal)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.MapVal) > 0 {
+		for k, v := range m.MapVal {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.PodSpecPatch)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.AutomountServiceAccountToken != nil {
-		n += 3
-	}
-	if m.Executor != nil {
-		l = m.Executor.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.TTLStrategy != nil {
-		l = m.TTLStrategy.Size()
-		n += 2 + l + sovGenerated(uint64(l))
+	if len(m.ListVal) > 0 {
+		for _, b := range m.ListVal {
+			l = len(b)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *WorkflowStatus) Size() (n int) {
+func (m *LockHolding) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
+	l = len(m.Lock)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.StartedAt.Size()
+	l = len(m.Holder)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.FinishedAt.Size()
+	return n
+}
+
+func (m *MemoizationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	l = len(m.Key)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.CacheName)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CompressedNodes)
+	return n
+}
+
+func (m *Memoize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Nodes) > 0 {
-		for k, v := range m.Nodes {
+	if m.Cache != nil {
+		l = m.Cache.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.MaxAge)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
 			_ = k
 			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if len(m.PersistentVolumeClaims) > 0 {
-		for _, e := range m.PersistentVolumeClaims {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.Outputs != nil {
-		l = m.Outputs.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.StoredTemplates) > 0 {
-		for k, v := range m.StoredTemplates {
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
 			_ = k
 			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	l = len(m.OffloadNodeStatusVersion)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowStep) Size() (n int) {
+func (m *MetricLabel) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Template)
+	l = len(m.Key)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Arguments.Size()
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.TemplateRef != nil {
-		l = m.TemplateRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Metrics) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.WithItems) > 0 {
-		for _, e := range m.WithItems {
+	var l int
+	_ = l
+	if len(m.Prometheus) > 0 {
+		for _, e := range m.Prometheus {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.WithParam)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.WithSequence != nil {
-		l = m.WithSequence.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.When)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.ContinueOn != nil {
-		l = m.ContinueOn.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.OnExit)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowTemplate) Size() (n int) {
+func (m *Mutex) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowTemplateList) Size() (n int) {
+func (m *NodeDiagnostics) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Logs)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LogsArtifact)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowTemplateSpec) Size() (n int) {
+func (m *NodeEnvironment) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Templates) > 0 {
-		for _, e := range m.Templates {
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = m.Arguments.Size()
+	l = len(m.ControllerVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ExecutorVersion)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ArchiveStrategy) String() string {
-	if this == nil {
-		return "nil"
+func (m *NodeStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArchiveStrategy{`,
-		`Tar:` + strings.Replace(this.Tar.String(), "TarStrategy", "TarStrategy", 1) + `,`,
-		`None:` + strings.Replace(this.None.String(), "NoneStrategy", "NoneStrategy", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Arguments) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TemplateName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters := "[]Parameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BoundaryID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PodIP)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Daemoned != nil {
+		n += 2
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForArtifacts := "[]Artifact{"
-	for _, f := range this.Artifacts {
-		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	if m.Inputs != nil {
+		l = m.Inputs.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForArtifacts += "}"
-	s := strings.Join([]string{`&Arguments{`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Artifacts:` + repeatedStringForArtifacts + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Artifact) String() string {
-	if this == nil {
-		return "nil"
+	if m.Outputs != nil {
+		l = m.Outputs.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Artifact{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Mode:` + valueToStringGenerated(this.Mode) + `,`,
-		`From:` + fmt.Sprintf("%v", this.From) + `,`,
-		`ArtifactLocation:` + strings.Replace(strings.Replace(this.ArtifactLocation.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
-		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
-		`Archive:` + strings.Replace(this.Archive.String(), "ArchiveStrategy", "ArchiveStrategy", 1) + `,`,
-		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArtifactLocation) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Children) > 0 {
+		for _, s := range m.Children {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ArtifactLocation{`,
-		`ArchiveLogs:` + valueToStringGenerated(this.ArchiveLogs) + `,`,
-		`S3:` + strings.Replace(this.S3.String(), "S3Artifact", "S3Artifact", 1) + `,`,
-		`Git:` + strings.Replace(this.Git.String(), "GitArtifact", "GitArtifact", 1) + `,`,
-		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPArtifact", "HTTPArtifact", 1) + `,`,
-		`Artifactory:` + strings.Replace(this.Artifactory.String(), "ArtifactoryArtifact", "ArtifactoryArtifact", 1) + `,`,
-		`HDFS:` + strings.Replace(this.HDFS.String(), "HDFSArtifact", "HDFSArtifact", 1) + `,`,
-		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArtifactRepositoryRef) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.OutboundNodes) > 0 {
+		for _, s := range m.OutboundNodes {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ArtifactRepositoryRef{`,
-		`ConfigMap:` + fmt.Sprintf("%v", this.ConfigMap) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArtifactoryArtifact) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.StoredTemplateID)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.WorkflowTemplateName)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.TemplateScope)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.MemoizationStatus != nil {
+		l = m.MemoizationStatus.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ArtifactoryArtifact{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`ArtifactoryAuth:` + strings.Replace(strings.Replace(this.ArtifactoryAuth.String(), "ArtifactoryAuth", "ArtifactoryAuth", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArtifactoryAuth) String() string {
-	if this == nil {
-		return "nil"
+	if m.SynchronizationStatus != nil {
+		l = m.SynchronizationStatus.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ArtifactoryAuth{`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Backoff) String() string {
-	if this == nil {
-		return "nil"
+	if m.Diagnostics != nil {
+		l = m.Diagnostics.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Backoff{`,
-		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
-		`Factor:` + fmt.Sprintf("%v", this.Factor) + `,`,
-		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ContinueOn) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ResourcesDuration) > 0 {
+		for k, v := range m.ResourcesDuration {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&ContinueOn{`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CronWorkflow) String() string {
-	if this == nil {
-		return "nil"
+	if m.Environment != nil {
+		l = m.Environment.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&CronWorkflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CronWorkflowSpec", "CronWorkflowSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "CronWorkflowStatus", "CronWorkflowStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 2 + sovGenerated(uint64(m.EstimatedDuration))
+	l = len(m.Progress)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.Cluster)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.WaitingFor)
+	n += 2 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *CronWorkflowList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]CronWorkflow{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "CronWorkflow", "CronWorkflow", 1), `&`, ``, 1) + ","
+
+func (m *NodeSynchronizationStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&CronWorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Waiting)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *CronWorkflowSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NoneStrategy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CronWorkflowSpec{`,
-		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
-		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
-		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
-		`Suspend:` + fmt.Sprintf("%v", this.Suspend) + `,`,
-		`StartingDeadlineSeconds:` + valueToStringGenerated(this.StartingDeadlineSeconds) + `,`,
-		`SuccessfulJobsHistoryLimit:` + valueToStringGenerated(this.SuccessfulJobsHistoryLimit) + `,`,
-		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
-		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *CronWorkflowStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Outputs) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForActive := "[]ObjectReference{"
-	for _, f := range this.Active {
-		repeatedStringForActive += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForActive += "}"
-	s := strings.Join([]string{`&CronWorkflowStatus{`,
-		`Active:` + repeatedStringForActive + `,`,
-		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DAGTask) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForWithItems := "[]Item{"
-	for _, f := range this.WithItems {
-		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
+	if m.Result != nil {
+		l = len(*m.Result)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForWithItems += "}"
-	s := strings.Join([]string{`&DAGTask{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
-		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
-		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
-		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
-		`WithItems:` + repeatedStringForWithItems + `,`,
-		`WithParam:` + fmt.Sprintf("%v", this.WithParam) + `,`,
-		`WithSequence:` + strings.Replace(this.WithSequence.String(), "Sequence", "Sequence", 1) + `,`,
-		`When:` + fmt.Sprintf("%v", this.When) + `,`,
-		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
-		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *DAGTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ParallelSteps) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTasks := "[]DAGTask{"
-	for _, f := range this.Tasks {
-		repeatedStringForTasks += strings.Replace(strings.Replace(f.String(), "DAGTask", "DAGTask", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForTasks += "}"
-	s := strings.Join([]string{`&DAGTemplate{`,
-		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
-		`Tasks:` + repeatedStringForTasks + `,`,
-		`FailFast:` + valueToStringGenerated(this.FailFast) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ExecutorConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Parameter) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ExecutorConfig{`,
-		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GitArtifact) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Default != nil {
+		l = len(*m.Default)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&GitArtifact{`,
-		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
-		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
-		`Depth:` + valueToStringGenerated(this.Depth) + `,`,
-		`Fetch:` + fmt.Sprintf("%v", this.Fetch) + `,`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HDFSArtifact) String() string {
-	if this == nil {
-		return "nil"
+	if m.Value != nil {
+		l = len(*m.Value)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&HDFSArtifact{`,
-		`HDFSConfig:` + strings.Replace(strings.Replace(this.HDFSConfig.String(), "HDFSConfig", "HDFSConfig", 1), `&`, ``, 1) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HDFSConfig) String() string {
-	if this == nil {
-		return "nil"
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&HDFSConfig{`,
-		`HDFSKrbConfig:` + strings.Replace(strings.Replace(this.HDFSKrbConfig.String(), "HDFSKrbConfig", "HDFSKrbConfig", 1), `&`, ``, 1) + `,`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
-		`HDFSUser:` + fmt.Sprintf("%v", this.HDFSUser) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.GlobalName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *HDFSKrbConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodGC) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&HDFSKrbConfig{`,
-		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KrbUsername:` + fmt.Sprintf("%v", this.KrbUsername) + `,`,
-		`KrbRealm:` + fmt.Sprintf("%v", this.KrbRealm) + `,`,
-		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
-		`KrbServicePrincipalName:` + fmt.Sprintf("%v", this.KrbServicePrincipalName) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *HTTPArtifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Prometheus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&HTTPArtifact{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Inputs) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Help)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForParameters := "[]Parameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForArtifacts := "[]Artifact{"
-	for _, f := range this.Artifacts {
-		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	if m.Counter != nil {
+		l = m.Counter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForArtifacts += "}"
-	s := strings.Join([]string{`&Inputs{`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Artifacts:` + repeatedStringForArtifacts + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Histogram != nil {
+		l = m.Histogram.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *Metadata) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RawArtifact) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	var l int
+	_ = l
+	l = len(m.Data)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ResourceTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	var l int
+	_ = l
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MergeStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Manifest)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.SuccessCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FailureCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Finalizers) > 0 {
+		for _, s := range m.Finalizers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForAnnotations += "}"
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	return n
+}
+
+func (m *RetryStrategy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	var l int
+	_ = l
+	if m.Limit != nil {
+		n += 1 + sovGenerated(uint64(*m.Limit))
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&Metadata{`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NodeStatus) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.RetryPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&NodeStatus{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
-		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`BoundaryID:` + fmt.Sprintf("%v", this.BoundaryID) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`PodIP:` + fmt.Sprintf("%v", this.PodIP) + `,`,
-		`Daemoned:` + valueToStringGenerated(this.Daemoned) + `,`,
-		`Inputs:` + strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1) + `,`,
-		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
-		`Children:` + fmt.Sprintf("%v", this.Children) + `,`,
-		`OutboundNodes:` + fmt.Sprintf("%v", this.OutboundNodes) + `,`,
-		`StoredTemplateID:` + fmt.Sprintf("%v", this.StoredTemplateID) + `,`,
-		`WorkflowTemplateName:` + fmt.Sprintf("%v", this.WorkflowTemplateName) + `,`,
-		`TemplateScope:` + fmt.Sprintf("%v", this.TemplateScope) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *NoneStrategy) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *S3Artifact) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&NoneStrategy{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.S3Bucket.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Outputs) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *S3Bucket) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForParameters := "[]Parameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Insecure != nil {
+		n += 2
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForArtifacts := "[]Artifact{"
-	for _, f := range this.Artifacts {
-		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	l = m.AccessKeySecret.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SecretKeySecret.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RoleARN)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ScriptTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForArtifacts += "}"
-	s := strings.Join([]string{`&Outputs{`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Artifacts:` + repeatedStringForArtifacts + `,`,
-		`Result:` + valueToStringGenerated(this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.Container.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Source)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ParallelSteps) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SemaphoreRef) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForSteps := "[]WorkflowStep{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "WorkflowStep", "WorkflowStep", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForSteps += "}"
-	s := strings.Join([]string{`&ParallelSteps{`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Parameter) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Sequence) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Parameter{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Default:` + valueToStringGenerated(this.Default) + `,`,
-		`Value:` + valueToStringGenerated(this.Value) + `,`,
-		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ValueFrom", "ValueFrom", 1) + `,`,
-		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Count)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Start)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.End)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *PodGC) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Stream) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PodGC{`,
-		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Port))
+	return n
 }
-func (this *RawArtifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SuspendTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RawArtifact{`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ResourceTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Synchronization) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ResourceTemplate{`,
-		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
-		`MergeStrategy:` + fmt.Sprintf("%v", this.MergeStrategy) + `,`,
-		`Manifest:` + fmt.Sprintf("%v", this.Manifest) + `,`,
-		`SetOwnerReference:` + fmt.Sprintf("%v", this.SetOwnerReference) + `,`,
-		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
-		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RetryStrategy) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Semaphore != nil {
+		l = m.Semaphore.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&RetryStrategy{`,
-		`Limit:` + valueToStringGenerated(this.Limit) + `,`,
-		`RetryPolicy:` + fmt.Sprintf("%v", this.RetryPolicy) + `,`,
-		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *S3Artifact) String() string {
-	if this == nil {
-		return "nil"
+	if m.Mutex != nil {
+		l = m.Mutex.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&S3Artifact{`,
-		`S3Bucket:` + strings.Replace(strings.Replace(this.S3Bucket.String(), "S3Bucket", "S3Bucket", 1), `&`, ``, 1) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *S3Bucket) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SynchronizationStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&S3Bucket{`,
-		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
-		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
-		`Insecure:` + valueToStringGenerated(this.Insecure) + `,`,
-		`AccessKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
-		`SecretKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
-		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScriptTemplate) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.Holding) > 0 {
+		for _, e := range m.Holding {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ScriptTemplate{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Sequence) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TTLStrategy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Sequence{`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
-		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
-		`End:` + fmt.Sprintf("%v", this.End) + `,`,
-		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SuspendTemplate) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.SecondsAfterCompletion != nil {
+		n += 1 + sovGenerated(uint64(*m.SecondsAfterCompletion))
 	}
-	s := strings.Join([]string{`&SuspendTemplate{`,
-		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TTLStrategy) String() string {
-	if this == nil {
-		return "nil"
+	if m.SecondsAfterSuccess != nil {
+		n += 1 + sovGenerated(uint64(*m.SecondsAfterSuccess))
 	}
-	s := strings.Join([]string{`&TTLStrategy{`,
-		`SecondsAfterCompletion:` + valueToStringGenerated(this.SecondsAfterCompletion) + `,`,
-		`SecondsAfterSuccess:` + valueToStringGenerated(this.SecondsAfterSuccess) + `,`,
-		`SecondsAfterFailure:` + valueToStringGenerated(this.SecondsAfterFailure) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TarStrategy) String() string {
-	if this == nil {
-		return "nil"
+	if m.SecondsAfterFailure != nil {
+		n += 1 + sovGenerated(uint64(*m.SecondsAfterFailure))
 	}
-	s := strings.Join([]string{`&TarStrategy{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Template) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForSteps := "[]ParallelSteps{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "ParallelSteps", "ParallelSteps", 1), `&`, ``, 1) + ","
+
+func (m *TarStrategy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForSteps += "}"
-	repeatedStringForVolumes := "[]Volume{"
-	for _, f := range this.Volumes {
-		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Template) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVolumes += "}"
-	repeatedStringForInitContainers := "[]UserContainer{"
-	for _, f := range this.InitContainers {
-		repeatedStringForInitContainers += strings.Replace(strings.Replace(f.String(), "UserContainer", "UserContainer", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForInitContainers += "}"
-	repeatedStringForSidecars := "[]UserContainer{"
-	for _, f := range this.Sidecars {
-		repeatedStringForSidecars += strings.Replace(strings.Replace(f.String(), "UserContainer", "UserContainer", 1), `&`, ``, 1) + ","
+	l = m.Inputs.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Outputs.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForSidecars += "}"
-	repeatedStringForTolerations := "[]Toleration{"
-	for _, f := range this.Tolerations {
-		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForTolerations += "}"
-	repeatedStringForHostAliases := "[]HostAlias{"
-	for _, f := range this.HostAliases {
-		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
+	l = m.Metadata.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Daemon != nil {
+		n += 2
 	}
-	repeatedStringForHostAliases += "}"
-	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
-	for k := range this.NodeSelector {
-		keysForNodeSelector = append(keysForNodeSelector, k)
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-	mapStringForNodeSelector := "map[string]string{"
-	for _, k := range keysForNodeSelector {
-		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForNodeSelector += "}"
-	s := strings.Join([]string{`&Template{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
-		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
-		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
-		`Inputs:` + strings.Replace(strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1), `&`, ``, 1) + `,`,
-		`Outputs:` + strings.Replace(strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1), `&`, ``, 1) + `,`,
-		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
-		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1), `&`, ``, 1) + `,`,
-		`Daemon:` + valueToStringGenerated(this.Daemon) + `,`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1) + `,`,
-		`Script:` + strings.Replace(this.Script.String(), "ScriptTemplate", "ScriptTemplate", 1) + `,`,
-		`Resource:` + strings.Replace(this.Resource.String(), "ResourceTemplate", "ResourceTemplate", 1) + `,`,
-		`DAG:` + strings.Replace(this.DAG.String(), "DAGTemplate", "DAGTemplate", 1) + `,`,
-		`Suspend:` + strings.Replace(this.Suspend.String(), "SuspendTemplate", "SuspendTemplate", 1) + `,`,
-		`Volumes:` + repeatedStringForVolumes + `,`,
-		`InitContainers:` + repeatedStringForInitContainers + `,`,
-		`Sidecars:` + repeatedStringForSidecars + `,`,
-		`ArchiveLocation:` + strings.Replace(this.ArchiveLocation.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
-		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
-		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
-		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
-		`Tolerations:` + repeatedStringForTolerations + `,`,
-		`SchedulerName:` + fmt.Sprintf("%v", this.SchedulerName) + `,`,
-		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
-		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
-		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
-		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
-		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
-		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
-		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TemplateRef) String() string {
-	if this == nil {
-		return "nil"
+	if m.Script != nil {
+		l = m.Script.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&TemplateRef{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
-		`RuntimeResolution:` + fmt.Sprintf("%v", this.RuntimeResolution) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UserContainer) String() string {
-	if this == nil {
-		return "nil"
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&UserContainer{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
-		`MirrorVolumeMounts:` + valueToStringGenerated(this.MirrorVolumeMounts) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ValueFrom) String() string {
-	if this == nil {
-		return "nil"
+	if m.DAG != nil {
+		l = m.DAG.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ValueFrom{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
-		`JQFilter:` + fmt.Sprintf("%v", this.JQFilter) + `,`,
-		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Workflow) String() string {
-	if this == nil {
-		return "nil"
+	if m.Suspend != nil {
+		l = m.Suspend.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Workflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowStatus", "WorkflowStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowList) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems := "[]Workflow{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Workflow", "Workflow", 1), `&`, ``, 1) + ","
+	if len(m.InitContainers) > 0 {
+		for _, e := range m.InitContainers {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&WorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowSpec) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Sidecars) > 0 {
+		for _, e := range m.Sidecars {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForTemplates := "[]Template{"
-	for _, f := range this.Templates {
-		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "Template", "Template", 1), `&`, ``, 1) + ","
+	if m.ArchiveLocation != nil {
+		l = m.ArchiveLocation.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForTemplates += "}"
-	repeatedStringForVolumes := "[]Volume{"
-	for _, f := range this.Volumes {
-		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	if m.ActiveDeadlineSeconds != nil {
+		n += 2 + sovGenerated(uint64(*m.ActiveDeadlineSeconds))
 	}
-	repeatedStringForVolumes += "}"
-	repeatedStringForVolumeClaimTemplates := "[]PersistentVolumeClaim{"
-	for _, f := range this.VolumeClaimTemplates {
-		repeatedStringForVolumeClaimTemplates += fmt.Sprintf("%v", f) + ","
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForVolumeClaimTemplates += "}"
-	repeatedStringForTolerations := "[]Toleration{"
-	for _, f := range this.Tolerations {
-		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	if m.Parallelism != nil {
+		n += 2 + sovGenerated(uint64(*m.Parallelism))
 	}
-	repeatedStringForTolerations += "}"
-	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
-	for _, f := range this.ImagePullSecrets {
-		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForImagePullSecrets += "}"
-	repeatedStringForHostAliases := "[]HostAlias{"
-	for _, f := range this.HostAliases {
-		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
+	l = len(m.SchedulerName)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.PriorityClassName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.Priority != nil {
+		n += 2 + sovGenerated(uint64(*m.Priority))
 	}
-	repeatedStringForHostAliases += "}"
-	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
-	for k := range this.NodeSelector {
-		keysForNodeSelector = append(keysForNodeSelector, k)
+	l = len(m.ServiceAccountName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.HostAliases) > 0 {
+		for _, e := range m.HostAliases {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-	mapStringForNodeSelector := "map[string]string{"
-	for _, k := range keysForNodeSelector {
-		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	mapStringForNodeSelector += "}"
-	s := strings.Join([]string{`&WorkflowSpec{`,
-		`Templates:` + repeatedStringForTemplates + `,`,
-		`Entrypoint:` + fmt.Sprintf("%v", this.Entrypoint) + `,`,
-		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
-		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
-		`Volumes:` + repeatedStringForVolumes + `,`,
-		`VolumeClaimTemplates:` + repeatedStringForVolumeClaimTemplates + `,`,
-		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
-		`ArtifactRepositoryRef:` + strings.Replace(this.ArtifactRepositoryRef.String(), "ArtifactRepositoryRef", "ArtifactRepositoryRef", 1) + `,`,
-		`Suspend:` + valueToStringGenerated(this.Suspend) + `,`,
-		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
-		`Tolerations:` + repeatedStringForTolerations + `,`,
-		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
-		`HostNetwork:` + valueToStringGenerated(this.HostNetwork) + `,`,
-		`DNSPolicy:` + valueToStringGenerated(this.DNSPolicy) + `,`,
-		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
-		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
-		`TTLSecondsAfterFinished:` + valueToStringGenerated(this.TTLSecondsAfterFinished) + `,`,
-		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
-		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
-		`SchedulerName:` + fmt.Sprintf("%v", this.SchedulerName) + `,`,
-		`PodGC:` + strings.Replace(this.PodGC.String(), "PodGC", "PodGC", 1) + `,`,
-		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
-		`PodPriority:` + valueToStringGenerated(this.PodPriority) + `,`,
-		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
-		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
-		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
-		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
-		`TTLStrategy:` + strings.Replace(this.TTLStrategy.String(), "TTLStrategy", "TTLStrategy", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowStatus) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.PodSpecPatch)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.AutomountServiceAccountToken != nil {
+		n += 3
 	}
-	repeatedStringForPersistentVolumeClaims := "[]Volume{"
-	for _, f := range this.PersistentVolumeClaims {
-		repeatedStringForPersistentVolumeClaims += fmt.Sprintf("%v", f) + ","
+	if m.Executor != nil {
+		l = m.Executor.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPersistentVolumeClaims += "}"
-	keysForNodes := make([]string, 0, len(this.Nodes))
-	for k := range this.Nodes {
-		keysForNodes = append(keysForNodes, k)
+	if len(m.ExecutionWindows) > 0 {
+		for _, e := range m.ExecutionWindows {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
-	mapStringForNodes := "Nodes{"
-	for _, k := range keysForNodes {
-		mapStringForNodes += fmt.Sprintf("%v: %v,", k, this.Nodes[k])
+	l = len(m.StopSignal)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.TerminationGracePeriodSeconds != nil {
+		n += 2 + sovGenerated(uint64(*m.TerminationGracePeriodSeconds))
 	}
-	mapStringForNodes += "}"
-	keysForStoredTemplates := make([]string, 0, len(this.StoredTemplates))
-	for k := range this.StoredTemplates {
-		keysForStoredTemplates = append(keysForStoredTemplates, k)
+	if m.Metrics != nil {
+		l = m.Metrics.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForStoredTemplates)
-	mapStringForStoredTemplates := "map[string]Template{"
-	for _, k := range keysForStoredTemplates {
-		mapStringForStoredTemplates += fmt.Sprintf("%v: %v,", k, this.StoredTemplates[k])
+	if m.Memoize != nil {
+		l = m.Memoize.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	mapStringForStoredTemplates += "}"
-	s := strings.Join([]string{`&WorkflowStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`CompressedNodes:` + fmt.Sprintf("%v", this.CompressedNodes) + `,`,
-		`Nodes:` + mapStringForNodes + `,`,
-		`PersistentVolumeClaims:` + repeatedStringForPersistentVolumeClaims + `,`,
-		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
-		`StoredTemplates:` + mapStringForStoredTemplates + `,`,
-		`OffloadNodeStatusVersion:` + fmt.Sprintf("%v", this.OffloadNodeStatusVersion) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowStep) String() string {
-	if this == nil {
-		return "nil"
+	if m.Synchronization != nil {
+		l = m.Synchronization.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForWithItems := "[]Item{"
-	for _, f := range this.WithItems {
-		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
+	n += 3
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForWithItems += "}"
-	s := strings.Join([]string{`&WorkflowStep{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
-		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
-		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
-		`WithItems:` + repeatedStringForWithItems + `,`,
-		`WithParam:` + fmt.Sprintf("%v", this.WithParam) + `,`,
-		`WithSequence:` + strings.Replace(this.WithSequence.String(), "Sequence", "Sequence", 1) + `,`,
-		`When:` + fmt.Sprintf("%v", this.When) + `,`,
-		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
-		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Cluster)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *WorkflowTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TemplateRef) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&WorkflowTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTemplateSpec", "WorkflowTemplateSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.ConfigMapKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *WorkflowTemplateList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UserContainer) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]WorkflowTemplate{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowTemplate", "WorkflowTemplate", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.Container.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MirrorVolumeMounts != nil {
+		n += 2
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&WorkflowTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *WorkflowTemplateSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ValueFrom) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTemplates := "[]Template{"
-	for _, f := range this.Templates {
-		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "Template", "Template", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JSONPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JQFilter)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Parameter)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *VolumeClaimGC) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTemplates += "}"
-	s := strings.Join([]string{`&WorkflowTemplateSpec{`,
-		`Templates:` + repeatedStringForTemplates + `,`,
-		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *Workflow) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+
+func (m *WorkflowEnvironment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ControllerVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ExecutorVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Entrypoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServiceAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.VolumeClaimTemplates) > 0 {
+		for _, e := range m.VolumeClaimTemplates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Parallelism != nil {
+		n += 1 + sovGenerated(uint64(*m.Parallelism))
+	}
+	if m.ArtifactRepositoryRef != nil {
+		l = m.ArtifactRepositoryRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Suspend != nil {
+		n += 2
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for _, e := range m.ImagePullSecrets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.HostNetwork != nil {
+		n += 2
+	}
+	if m.DNSPolicy != nil {
+		l = len(*m.DNSPolicy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DNSConfig != nil {
+		l = m.DNSConfig.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.OnExit)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.TTLSecondsAfterFinished != nil {
+		n += 2 + sovGenerated(uint64(*m.TTLSecondsAfterFinished))
+	}
+	if m.ActiveDeadlineSeconds != nil {
+		n += 2 + sovGenerated(uint64(*m.ActiveDeadlineSeconds))
+	}
+	if m.Priority != nil {
+		n += 2 + sovGenerated(uint64(*m.Priority))
+	}
+	l = len(m.SchedulerName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.PodGC != nil {
+		l = m.PodGC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PodPriorityClassName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.PodPriority != nil {
+		n += 2 + sovGenerated(uint64(*m.PodPriority))
+	}
+	if len(m.HostAliases) > 0 {
+		for _, e := range m.HostAliases {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PodSpecPatch)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.AutomountServiceAccountToken != nil {
+		n += 3
+	}
+	if m.Executor != nil {
+		l = m.Executor.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.TTLStrategy != nil {
+		l = m.TTLStrategy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.VolumeClaimGC != nil {
+		l = m.VolumeClaimGC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.EnvFrom) > 0 {
+		for _, e := range m.EnvFrom {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Synchronization != nil {
+		l = m.Synchronization.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
+	return n
+}
+
+func (m *WorkflowStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CompressedNodes)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Nodes) > 0 {
+		for k, v := range m.Nodes {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PersistentVolumeClaims) > 0 {
+		for _, e := range m.PersistentVolumeClaims {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Outputs != nil {
+		l = m.Outputs.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.StoredTemplates) > 0 {
+		for k, v := range m.StoredTemplates {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.OffloadNodeStatusVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Synchronization != nil {
+		l = m.Synchronization.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ResourcesToDelete) > 0 {
+		for _, s := range m.ResourcesToDelete {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ArtifactManifest != nil {
+		l = m.ArtifactManifest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ResourcesDuration) > 0 {
+		for k, v := range m.ResourcesDuration {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.EstimatedDuration))
+	l = len(m.Progress)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.ArtifactGCPhase)
+	n += 2 + l + sovGenerated(uint64(l))
+	n += 2 + sovGenerated(uint64(m.NodeIDMaxLength))
+	if m.Environment != nil {
+		l = m.Environment.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
+	return n
+}
+
+func (m *WorkflowStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.WithItems) > 0 {
+		for _, e := range m.WithItems {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.WithParam)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.WithSequence != nil {
+		l = m.WithSequence.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ContinueOn != nil {
+		l = m.ContinueOn.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.OnExit)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FailureThreshold != nil {
+		l = m.FailureThreshold.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowTemplateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowTemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ArchiveStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArchiveStrategy{`,
+		`Tar:` + strings.Replace(this.Tar.String(), "TarStrategy", "TarStrategy", 1) + `,`,
+		`None:` + strings.Replace(this.None.String(), "NoneStrategy", "NoneStrategy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Arguments) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]Parameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForArtifacts := "[]Artifact{"
+	for _, f := range this.Artifacts {
+		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArtifacts += "}"
+	s := strings.Join([]string{`&Arguments{`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Artifacts:` + repeatedStringForArtifacts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Artifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Artifact{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Mode:` + valueToStringGenerated(this.Mode) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`ArtifactLocation:` + strings.Replace(strings.Replace(this.ArtifactLocation.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
+		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
+		`Archive:` + strings.Replace(this.Archive.String(), "ArchiveStrategy", "ArchiveStrategy", 1) + `,`,
+		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
+		`SizeBytes:` + valueToStringGenerated(this.SizeBytes) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactGC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactGC{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactLocation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactLocation{`,
+		`ArchiveLogs:` + valueToStringGenerated(this.ArchiveLogs) + `,`,
+		`S3:` + strings.Replace(this.S3.String(), "S3Artifact", "S3Artifact", 1) + `,`,
+		`Git:` + strings.Replace(this.Git.String(), "GitArtifact", "GitArtifact", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPArtifact", "HTTPArtifact", 1) + `,`,
+		`Artifactory:` + strings.Replace(this.Artifactory.String(), "ArtifactoryArtifact", "ArtifactoryArtifact", 1) + `,`,
+		`HDFS:` + strings.Replace(this.HDFS.String(), "HDFSArtifact", "HDFSArtifact", 1) + `,`,
+		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactRepositoryRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactRepositoryRef{`,
+		`ConfigMap:` + fmt.Sprintf("%v", this.ConfigMap) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactoryArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactoryArtifact{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`ArtifactoryAuth:` + strings.Replace(strings.Replace(this.ArtifactoryAuth.String(), "ArtifactoryAuth", "ArtifactoryAuth", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactoryAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactoryAuth{`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Backoff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Backoff{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Factor:` + fmt.Sprintf("%v", this.Factor) + `,`,
+		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Cache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Cache{`,
+		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "LocalObjectReference", "v1.LocalObjectReference", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerDiagnostics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerDiagnostics{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ExitCode:` + valueToStringGenerated(this.ExitCode) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerEnvironment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerEnvironment{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`ImageID:` + fmt.Sprintf("%v", this.ImageID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContinueOn) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContinueOn{`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Counter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Counter{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflow{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CronWorkflowSpec", "CronWorkflowSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "CronWorkflowStatus", "CronWorkflowStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]CronWorkflow{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "CronWorkflow", "CronWorkflow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&CronWorkflowList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowSpec{`,
+		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
+		`Suspend:` + fmt.Sprintf("%v", this.Suspend) + `,`,
+		`StartingDeadlineSeconds:` + valueToStringGenerated(this.StartingDeadlineSeconds) + `,`,
+		`SuccessfulJobsHistoryLimit:` + valueToStringGenerated(this.SuccessfulJobsHistoryLimit) + `,`,
+		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActive := "[]ObjectReference{"
+	for _, f := range this.Active {
+		repeatedStringForActive += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForActive += "}"
+	s := strings.Join([]string{`&CronWorkflowStatus{`,
+		`Active:` + repeatedStringForActive + `,`,
+		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DAGTask) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWithItems := "[]Item{"
+	for _, f := range this.WithItems {
+		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForWithItems += "}"
+	s := strings.Join([]string{`&DAGTask{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
+		`WithItems:` + repeatedStringForWithItems + `,`,
+		`WithParam:` + fmt.Sprintf("%v", this.WithParam) + `,`,
+		`WithSequence:` + strings.Replace(this.WithSequence.String(), "Sequence", "Sequence", 1) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
+		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
+		`FailureThreshold:` + strings.Replace(this.FailureThreshold.String(), "FailureThreshold", "FailureThreshold", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DAGTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTasks := "[]DAGTask{"
+	for _, f := range this.Tasks {
+		repeatedStringForTasks += strings.Replace(strings.Replace(f.String(), "DAGTask", "DAGTask", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTasks += "}"
+	s := strings.Join([]string{`&DAGTemplate{`,
+		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
+		`Tasks:` + repeatedStringForTasks + `,`,
+		`FailFast:` + valueToStringGenerated(this.FailFast) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecutionWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecutionWindow{`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecutorConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecutorConfig{`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FailureThreshold) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FailureThreshold{`,
+		`Percent:` + fmt.Sprintf("%v", this.Percent) + `,`,
+		`MinCompleted:` + fmt.Sprintf("%v", this.MinCompleted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Gauge) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Gauge{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitArtifact{`,
+		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Depth:` + valueToStringGenerated(this.Depth) + `,`,
+		`Fetch:` + fmt.Sprintf("%v", this.Fetch) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
+		`DisableSubmodules:` + fmt.Sprintf("%v", this.DisableSubmodules) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HDFSArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HDFSArtifact{`,
+		`HDFSConfig:` + strings.Replace(strings.Replace(this.HDFSConfig.String(), "HDFSConfig", "HDFSConfig", 1), `&`, ``, 1) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HDFSConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HDFSConfig{`,
+		`HDFSKrbConfig:` + strings.Replace(strings.Replace(this.HDFSKrbConfig.String(), "HDFSKrbConfig", "HDFSKrbConfig", 1), `&`, ``, 1) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`HDFSUser:` + fmt.Sprintf("%v", this.HDFSUser) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HDFSKrbConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HDFSKrbConfig{`,
+		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KrbUsername:` + fmt.Sprintf("%v", this.KrbUsername) + `,`,
+		`KrbRealm:` + fmt.Sprintf("%v", this.KrbRealm) + `,`,
+		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`KrbServicePrincipalName:` + fmt.Sprintf("%v", this.KrbServicePrincipalName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]HTTPHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeader", "HTTPHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTP{`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPArtifact{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeader{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "HTTPHeaderSource", "HTTPHeaderSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPHeaderSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeaderSource{`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Histogram) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Histogram{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Buckets:` + fmt.Sprintf("%v", this.Buckets) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Inputs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]Parameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForArtifacts := "[]Artifact{"
+	for _, f := range this.Artifacts {
+		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArtifacts += "}"
+	s := strings.Join([]string{`&Inputs{`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Artifacts:` + repeatedStringForArtifacts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LockHolding) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LockHolding{`,
+		`Lock:` + fmt.Sprintf("%v", this.Lock) + `,`,
+		`Holder:` + fmt.Sprintf("%v", this.Holder) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemoizationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemoizationStatus{`,
+		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Memoize) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Memoize{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Cache:` + strings.Replace(this.Cache.String(), "Cache", "Cache", 1) + `,`,
+		`MaxAge:` + fmt.Sprintf("%v", this.MaxAge) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&Metadata{`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetricLabel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetricLabel{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Metrics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPrometheus := "[]Prometheus{"
+	for _, f := range this.Prometheus {
+		repeatedStringForPrometheus += strings.Replace(strings.Replace(f.String(), "Prometheus", "Prometheus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPrometheus += "}"
+	s := strings.Join([]string{`&Metrics{`,
+		`Prometheus:` + repeatedStringForPrometheus + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Mutex) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Mutex{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeDiagnostics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainers := "[]ContainerDiagnostics{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(strings.Replace(f.String(), "ContainerDiagnostics", "ContainerDiagnostics", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForContainers += "}"
+	s := strings.Join([]string{`&NodeDiagnostics{`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
+		`Logs:` + fmt.Sprintf("%v", this.Logs) + `,`,
+		`LogsArtifact:` + fmt.Sprintf("%v", this.LogsArtifact) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeEnvironment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainers := "[]ContainerEnvironment{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(strings.Replace(f.String(), "ContainerEnvironment", "ContainerEnvironment", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForContainers += "}"
+	s := strings.Join([]string{`&NodeEnvironment{`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`ControllerVersion:` + fmt.Sprintf("%v", this.ControllerVersion) + `,`,
+		`ExecutorVersion:` + fmt.Sprintf("%v", this.ExecutorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForResourcesDuration := make([]string, 0, len(this.ResourcesDuration))
+	for k := range this.ResourcesDuration {
+		keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
+	mapStringForResourcesDuration := "ResourcesDuration{"
+	for _, k := range keysForResourcesDuration {
+		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForResourcesDuration += "}"
+	s := strings.Join([]string{`&NodeStatus{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`BoundaryID:` + fmt.Sprintf("%v", this.BoundaryID) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`PodIP:` + fmt.Sprintf("%v", this.PodIP) + `,`,
+		`Daemoned:` + valueToStringGenerated(this.Daemoned) + `,`,
+		`Inputs:` + strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1) + `,`,
+		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
+		`Children:` + fmt.Sprintf("%v", this.Children) + `,`,
+		`OutboundNodes:` + fmt.Sprintf("%v", this.OutboundNodes) + `,`,
+		`StoredTemplateID:` + fmt.Sprintf("%v", this.StoredTemplateID) + `,`,
+		`WorkflowTemplateName:` + fmt.Sprintf("%v", this.WorkflowTemplateName) + `,`,
+		`TemplateScope:` + fmt.Sprintf("%v", this.TemplateScope) + `,`,
+		`MemoizationStatus:` + strings.Replace(this.MemoizationStatus.String(), "MemoizationStatus", "MemoizationStatus", 1) + `,`,
+		`SynchronizationStatus:` + strings.Replace(this.SynchronizationStatus.String(), "NodeSynchronizationStatus", "NodeSynchronizationStatus", 1) + `,`,
+		`Diagnostics:` + strings.Replace(this.Diagnostics.String(), "NodeDiagnostics", "NodeDiagnostics", 1) + `,`,
+		`ResourcesDuration:` + mapStringForResourcesDuration + `,`,
+		`Environment:` + strings.Replace(this.Environment.String(), "NodeEnvironment", "NodeEnvironment", 1) + `,`,
+		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
+		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`WaitingFor:` + fmt.Sprintf("%v", this.WaitingFor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeSynchronizationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeSynchronizationStatus{`,
+		`Waiting:` + fmt.Sprintf("%v", this.Waiting) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NoneStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NoneStrategy{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Outputs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]Parameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForArtifacts := "[]Artifact{"
+	for _, f := range this.Artifacts {
+		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArtifacts += "}"
+	s := strings.Join([]string{`&Outputs{`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Artifacts:` + repeatedStringForArtifacts + `,`,
+		`Result:` + valueToStringGenerated(this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParallelSteps) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]WorkflowStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "WorkflowStep", "WorkflowStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	s := strings.Join([]string{`&ParallelSteps{`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Parameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Parameter{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Default:` + valueToStringGenerated(this.Default) + `,`,
+		`Value:` + valueToStringGenerated(this.Value) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ValueFrom", "ValueFrom", 1) + `,`,
+		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodGC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodGC{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Prometheus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLabels := "[]MetricLabel{"
+	for _, f := range this.Labels {
+		repeatedStringForLabels += strings.Replace(strings.Replace(f.String(), "MetricLabel", "MetricLabel", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForLabels += "}"
+	s := strings.Join([]string{`&Prometheus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Help:` + fmt.Sprintf("%v", this.Help) + `,`,
+		`Labels:` + repeatedStringForLabels + `,`,
+		`Gauge:` + strings.Replace(this.Gauge.String(), "Gauge", "Gauge", 1) + `,`,
+		`Counter:` + strings.Replace(this.Counter.String(), "Counter", "Counter", 1) + `,`,
+		`Histogram:` + strings.Replace(this.Histogram.String(), "Histogram", "Histogram", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RawArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RawArtifact{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceTemplate{`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`MergeStrategy:` + fmt.Sprintf("%v", this.MergeStrategy) + `,`,
+		`Manifest:` + fmt.Sprintf("%v", this.Manifest) + `,`,
+		`SetOwnerReference:` + fmt.Sprintf("%v", this.SetOwnerReference) + `,`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
+		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryStrategy{`,
+		`Limit:` + valueToStringGenerated(this.Limit) + `,`,
+		`RetryPolicy:` + fmt.Sprintf("%v", this.RetryPolicy) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *S3Artifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&S3Artifact{`,
+		`S3Bucket:` + strings.Replace(strings.Replace(this.S3Bucket.String(), "S3Bucket", "S3Bucket", 1), `&`, ``, 1) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *S3Bucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&S3Bucket{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Insecure:` + valueToStringGenerated(this.Insecure) + `,`,
+		`AccessKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScriptTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScriptTemplate{`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SemaphoreRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SemaphoreRef{`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Sequence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Sequence{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Stream) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Stream{`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuspendTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuspendTemplate{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Synchronization) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Synchronization{`,
+		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreRef", "SemaphoreRef", 1) + `,`,
+		`Mutex:` + strings.Replace(this.Mutex.String(), "Mutex", "Mutex", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SynchronizationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHolding := "[]LockHolding{"
+	for _, f := range this.Holding {
+		repeatedStringForHolding += strings.Replace(strings.Replace(f.String(), "LockHolding", "LockHolding", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHolding += "}"
+	s := strings.Join([]string{`&SynchronizationStatus{`,
+		`Holding:` + repeatedStringForHolding + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TTLStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TTLStrategy{`,
+		`SecondsAfterCompletion:` + valueToStringGenerated(this.SecondsAfterCompletion) + `,`,
+		`SecondsAfterSuccess:` + valueToStringGenerated(this.SecondsAfterSuccess) + `,`,
+		`SecondsAfterFailure:` + valueToStringGenerated(this.SecondsAfterFailure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TarStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TarStrategy{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Template) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]ParallelSteps{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "ParallelSteps", "ParallelSteps", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	repeatedStringForVolumes := "[]Volume{"
+	for _, f := range this.Volumes {
+		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumes += "}"
+	repeatedStringForInitContainers := "[]UserContainer{"
+	for _, f := range this.InitContainers {
+		repeatedStringForInitContainers += strings.Replace(strings.Replace(f.String(), "UserContainer", "UserContainer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForInitContainers += "}"
+	repeatedStringForSidecars := "[]UserContainer{"
+	for _, f := range this.Sidecars {
+		repeatedStringForSidecars += strings.Replace(strings.Replace(f.String(), "UserContainer", "UserContainer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSidecars += "}"
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	repeatedStringForHostAliases := "[]HostAlias{"
+	for _, f := range this.HostAliases {
+		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForHostAliases += "}"
+	repeatedStringForExecutionWindows := "[]ExecutionWindow{"
+	for _, f := range this.ExecutionWindows {
+		repeatedStringForExecutionWindows += strings.Replace(strings.Replace(f.String(), "ExecutionWindow", "ExecutionWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForExecutionWindows += "}"
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	s := strings.Join([]string{`&Template{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`Inputs:` + strings.Replace(strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1), `&`, ``, 1) + `,`,
+		`Outputs:` + strings.Replace(strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1), `&`, ``, 1) + `,`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1), `&`, ``, 1) + `,`,
+		`Daemon:` + valueToStringGenerated(this.Daemon) + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1) + `,`,
+		`Script:` + strings.Replace(this.Script.String(), "ScriptTemplate", "ScriptTemplate", 1) + `,`,
+		`Resource:` + strings.Replace(this.Resource.String(), "ResourceTemplate", "ResourceTemplate", 1) + `,`,
+		`DAG:` + strings.Replace(this.DAG.String(), "DAGTemplate", "DAGTemplate", 1) + `,`,
+		`Suspend:` + strings.Replace(this.Suspend.String(), "SuspendTemplate", "SuspendTemplate", 1) + `,`,
+		`Volumes:` + repeatedStringForVolumes + `,`,
+		`InitContainers:` + repeatedStringForInitContainers + `,`,
+		`Sidecars:` + repeatedStringForSidecars + `,`,
+		`ArchiveLocation:` + strings.Replace(this.ArchiveLocation.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
+		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
+		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`SchedulerName:` + fmt.Sprintf("%v", this.SchedulerName) + `,`,
+		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
+		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`HostAliases:` + repeatedStringForHostAliases + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
+		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
+		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
+		`ExecutionWindows:` + repeatedStringForExecutionWindows + `,`,
+		`StopSignal:` + fmt.Sprintf("%v", this.StopSignal) + `,`,
+		`TerminationGracePeriodSeconds:` + valueToStringGenerated(this.TerminationGracePeriodSeconds) + `,`,
+		`Metrics:` + strings.Replace(this.Metrics.String(), "Metrics", "Metrics", 1) + `,`,
+		`Memoize:` + strings.Replace(this.Memoize.String(), "Memoize", "Memoize", 1) + `,`,
+		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
+		`FailFast:` + fmt.Sprintf("%v", this.FailFast) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`Stream:` + strings.Replace(this.Stream.String(), "Stream", "Stream", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`RuntimeResolution:` + fmt.Sprintf("%v", this.RuntimeResolution) + `,`,
+		`ConfigMapKey:` + fmt.Sprintf("%v", this.ConfigMapKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserContainer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserContainer{`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`MirrorVolumeMounts:` + valueToStringGenerated(this.MirrorVolumeMounts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValueFrom) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValueFrom{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
+		`JQFilter:` + fmt.Sprintf("%v", this.JQFilter) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VolumeClaimGC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VolumeClaimGC{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Workflow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Workflow{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowStatus", "WorkflowStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowEnvironment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowEnvironment{`,
+		`ControllerVersion:` + fmt.Sprintf("%v", this.ControllerVersion) + `,`,
+		`ExecutorVersion:` + fmt.Sprintf("%v", this.ExecutorVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Workflow{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Workflow", "Workflow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WorkflowList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTemplates := "[]Template{"
+	for _, f := range this.Templates {
+		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "Template", "Template", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTemplates += "}"
+	repeatedStringForVolumes := "[]Volume{"
+	for _, f := range this.Volumes {
+		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumes += "}"
+	repeatedStringForVolumeClaimTemplates := "[]PersistentVolumeClaim{"
+	for _, f := range this.VolumeClaimTemplates {
+		repeatedStringForVolumeClaimTemplates += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeClaimTemplates += "}"
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
+	for _, f := range this.ImagePullSecrets {
+		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForImagePullSecrets += "}"
+	repeatedStringForHostAliases := "[]HostAlias{"
+	for _, f := range this.HostAliases {
+		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForHostAliases += "}"
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnv += "}"
+	repeatedStringForEnvFrom := "[]EnvFromSource{"
+	for _, f := range this.EnvFrom {
+		repeatedStringForEnvFrom += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnvFrom += "}"
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	s := strings.Join([]string{`&WorkflowSpec{`,
+		`Templates:` + repeatedStringForTemplates + `,`,
+		`Entrypoint:` + fmt.Sprintf("%v", this.Entrypoint) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`Volumes:` + repeatedStringForVolumes + `,`,
+		`VolumeClaimTemplates:` + repeatedStringForVolumeClaimTemplates + `,`,
+		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
+		`ArtifactRepositoryRef:` + strings.Replace(this.ArtifactRepositoryRef.String(), "ArtifactRepositoryRef", "ArtifactRepositoryRef", 1) + `,`,
+		`Suspend:` + valueToStringGenerated(this.Suspend) + `,`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
+		`HostNetwork:` + valueToStringGenerated(this.HostNetwork) + `,`,
+		`DNSPolicy:` + valueToStringGenerated(this.DNSPolicy) + `,`,
+		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
+		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
+		`TTLSecondsAfterFinished:` + valueToStringGenerated(this.TTLSecondsAfterFinished) + `,`,
+		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
+		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`SchedulerName:` + fmt.Sprintf("%v", this.SchedulerName) + `,`,
+		`PodGC:` + strings.Replace(this.PodGC.String(), "PodGC", "PodGC", 1) + `,`,
+		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
+		`PodPriority:` + valueToStringGenerated(this.PodPriority) + `,`,
+		`HostAliases:` + repeatedStringForHostAliases + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
+		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
+		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
+		`TTLStrategy:` + strings.Replace(this.TTLStrategy.String(), "TTLStrategy", "TTLStrategy", 1) + `,`,
+		`VolumeClaimGC:` + strings.Replace(this.VolumeClaimGC.String(), "VolumeClaimGC", "VolumeClaimGC", 1) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`EnvFrom:` + repeatedStringForEnvFrom + `,`,
+		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
+		`ArtifactManifest:` + fmt.Sprintf("%v", this.ArtifactManifest) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPersistentVolumeClaims := "[]Volume{"
+	for _, f := range this.PersistentVolumeClaims {
+		repeatedStringForPersistentVolumeClaims += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForPersistentVolumeClaims += "}"
+	keysForNodes := make([]string, 0, len(this.Nodes))
+	for k := range this.Nodes {
+		keysForNodes = append(keysForNodes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
+	mapStringForNodes := "Nodes{"
+	for _, k := range keysForNodes {
+		mapStringForNodes += fmt.Sprintf("%v: %v,", k, this.Nodes[k])
+	}
+	mapStringForNodes += "}"
+	keysForStoredTemplates := make([]string, 0, len(this.StoredTemplates))
+	for k := range this.StoredTemplates {
+		keysForStoredTemplates = append(keysForStoredTemplates, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStoredTemplates)
+	mapStringForStoredTemplates := "map[string]Template{"
+	for _, k := range keysForStoredTemplates {
+		mapStringForStoredTemplates += fmt.Sprintf("%v: %v,", k, this.StoredTemplates[k])
+	}
+	mapStringForStoredTemplates += "}"
+	keysForResourcesDuration := make([]string, 0, len(this.ResourcesDuration))
+	for k := range this.ResourcesDuration {
+		keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
+	mapStringForResourcesDuration := "ResourcesDuration{"
+	for _, k := range keysForResourcesDuration {
+		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForResourcesDuration += "}"
+	s := strings.Join([]string{`&WorkflowStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`CompressedNodes:` + fmt.Sprintf("%v", this.CompressedNodes) + `,`,
+		`Nodes:` + mapStringForNodes + `,`,
+		`PersistentVolumeClaims:` + repeatedStringForPersistentVolumeClaims + `,`,
+		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
+		`StoredTemplates:` + mapStringForStoredTemplates + `,`,
+		`OffloadNodeStatusVersion:` + fmt.Sprintf("%v", this.OffloadNodeStatusVersion) + `,`,
+		`Synchronization:` + strings.Replace(this.Synchronization.String(), "SynchronizationStatus", "SynchronizationStatus", 1) + `,`,
+		`ResourcesToDelete:` + fmt.Sprintf("%v", this.ResourcesToDelete) + `,`,
+		`ArtifactManifest:` + strings.Replace(this.ArtifactManifest.String(), "Artifact", "Artifact", 1) + `,`,
+		`ResourcesDuration:` + mapStringForResourcesDuration + `,`,
+		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
+		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`ArtifactGCPhase:` + fmt.Sprintf("%v", this.ArtifactGCPhase) + `,`,
+		`NodeIDMaxLength:` + fmt.Sprintf("%v", this.NodeIDMaxLength) + `,`,
+		`Environment:` + strings.Replace(this.Environment.String(), "WorkflowEnvironment", "WorkflowEnvironment", 1) + `,`,
+		`NodeIDNamespaced:` + fmt.Sprintf("%v", this.NodeIDNamespaced) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWithItems := "[]Item{"
+	for _, f := range this.WithItems {
+		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForWithItems += "}"
+	s := strings.Join([]string{`&WorkflowStep{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`WithItems:` + repeatedStringForWithItems + `,`,
+		`WithParam:` + fmt.Sprintf("%v", this.WithParam) + `,`,
+		`WithSequence:` + strings.Replace(this.WithSequence.String(), "Sequence", "Sequence", 1) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
+		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
+		`FailureThreshold:` + strings.Replace(this.FailureThreshold.String(), "FailureThreshold", "FailureThreshold", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTemplateSpec", "WorkflowTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]WorkflowTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowTemplate", "WorkflowTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WorkflowTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTemplates := "[]Template{"
+	for _, f := range this.Templates {
+		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "Template", "Template", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTemplates += "}"
+	s := strings.Join([]string{`&WorkflowTemplateSpec{`,
+		`Templates:` + repeatedStringForTemplates + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tar == nil {
+				m.Tar = &TarStrategy{}
+			}
+			if err := m.Tar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field None", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.None == nil {
+				m.None = &NoneStrategy{}
+			}
+			if err := m.None.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Arguments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Arguments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Arguments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Artifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mode = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Archive == nil {
+				m.Archive = &ArchiveStrategy{}
+			}
+			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SizeBytes = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactGC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactGC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = ArtifactGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ArchiveLogs = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S3 == nil {
+				m.S3 = &S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitArtifact{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPArtifact{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifactory == nil {
+				m.Artifactory = &ArtifactoryArtifact{}
+			}
+			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HDFS == nil {
+				m.HDFS = &HDFSArtifact{}
+			}
+			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Raw == nil {
+				m.Raw = &RawArtifact{}
+			}
+			if err := m.Raw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactRepositoryRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactRepositoryRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactoryAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactoryAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			m.Factor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Factor |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v1.LocalObjectReference{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerDiagnostics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerDiagnostics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerDiagnostics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExitCode = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerEnvironment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerEnvironment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerEnvironment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinueOn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Error = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, CronWorkflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartingDeadlineSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessfulJobsHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedJobsHistoryLimit = &v
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Active = append(m.Active, v1.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScheduledTime == nil {
+				m.LastScheduledTime = &v11.Time{}
+			}
+			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithParam = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
+			}
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinueOn == nil {
+				m.ContinueOn = &ContinueOn{}
+			}
+			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnExit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailureThreshold == nil {
+				m.FailureThreshold = &FailureThreshold{}
+			}
+			if err := m.FailureThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, DAGTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.FailFast = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailureThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailureThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailureThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCompleted", wireType)
+			}
+			m.MinCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCompleted |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Depth = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArchiveStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArchiveStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7831,16 +15158,16 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tar == nil {
-				m.Tar = &TarStrategy{}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Tar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field None", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7867,13 +15194,53 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.None == nil {
-				m.None = &NoneStrategy{}
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.None.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableSubmodules = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -7898,7 +15265,7 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Arguments) Unmarshal(dAtA []byte) error {
+func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7921,15 +15288,15 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Arguments: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Arguments: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7956,16 +15323,15 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7975,26 +15341,44 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8019,7 +15403,7 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Artifact) Unmarshal(dAtA []byte) error {
+func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8042,15 +15426,48 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8078,11 +15495,11 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8110,13 +15527,66 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int32
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8126,17 +15596,33 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Mode = &v
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8146,29 +15632,33 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8178,28 +15668,27 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8227,11 +15716,11 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8258,18 +15747,18 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Archive == nil {
-				m.Archive = &ArchiveStrategy{}
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8279,12 +15768,24 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Optional = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8309,7 +15810,7 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+func (m *HTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8332,38 +15833,17 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ArchiveLogs = &b
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8373,33 +15853,29 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.S3 == nil {
-				m.S3 = &S3Artifact{}
-			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8409,31 +15885,27 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitArtifact{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8460,18 +15932,16 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPArtifact{}
-			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8481,33 +15951,29 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Artifactory == nil {
-				m.Artifactory = &ArtifactoryArtifact{}
-			}
-			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8517,33 +15983,17 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HDFS == nil {
-				m.HDFS = &HDFSArtifact{}
-			}
-			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.TimeoutSeconds = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8553,27 +16003,23 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Raw == nil {
-				m.Raw = &RawArtifact{}
-			}
-			if err := m.Raw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8599,7 +16045,7 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8616,53 +16062,21 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactRepositoryRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactRepositoryRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				break
 			}
-			m.ConfigMap = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8690,7 +16104,7 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8716,7 +16130,7 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8739,15 +16153,15 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8775,11 +16189,43 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8806,7 +16252,10 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8834,7 +16283,7 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8857,51 +16306,15 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8928,10 +16341,10 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
 			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8959,7 +16372,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *Histogram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8982,15 +16395,15 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9018,59 +16431,62 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
-			}
-			m.Factor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Factor |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Buckets = append(m.Buckets, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Buckets) == 0 {
+					m.Buckets = make([]float64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Buckets = append(m.Buckets, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9095,7 +16511,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinueOn) Unmarshal(dAtA []byte) error {
+func (m *Inputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9118,17 +16534,17 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
+			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9138,17 +16554,31 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Error = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9158,12 +16588,26 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Failed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9188,7 +16632,7 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+func (m *Item) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9211,17 +16655,17 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9231,30 +16675,16 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9264,30 +16694,49 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NumVal = encoding_json.Number(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolVal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BoolVal = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrVal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9297,81 +16746,27 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StrVal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MapVal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9398,13 +16793,109 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.MapVal == nil {
+				m.MapVal = make(map[string]ItemValue)
+			}
+			var mapkey string
+			mapvalue := &ItemValue{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ItemValue{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.MapVal[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListVal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9431,8 +16922,8 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CronWorkflow{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ListVal = append(m.ListVal, ItemValue{})
+			if err := m.ListVal[len(m.ListVal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9460,7 +16951,7 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+func (m *ItemValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9483,50 +16974,17 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ItemValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ItemValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9536,27 +16994,14 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9584,11 +17029,11 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			m.NumVal = encoding_json.Number(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolVal", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9605,12 +17050,12 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Suspend = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+			m.BoolVal = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrVal", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9620,17 +17065,29 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StartingDeadlineSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrVal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapVal", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9640,37 +17097,124 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SuccessfulJobsHistoryLimit = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapVal == nil {
+				m.MapVal = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.FailedJobsHistoryLimit = &v
-		case 8:
+			m.MapVal[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListVal", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9680,23 +17224,23 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			m.ListVal = append(m.ListVal, make([]byte, postIndex-iNdEx))
+			copy(m.ListVal[len(m.ListVal)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9722,7 +17266,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+func (m *LockHolding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9745,17 +17289,17 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockHolding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockHolding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9765,31 +17309,29 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Lock = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9799,27 +17341,23 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
-			}
-			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9845,7 +17383,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9868,17 +17406,17 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9888,27 +17426,15 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Hit = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9936,13 +17462,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9952,64 +17478,80 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Memoize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10037,11 +17579,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10068,14 +17610,16 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Cache == nil {
+				m.Cache = &Cache{}
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10103,11 +17647,64 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10134,18 +17731,109 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10155,29 +17843,177 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10187,31 +18023,27 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinueOn == nil {
-				m.ContinueOn = &ContinueOn{}
-			}
-			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10239,7 +18071,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnExit = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10265,7 +18097,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+func (m *Metrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10288,47 +18120,15 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Target = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10355,32 +18155,11 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, DAGTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prometheus = append(m.Prometheus, Prometheus{})
+			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.FailFast = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10405,7 +18184,7 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
+func (m *Mutex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10428,15 +18207,15 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10464,7 +18243,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10490,7 +18269,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *NodeDiagnostics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10513,17 +18292,17 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeDiagnostics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeDiagnostics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10533,27 +18312,29 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Containers = append(m.Containers, ContainerDiagnostics{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10581,31 +18362,11 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Depth = &v
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10633,13 +18394,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
+			m.Logs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogsArtifact", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10649,31 +18410,80 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LogsArtifact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeEnvironment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeEnvironment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeEnvironment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10700,18 +18510,16 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Containers = append(m.Containers, ContainerEnvironment{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10721,33 +18529,29 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ControllerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorVersion", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10757,12 +18561,24 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10787,7 +18603,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10810,17 +18626,17 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10830,28 +18646,27 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10879,13 +18694,13 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10895,68 +18710,91 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if skippy < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Type = NodeType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10983,13 +18821,16 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11017,11 +18858,11 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11049,64 +18890,43 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			m.BoundaryID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11133,16 +18953,13 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11169,16 +18986,13 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11206,13 +19020,13 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.PodIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11222,27 +19036,16 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := bool(v != 0)
+			m.Daemoned = &b
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11269,18 +19072,18 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			if m.Inputs == nil {
+				m.Inputs = &Inputs{}
 			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11290,80 +19093,31 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11391,66 +19145,13 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Inputs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11460,31 +19161,29 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTemplateID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11494,84 +19193,61 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StoredTemplateID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateName", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Item) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			m.WorkflowTemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11581,16 +19257,29 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11600,29 +19289,33 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NumVal = encoding_json.Number(dAtA[iNdEx:postIndex])
+			if m.MemoizationStatus == nil {
+				m.MemoizationStatus = &MemoizationStatus{}
+			}
+			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoolVal", wireType)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11632,17 +19325,33 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BoolVal = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SynchronizationStatus == nil {
+				m.SynchronizationStatus = &NodeSynchronizationStatus{}
+			}
+			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diagnostics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11652,27 +19361,31 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StrVal = string(dAtA[iNdEx:postIndex])
+			if m.Diagnostics == nil {
+				m.Diagnostics = &NodeDiagnostics{}
+			}
+			if err := m.Diagnostics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MapVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11699,11 +19412,11 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MapVal == nil {
-				m.MapVal = make(map[string]ItemValue)
+			if m.ResourcesDuration == nil {
+				m.ResourcesDuration = make(ResourcesDuration)
 			}
-			var mapkey string
-			mapvalue := &ItemValue{}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			var mapvalue int64
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -11749,10 +19462,9 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -11762,26 +19474,11 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						mapvalue |= int64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ItemValue{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11797,11 +19494,11 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapVal[mapkey] = *mapvalue
+			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
 			iNdEx = postIndex
-		case 6:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11828,69 +19525,18 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ListVal = append(m.ListVal, ItemValue{})
-			if err := m.ListVal[len(m.ListVal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Environment == nil {
+				m.Environment = &NodeEnvironment{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ItemValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ItemValue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ItemValue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 26:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
 			}
-			m.Type = 0
+			m.EstimatedDuration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11900,14 +19546,14 @@ func (m *ItemValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11935,31 +19581,11 @@ func (m *ItemValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NumVal = encoding_json.Number(dAtA[iNdEx:postIndex])
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoolVal", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BoolVal = bool(v != 0)
-		case 4:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11987,13 +19613,13 @@ func (m *ItemValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StrVal = string(dAtA[iNdEx:postIndex])
+			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MapVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingFor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12003,124 +19629,82 @@ func (m *ItemValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MapVal == nil {
-				m.MapVal = make(map[string]string)
+			m.WaitingFor = NodeWaitingReason(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.MapVal[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12130,23 +19714,23 @@ func (m *ItemValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ListVal = append(m.ListVal, make([]byte, postIndex-iNdEx))
-			copy(m.ListVal[len(m.ListVal)-1], dAtA[iNdEx:postIndex])
+			m.Waiting = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12172,7 +19756,7 @@ func (m *ItemValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12195,15 +19779,68 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Outputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12230,107 +19867,134 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Result = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12357,103 +20021,10 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Steps = append(m.Steps, WorkflowStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12479,7 +20050,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+func (m *Parameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12502,15 +20073,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12538,11 +20109,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12570,11 +20141,12 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Default = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12602,11 +20174,48 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12634,11 +20243,64 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = NodeType(dAtA[iNdEx:postIndex])
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodGC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12666,13 +20328,66 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Prometheus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12682,31 +20397,27 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
-			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12734,13 +20445,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.Help = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12750,29 +20461,31 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BoundaryID = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, MetricLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12782,27 +20495,31 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12829,13 +20546,16 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Counter == nil {
+				m.Counter = &Counter{}
+			}
+			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12862,13 +20582,69 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Histogram == nil {
+				m.Histogram = &Histogram{}
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12896,34 +20672,66 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodIP = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := bool(v != 0)
-			m.Daemoned = &b
-		case 14:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12933,33 +20741,29 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inputs == nil {
-				m.Inputs = &Inputs{}
-			}
-			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12969,31 +20773,27 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
-			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13021,13 +20821,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			m.Manifest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13037,27 +20837,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 18:
+			m.SetOwnerReference = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredTemplateID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13085,11 +20873,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoredTemplateID = string(dAtA[iNdEx:postIndex])
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13117,11 +20905,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTemplateName = string(dAtA[iNdEx:postIndex])
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13149,7 +20937,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			m.Finalizers = append(m.Finalizers, ResourceFinalizer(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13175,60 +20963,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Outputs) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13251,17 +20986,17 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13271,31 +21006,17 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Limit = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13305,31 +21026,29 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13339,24 +21058,27 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Result = &s
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13382,7 +21104,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
+func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13405,15 +21127,15 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13440,11 +21162,42 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, WorkflowStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13469,7 +21222,7 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Parameter) Unmarshal(dAtA []byte) error {
+func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13492,15 +21245,15 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13528,11 +21281,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13560,12 +21313,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Default = &s
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13593,12 +21345,32 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Insecure = &b
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13625,16 +21397,46 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13662,7 +21464,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13688,7 +21490,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodGC) Unmarshal(dAtA []byte) error {
+func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13711,15 +21513,48 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13747,7 +21582,7 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13773,7 +21608,7 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawArtifact) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13796,17 +21631,17 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13816,23 +21651,27 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13858,7 +21697,7 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
+func (m *Sequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13881,15 +21720,15 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13917,11 +21756,11 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.Count = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13949,11 +21788,11 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
+			m.Start = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13981,13 +21820,13 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifest = string(dAtA[iNdEx:postIndex])
+			m.End = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13997,17 +21836,82 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SetOwnerReference = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var stringLen uint64
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14017,27 +21921,67 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Port |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuspendTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuspendTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14065,7 +22009,7 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14091,7 +22035,7 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *Synchronization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14114,37 +22058,17 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Synchronization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Synchronization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Limit = &v
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14154,27 +22078,31 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
+			if m.Semaphore == nil {
+				m.Semaphore = &SemaphoreRef{}
+			}
+			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14201,10 +22129,10 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
+			if m.Mutex == nil {
+				m.Mutex = &Mutex{}
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14232,7 +22160,7 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Artifact) Unmarshal(dAtA []byte) error {
+func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14255,15 +22183,15 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: SynchronizationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14290,15 +22218,69 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holding = append(m.Holding, LockHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+			}
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14308,24 +22290,52 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.SecondsAfterCompletion = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SecondsAfterSuccess = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterFailure = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14350,7 +22360,7 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Bucket) Unmarshal(dAtA []byte) error {
+func (m *TarStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14373,47 +22383,68 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: TarStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TarStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Template) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14441,11 +22472,11 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14473,32 +22504,11 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Insecure = &b
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14525,13 +22535,13 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14558,15 +22568,18 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14576,80 +22589,28 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14676,15 +22637,15 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14694,82 +22655,124 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Sequence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14779,29 +22782,33 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Count = string(dAtA[iNdEx:postIndex])
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14811,29 +22818,30 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemon", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14843,29 +22851,18 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.End = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := bool(v != 0)
+			m.Daemon = &b
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14875,82 +22872,31 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Steps = append(m.Steps, ParallelSteps{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SuspendTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuspendTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14960,82 +22906,33 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Container == nil {
+				m.Container = &v1.Container{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15045,17 +22942,33 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterCompletion = &v
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Script == nil {
+				m.Script = &ScriptTemplate{}
+			}
+			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15065,17 +22978,33 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterSuccess = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &ResourceTemplate{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAG", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15085,123 +23014,69 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterFailure = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TarStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.DAG == nil {
+				m.DAG = &DAGTemplate{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.DAG.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TarStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TarStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Template) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Suspend == nil {
+				m.Suspend = &SuspendTemplate{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15211,29 +23086,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15243,27 +23120,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.InitContainers = append(m.InitContainers, UserContainer{})
+			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15290,13 +23169,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sidecars = append(m.Sidecars, UserContainer{})
+			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15323,16 +23203,36 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.ArchiveLocation == nil {
+				m.ArchiveLocation = &ArtifactLocation{}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArchiveLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveDeadlineSeconds = &v
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15359,15 +23259,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15377,28 +23280,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.Parallelism = &v
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15425,109 +23315,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15537,33 +23334,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SchedulerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15573,30 +23366,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 27:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var v int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15606,18 +23398,17 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Daemon = &b
-		case 11:
+			m.Priority = &v
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15627,29 +23418,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, ParallelSteps{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAliases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15676,16 +23465,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &v1.Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15712,18 +23499,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Script == nil {
-				m.Script = &ScriptTemplate{}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
 			}
-			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15733,31 +23520,48 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &ResourceTemplate{}
+			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 15:
+			b := bool(v != 0)
+			m.AutomountServiceAccountToken = &b
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAG", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15784,16 +23588,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DAG == nil {
-				m.DAG = &DAGTemplate{}
+			if m.Executor == nil {
+				m.Executor = &ExecutorConfig{}
 			}
-			if err := m.DAG.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionWindows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15820,18 +23624,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Suspend == nil {
-				m.Suspend = &SuspendTemplate{}
-			}
-			if err := m.Suspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExecutionWindows = append(m.ExecutionWindows, ExecutionWindow{})
+			if err := m.ExecutionWindows[len(m.ExecutionWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopSignal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15841,31 +23643,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StopSignal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationGracePeriodSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15875,29 +23675,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitContainers = append(m.InitContainers, UserContainer{})
-			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 19:
+			m.TerminationGracePeriodSeconds = &v
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15924,14 +23710,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sidecars = append(m.Sidecars, UserContainer{})
-			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metrics == nil {
+				m.Metrics = &Metrics{}
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15958,36 +23746,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArchiveLocation == nil {
-				m.ArchiveLocation = &ArtifactLocation{}
+			if m.Memoize == nil {
+				m.Memoize = &Memoize{}
 			}
-			if err := m.ArchiveLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Memoize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ActiveDeadlineSeconds = &v
-		case 22:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16014,18 +23782,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &RetryStrategy{}
+			if m.Synchronization == nil {
+				m.Synchronization = &Synchronization{}
 			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 40:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
 			}
-			var v int64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16035,15 +23803,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Parallelism = &v
-		case 24:
+			m.FailFast = bool(v != 0)
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16070,14 +23838,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HTTP == nil {
+				m.HTTP = &HTTP{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16105,13 +23875,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SchedulerName = string(dAtA[iNdEx:postIndex])
+			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16121,47 +23891,84 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			if m.Stream == nil {
+				m.Stream = &Stream{}
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Priority = &v
-		case 28:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16189,13 +23996,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 29:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16205,31 +24012,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
-			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 30:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeResolution", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16239,31 +24044,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
-			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 31:
+			m.RuntimeResolution = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16291,32 +24080,64 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
+			m.ConfigMapKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := bool(v != 0)
-			m.AutomountServiceAccountToken = &b
-		case 33:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserContainer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserContainer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16343,13 +24164,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Executor == nil {
-				m.Executor = &ExecutorConfig{}
-			}
-			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorVolumeMounts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.MirrorVolumeMounts = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16374,7 +24213,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateRef) Unmarshal(dAtA []byte) error {
+func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16397,15 +24236,15 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueFrom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16433,11 +24272,11 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16465,13 +24304,13 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeResolution", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JQFilter", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16481,12 +24320,56 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RuntimeResolution = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JQFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16511,7 +24394,7 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserContainer) Unmarshal(dAtA []byte) error {
+func (m *VolumeClaimGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16534,17 +24417,17 @@ func (m *UserContainer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserContainer: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeClaimGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeClaimGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16554,46 +24437,24 @@ func (m *UserContainer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Strategy = VolumeClaimGCStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MirrorVolumeMounts", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.MirrorVolumeMounts = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16618,7 +24479,7 @@ func (m *UserContainer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueFrom) Unmarshal(dAtA []byte) error {
+func (m *Workflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16641,49 +24502,17 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: Workflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Workflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16693,29 +24522,30 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16725,29 +24555,30 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQFilter = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16757,23 +24588,24 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameter = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16799,7 +24631,7 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Workflow) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEnvironment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16822,17 +24654,17 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Workflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEnvironment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Workflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEnvironment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16842,63 +24674,29 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ControllerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16908,24 +24706,23 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExecutorVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17998,24 +25795,187 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.AutomountServiceAccountToken = &b
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Executor == nil {
+				m.Executor = &ExecutorConfig{}
+			}
+			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TTLStrategy == nil {
+				m.TTLStrategy = &TTLStrategy{}
+			}
+			if err := m.TTLStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeClaimGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VolumeClaimGC == nil {
+				m.VolumeClaimGC = &VolumeClaimGC{}
+			}
+			if err := m.VolumeClaimGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpecPatch = string(dAtA[iNdEx:postIndex])
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutomountServiceAccountToken", wireType)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFrom", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18025,16 +25985,29 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.AutomountServiceAccountToken = &b
-		case 29:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvFrom = append(m.EnvFrom, v1.EnvFromSource{})
+			if err := m.EnvFrom[len(m.EnvFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18061,16 +26034,16 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Executor == nil {
-				m.Executor = &ExecutorConfig{}
+			if m.Synchronization == nil {
+				m.Synchronization = &Synchronization{}
 			}
-			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTLStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18097,13 +26070,33 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTLStrategy == nil {
-				m.TTLStrategy = &TTLStrategy{}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
 			}
-			if err := m.TTLStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactManifest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArtifactManifest = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18352,7 +26345,206 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 				m.Nodes = make(Nodes)
 			}
 			var mapkey string
-			mapvalue := &NodeStatus{}
+			mapvalue := &NodeStatus{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NodeStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Nodes[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersistentVolumeClaims = append(m.PersistentVolumeClaims, v1.Volume{})
+			if err := m.PersistentVolumeClaims[len(m.PersistentVolumeClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTemplates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StoredTemplates == nil {
+				m.StoredTemplates = make(map[string]Template)
+			}
+			var mapkey string
+			mapvalue := &Template{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -18426,7 +26618,7 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &NodeStatus{}
+					mapvalue = &Template{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -18446,11 +26638,43 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Nodes[mapkey] = *mapvalue
+			m.StoredTemplates[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffloadNodeStatusVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffloadNodeStatusVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18477,14 +26701,48 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PersistentVolumeClaims = append(m.PersistentVolumeClaims, v1.Volume{})
-			if err := m.PersistentVolumeClaims[len(m.PersistentVolumeClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Synchronization == nil {
+				m.Synchronization = &SynchronizationStatus{}
+			}
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesToDelete", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourcesToDelete = append(m.ResourcesToDelete, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactManifest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18511,16 +26769,16 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
+			if m.ArtifactManifest == nil {
+				m.ArtifactManifest = &Artifact{}
 			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactManifest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredTemplates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18547,11 +26805,11 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StoredTemplates == nil {
-				m.StoredTemplates = make(map[string]Template)
+			if m.ResourcesDuration == nil {
+				m.ResourcesDuration = make(ResourcesDuration)
 			}
-			var mapkey string
-			mapvalue := &Template{}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			var mapvalue int64
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -18597,10 +26855,9 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -18610,26 +26867,11 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						mapvalue |= int64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Template{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18645,11 +26887,30 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.StoredTemplates[mapkey] = *mapvalue
+			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
 			iNdEx = postIndex
-		case 10:
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffloadNodeStatusVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18677,8 +26938,115 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OffloadNodeStatusVersion = string(dAtA[iNdEx:postIndex])
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGCPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactGCPhase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeIDMaxLength", wireType)
+			}
+			m.NodeIDMaxLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeIDMaxLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Environment == nil {
+				m.Environment = &WorkflowEnvironment{}
+			}
+			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeIDNamespaced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NodeIDNamespaced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19067,6 +27435,42 @@ func (m *WorkflowStep) Unmarshal(dAtA []byte) error {
 			}
 			m.OnExit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailureThreshold == nil {
+				m.FailureThreshold = &FailureThreshold{}
+			}
+			if err := m.FailureThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -0,0 +1,54 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+)
+
+// EstimatedDuration is an estimated duration in seconds
+type EstimatedDuration int64
+
+// NewEstimatedDuration returns the estimated duration of a duration, rounded to the second
+func NewEstimatedDuration(d time.Duration) EstimatedDuration {
+	return EstimatedDuration(d.Round(time.Second) / time.Second)
+}
+
+func (in EstimatedDuration) Duration() time.Duration {
+	return time.Duration(in) * time.Second
+}
+
+// Progress is the number of completed units of work (pods and HTTP requests) out of the number of units of work known
+// so far, e.g. "3/5". The number of units of work grows as a workflow runs, e.g. when loops are expanded.
+type Progress string
+
+// NewProgress returns the progress of n completed units of work out of m
+func NewProgress(n, m int64) Progress {
+	return Progress(fmt.Sprintf("%d/%d", n, m))
+}
+
+// Parse returns the number of completed units of work and the number of units of work, and whether the progress is
+// valid
+func (in Progress) Parse() (int64, int64, bool) {
+	var n, m int64
+	_, err := fmt.Sscanf(string(in), "%d/%d", &n, &m)
+	if err != nil || n < 0 || m < 0 || n > m {
+		return 0, 0, false
+	}
+	return n, m, true
+}
+
+// Add returns the sum of both progresses
+func (in Progress) Add(o Progress) Progress {
+	n, m, _ := in.Parse()
+	on, om, _ := o.Parse()
+	return NewProgress(n+on, m+om)
+}
+
+// Percent returns the percentage of the units of work which completed, or 0 if there is none
+func (in Progress) Percent() int64 {
+	n, m, ok := in.Parse()
+	if !ok || m == 0 {
+		return 0
+	}
+	return n * 100 / m
+}
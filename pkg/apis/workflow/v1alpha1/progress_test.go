@@ -0,0 +1,28 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimatedDuration(t *testing.T) {
+	assert.Equal(t, EstimatedDuration(91), NewEstimatedDuration(90*time.Second+600*time.Millisecond))
+	assert.Equal(t, 91*time.Second, EstimatedDuration(91).Duration())
+}
+
+func TestProgress(t *testing.T) {
+	n, m, ok := NewProgress(3, 5).Parse()
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), n)
+	assert.Equal(t, int64(5), m)
+	for _, p := range []Progress{"", "3", "5/3", "-1/2", "a/b"} {
+		_, _, ok = p.Parse()
+		assert.False(t, ok, p)
+	}
+	assert.Equal(t, Progress("4/7"), NewProgress(3, 5).Add("1/2"))
+	assert.Equal(t, Progress("1/2"), Progress("").Add("1/2"))
+	assert.Equal(t, int64(60), NewProgress(3, 5).Percent())
+	assert.Equal(t, int64(0), NewProgress(0, 0).Percent())
+}
@@ -0,0 +1,77 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ResourceDuration is the number of seconds a resource was requested for, in units of its denominator
+type ResourceDuration int64
+
+func (in ResourceDuration) Duration() time.Duration {
+	return time.Duration(in) * time.Second
+}
+
+// ResourcesDuration is the estimated usage of each resource, e.g. `cpu: 60` for one CPU requested for one minute, or
+// for two CPUs requested for 30 seconds
+type ResourcesDuration map[apiv1.ResourceName]ResourceDuration
+
+// Add returns the sum of both resource durations
+func (in ResourcesDuration) Add(o ResourcesDuration) ResourcesDuration {
+	if len(in) == 0 && len(o) == 0 {
+		return nil
+	}
+	res := ResourcesDuration{}
+	for n, d := range in {
+		res[n] += d
+	}
+	for n, d := range o {
+		res[n] += d
+	}
+	return res
+}
+
+// String returns the resource durations sorted by resource, e.g. `1m0s*cpu,30s*(1Gi memory)`
+func (in ResourcesDuration) String() string {
+	var names []string
+	for n := range in {
+		names = append(names, string(n))
+	}
+	sort.Strings(names)
+	var parts []string
+	for _, n := range names {
+		name := apiv1.ResourceName(n)
+		denominator := ResourceQuantityDenominator(name)
+		unit := n
+		if !denominator.Equal(resource.MustParse("1")) {
+			unit = fmt.Sprintf("(%s %s)", denominator.String(), n)
+		}
+		parts = append(parts, fmt.Sprintf("%v*%s", in[name].Duration(), unit))
+	}
+	return strings.Join(parts, ",")
+}
+
+// ResourceQuantityDenominator is the quantity of a resource which a ResourceDuration is counted in: one unit of CPU
+// and of other resources, such as GPUs, and one Gi of memory and storage
+func ResourceQuantityDenominator(r apiv1.ResourceName) resource.Quantity {
+	switch r {
+	case apiv1.ResourceMemory, apiv1.ResourceStorage, apiv1.ResourceEphemeralStorage:
+		return resource.MustParse("1Gi")
+	default:
+		return resource.MustParse("1")
+	}
+}
+
+// GetResourcesDuration returns the sum of the resource durations of the nodes
+func (n Nodes) GetResourcesDuration() ResourcesDuration {
+	var res ResourcesDuration
+	for _, node := range n {
+		res = res.Add(node.ResourcesDuration)
+	}
+	return res
+}
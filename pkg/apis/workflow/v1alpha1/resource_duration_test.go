@@ -0,0 +1,20 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestResourcesDuration(t *testing.T) {
+	nodes := Nodes{
+		"a": NodeStatus{ResourcesDuration: ResourcesDuration{apiv1.ResourceCPU: 60, apiv1.ResourceMemory: 30}},
+		"b": NodeStatus{ResourcesDuration: ResourcesDuration{apiv1.ResourceCPU: 30, "nvidia.com/gpu": 10}},
+		"c": NodeStatus{},
+	}
+	total := nodes.GetResourcesDuration()
+	assert.Equal(t, ResourcesDuration{apiv1.ResourceCPU: 90, apiv1.ResourceMemory: 30, "nvidia.com/gpu": 10}, total)
+	assert.Equal(t, "1m30s*cpu,30s*(1Gi memory),10s*nvidia.com/gpu", total.String())
+	assert.Nil(t, Nodes{"c": NodeStatus{}}.GetResourcesDuration())
+}
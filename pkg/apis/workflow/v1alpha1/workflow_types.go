@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
+	"strings"
 
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -21,6 +22,7 @@ const (
 	TemplateTypeResource  TemplateType = "Resource"
 	TemplateTypeDAG       TemplateType = "DAG"
 	TemplateTypeSuspend   TemplateType = "Suspend"
+	TemplateTypeHTTP      TemplateType = "HTTP"
 	TemplateTypeUnknown   TemplateType = "Unknown"
 )
 
@@ -50,6 +52,16 @@ const (
 	NodeTypeRetry     NodeType = "Retry"
 	NodeTypeSkipped   NodeType = "Skipped"
 	NodeTypeSuspend   NodeType = "Suspend"
+	NodeTypeHTTP      NodeType = "HTTP"
+)
+
+// NodeWaitingReason is what the placeholder of a node which has not started yet waits for
+type NodeWaitingReason string
+
+// Node waiting reasons
+const (
+	NodeWaitingForExecutionWindow NodeWaitingReason = "ExecutionWindow"
+	NodeWaitingForLock            NodeWaitingReason = "Lock"
 )
 
 // PodGCStrategy is the strategy when to delete completed pods for GC.
@@ -63,6 +75,24 @@ const (
 	PodGCOnWorkflowSuccess    PodGCStrategy = "OnWorkflowSuccess"
 )
 
+// VolumeClaimGCStrategy is the strategy when to delete the volumes created from volumeClaimTemplates.
+type VolumeClaimGCStrategy string
+
+// VolumeClaimGCStrategy
+const (
+	VolumeClaimGCOnWorkflowCompletion VolumeClaimGCStrategy = "OnWorkflowCompletion"
+	VolumeClaimGCOnWorkflowSuccess    VolumeClaimGCStrategy = "OnWorkflowSuccess"
+)
+
+// ArtifactGCStrategy is the strategy when to delete the output artifacts of a workflow.
+type ArtifactGCStrategy string
+
+// ArtifactGCStrategy
+const (
+	ArtifactGCOnWorkflowCompletion ArtifactGCStrategy = "OnWorkflowCompletion"
+	ArtifactGCOnWorkflowDeletion   ArtifactGCStrategy = "OnWorkflowDeletion"
+)
+
 // TemplateGetter is an interface to get templates.
 type TemplateGetter interface {
 	GetNamespace() string
@@ -244,7 +274,7 @@ type WorkflowSpec struct {
 	// terminate a Running workflow
 	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty" protobuf:"bytes,19,opt,name=activeDeadlineSeconds"`
 
-	// Priority is used if controller is configured to process limited number of workflows in parallel. Workflows with higher priority are processed first.
+	// Priority is used if controller is configured to process limited number of workflows in parallel, to order the workflows and nodes waiting for the same lock, and to order the workflows of a namespace waiting for the pod parallelism of the controller. Workflows with higher priority are processed first.
 	Priority *int32 `json:"priority,omitempty" protobuf:"bytes,20,opt,name=priority"`
 
 	// Set scheduler name for all pods.
@@ -256,6 +286,18 @@ type WorkflowSpec struct {
 	// PodGC describes the strategy to use when to deleting completed pods
 	PodGC *PodGC `json:"podGC,omitempty" protobuf:"bytes,22,opt,name=podGC"`
 
+	// VolumeClaimGC describes the strategy to use when deleting the volumes created from volumeClaimTemplates.
+	// Defaults to OnWorkflowSuccess, which keeps the volumes of unsuccessful workflows so that they can be retried.
+	VolumeClaimGC *VolumeClaimGC `json:"volumeClaimGC,omitempty" protobuf:"bytes,31,opt,name=volumeClaimGC"`
+
+	// ArtifactGC describes the strategy to use when deleting the output artifacts of the workflow from the
+	// artifact repository
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,35,opt,name=artifactGC"`
+
+	// ArtifactManifest saves a JSON manifest of the output artifacts of the workflow to the artifact repository
+	// once the workflow completes, listing the node, name, location, size and checksum of each artifact
+	ArtifactManifest bool `json:"artifactManifest,omitempty" protobuf:"varint,36,opt,name=artifactManifest"`
+
 	// PriorityClassName to apply to workflow pods.
 	PodPriorityClassName string `json:"podPriorityClassName,omitempty" protobuf:"bytes,23,opt,name=podPriorityClassName"`
 
@@ -274,6 +316,18 @@ type WorkflowSpec struct {
 	// PodSpecPatch holds strategic merge patch to apply against the pod spec. Allows parameterization of
 	// container fields which are not strings (e.g. resource limits).
 	PodSpecPatch string `json:"podSpecPatch,omitempty" protobuf:"bytes,27,opt,name=podSpecPatch"`
+
+	// Env is a list of environment variables set on the main container of every pod in the workflow.
+	// A variable defined by a template's container with the same name takes precedence.
+	Env []apiv1.EnvVar `json:"env,omitempty" protobuf:"bytes,32,rep,name=env"`
+
+	// EnvFrom is a list of sources (e.g. Secrets or ConfigMaps) to populate environment variables on the main
+	// container of every pod in the workflow. Sources defined by a template's container take precedence.
+	EnvFrom []apiv1.EnvFromSource `json:"envFrom,omitempty" protobuf:"bytes,33,rep,name=envFrom"`
+
+	// Synchronization holds back the workflow until it acquires a lock, limiting how many workflows synchronizing
+	// on the same lock run at the same time
+	Synchronization *Synchronization `json:"synchronization,omitempty" protobuf:"bytes,34,opt,name=synchronization"`
 }
 
 type ParallelSteps struct {
@@ -330,6 +384,9 @@ type Template struct {
 	// Deamon will allow a workflow to proceed to the next step so long as the container reaches readiness
 	Daemon *bool `json:"daemon,omitempty" protobuf:"bytes,10,opt,name=daemon"`
 
+	// Stream is the channel a daemon receives data on from the steps or tasks after it, while they produce it
+	Stream *Stream `json:"stream,omitempty" protobuf:"bytes,43,opt,name=stream"`
+
 	// Steps define a series of sequential/parallel workflow steps
 	Steps []ParallelSteps `json:"steps,omitempty" protobuf:"bytes,11,opt,name=steps"`
 
@@ -348,6 +405,9 @@ type Template struct {
 	// Suspend template subtype which can suspend a workflow when reaching the step
 	Suspend *SuspendTemplate `json:"suspend,omitempty" protobuf:"bytes,16,opt,name=suspend"`
 
+	// HTTP template subtype which performs an HTTP request from the controller, without a pod
+	HTTP *HTTP `json:"http,omitempty" protobuf:"bytes,41,opt,name=http"`
+
 	// Volumes is a list of volumes that can be mounted by containers in a template.
 	// +patchStrategy=merge
 	// +patchMergeKey=name
@@ -423,6 +483,38 @@ type Template struct {
 	// PodSpecPatch holds strategic merge patch to apply against the pod spec. Allows parameterization of
 	// container fields which are not strings (e.g. resource limits).
 	PodSpecPatch string `json:"podSpecPatch,omitempty" protobuf:"bytes,31,opt,name=podSpecPatch"`
+
+	// ExecutionWindows restricts the times at which nodes of this template are allowed to start.
+	// Nodes which become ready outside of all windows wait in the Pending phase until one opens.
+	ExecutionWindows []ExecutionWindow `json:"executionWindows,omitempty" protobuf:"bytes,34,rep,name=executionWindows"`
+
+	// StopSignal is the signal sent to the main container when the step is terminated, e.g. SIGINT or SIGQUIT.
+	// Defaults to SIGTERM.
+	StopSignal string `json:"stopSignal,omitempty" protobuf:"bytes,35,opt,name=stopSignal"`
+
+	// TerminationGracePeriodSeconds is the time to wait after sending the stop signal before the main container
+	// is killed with SIGKILL. Defaults to 10 seconds.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty" protobuf:"varint,36,opt,name=terminationGracePeriodSeconds"`
+
+	// Metrics are custom metrics emitted by the controller whenever a node of this template completes
+	Metrics *Metrics `json:"metrics,omitempty" protobuf:"bytes,37,opt,name=metrics"`
+
+	// Memoize caches the outputs of this template. Nodes whose key is found in the cache are not run again, and
+	// reuse the cached outputs instead.
+	Memoize *Memoize `json:"memoize,omitempty" protobuf:"bytes,38,opt,name=memoize"`
+
+	// Synchronization holds back nodes of this template until they acquire a lock, limiting how many nodes
+	// synchronizing on the same lock run at the same time, across all workflows of the namespace
+	Synchronization *Synchronization `json:"synchronization,omitempty" protobuf:"bytes,39,opt,name=synchronization"`
+
+	// FailFast terminates the running pods of a steps template as soon as a step of the current step group fails,
+	// rather than waiting for them to complete. Steps which continue on failure do not terminate the others. DAG
+	// templates set dag.failFast to true instead.
+	FailFast bool `json:"failFast,omitempty" protobuf:"varint,40,opt,name=failFast"`
+
+	// Cluster is the name of the cluster, among the clusters of the controller configuration, to run the pod of this
+	// template in. Defaults to the cluster of the controller, in the namespace of the workflow.
+	Cluster string `json:"cluster,omitempty" protobuf:"bytes,42,opt,name=cluster"`
 }
 
 var _ TemplateHolder = &Template{}
@@ -547,6 +639,14 @@ type Artifact struct {
 
 	// Make Artifacts optional, if Artifacts doesn't generate or exist
 	Optional bool `json:"optional,omitempty" protobuf:"varint,8,opt,name=optional"`
+
+	// SizeBytes is the size in bytes of the saved output artifact, as stored in the artifact repository.
+	// Set by the executor.
+	SizeBytes *int64 `json:"size,omitempty" protobuf:"varint,9,opt,name=size"`
+
+	// Checksum is the SHA-256 checksum of the saved output artifact, as stored in the artifact repository,
+	// e.g. "sha256:<hex>". Set by the executor.
+	Checksum string `json:"checksum,omitempty" protobuf:"bytes,10,opt,name=checksum"`
 }
 
 // PodGC describes how to delete completed pods as they complete
@@ -554,6 +654,25 @@ type PodGC struct {
 	Strategy PodGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=PodGCStrategy"`
 }
 
+// VolumeClaimGC describes how to delete the volumes created from volumeClaimTemplates
+type VolumeClaimGC struct {
+	Strategy VolumeClaimGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=VolumeClaimGCStrategy"`
+}
+
+// GetStrategy returns the VolumeClaimGCStrategy, defaulting to OnWorkflowSuccess
+func (vgc *VolumeClaimGC) GetStrategy() VolumeClaimGCStrategy {
+	if vgc == nil || vgc.Strategy == "" {
+		return VolumeClaimGCOnWorkflowSuccess
+	}
+	return vgc.Strategy
+}
+
+// ArtifactGC describes how to delete the output artifacts of a workflow
+type ArtifactGC struct {
+	// Strategy is when to delete the artifacts, either OnWorkflowCompletion or OnWorkflowDeletion
+	Strategy ArtifactGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=ArtifactGCStrategy"`
+}
+
 // ArchiveStrategy describes how to archive files/directory when saving artifacts
 type ArchiveStrategy struct {
 	Tar  *TarStrategy  `json:"tar,omitempty" protobuf:"bytes,1,opt,name=tar"`
@@ -651,6 +770,9 @@ type WorkflowStep struct {
 	// template, irrespective of the success, failure, or error of the
 	// primary template.
 	OnExit string `json:"onExit,omitempty" protobuf:"bytes,11,opt,name=onExit"`
+
+	// FailureThreshold stops launching the remaining items of an expanded step when too many of them failed
+	FailureThreshold *FailureThreshold `json:"failureThreshold,omitempty" protobuf:"bytes,12,opt,name=failureThreshold"`
 }
 
 var _ TemplateHolder = &WorkflowStep{}
@@ -712,6 +834,22 @@ type TemplateRef struct {
 	// RuntimeResolution skips validation at creation time.
 	// By enabling this option, you can create the referred workflow template before the actual runtime.
 	RuntimeResolution bool `json:"runtimeResolution,omitempty" protobuf:"varint,3,opt,name=runtimeResolution"`
+	// ConfigMapKey is the key of the ConfigMap named Name which holds the WorkflowTemplate, in YAML or JSON, to
+	// refer to instead of a WorkflowTemplate resource. It is resolved at runtime.
+	ConfigMapKey string `json:"configMapKey,omitempty" protobuf:"bytes,4,opt,name=configMapKey"`
+}
+
+// ConfigMapWorkflowTemplatePrefix prefixes the names of the WorkflowTemplates held by ConfigMaps, which no
+// WorkflowTemplate resource can be named
+const ConfigMapWorkflowTemplatePrefix = "configmap/"
+
+// GetWorkflowTemplateName returns the name of the WorkflowTemplate referred to, which is configmap/<name>/<key> for a
+// WorkflowTemplate held by a ConfigMap
+func (ref *TemplateRef) GetWorkflowTemplateName() string {
+	if ref.ConfigMapKey != "" {
+		return ConfigMapWorkflowTemplatePrefix + ref.Name + "/" + ref.ConfigMapKey
+	}
+	return ref.Name
 }
 
 type ArgumentsProvider interface {
@@ -789,6 +927,53 @@ type WorkflowStatus struct {
 
 	// Outputs captures output values and artifact locations produced by the workflow via global outputs
 	Outputs *Outputs `json:"outputs,omitempty" protobuf:"bytes,8,opt,name=outputs"`
+
+	// Synchronization records the locks held by the workflow and its nodes
+	Synchronization *SynchronizationStatus `json:"synchronization,omitempty" protobuf:"bytes,11,opt,name=synchronization"`
+
+	// ResourcesToDelete tracks the manifests of the resources created by resource templates with the
+	// DeleteOnWorkflowCompletion finalizer. These resources are deleted at the end of the workflow.
+	ResourcesToDelete []string `json:"resourcesToDelete,omitempty" protobuf:"bytes,12,rep,name=resourcesToDelete"`
+
+	// ArtifactManifest is the location of the manifest of the output artifacts of the workflow, once it is saved
+	ArtifactManifest *Artifact `json:"artifactManifest,omitempty" protobuf:"bytes,13,opt,name=artifactManifest"`
+
+	// ArtifactGCPhase is the phase of the deletion of the output artifacts once the workflow completed, with the
+	// OnWorkflowCompletion artifact GC strategy: Succeeded once they are deleted, or Failed if some of them could not be
+	ArtifactGCPhase NodePhase `json:"artifactGCPhase,omitempty" protobuf:"bytes,17,opt,name=artifactGCPhase,casttype=NodePhase"`
+
+	// ResourcesDuration is the sum of the resource durations of the nodes of the workflow
+	ResourcesDuration ResourcesDuration `json:"resourcesDuration,omitempty" protobuf:"bytes,14,opt,name=resourcesDuration"`
+
+	// EstimatedDuration is the duration in seconds the workflow is estimated to take, which is how long the last
+	// successful workflow of the same kind took
+	EstimatedDuration EstimatedDuration `json:"estimatedDuration,omitempty" protobuf:"varint,15,opt,name=estimatedDuration,casttype=EstimatedDuration"`
+
+	// Progress is the number of completed pods and HTTP requests of the workflow out of the number known so far
+	Progress Progress `json:"progress,omitempty" protobuf:"bytes,16,opt,name=progress,casttype=Progress"`
+
+	// NodeIDMaxLength is the length the IDs of the nodes are truncated to, which is recorded from the controller
+	// configuration when the workflow starts so that the IDs of its nodes do not change. Zero is the maximum length
+	// of a pod name.
+	NodeIDMaxLength int32 `json:"nodeIDMaxLength,omitempty" protobuf:"varint,18,opt,name=nodeIDMaxLength"`
+
+	// NodeIDNamespaced is whether the namespace of the workflow is hashed into the IDs of its nodes, and so into the
+	// names of its pods, which is recorded when the workflow starts if its pods may run in other clusters, where the
+	// pods of the workflows of the same name in different namespaces may run in the same namespace
+	NodeIDNamespaced bool `json:"nodeIDNamespaced,omitempty" protobuf:"varint,20,opt,name=nodeIDNamespaced"`
+
+	// Environment records the versions of the controller and the executor which ran the nodes of the workflow
+	Environment *WorkflowEnvironment `json:"environment,omitempty" protobuf:"bytes,19,opt,name=environment"`
+}
+
+// WorkflowEnvironment records the versions of the controller and the executor which ran the nodes of a workflow, as
+// recorded when its first pod node completed. The environments of the nodes only record the versions which differ.
+type WorkflowEnvironment struct {
+	// ControllerVersion is the version of the workflow controller which ran the nodes
+	ControllerVersion string `json:"controllerVersion,omitempty" protobuf:"bytes,1,opt,name=controllerVersion"`
+
+	// ExecutorVersion is the version of the executor which ran the pods of the nodes, as reported by the executor
+	ExecutorVersion string `json:"executorVersion,omitempty" protobuf:"bytes,2,opt,name=executorVersion"`
 }
 
 func (ws *WorkflowStatus) IsOffloadNodeStatus() bool {
@@ -907,6 +1092,115 @@ type NodeStatus struct {
 	// a DAG/steps template invokes another DAG/steps template. In other words, the outbound nodes of
 	// a template, will be a superset of the outbound nodes of its last children.
 	OutboundNodes []string `json:"outboundNodes,omitempty" protobuf:"bytes,17,rep,name=outboundNodes"`
+
+	// MemoizationStatus records the cache key of a memoized node, and whether its outputs were found in the cache
+	MemoizationStatus *MemoizationStatus `json:"memoizationStatus,omitempty" protobuf:"bytes,21,opt,name=memoizationStatus"`
+
+	// SynchronizationStatus records the lock a node is waiting for before it runs
+	SynchronizationStatus *NodeSynchronizationStatus `json:"synchronizationStatus,omitempty" protobuf:"bytes,22,opt,name=synchronizationStatus"`
+
+	// Diagnostics are collected from the pod of a failed node, if the controller is configured to collect them
+	Diagnostics *NodeDiagnostics `json:"diagnostics,omitempty" protobuf:"bytes,23,opt,name=diagnostics"`
+
+	// ResourcesDuration is the estimated usage of the resources of a pod node, which is the resources requested by
+	// each of its containers multiplied by how long the container ran. It is set when the node completes.
+	ResourcesDuration ResourcesDuration `json:"resourcesDuration,omitempty" protobuf:"bytes,24,opt,name=resourcesDuration"`
+
+	// Environment records the images the pod of a node ran, resolved to their digests, and the version of the
+	// controller which ran it. It is set when the node completes.
+	Environment *NodeEnvironment `json:"environment,omitempty" protobuf:"bytes,25,opt,name=environment"`
+
+	// EstimatedDuration is the duration in seconds the node is estimated to take, which is how long the same node of
+	// the last successful workflow of the same kind took
+	EstimatedDuration EstimatedDuration `json:"estimatedDuration,omitempty" protobuf:"varint,26,opt,name=estimatedDuration,casttype=EstimatedDuration"`
+
+	// Progress is the number of completed pods and HTTP requests of the node and its descendants out of the number
+	// known so far
+	Progress Progress `json:"progress,omitempty" protobuf:"bytes,27,opt,name=progress,casttype=Progress"`
+
+	// Cluster is the name of the cluster the pod of a pod node runs in, empty for the cluster of the controller
+	Cluster string `json:"cluster,omitempty" protobuf:"bytes,28,opt,name=cluster"`
+
+	// WaitingFor is set on the placeholder of a node which has not started yet, and tells what the node waits for
+	// before it may start
+	WaitingFor NodeWaitingReason `json:"waitingFor,omitempty" protobuf:"bytes,29,opt,name=waitingFor,casttype=NodeWaitingReason"`
+}
+
+// MemoizationStatus is the status of a memoized node
+type MemoizationStatus struct {
+	// Hit is true if the outputs were found in the cache, and the node was not run
+	Hit bool `json:"hit" protobuf:"varint,1,opt,name=hit"`
+
+	// Key is the key the outputs are cached under
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+
+	// CacheName is the name of the config map the outputs are cached in
+	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+}
+
+// NodeSynchronizationStatus is the synchronization status of a node
+type NodeSynchronizationStatus struct {
+	// Waiting is the name of the lock the node is waiting for
+	Waiting string `json:"waiting,omitempty" protobuf:"bytes,1,opt,name=waiting"`
+}
+
+// NodeDiagnostics holds the information needed to triage the failure of a node, which is kept after its pod was deleted
+type NodeDiagnostics struct {
+	// Containers are the states of the containers of the pod
+	Containers []ContainerDiagnostics `json:"containers,omitempty" protobuf:"bytes,1,rep,name=containers"`
+
+	// Events are the events of the pod, e.g. "Warning FailedMount: ..."
+	Events []string `json:"events,omitempty" protobuf:"bytes,2,rep,name=events"`
+
+	// Logs are the last lines of the logs of the main container, unless they are archived
+	Logs string `json:"logs,omitempty" protobuf:"bytes,3,opt,name=logs"`
+
+	// LogsArtifact is the name of the output artifact the logs of the main container are archived in, e.g. "main-logs",
+	// if the template archives its logs. The logs are then not copied into Logs.
+	LogsArtifact string `json:"logsArtifact,omitempty" protobuf:"bytes,4,opt,name=logsArtifact"`
+}
+
+// ContainerDiagnostics is the state of a container of a failed pod
+type ContainerDiagnostics struct {
+	// Name of the container
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// ExitCode of the container, if it terminated
+	ExitCode *int32 `json:"exitCode,omitempty" protobuf:"varint,2,opt,name=exitCode"`
+
+	// Reason the container terminated, or is waiting, e.g. "OOMKilled"
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+
+	// Message explaining the reason
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// NodeEnvironment records what the pod of a node ran, so that the node can be reproduced after the tags of its images
+// moved. The resolved parameters of the node are its inputs.
+type NodeEnvironment struct {
+	// Containers are the images of the containers of the pod, including the init and wait containers of the executor
+	Containers []ContainerEnvironment `json:"containers,omitempty" protobuf:"bytes,1,rep,name=containers"`
+
+	// ControllerVersion is the version of the workflow controller which ran the node, if it differs from the one of
+	// the environment of the workflow
+	ControllerVersion string `json:"controllerVersion,omitempty" protobuf:"bytes,2,opt,name=controllerVersion"`
+
+	// ExecutorVersion is the version of the executor which ran the pod, as reported by the executor, if it differs
+	// from the one of the environment of the workflow
+	ExecutorVersion string `json:"executorVersion,omitempty" protobuf:"bytes,3,opt,name=executorVersion"`
+}
+
+// ContainerEnvironment is the image a container of a pod ran
+type ContainerEnvironment struct {
+	// Name of the container
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Image referenced by the container, e.g. "docker/whalesay:latest"
+	Image string `json:"image" protobuf:"bytes,2,opt,name=image"`
+
+	// ImageID is the image as resolved by the container runtime, which includes its digest, e.g.
+	// "docker-pullable://docker/whalesay@sha256:..."
+	ImageID string `json:"imageID,omitempty" protobuf:"bytes,3,opt,name=imageID"`
 }
 
 //func (n NodeStatus) String() string {
@@ -1035,6 +1329,9 @@ type GitArtifact struct {
 
 	// InsecureIgnoreHostKey disables SSH strict host key checking during git clone
 	InsecureIgnoreHostKey bool `json:"insecureIgnoreHostKey,omitempty" protobuf:"varint,8,opt,name=insecureIgnoreHostKey"`
+
+	// DisableSubmodules disables the recursive clone of the submodules of the repository
+	DisableSubmodules bool `json:"disableSubmodules,omitempty" protobuf:"varint,9,opt,name=disableSubmodules"`
 }
 
 func (g *GitArtifact) HasLocation() bool {
@@ -1176,6 +1473,28 @@ type ResourceTemplate struct {
 	// FailureCondition is a label selector expression which describes the conditions
 	// of the k8s resource in which the step was considered failed
 	FailureCondition string `json:"failureCondition,omitempty" protobuf:"bytes,6,opt,name=failureCondition"`
+
+	// Finalizers are run on the created resource when the workflow completes, whether it succeeded or not.
+	// Must be one of: DeleteOnWorkflowCompletion
+	Finalizers []ResourceFinalizer `json:"finalizers,omitempty" protobuf:"bytes,7,rep,name=finalizers,casttype=ResourceFinalizer"`
+}
+
+// ResourceFinalizer is an action run on the resource created by a resource template when the workflow completes
+type ResourceFinalizer string
+
+// ResourceFinalizer
+const (
+	ResourceFinalizerDeleteOnWorkflowCompletion ResourceFinalizer = "DeleteOnWorkflowCompletion"
+)
+
+// HasFinalizer returns whether or not the resource template has the finalizer
+func (r *ResourceTemplate) HasFinalizer(finalizer ResourceFinalizer) bool {
+	for _, f := range r.Finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
 }
 
 // GetType returns the type of this template
@@ -1198,6 +1517,9 @@ func (tmpl *Template) GetType() TemplateType {
 	if tmpl.Suspend != nil {
 		return TemplateTypeSuspend
 	}
+	if tmpl.HTTP != nil {
+		return TemplateTypeHTTP
+	}
 	return TemplateTypeUnknown
 }
 
@@ -1213,7 +1535,7 @@ func (tmpl *Template) IsPodType() bool {
 // IsLeaf returns whether or not the template is a leaf
 func (tmpl *Template) IsLeaf() bool {
 	switch tmpl.GetType() {
-	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource:
+	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource, TemplateTypeHTTP:
 		return true
 	}
 	return false
@@ -1235,6 +1557,8 @@ type DAGTemplate struct {
 	// The FailFast flag default is true,  if set to false, it will allow a DAG to run all branches of the DAG to
 	// completion (either success or failure), regardless of the failed outcomes of branches in the DAG.
 	// More info and example about this feature at https://github.com/argoproj/argo/issues/1442
+	// Set to true explicitly, it also terminates the running tasks as soon as a task fails, rather than waiting for
+	// them to complete. Tasks which continue on failure do not terminate the others.
 	FailFast *bool `json:"failFast,omitempty" protobuf:"varint,3,opt,name=failFast"`
 }
 
@@ -1276,6 +1600,9 @@ type DAGTask struct {
 	// template, irrespective of the success, failure, or error of the
 	// primary template.
 	OnExit string `json:"onExit,omitempty" protobuf:"bytes,11,opt,name=onExit"`
+
+	// FailureThreshold stops launching the remaining items of an expanded task when too many of them failed
+	FailureThreshold *FailureThreshold `json:"failureThreshold,omitempty" protobuf:"bytes,12,opt,name=failureThreshold"`
 }
 
 var _ TemplateHolder = &DAGTask{}
@@ -1298,6 +1625,176 @@ type SuspendTemplate struct {
 	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
 }
 
+// Stream is a TCP channel between the steps or tasks producing data and the daemon step or task processing it, so
+// that the processing starts without waiting for the data to be uploaded as an artifact. The address of the channel is
+// {{steps.<name>.stream}} or {{tasks.<name>.stream}}, once the daemon is ready.
+type Stream struct {
+	// Port is the TCP port the main container of the daemon listens on
+	Port int32 `json:"port" protobuf:"varint,1,opt,name=port"`
+}
+
+// HTTP is a template subtype which performs an HTTP request from the controller, without a pod. The body of the response
+// is the result of the template.
+type HTTP struct {
+	// Method of the request, default to GET
+	Method string `json:"method,omitempty" protobuf:"bytes,1,opt,name=method"`
+	// URL of the request
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// Headers of the request
+	Headers []HTTPHeader `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// Body of the request
+	Body string `json:"body,omitempty" protobuf:"bytes,4,opt,name=body"`
+	// TimeoutSeconds is the time the request may take, default to 30 seconds, and capped by the configuration of the
+	// controller
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,5,opt,name=timeoutSeconds"`
+	// SuccessCondition is an expression of the status code and body of the response, e.g.
+	// `[response.statusCode] == 200 && jsonpath([response.body], 'status') == 'done'`, which must evaluate to true
+	// for the node to succeed. By default, the node succeeds if the status code is 2xx.
+	SuccessCondition string `json:"successCondition,omitempty" protobuf:"bytes,6,opt,name=successCondition"`
+}
+
+// HTTPHeader is a header of an HTTP request
+type HTTPHeader struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the literal value of the header
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// ValueFrom is the source of the value of the header, instead of Value
+	ValueFrom *HTTPHeaderSource `json:"valueFrom,omitempty" protobuf:"bytes,3,opt,name=valueFrom"`
+}
+
+// HTTPHeaderSource is the source of the value of an HTTP header
+type HTTPHeaderSource struct {
+	// SecretKeyRef is a key of a secret in the namespace of the workflow, e.g. a token, which the controller reads
+	SecretKeyRef *apiv1.SecretKeySelector `json:"secretKeyRef,omitempty" protobuf:"bytes,1,opt,name=secretKeyRef"`
+}
+
+// ExecutionWindow is a recurring period of time during which a template is allowed to start
+type ExecutionWindow struct {
+	// Schedule is a cron expression at which the window opens
+	Schedule string `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+
+	// Duration is how long the window stays open after each opening (e.g. 30m, 2h)
+	Duration string `json:"duration" protobuf:"bytes,2,opt,name=duration"`
+
+	// Timezone in which to evaluate the schedule (e.g. America/Los_Angeles). Defaults to the controller's local time.
+	Timezone string `json:"timezone,omitempty" protobuf:"bytes,3,opt,name=timezone"`
+}
+
+// Metrics are custom metrics emitted by the controller. The values and label values may refer to the
+// {{status}} and {{duration}} (in seconds) of the completed node.
+type Metrics struct {
+	// Prometheus is a list of prometheus metrics to be emitted
+	Prometheus []Prometheus `json:"prometheus" protobuf:"bytes,1,rep,name=prometheus"`
+}
+
+// Prometheus is a prometheus metric. Exactly one of gauge, counter or histogram must be set.
+type Prometheus struct {
+	// Name is the name of the metric
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Help is a string that describes the metric
+	Help string `json:"help" protobuf:"bytes,2,opt,name=help"`
+
+	// Labels is a list of metric labels
+	Labels []MetricLabel `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
+
+	// Gauge is set to its value
+	Gauge *Gauge `json:"gauge,omitempty" protobuf:"bytes,4,opt,name=gauge"`
+
+	// Counter is incremented by its value
+	Counter *Counter `json:"counter,omitempty" protobuf:"bytes,5,opt,name=counter"`
+
+	// Histogram observes its value
+	Histogram *Histogram `json:"histogram,omitempty" protobuf:"bytes,6,opt,name=histogram"`
+}
+
+// MetricLabel is a single label for a prometheus metric
+type MetricLabel struct {
+	Key   string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+}
+
+// Gauge is a gauge metric
+type Gauge struct {
+	// Value is the value of the gauge
+	Value string `json:"value" protobuf:"bytes,1,opt,name=value"`
+}
+
+// Counter is a counter metric
+type Counter struct {
+	// Value is the amount the counter is incremented by
+	Value string `json:"value" protobuf:"bytes,1,opt,name=value"`
+}
+
+// Histogram is a histogram metric
+type Histogram struct {
+	// Value is the value to be observed
+	Value string `json:"value" protobuf:"bytes,1,opt,name=value"`
+
+	// Buckets are the upper bounds of the histogram buckets. Defaults to the prometheus default buckets.
+	Buckets []float64 `json:"buckets,omitempty" protobuf:"fixed64,2,rep,name=buckets"`
+}
+
+// Memoize caches the outputs of a template under a key
+type Memoize struct {
+	// Key is the key to cache the outputs under. It is usually made of the inputs of the template, e.g.
+	// "{{inputs.parameters.message}}", and may only contain alphanumeric characters, '-', '_' and '.'.
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+
+	// Cache is where the outputs are cached
+	Cache *Cache `json:"cache" protobuf:"bytes,2,opt,name=cache"`
+
+	// MaxAge is how long the cached outputs are reused for, e.g. "24h". Older outputs are treated as missing, and
+	// replaced once the node succeeds. By default, the cached outputs do not expire.
+	MaxAge string `json:"maxAge,omitempty" protobuf:"bytes,3,opt,name=maxAge"`
+}
+
+// Cache is a store of memoized outputs
+type Cache struct {
+	// ConfigMap is the config map, in the namespace of the workflow, to cache the outputs in. It is created if it
+	// does not exist.
+	ConfigMap *apiv1.LocalObjectReference `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+}
+
+// Synchronization is a lock which a workflow or template must acquire before it runs. Exactly one of semaphore or
+// mutex must be set.
+type Synchronization struct {
+	// Semaphore is a lock which may be held by up to a configured number of workflows or nodes at the same time
+	Semaphore *SemaphoreRef `json:"semaphore,omitempty" protobuf:"bytes,1,opt,name=semaphore"`
+
+	// Mutex is a lock which may be held by a single workflow or node at a time
+	Mutex *Mutex `json:"mutex,omitempty" protobuf:"bytes,2,opt,name=mutex"`
+}
+
+// SemaphoreRef is a reference to the limit of a semaphore
+type SemaphoreRef struct {
+	// ConfigMapKeyRef is the key of a config map, in the namespace of the workflow, holding the number of workflows
+	// or nodes which may hold the semaphore at the same time. Changes to the limit take effect without a restart.
+	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,1,opt,name=configMapKeyRef"`
+}
+
+// Mutex is a named lock
+type Mutex struct {
+	// Name of the mutex, which is shared by all workflows of the namespace
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+}
+
+// SynchronizationStatus records the locks held by a workflow and its nodes, so that they are restored after a
+// restart of the controller
+type SynchronizationStatus struct {
+	// Holding are the locks currently held
+	Holding []LockHolding `json:"holding,omitempty" protobuf:"bytes,1,rep,name=holding"`
+}
+
+// LockHolding is a lock held by a workflow or one of its nodes
+type LockHolding struct {
+	// Lock is the name of the lock, e.g. "argo/ConfigMap/my-config/my-key" or "argo/Mutex/my-mutex"
+	Lock string `json:"lock" protobuf:"bytes,1,opt,name=lock"`
+
+	// Holder is the ID of the node holding the lock, or empty if the workflow itself holds the lock
+	Holder string `json:"holder,omitempty" protobuf:"bytes,2,opt,name=holder"`
+}
+
 // GetArtifactByName returns an input artifact by its name
 func (in *Inputs) GetArtifactByName(name string) *Artifact {
 	return in.Artifacts.GetArtifactByName(name)
@@ -1357,6 +1854,19 @@ func (args *Arguments) GetParameterByName(name string) *Parameter {
 	return nil
 }
 
+// SetDefaults sets the value of each parameter without a value to its default, and returns whether any was set
+func (args *Arguments) SetDefaults() bool {
+	defaulted := false
+	for i, param := range args.Parameters {
+		if param.Value == nil && param.Default != nil {
+			value := *param.Default
+			args.Parameters[i].Value = &value
+			defaulted = true
+		}
+	}
+	return defaulted
+}
+
 // HasLocation whether or not an artifact has a location defined
 func (a *Artifact) HasLocation() bool {
 	return a.S3.HasLocation() ||
@@ -1382,14 +1892,38 @@ func (wf *Workflow) GetTemplateScope() string {
 	return ""
 }
 
-// NodeID creates a deterministic node ID based on a node name
+// MaxNodeIDLength is the maximum length of the name of a pod, since the ID of a pod node is the name of its pod
+const MaxNodeIDLength = 253
+
+// MinNodeIDLength is the minimum length node IDs may be truncated to, which leaves room for the hash of the node name
+const MinNodeIDLength = 32
+
+// NodeID creates a deterministic node ID based on a node name. The ID is the workflow name followed by the hash of the
+// node name, whatever the depth of the node, or the workflow name for the root node. When the status says the IDs are
+// namespaced, the namespace of the workflow is hashed along with the node name, the root node's included. Any ID longer
+// than the NodeIDMaxLength of the workflow status is truncated deterministically.
 func (wf *Workflow) NodeID(name string) string {
-	if name == wf.ObjectMeta.Name {
-		return wf.ObjectMeta.Name
+	maxLength := int(wf.Status.NodeIDMaxLength)
+	if maxLength < MinNodeIDLength || maxLength > MaxNodeIDLength {
+		maxLength = MaxNodeIDLength
 	}
 	h := fnv.New32a()
+	if wf.Status.NodeIDNamespaced {
+		_, _ = h.Write([]byte(wf.ObjectMeta.Namespace + "/"))
+	}
 	_, _ = h.Write([]byte(name))
-	return fmt.Sprintf("%s-%v", wf.ObjectMeta.Name, h.Sum32())
+	id := fmt.Sprintf("%s-%v", wf.ObjectMeta.Name, h.Sum32())
+	if name == wf.ObjectMeta.Name && !wf.Status.NodeIDNamespaced {
+		id = wf.ObjectMeta.Name
+	}
+	if len(id) <= maxLength {
+		return id
+	}
+	// The workflow name is truncated so that the ID fits. The workflow name is then hashed along with the node name,
+	// so that the IDs of workflows whose names only differ after the truncation are still unique.
+	_, _ = h.Write([]byte(wf.ObjectMeta.Name))
+	suffix := fmt.Sprintf("-%v", h.Sum32())
+	return strings.TrimRight(wf.ObjectMeta.Name[:maxLength-len(suffix)], "-.") + suffix
 }
 
 // GetStoredTemplate retrieves a template from stored templates of the workflow.
@@ -1426,7 +1960,7 @@ func (wf *Workflow) SetStoredTemplate(templateScope string, holder TemplateHolde
 func (wf *Workflow) getStoredTemplateName(templateScope string, holder TemplateHolder) string {
 	tmplRef := holder.GetTemplateRef()
 	if tmplRef != nil {
-		return fmt.Sprintf("%s/%s", tmplRef.Name, tmplRef.Template)
+		return fmt.Sprintf("%s/%s", tmplRef.GetWorkflowTemplateName(), tmplRef.Template)
 	} else if templateScope != "" {
 		return fmt.Sprintf("%s/%s", templateScope, holder.GetTemplateName())
 	} else {
@@ -1466,3 +2000,14 @@ func (t *DAGTask) ContinuesOn(phase NodePhase) bool {
 func (s *WorkflowStep) ContinuesOn(phase NodePhase) bool {
 	return continues(s.ContinueOn, phase)
 }
+
+// FailureThreshold stops launching the remaining items of a step or task expanded with withItems, withParam or
+// withSequence once too many of the completed items have failed
+type FailureThreshold struct {
+	// Percent is the percentage of the completed items which may fail. Once it is exceeded, the items which have
+	// not been launched yet are skipped.
+	Percent int32 `json:"percent" protobuf:"varint,1,opt,name=percent"`
+
+	// MinCompleted is the number of items which must have completed before the threshold is applied.
+	MinCompleted int32 `json:"minCompleted,omitempty" protobuf:"varint,2,opt,name=minCompleted"`
+}
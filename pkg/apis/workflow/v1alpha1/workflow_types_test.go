@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,3 +28,37 @@ func TestNodes_FindByDisplayName(t *testing.T) {
 	assert.Nil(t, Nodes{}.FindByDisplayName(""))
 	assert.NotNil(t, Nodes{"": NodeStatus{DisplayName: "foo"}}.FindByDisplayName("foo"))
 }
+
+func TestWorkflow_NodeID(t *testing.T) {
+	wf := &Workflow{ObjectMeta: v1.ObjectMeta{Name: "my-wf"}}
+	assert.Equal(t, "my-wf", wf.NodeID("my-wf"))
+	assert.Equal(t, "my-wf-1320795735", wf.NodeID("my-wf[0].a(0:foo)"))
+
+	wf.Name = strings.Repeat("a", 250)
+	id := wf.NodeID("a")
+	assert.Len(t, id, 253)
+	assert.Equal(t, id, wf.NodeID("a"))
+	assert.NotEqual(t, id, wf.NodeID("b"))
+	// only differs after the truncation
+	other := &Workflow{ObjectMeta: v1.ObjectMeta{Name: strings.Repeat("a", 249) + "b"}}
+	assert.NotEqual(t, id, other.NodeID("a"))
+	assert.Equal(t, wf.Name, wf.NodeID(wf.Name))
+
+	wf.Status.NodeIDMaxLength = 63
+	id = wf.NodeID("a")
+	assert.Len(t, id, 63)
+	assert.NotEqual(t, id, wf.NodeID("b"))
+	// the root node is truncated too
+	assert.Len(t, wf.NodeID(wf.Name), 63)
+	wf.Name = "my-wf"
+	assert.Equal(t, "my-wf", wf.NodeID("my-wf"))
+
+	// the IDs of the workflows of the same name in different namespaces differ, the root node's included
+	wf.Namespace = "my-ns"
+	wf.Status.NodeIDNamespaced = true
+	other = &Workflow{ObjectMeta: v1.ObjectMeta{Name: "my-wf", Namespace: "other-ns"}, Status: WorkflowStatus{NodeIDNamespaced: true}}
+	assert.NotEqual(t, "my-wf", wf.NodeID("my-wf"))
+	assert.Regexp(t, "^my-wf-[0-9]+$", wf.NodeID("my-wf"))
+	assert.NotEqual(t, wf.NodeID("my-wf"), other.NodeID("my-wf"))
+	assert.NotEqual(t, wf.NodeID("my-wf[0].a(0:foo)"), other.NodeID("my-wf[0].a(0:foo)"))
+}
@@ -81,6 +81,11 @@ func (in *Artifact) DeepCopyInto(out *Artifact) {
 		*out = new(ArchiveStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SizeBytes != nil {
+		in, out := &in.SizeBytes, &out.SizeBytes
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -94,6 +99,22 @@ func (in *Artifact) DeepCopy() *Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactGC) DeepCopyInto(out *ArtifactGC) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactGC.
+func (in *ArtifactGC) DeepCopy() *ArtifactGC {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactGC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 	*out = *in
@@ -242,6 +263,64 @@ func (in *Backoff) DeepCopy() *Backoff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cache) DeepCopyInto(out *Cache) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cache.
+func (in *Cache) DeepCopy() *Cache {
+	if in == nil {
+		return nil
+	}
+	out := new(Cache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerDiagnostics) DeepCopyInto(out *ContainerDiagnostics) {
+	*out = *in
+	if in.ExitCode != nil {
+		in, out := &in.ExitCode, &out.ExitCode
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerDiagnostics.
+func (in *ContainerDiagnostics) DeepCopy() *ContainerDiagnostics {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerDiagnostics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerEnvironment) DeepCopyInto(out *ContainerEnvironment) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerEnvironment.
+func (in *ContainerEnvironment) DeepCopy() *ContainerEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContinueOn) DeepCopyInto(out *ContinueOn) {
 	*out = *in
@@ -258,6 +337,22 @@ func (in *ContinueOn) DeepCopy() *ContinueOn {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Counter) DeepCopyInto(out *Counter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Counter.
+func (in *Counter) DeepCopy() *Counter {
+	if in == nil {
+		return nil
+	}
+	out := new(Counter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronWorkflow) DeepCopyInto(out *CronWorkflow) {
 	*out = *in
@@ -407,6 +502,11 @@ func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 		*out = new(ContinueOn)
 		**out = **in
 	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(FailureThreshold)
+		**out = **in
+	}
 	return
 }
 
@@ -448,6 +548,22 @@ func (in *DAGTemplate) DeepCopy() *DAGTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionWindow) DeepCopyInto(out *ExecutionWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionWindow.
+func (in *ExecutionWindow) DeepCopy() *ExecutionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutorConfig) DeepCopyInto(out *ExecutorConfig) {
 	*out = *in
@@ -464,6 +580,38 @@ func (in *ExecutorConfig) DeepCopy() *ExecutorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureThreshold) DeepCopyInto(out *FailureThreshold) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureThreshold.
+func (in *FailureThreshold) DeepCopy() *FailureThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Gauge) DeepCopyInto(out *Gauge) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gauge.
+func (in *Gauge) DeepCopy() *Gauge {
+	if in == nil {
+		return nil
+	}
+	out := new(Gauge)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitArtifact) DeepCopyInto(out *GitArtifact) {
 	*out = *in
@@ -575,6 +723,34 @@ func (in *HDFSKrbConfig) DeepCopy() *HDFSKrbConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTP) DeepCopyInto(out *HTTP) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP.
+func (in *HTTP) DeepCopy() *HTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPArtifact) DeepCopyInto(out *HTTPArtifact) {
 	*out = *in
@@ -591,6 +767,69 @@ func (in *HTTPArtifact) DeepCopy() *HTTPArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(HTTPHeaderSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeader.
+func (in *HTTPHeader) DeepCopy() *HTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderSource) DeepCopyInto(out *HTTPHeaderSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderSource.
+func (in *HTTPHeaderSource) DeepCopy() *HTTPHeaderSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Histogram) DeepCopyInto(out *Histogram) {
+	*out = *in
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]float64, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Histogram.
+func (in *Histogram) DeepCopy() *Histogram {
+	if in == nil {
+		return nil
+	}
+	out := new(Histogram)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Inputs) DeepCopyInto(out *Inputs) {
 	*out = *in
@@ -665,6 +904,59 @@ func (in *ItemValue) DeepCopy() *ItemValue {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LockHolding) DeepCopyInto(out *LockHolding) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockHolding.
+func (in *LockHolding) DeepCopy() *LockHolding {
+	if in == nil {
+		return nil
+	}
+	out := new(LockHolding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoizationStatus) DeepCopyInto(out *MemoizationStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoizationStatus.
+func (in *MemoizationStatus) DeepCopy() *MemoizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Memoize) DeepCopyInto(out *Memoize) {
+	*out = *in
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(Cache)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Memoize.
+func (in *Memoize) DeepCopy() *Memoize {
+	if in == nil {
+		return nil
+	}
+	out := new(Memoize)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -695,6 +987,110 @@ func (in *Metadata) DeepCopy() *Metadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricLabel) DeepCopyInto(out *MetricLabel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricLabel.
+func (in *MetricLabel) DeepCopy() *MetricLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Metrics) DeepCopyInto(out *Metrics) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = make([]Prometheus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metrics.
+func (in *Metrics) DeepCopy() *Metrics {
+	if in == nil {
+		return nil
+	}
+	out := new(Metrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Mutex) DeepCopyInto(out *Mutex) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mutex.
+func (in *Mutex) DeepCopy() *Mutex {
+	if in == nil {
+		return nil
+	}
+	out := new(Mutex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDiagnostics) DeepCopyInto(out *NodeDiagnostics) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerDiagnostics, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDiagnostics.
+func (in *NodeDiagnostics) DeepCopy() *NodeDiagnostics {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDiagnostics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeEnvironment) DeepCopyInto(out *NodeEnvironment) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerEnvironment, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeEnvironment.
+func (in *NodeEnvironment) DeepCopy() *NodeEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 	*out = *in
@@ -730,6 +1126,33 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MemoizationStatus != nil {
+		in, out := &in.MemoizationStatus, &out.MemoizationStatus
+		*out = new(MemoizationStatus)
+		**out = **in
+	}
+	if in.SynchronizationStatus != nil {
+		in, out := &in.SynchronizationStatus, &out.SynchronizationStatus
+		*out = new(NodeSynchronizationStatus)
+		**out = **in
+	}
+	if in.Diagnostics != nil {
+		in, out := &in.Diagnostics, &out.Diagnostics
+		*out = new(NodeDiagnostics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourcesDuration != nil {
+		in, out := &in.ResourcesDuration, &out.ResourcesDuration
+		*out = make(ResourcesDuration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(NodeEnvironment)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -765,6 +1188,22 @@ func (in Nodes) DeepCopy() Nodes {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSynchronizationStatus) DeepCopyInto(out *NodeSynchronizationStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSynchronizationStatus.
+func (in *NodeSynchronizationStatus) DeepCopy() *NodeSynchronizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSynchronizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NoneStrategy) DeepCopyInto(out *NoneStrategy) {
 	*out = *in
@@ -886,6 +1325,42 @@ func (in *PodGC) DeepCopy() *PodGC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Prometheus) DeepCopyInto(out *Prometheus) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]MetricLabel, len(*in))
+		copy(*out, *in)
+	}
+	if in.Gauge != nil {
+		in, out := &in.Gauge, &out.Gauge
+		*out = new(Gauge)
+		**out = **in
+	}
+	if in.Counter != nil {
+		in, out := &in.Counter, &out.Counter
+		*out = new(Counter)
+		**out = **in
+	}
+	if in.Histogram != nil {
+		in, out := &in.Histogram, &out.Histogram
+		*out = new(Histogram)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Prometheus.
+func (in *Prometheus) DeepCopy() *Prometheus {
+	if in == nil {
+		return nil
+	}
+	out := new(Prometheus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RawArtifact) DeepCopyInto(out *RawArtifact) {
 	*out = *in
@@ -902,9 +1377,36 @@ func (in *RawArtifact) DeepCopy() *RawArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ResourcesDuration) DeepCopyInto(out *ResourcesDuration) {
+	{
+		in := &in
+		*out = make(ResourcesDuration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcesDuration.
+func (in ResourcesDuration) DeepCopy() ResourcesDuration {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcesDuration)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceTemplate) DeepCopyInto(out *ResourceTemplate) {
 	*out = *in
+	if in.Finalizers != nil {
+		in, out := &in.Finalizers, &out.Finalizers
+		*out = make([]ResourceFinalizer, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1001,6 +1503,27 @@ func (in *ScriptTemplate) DeepCopy() *ScriptTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SemaphoreRef) DeepCopyInto(out *SemaphoreRef) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SemaphoreRef.
+func (in *SemaphoreRef) DeepCopy() *SemaphoreRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SemaphoreRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sequence) DeepCopyInto(out *Sequence) {
 	*out = *in
@@ -1017,6 +1540,22 @@ func (in *Sequence) DeepCopy() *Sequence {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Stream) DeepCopyInto(out *Stream) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stream.
+func (in *Stream) DeepCopy() *Stream {
+	if in == nil {
+		return nil
+	}
+	out := new(Stream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuspendTemplate) DeepCopyInto(out *SuspendTemplate) {
 	*out = *in
@@ -1033,6 +1572,53 @@ func (in *SuspendTemplate) DeepCopy() *SuspendTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Synchronization) DeepCopyInto(out *Synchronization) {
+	*out = *in
+	if in.Semaphore != nil {
+		in, out := &in.Semaphore, &out.Semaphore
+		*out = new(SemaphoreRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mutex != nil {
+		in, out := &in.Mutex, &out.Mutex
+		*out = new(Mutex)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Synchronization.
+func (in *Synchronization) DeepCopy() *Synchronization {
+	if in == nil {
+		return nil
+	}
+	out := new(Synchronization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SynchronizationStatus) DeepCopyInto(out *SynchronizationStatus) {
+	*out = *in
+	if in.Holding != nil {
+		in, out := &in.Holding, &out.Holding
+		*out = make([]LockHolding, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SynchronizationStatus.
+func (in *SynchronizationStatus) DeepCopy() *SynchronizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SynchronizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TTLStrategy) DeepCopyInto(out *TTLStrategy) {
 	*out = *in
@@ -1109,6 +1695,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(Stream)
+		**out = **in
+	}
 	if in.Steps != nil {
 		in, out := &in.Steps, &out.Steps
 		*out = make([]ParallelSteps, len(*in))
@@ -1129,7 +1720,7 @@ func (in *Template) DeepCopyInto(out *Template) {
 	if in.Resource != nil {
 		in, out := &in.Resource, &out.Resource
 		*out = new(ResourceTemplate)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.DAG != nil {
 		in, out := &in.DAG, &out.DAG
@@ -1141,6 +1732,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(SuspendTemplate)
 		**out = **in
 	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTP)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]v1.Volume, len(*in))
@@ -1216,6 +1812,31 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(v1.PodSecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExecutionWindows != nil {
+		in, out := &in.ExecutionWindows, &out.ExecutionWindows
+		*out = make([]ExecutionWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(Metrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Memoize != nil {
+		in, out := &in.Memoize, &out.Memoize
+		*out = new(Memoize)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Synchronization != nil {
+		in, out := &in.Synchronization, &out.Synchronization
+		*out = new(Synchronization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1283,6 +1904,22 @@ func (in *ValueFrom) DeepCopy() *ValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeClaimGC) DeepCopyInto(out *VolumeClaimGC) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeClaimGC.
+func (in *VolumeClaimGC) DeepCopy() *VolumeClaimGC {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeClaimGC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workflow) DeepCopyInto(out *Workflow) {
 	*out = *in
@@ -1311,6 +1948,22 @@ func (in *Workflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowEnvironment) DeepCopyInto(out *WorkflowEnvironment) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowEnvironment.
+func (in *WorkflowEnvironment) DeepCopy() *WorkflowEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowList) DeepCopyInto(out *WorkflowList) {
 	*out = *in
@@ -1458,6 +2111,16 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(PodGC)
 		**out = **in
 	}
+	if in.VolumeClaimGC != nil {
+		in, out := &in.VolumeClaimGC, &out.VolumeClaimGC
+		*out = new(VolumeClaimGC)
+		**out = **in
+	}
+	if in.ArtifactGC != nil {
+		in, out := &in.ArtifactGC, &out.ArtifactGC
+		*out = new(ArtifactGC)
+		**out = **in
+	}
 	if in.PodPriority != nil {
 		in, out := &in.PodPriority, &out.PodPriority
 		*out = new(int32)
@@ -1475,6 +2138,25 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(v1.PodSecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]v1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Synchronization != nil {
+		in, out := &in.Synchronization, &out.Synchronization
+		*out = new(Synchronization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1519,6 +2201,33 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 		*out = new(Outputs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Synchronization != nil {
+		in, out := &in.Synchronization, &out.Synchronization
+		*out = new(SynchronizationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourcesToDelete != nil {
+		in, out := &in.ResourcesToDelete, &out.ResourcesToDelete
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArtifactManifest != nil {
+		in, out := &in.ArtifactManifest, &out.ArtifactManifest
+		*out = new(Artifact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourcesDuration != nil {
+		in, out := &in.ResourcesDuration, &out.ResourcesDuration
+		*out = make(ResourcesDuration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(WorkflowEnvironment)
+		**out = **in
+	}
 	return
 }
 
@@ -1558,6 +2267,11 @@ func (in *WorkflowStep) DeepCopyInto(out *WorkflowStep) {
 		*out = new(ContinueOn)
 		**out = **in
 	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(FailureThreshold)
+		**out = **in
+	}
 	return
 }
 
@@ -0,0 +1,127 @@
+// Package client contains the helpers for Go programs which submit and monitor workflows. The typed clientset,
+// informers and listers are generated into its sub-packages.
+package client
+
+import (
+	"context"
+	"fmt"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	wfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/packer"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+// WatchWorkflow calls fn with the current state of a workflow, and then with every update to it, until the workflow
+// completes, fn returns an error or the context is done. The updates are watched from the resource version of the
+// current state, so that none is missed when the watch is re-established. Compressed node statuses are decompressed,
+// but offloaded node statuses are not available to clients.
+func WatchWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, name string, fn func(wf *wfv1.Workflow) error) error {
+	wf, err := wfIf.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	watcher, err := watchtools.NewRetryWatcher(wf.ResourceVersion, &cache.ListWatch{
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return wfIf.Watch(options)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+	for {
+		err = packer.DecompressWorkflow(wf)
+		if err != nil {
+			return err
+		}
+		err = fn(wf)
+		if err != nil {
+			return err
+		}
+		if !wf.Status.FinishedAt.IsZero() {
+			return nil
+		}
+		wf, err = nextWorkflow(ctx, watcher, name)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// nextWorkflow waits for the next update of a watched workflow
+func nextWorkflow(ctx context.Context, watcher watch.Interface, name string) (*wfv1.Workflow, error) {
+	select {
+	case event, ok := <-watcher.ResultChan():
+		if !ok {
+			return nil, fmt.Errorf("stopped watching workflow %s before it completed", name)
+		}
+		switch event.Type {
+		case watch.Error:
+			return nil, apierr.FromObject(event.Object)
+		case watch.Deleted:
+			return nil, fmt.Errorf("workflow %s was deleted before it completed", name)
+		}
+		wf, ok := event.Object.(*wfv1.Workflow)
+		if !ok {
+			return nil, fmt.Errorf("unexpected %T while watching workflow %s", event.Object, name)
+		}
+		return wf, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// WaitForWorkflow blocks until a workflow completes and returns the completed workflow
+func WaitForWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, name string) (*wfv1.Workflow, error) {
+	var completed *wfv1.Workflow
+	err := WatchWorkflow(ctx, wfIf, name, func(wf *wfv1.Workflow) error {
+		completed = wf
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return completed, nil
+}
+
+// SubmitAndWait submits a workflow and blocks until it completes
+func SubmitAndWait(ctx context.Context, wfIf v1alpha1.WorkflowInterface, wfClientset wfclientset.Interface, namespace string, wf *wfv1.Workflow, opts *util.SubmitOpts) (*wfv1.Workflow, error) {
+	created, err := util.SubmitWorkflow(wfIf, wfClientset, namespace, wf, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil && (opts.DryRun || opts.ServerDryRun) {
+		return created, nil
+	}
+	return WaitForWorkflow(ctx, wfIf, created.Name)
+}
+
+// WatchNodes calls fn with the nodes of a workflow whenever they first appear or their phase changes, until the
+// workflow completes, fn returns an error or the context is done
+func WatchNodes(ctx context.Context, wfIf v1alpha1.WorkflowInterface, name string, fn func(node wfv1.NodeStatus) error) error {
+	phases := make(map[string]wfv1.NodePhase)
+	return WatchWorkflow(ctx, wfIf, name, func(wf *wfv1.Workflow) error {
+		for id, node := range wf.Status.Nodes {
+			if phase, ok := phases[id]; ok && phase == node.Phase {
+				continue
+			}
+			phases[id] = node.Phase
+			err := fn(node)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
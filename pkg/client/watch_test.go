@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	fakeClientset "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+)
+
+var watchedWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: watched
+  resourceVersion: "1"
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+status:
+  phase: Running
+  nodes:
+    watched:
+      id: watched
+      name: watched
+      phase: Running
+`
+
+func unmarshalWF(yamlStr string) *wfv1.Workflow {
+	var wf wfv1.Workflow
+	err := yaml.Unmarshal([]byte(yamlStr), &wf)
+	if err != nil {
+		panic(err)
+	}
+	return &wf
+}
+
+func TestWatchNodes(t *testing.T) {
+	clientset := fakeClientset.NewSimpleClientset()
+	watcher := watch.NewFake()
+	clientset.PrependWatchReactor("workflows", k8stesting.DefaultWatchReactor(watcher, nil))
+	wfIf := clientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfIf.Create(unmarshalWF(watchedWorkflow))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	nodes := make(chan wfv1.NodeStatus)
+	done := make(chan error)
+	go func() {
+		done <- WatchNodes(ctx, wfIf, "watched", func(node wfv1.NodeStatus) error {
+			nodes <- node
+			return nil
+		})
+	}()
+
+	node := <-nodes
+	assert.Equal(t, wfv1.NodeRunning, node.Phase)
+
+	wf = wf.DeepCopy()
+	wf.ResourceVersion = "2"
+	wf.Status.Phase = wfv1.NodeSucceeded
+	wf.Status.FinishedAt = metav1.Time{Time: time.Now()}
+	node = wf.Status.Nodes["watched"]
+	node.Phase = wfv1.NodeSucceeded
+	wf.Status.Nodes["watched"] = node
+	watcher.Modify(wf)
+
+	node = <-nodes
+	assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+	assert.NoError(t, <-done, "watch stops once the workflow completed")
+}
+
+func TestWatchWorkflowErrors(t *testing.T) {
+	clientset := fakeClientset.NewSimpleClientset()
+	watcher := watch.NewFake()
+	clientset.PrependWatchReactor("workflows", k8stesting.DefaultWatchReactor(watcher, nil))
+	wfIf := clientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfIf.Create(unmarshalWF(watchedWorkflow))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		done <- WatchWorkflow(ctx, wfIf, "watched", func(wf *wfv1.Workflow) error { return nil })
+	}()
+
+	wf = wf.DeepCopy()
+	wf.ResourceVersion = "2"
+	wf.Status.Nodes = nil
+	wf.Status.CompressedNodes = "not compressed"
+	watcher.Modify(wf)
+	assert.Error(t, <-done, "decode errors are returned")
+}
+
+func TestWaitForWorkflow(t *testing.T) {
+	wfIf := fakeClientset.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	wf := unmarshalWF(watchedWorkflow)
+	wf.Status.Phase = wfv1.NodeFailed
+	wf.Status.FinishedAt = metav1.Time{Time: time.Now()}
+	_, err := wfIf.Create(wf)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	completed, err := WaitForWorkflow(ctx, wfIf, "watched")
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.NodeFailed, completed.Status.Phase)
+	}
+
+	_, err = WaitForWorkflow(ctx, wfIf, "missing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package fixtures
+
+import (
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// NodeCondition selects the nodes of a workflow to check
+type NodeCondition func(node wfv1.NodeStatus) bool
+
+// NodeWithDisplayName selects the nodes with the display name, e.g. the name of a step or task
+func NodeWithDisplayName(displayName string) NodeCondition {
+	return func(node wfv1.NodeStatus) bool {
+		return node.DisplayName == displayName
+	}
+}
+
+// NodeWithTemplateName selects the nodes which ran the template
+func NodeWithTemplateName(templateName string) NodeCondition {
+	return func(node wfv1.NodeStatus) bool {
+		return node.TemplateName == templateName
+	}
+}
+
+// NodeWithType selects the nodes of the type, e.g. pods
+func NodeWithType(nodeType wfv1.NodeType) NodeCondition {
+	return func(node wfv1.NodeStatus) bool {
+		return node.Type == nodeType
+	}
+}
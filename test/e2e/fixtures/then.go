@@ -1,6 +1,7 @@
 package fixtures
 
 import (
+	"sort"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
@@ -11,6 +12,8 @@ import (
 	"github.com/argoproj/argo/persist/sqldb"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/hydrator"
 )
 
 type Then struct {
@@ -30,18 +33,62 @@ func (t *Then) Expect(block func(t *testing.T, metadata *metav1.ObjectMeta, stat
 		t.t.Fatal("No workflow to test")
 	}
 	log.WithFields(log.Fields{"workflow": t.workflowName}).Info("Checking expectation")
+	wf := t.getWorkflow()
+	block(t.t, &wf.ObjectMeta, &wf.Status)
+	return t
+}
+
+// getWorkflow returns the workflow under test, with its offloaded or compressed nodes loaded
+func (t *Then) getWorkflow() *wfv1.Workflow {
 	wf, err := t.client.Get(t.workflowName, metav1.GetOptions{})
 	if err != nil {
 		t.t.Fatal(err)
 	}
-	if wf.Status.IsOffloadNodeStatus() {
-		offloadedNodes, err := t.offloadNodeStatusRepo.Get(string(wf.UID), wf.GetOffloadNodeStatusVersion())
-		if err != nil {
-			t.t.Fatal(err)
+	err = hydrator.New(t.offloadNodeStatusRepo, config.NodeStatusStorageOffload).Hydrate(wf)
+	if err != nil {
+		t.t.Fatal(err)
+	}
+	return wf
+}
+
+// ExpectNode checks the first node of the workflow which meets the condition, failing the test if there is none
+func (t *Then) ExpectNode(condition NodeCondition, block func(t *testing.T, node *wfv1.NodeStatus)) *Then {
+	return t.ExpectNodeChildren(condition, func(t *testing.T, node *wfv1.NodeStatus, _ []wfv1.NodeStatus) {
+		block(t, node)
+	})
+}
+
+// ExpectNodeChildren checks the first node of the workflow which meets the condition, along with its children in
+// the order they were created
+func (t *Then) ExpectNodeChildren(condition NodeCondition, block func(t *testing.T, node *wfv1.NodeStatus, children []wfv1.NodeStatus)) *Then {
+	if t.workflowName == "" {
+		t.t.Fatal("No workflow to test")
+	}
+	log.WithFields(log.Fields{"workflow": t.workflowName}).Info("Checking node expectation")
+	wf := t.getWorkflow()
+	var ids []string
+	for id := range wf.Status.Nodes {
+		ids = append(ids, id)
+	}
+	// make the chosen node deterministic when several nodes meet the condition
+	sort.Strings(ids)
+	for _, id := range ids {
+		node := wf.Status.Nodes[id]
+		if !condition(node) {
+			continue
 		}
-		wf.Status.Nodes = offloadedNodes
+		var children []wfv1.NodeStatus
+		for _, childID := range node.Children {
+			child, ok := wf.Status.Nodes[childID]
+			if !ok {
+				t.t.Fatalf("Child %s of node %s not found", childID, node.Name)
+			}
+			children = append(children, child)
+		}
+		block(t.t, &node, children)
+		return t
 	}
-	block(t.t, &wf.ObjectMeta, &wf.Status)
+	t.t.Fatal("No node meets the condition")
 	return t
 }
 
@@ -15,7 +15,8 @@ import (
 	"github.com/argoproj/argo/persist/sqldb"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
-	"github.com/argoproj/argo/workflow/packer"
+	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/hydrator"
 )
 
 type When struct {
@@ -116,18 +117,12 @@ func (w *When) WaitForWorkflowCondition(test func(wf *wfv1.Workflow) bool, condi
 }
 
 func (w *When) hydrateWorkflow(wf *wfv1.Workflow) {
-	err := packer.DecompressWorkflow(wf)
+	err := hydrator.New(w.offloadNodeStatusRepo, config.NodeStatusStorageOffload).Hydrate(wf)
 	if err != nil {
 		w.t.Fatal(err)
 	}
-	if wf.Status.IsOffloadNodeStatus() && w.offloadNodeStatusRepo.IsEnabled() {
-		offloadedNodes, err := w.offloadNodeStatusRepo.Get(string(wf.UID), wf.GetOffloadNodeStatusVersion())
-		if err != nil {
-			w.t.Fatal(err)
-		}
-		wf.Status.Nodes = offloadedNodes
-	}
 }
+
 func (w *When) WaitForWorkflowToStart(timeout time.Duration) *When {
 	return w.WaitForWorkflowCondition(func(wf *wfv1.Workflow) bool {
 		return !wf.Status.StartedAt.IsZero()
@@ -140,6 +135,13 @@ func (w *When) WaitForWorkflow(timeout time.Duration) *When {
 	}, "to finish", timeout)
 }
 
+// WaitForWorkflowPhase waits for the workflow to be in the phase, e.g. to check a workflow while it is running
+func (w *When) WaitForWorkflowPhase(phase wfv1.NodePhase, timeout time.Duration) *When {
+	return w.WaitForWorkflowCondition(func(wf *wfv1.Workflow) bool {
+		return wf.Status.Phase == phase
+	}, fmt.Sprintf("to be %s", phase), timeout)
+}
+
 func (w *When) Wait(timeout time.Duration) *When {
 	logCtx := log.WithFields(log.Fields{"cronWorkflow": w.cronWorkflowName})
 	logCtx.Infof("Waiting for %s", humanize.Duration(timeout))
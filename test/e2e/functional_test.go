@@ -75,6 +75,12 @@ spec:
 				assert.Len(t, nodeStatus.Children, 1)
 				assert.Len(t, nodeStatus.OutboundNodes, 1)
 			}
+		}).
+		ExpectNodeChildren(fixtures.NodeWithDisplayName("B"), func(t *testing.T, _ *wfv1.NodeStatus, children []wfv1.NodeStatus) {
+			if assert.Len(t, children, 1) {
+				assert.Equal(t, "B-1", children[0].DisplayName)
+				assert.Equal(t, wfv1.NodeFailed, children[0].Phase)
+			}
 		})
 }
 
@@ -110,13 +110,13 @@ export class WorkflowDag extends React.Component<WorkflowDagProps> {
 
     private getOutboundNodes(nodeID: string): string[] {
         const node = this.props.workflow.status.nodes[nodeID];
-        if (node.type === 'Pod' || node.type === 'Skipped') {
+        if (node.type === 'Pod' || node.type === 'HTTP' || node.type === 'Skipped') {
             return [node.id];
         }
         let outbound = Array<string>();
         for (const outboundNodeID of node.outboundNodes || []) {
             const outNode = this.props.workflow.status.nodes[outboundNodeID];
-            if (outNode.type === 'Pod') {
+            if (outNode.type === 'Pod' || outNode.type === 'HTTP') {
                 outbound.push(outboundNodeID);
             } else {
                 outbound = outbound.concat(this.getOutboundNodes(outboundNodeID));
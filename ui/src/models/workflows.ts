@@ -472,6 +472,16 @@ export interface SidecarOptions {
 /**
  * Template is a reusable and composable unit of execution in a workflow
  */
+/**
+ * Stream is a TCP channel between the steps or tasks producing data and the daemon step or task processing it
+ */
+export interface Stream {
+    /**
+     * Port is the TCP port the main container of the daemon listens on
+     */
+    port: number;
+}
+
 export interface Template {
     /**
      * Optional duration in seconds relative to the StartTime that the pod may be active on a node before the system actively tries to terminate the pod;
@@ -496,6 +506,10 @@ export interface Template {
      * Deamon will allow a workflow to proceed to the next step so long as the container reaches readiness
      */
     daemon?: boolean;
+    /**
+     * Stream is the channel a daemon receives data on from the steps or tasks after it, while they produce it
+     */
+    stream?: Stream;
     /**
      * Inputs describe what inputs parameters and artifacts are supplied to this template
      */
@@ -609,7 +623,7 @@ export function compareWorkflows(first: Workflow, second: Workflow) {
     return moment(jStart).diff(iStart);
 }
 
-export type NodeType = 'Pod' | 'Steps' | 'StepGroup' | 'DAG' | 'Retry' | 'Skipped' | 'TaskGroup' | 'Suspend';
+export type NodeType = 'Pod' | 'Steps' | 'StepGroup' | 'DAG' | 'Retry' | 'Skipped' | 'TaskGroup' | 'Suspend' | 'HTTP';
 
 export interface NodeStatus {
     /**
@@ -715,6 +729,16 @@ export interface NodeStatus {
      * TemplateScope is the template scope in which the template of this node was retrieved.
      */
     templateScope?: string;
+
+    /**
+     * EstimatedDuration is the duration in seconds the node is estimated to take
+     */
+    estimatedDuration?: number;
+
+    /**
+     * Progress is the number of completed pods and HTTP requests of the node and its descendants out of the number known so far, e.g. "3/5"
+     */
+    progress?: string;
 }
 
 export interface TemplateRef {
@@ -762,6 +786,16 @@ export interface WorkflowStatus {
      * StoredTemplates is a mapping between a template ref and the node's status.
      */
     storedTemplates: {[name: string]: Template};
+
+    /**
+     * EstimatedDuration is the duration in seconds the workflow is estimated to take
+     */
+    estimatedDuration?: number;
+
+    /**
+     * Progress is the number of completed pods and HTTP requests of the workflow out of the number known so far, e.g. "3/5"
+     */
+    progress?: string;
 }
 
 /**
@@ -39,6 +39,13 @@ const (
 	EventReasonWorkflowSucceded = "WorkflowSucceeded"
 	EventReasonWorkflowFailed   = "WorkflowFailed"
 	EventReasonWorkflowTimedOut = "WorkflowTimedOut"
+	EventReasonNodeFailed       = "WorkflowNodeFailed"
+	EventReasonNodeError        = "WorkflowNodeError"
+	EventReasonPodCreationError = "PodCreationError"
+	EventReasonQuarantined      = "WorkflowQuarantined"
+	EventReasonWorkflowRejected = "WorkflowRejected"
+	// EventReasonArtifactManifestTooLarge is the reason the artifact manifest of a workflow was not saved
+	EventReasonArtifactManifestTooLarge = "ArtifactManifestTooLarge"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]interface{}) {
@@ -41,6 +41,13 @@ func PanicLoggerStreamServerInterceptor(log *log.Entry) grpc.StreamServerInterce
 func translateError(err error) error {
 	switch e := err.(type) {
 	case *errors.StatusError:
+		// conflicts and already existing resources share the status code 409, but not the reason
+		if errors.IsAlreadyExists(e) {
+			return status.Error(codes.AlreadyExists, e.Error())
+		}
+		if errors.IsConflict(e) {
+			return status.Error(codes.Aborted, e.Error())
+		}
 		switch e.Status().Code {
 		case 400:
 			return status.Error(codes.InvalidArgument, e.Error())
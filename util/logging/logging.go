@@ -0,0 +1,110 @@
+package logging
+
+import (
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// The fields of the log entries about workflows, which are the same in every component so that the entries of a
+// workflow can be aggregated
+const (
+	// FieldNamespace is the namespace of the workflow
+	FieldNamespace = "namespace"
+	// FieldWorkflow is the name of the workflow
+	FieldWorkflow = "workflow"
+	// FieldWorkflowUID is the UID of the workflow, which tells apart workflows of the same name
+	FieldWorkflowUID = "workflowUID"
+	// FieldNodeID is the ID of the node of the workflow
+	FieldNodeID = "nodeID"
+	// FieldPod is the name of the pod of the node
+	FieldPod = "pod"
+	// FieldReconciliation is the sequence number of the reconciliation of the workflow in the controller, which groups
+	// the entries of a single reconciliation
+	FieldReconciliation = "reconciliation"
+)
+
+// WithWorkflow returns an entry with the fields of a workflow
+func WithWorkflow(namespace, name, uid string) *log.Entry {
+	return log.WithFields(log.Fields{FieldNamespace: namespace, FieldWorkflow: name, FieldWorkflowUID: uid})
+}
+
+// Format is the format of the log entries
+type Format string
+
+const (
+	// FormatText writes entries as key=value pairs
+	FormatText Format = "text"
+	// FormatJSON writes every entry as a JSON object
+	FormatJSON Format = "json"
+)
+
+var (
+	mutex         sync.Mutex
+	defaultLevel  = log.InfoLevel
+	defaultFormat = FormatText
+)
+
+// SetDefault configures the level and format of the logs, and makes them the defaults which Configure falls back on
+func SetDefault(level string, format string) error {
+	l, f, err := parse(level, format)
+	if err != nil {
+		return err
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	defaultLevel, defaultFormat = l, f
+	apply(l, f)
+	return nil
+}
+
+// Configure sets the level and format of the logs. An empty level or format reverts to the default.
+func Configure(level string, format string) error {
+	l, f, err := parse(level, format)
+	if err != nil {
+		return err
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if level == "" {
+		l = defaultLevel
+	}
+	if format == "" {
+		f = defaultFormat
+	}
+	apply(l, f)
+	return nil
+}
+
+func parse(level string, format string) (log.Level, Format, error) {
+	l := log.InfoLevel
+	if level != "" {
+		var err error
+		l, err = log.ParseLevel(level)
+		if err != nil {
+			return l, "", err
+		}
+	}
+	f := Format(format)
+	switch f {
+	case "":
+		f = FormatText
+	case FormatText, FormatJSON:
+	default:
+		return l, "", fmt.Errorf("unknown log format '%s', must be one of: %s, %s", format, FormatText, FormatJSON)
+	}
+	return l, f, nil
+}
+
+func apply(level log.Level, format Format) {
+	if log.GetLevel() != level {
+		log.Infof("Setting the log level to %s", level)
+	}
+	log.SetLevel(level)
+	if format == FormatJSON {
+		log.SetFormatter(&log.JSONFormatter{})
+	} else {
+		log.SetFormatter(&log.TextFormatter{})
+	}
+}
@@ -0,0 +1,35 @@
+package logging
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigure(t *testing.T) {
+	defer func() {
+		_ = SetDefault("info", "text")
+	}()
+	assert.NoError(t, SetDefault("warn", ""))
+	assert.Equal(t, log.WarnLevel, log.GetLevel())
+	assert.IsType(t, &log.TextFormatter{}, log.StandardLogger().Formatter)
+
+	assert.NoError(t, Configure("debug", "json"))
+	assert.Equal(t, log.DebugLevel, log.GetLevel())
+	assert.IsType(t, &log.JSONFormatter{}, log.StandardLogger().Formatter)
+
+	// revert to the default
+	assert.NoError(t, Configure("", ""))
+	assert.Equal(t, log.WarnLevel, log.GetLevel())
+	assert.IsType(t, &log.TextFormatter{}, log.StandardLogger().Formatter)
+
+	assert.Error(t, Configure("verbose", ""))
+	assert.EqualError(t, Configure("", "xml"), "unknown log format 'xml', must be one of: text, json")
+	assert.Equal(t, log.WarnLevel, log.GetLevel())
+}
+
+func TestWithWorkflow(t *testing.T) {
+	entry := WithWorkflow("argo", "hello-world", "6f2b1c4a")
+	assert.Equal(t, log.Fields{FieldNamespace: "argo", FieldWorkflow: "hello-world", FieldWorkflowUID: "6f2b1c4a"}, entry.Data)
+}
@@ -70,3 +70,27 @@ func (a *ArtifactoryArtifactDriver) Save(path string, artifact *wfv1.Artifact) e
 	}
 	return nil
 }
+
+// Delete artifact from an artifactory URL
+func (a *ArtifactoryArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	req, err := http.NewRequest(http.MethodDelete, artifact.Artifactory.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(a.Username, a.Password)
+	res, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	// the artifact may have been deleted already
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.InternalErrorf("deleting file from artifactory failed with reason:%s", res.Status)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package artifactory_test
 
 import (
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -59,3 +61,30 @@ func TestSaveAndLoad(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, fileContent, string(dat))
 }
+
+func TestDelete(t *testing.T) {
+	deleted := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		if deleted[r.URL.Path] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		deleted[r.URL.Path] = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	artifact := &wfv1.Artifact{}
+	artifact.Artifactory = &wfv1.ArtifactoryArtifact{
+		URL: server.URL + "/artifactory/" + RepoName + "/" + SaveFileName,
+	}
+	driver := &art.ArtifactoryArtifactDriver{
+		Username: Username,
+		Password: Password,
+	}
+	assert.NoError(t, driver.Delete(artifact))
+	assert.True(t, deleted["/artifactory/"+RepoName+"/"+SaveFileName])
+	// deleting an artifact which does not exist anymore succeeds
+	assert.NoError(t, driver.Delete(artifact))
+}
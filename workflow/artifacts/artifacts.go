@@ -22,6 +22,12 @@ type ArtifactDriver interface {
 	Save(path string, outputArtifact *wfv1.Artifact) error
 }
 
+// ArtifactDeleter is implemented by the artifact drivers which are able to delete artifacts
+type ArtifactDeleter interface {
+	// Delete removes the artifact from its destination
+	Delete(artifact *wfv1.Artifact) error
+}
+
 var ErrUnsupportedDriver = fmt.Errorf("unsupported artifact driver")
 
 // NewDriver initializes an instance of an artifact driver
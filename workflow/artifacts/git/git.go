@@ -87,6 +87,9 @@ func gitClone(path string, inputArtifact *wfv1.Artifact, auth transport.AuthMeth
 		RecurseSubmodules: git.DefaultSubmoduleRecursionDepth,
 		Auth:              auth,
 	}
+	if inputArtifact.Git.DisableSubmodules {
+		cloneOptions.RecurseSubmodules = git.NoRecurseSubmodules
+	}
 	if inputArtifact.Git.Depth != nil {
 		cloneOptions.Depth = int(*inputArtifact.Git.Depth)
 	}
@@ -135,6 +138,9 @@ func gitClone(path string, inputArtifact *wfv1.Artifact, auth transport.AuthMeth
 			return errors.InternalWrapError(err)
 		}
 		log.Errorf("`%s` stdout:\n%s", cmd.Args, string(output))
+		if inputArtifact.Git.DisableSubmodules {
+			return nil
+		}
 		if privateKey != "" {
 			err := writePrivateKey(privateKey, inputArtifact.Git.InsecureIgnoreHostKey)
 			if err != nil {
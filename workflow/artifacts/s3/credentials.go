@@ -0,0 +1,107 @@
+package s3
+
+import (
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/minio/minio-go/pkg/credentials"
+)
+
+// credentialsExpiryWindow is how long before they expire temporary credentials are renewed, so that a request is not
+// signed with credentials which expire before it is done
+const credentialsExpiryWindow = 5 * time.Minute
+
+// envVarWebIdentityTokenFile is the file of the web identity token, e.g. the projected service account token which
+// IAM roles for service accounts (IRSA) mount in the pod, which is exchanged for the credentials of the role of
+// AWS_ROLE_ARN
+const envVarWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+// refreshingProvider adapts the credentials of the AWS SDK, which refresh themselves, to the minio client. The minio
+// client gets the credentials before each request, including each part of a multipart upload, so long transfers go on
+// with new credentials once the old ones expire.
+type refreshingProvider struct {
+	creds *awscredentials.Credentials
+}
+
+// Retrieve returns the credentials, which are renewed first if they are about to expire
+func (p *refreshingProvider) Retrieve() (credentials.Value, error) {
+	if p.expiresSoon() {
+		p.creds.Expire()
+	}
+	value, err := p.creds.Get()
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	return credentials.Value{
+		AccessKeyID:     value.AccessKeyID,
+		SecretAccessKey: value.SecretAccessKey,
+		SessionToken:    value.SessionToken,
+		SignerType:      credentials.SignatureV4,
+	}, nil
+}
+
+// IsExpired returns whether the credentials are expired or about to expire
+func (p *refreshingProvider) IsExpired() bool {
+	return p.creds.IsExpired() || p.expiresSoon()
+}
+
+func (p *refreshingProvider) expiresSoon() bool {
+	expiresAt, err := p.creds.ExpiresAt()
+	// credentials which do not expire have no expiry time
+	return err == nil && time.Now().Add(credentialsExpiryWindow).After(expiresAt)
+}
+
+// newCredentials returns the credentials of the driver, which are, by order of precedence:
+//
+//   - those of the role of RoleARN, assumed with the access key if any, and otherwise with the default credentials of
+//     the AWS SDK, e.g. those of the web identity token or of the instance
+//   - the access key
+//   - those of the role of AWS_ROLE_ARN, exchanged for the web identity token of AWS_WEB_IDENTITY_TOKEN_FILE, e.g. with
+//     IRSA on EKS, or with a projected service account token on GKE whose issuer AWS trusts
+//   - those of the IAM role of the instance
+//
+// All the credentials but the access key are temporary, and are renewed before they expire.
+func (s3Driver *S3ArtifactDriver) newCredentials() (*credentials.Credentials, error) {
+	switch {
+	case s3Driver.RoleARN != "":
+		sess, err := s3Driver.newSession()
+		if err != nil {
+			return nil, err
+		}
+		return credentials.New(&refreshingProvider{creds: stscreds.NewCredentials(sess, s3Driver.RoleARN)}), nil
+	case s3Driver.AccessKey != "":
+		return credentials.NewStaticV4(s3Driver.AccessKey, s3Driver.SecretKey, ""), nil
+	case os.Getenv(envVarWebIdentityTokenFile) != "":
+		// the default credentials of the AWS SDK are those of the web identity token when it is set
+		sess, err := s3Driver.newSession()
+		if err != nil {
+			return nil, err
+		}
+		return credentials.New(&refreshingProvider{creds: sess.Config.Credentials}), nil
+	}
+	return credentials.NewIAM(""), nil
+}
+
+// newSession returns the session of the AWS SDK the temporary credentials are retrieved with, from STS
+func (s3Driver *S3ArtifactDriver) newSession() (*session.Session, error) {
+	config := aws.NewConfig()
+	if s3Driver.Region != "" {
+		config = config.WithRegion(s3Driver.Region)
+	}
+	if s3Driver.AccessKey != "" {
+		config = config.WithCredentials(awscredentials.NewStaticCredentials(s3Driver.AccessKey, s3Driver.SecretKey, ""))
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+	if aws.StringValue(sess.Config.Region) == "" {
+		// the global endpoint of STS
+		sess.Config.Region = aws.String("us-east-1")
+	}
+	return sess, nil
+}
@@ -0,0 +1,45 @@
+package s3
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/minio/minio-go/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+// expiringProvider returns new temporary credentials, which expire after expiresIn, each time they are retrieved
+type expiringProvider struct {
+	awscredentials.Expiry
+	expiresIn time.Duration
+	retrieved int
+}
+
+func (p *expiringProvider) Retrieve() (awscredentials.Value, error) {
+	p.retrieved++
+	p.SetExpiration(time.Now().Add(p.expiresIn), 0)
+	return awscredentials.Value{AccessKeyID: strconv.Itoa(p.retrieved)}, nil
+}
+
+func TestRefreshingProvider(t *testing.T) {
+	provider := &expiringProvider{expiresIn: time.Hour}
+	creds := credentials.New(&refreshingProvider{creds: awscredentials.NewCredentials(provider)})
+	for i := 0; i < 2; i++ {
+		value, err := creds.Get()
+		if assert.NoError(t, err) {
+			assert.Equal(t, "1", value.AccessKeyID)
+		}
+	}
+
+	// the credentials are renewed before they expire
+	provider = &expiringProvider{expiresIn: time.Minute}
+	creds = credentials.New(&refreshingProvider{creds: awscredentials.NewCredentials(provider)})
+	for i := 1; i <= 2; i++ {
+		value, err := creds.Get()
+		if assert.NoError(t, err) {
+			assert.Equal(t, strconv.Itoa(i), value.AccessKeyID)
+		}
+	}
+}
@@ -2,13 +2,15 @@ package s3
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/minio/minio-go"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/argoproj/pkg/file"
-	argos3 "github.com/argoproj/pkg/s3"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/workflow/common"
@@ -24,18 +26,20 @@ type S3ArtifactDriver struct {
 	RoleARN   string
 }
 
-// newMinioClient instantiates a new minio client object.
-func (s3Driver *S3ArtifactDriver) newS3Client() (argos3.S3Client, error) {
-	opts := argos3.S3ClientOpts{
-		Endpoint:  s3Driver.Endpoint,
-		Region:    s3Driver.Region,
-		Secure:    s3Driver.Secure,
-		AccessKey: s3Driver.AccessKey,
-		SecretKey: s3Driver.SecretKey,
-		RoleARN:   s3Driver.RoleARN,
-		Trace:     os.Getenv(common.EnvVarArgoTrace) == "1",
+// newMinioClient instantiates a minio client with the credentials of the driver
+func (s3Driver *S3ArtifactDriver) newMinioClient() (*minio.Client, error) {
+	creds, err := s3Driver.newCredentials()
+	if err != nil {
+		return nil, err
 	}
-	return argos3.NewS3Client(opts)
+	minioClient, err := minio.NewWithCredentials(s3Driver.Endpoint, creds, s3Driver.Secure, s3Driver.Region)
+	if err != nil {
+		return nil, err
+	}
+	if os.Getenv(common.EnvVarArgoTrace) == "1" {
+		minioClient.TraceOn(log.StandardLogger().Out)
+	}
+	return minioClient, nil
 }
 
 // Load downloads artifacts from S3 compliant storage
@@ -43,21 +47,21 @@ func (s3Driver *S3ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string
 	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
 		func() (bool, error) {
 			log.Infof("S3 Load path: %s, key: %s", path, inputArtifact.S3.Key)
-			s3cli, err := s3Driver.newS3Client()
+			minioClient, err := s3Driver.newMinioClient()
 			if err != nil {
 				log.Warnf("Failed to create new S3 client: %v", err)
 				return false, nil
 			}
-			origErr := s3cli.GetFile(inputArtifact.S3.Bucket, inputArtifact.S3.Key, path)
+			origErr := minioClient.FGetObject(inputArtifact.S3.Bucket, inputArtifact.S3.Key, path, minio.GetObjectOptions{})
 			if origErr == nil {
 				return true, nil
 			}
-			if !argos3.IsS3ErrCode(origErr, "NoSuchKey") {
+			if minio.ToErrorResponse(origErr).Code != "NoSuchKey" {
 				log.Warnf("Failed get file: %v", origErr)
 				return false, nil
 			}
 			// If we get here, the error was a NoSuchKey. The key might be a s3 "directory"
-			isDir, err := s3cli.IsDirectory(inputArtifact.S3.Bucket, inputArtifact.S3.Key)
+			isDir, err := isDirectory(minioClient, inputArtifact.S3.Bucket, inputArtifact.S3.Key)
 			if err != nil {
 				log.Warnf("Failed to test if %s is a directory: %v", inputArtifact.S3.Bucket, err)
 				return false, nil
@@ -67,7 +71,7 @@ func (s3Driver *S3ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string
 				return false, origErr
 			}
 
-			if err = s3cli.GetDirectory(inputArtifact.S3.Bucket, inputArtifact.S3.Key, path); err != nil {
+			if err = getDirectory(minioClient, inputArtifact.S3.Bucket, inputArtifact.S3.Key, path); err != nil {
 				log.Warnf("Failed get directory: %v", err)
 				return false, nil
 			}
@@ -82,7 +86,7 @@ func (s3Driver *S3ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifac
 	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
 		func() (bool, error) {
 			log.Infof("S3 Save path: %s, key: %s", path, outputArtifact.S3.Key)
-			s3cli, err := s3Driver.newS3Client()
+			minioClient, err := s3Driver.newMinioClient()
 			if err != nil {
 				log.Warnf("Failed to create new S3 client: %v", err)
 				return false, nil
@@ -93,12 +97,12 @@ func (s3Driver *S3ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifac
 				return false, nil
 			}
 			if isDir {
-				if err = s3cli.PutDirectory(outputArtifact.S3.Bucket, outputArtifact.S3.Key, path); err != nil {
+				if err = putDirectory(minioClient, outputArtifact.S3.Bucket, outputArtifact.S3.Key, path); err != nil {
 					log.Warnf("Failed to put directory: %v", err)
 					return false, nil
 				}
 			} else {
-				if err = s3cli.PutFile(outputArtifact.S3.Bucket, outputArtifact.S3.Key, path); err != nil {
+				if _, err = minioClient.FPutObject(outputArtifact.S3.Bucket, outputArtifact.S3.Key, path, minio.PutObjectOptions{}); err != nil {
 					log.Warnf("Failed to put file: %v", err)
 					return false, nil
 				}
@@ -107,3 +111,96 @@ func (s3Driver *S3ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifac
 		})
 	return err
 }
+
+// Delete deletes an artifact, or all the files of a directory artifact, from S3 compliant storage
+func (s3Driver *S3ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	// lastErr is returned rather than the timeout once the attempts are exhausted
+	var lastErr error
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("S3 Delete key: %s", artifact.S3.Key)
+			// the client is created for each attempt, as it is by Load and Save, so that an attempt which failed because
+			// the temporary credentials expired is retried with new ones
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				log.Warnf("Failed to create new S3 client: %v", err)
+				lastErr = err
+				return false, nil
+			}
+			doneCh := make(chan struct{})
+			defer close(doneCh)
+			dirPrefix := directoryPrefix(artifact.S3.Key)
+			for object := range minioClient.ListObjectsV2(artifact.S3.Bucket, artifact.S3.Key, true, doneCh) {
+				if object.Err != nil {
+					log.Warnf("Failed to list objects: %v", object.Err)
+					lastErr = object.Err
+					return false, nil
+				}
+				// the key is only a prefix of the other objects, e.g. "my-key.tgz" of "my-key.tgz.bak"
+				if object.Key != artifact.S3.Key && !strings.HasPrefix(object.Key, dirPrefix) {
+					continue
+				}
+				if err := minioClient.RemoveObject(artifact.S3.Bucket, object.Key); err != nil {
+					log.Warnf("Failed to remove object %s: %v", object.Key, err)
+					lastErr = err
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+	if err == wait.ErrWaitTimeout && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+// directoryPrefix returns the prefix of the keys of the files of a directory artifact
+func directoryPrefix(key string) string {
+	return strings.TrimSuffix(key, "/") + "/"
+}
+
+// isDirectory returns whether the key is the one of a directory artifact, i.e. the prefix of the keys of other objects
+func isDirectory(minioClient *minio.Client, bucket, key string) (bool, error) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	for object := range minioClient.ListObjectsV2(bucket, directoryPrefix(key), false, doneCh) {
+		if object.Err != nil {
+			return false, object.Err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// getDirectory downloads the files of a directory artifact into path
+func getDirectory(minioClient *minio.Client, bucket, key, path string) error {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	prefix := directoryPrefix(key)
+	for object := range minioClient.ListObjectsV2(bucket, prefix, true, doneCh) {
+		if object.Err != nil {
+			return object.Err
+		}
+		localPath := filepath.Join(path, filepath.FromSlash(strings.TrimPrefix(object.Key, prefix)))
+		if err := minioClient.FGetObject(bucket, object.Key, localPath, minio.GetObjectOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// putDirectory uploads the files of the directory at path as a directory artifact
+func putDirectory(minioClient *minio.Client, bucket, key, path string) error {
+	prefix := directoryPrefix(key)
+	return filepath.Walk(path, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		relPath, err := filepath.Rel(path, localPath)
+		if err != nil {
+			return err
+		}
+		_, err = minioClient.FPutObject(bucket, prefix+filepath.ToSlash(relPath), localPath, minio.PutObjectOptions{})
+		return err
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/argoproj/argo/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 )
 
 const (
@@ -29,6 +30,18 @@ const (
 	// PodMetadataAnnotationsPath is the file path containing pod metadata annotations. Examined by executor
 	PodMetadataAnnotationsPath = PodMetadataMountPath + "/" + PodMetadataAnnotationsVolumePath
 
+	// TemplateVolumeName is the volume name of the config map holding the template of the pods which garbage collect
+	// the artifacts of a workflow or save their manifest, which may not fit in an annotation
+	TemplateVolumeName = "template"
+	// TemplateKey is the key of the template in the config map, which is the name of its file in the volume
+	TemplateKey = "template"
+	// TemplatePath is the file path of the template in the template volume. Examined by executor
+	TemplatePath = "/argo/" + TemplateVolumeName + "/" + TemplateKey
+	// ArtifactManifestKey is the key of the artifact manifest in the config map of the pod which saves it
+	ArtifactManifestKey = "manifest"
+	// ArtifactManifestPath is the file path of the artifact manifest in the template volume. Examined by executor
+	ArtifactManifestPath = "/argo/" + TemplateVolumeName + "/" + ArtifactManifestKey
+
 	// DockerSockVolumeName is the volume name for the /var/run/docker.sock host path volume
 	DockerSockVolumeName = "docker-sock"
 
@@ -42,10 +55,28 @@ const (
 	AnnotationKeyTemplate = workflow.WorkflowFullName + "/template"
 	// AnnotationKeyOutputs is the pod metadata annotation key containing the container outputs
 	AnnotationKeyOutputs = workflow.WorkflowFullName + "/outputs"
+	// AnnotationKeyExecutorVersion is the pod metadata annotation key the executor reports its version with
+	AnnotationKeyExecutorVersion = workflow.WorkflowFullName + "/executor-version"
 	// AnnotationKeyExecutionControl is the pod metadata annotation key containing execution control parameters
 	// set by the controller and obeyed by the executor. For example, the controller will use this annotation to
 	// signal the executors of daemoned containers that it should terminate.
 	AnnotationKeyExecutionControl = workflow.WorkflowFullName + "/execution"
+	// AnnotationKeyWorkflowOutputs is the annotation key of the workflows and CronWorkflows which own other workflows,
+	// containing the outputs of the last of these workflows which completed
+	AnnotationKeyWorkflowOutputs = workflow.WorkflowFullName + "/workflow-outputs"
+
+	// AnnotationKeyDefaultServiceAccount is the annotation of a namespace with the service account of the workflows
+	// which are submitted to it without one
+	AnnotationKeyDefaultServiceAccount = workflow.WorkflowFullName + "/default-service-account"
+	// AnnotationKeyDefaultArtifactRepository is the annotation of a namespace with the <configmap>/<key> of the
+	// artifact repository of the workflows which are submitted to it without an artifactRepositoryRef
+	AnnotationKeyDefaultArtifactRepository = workflow.WorkflowFullName + "/default-artifact-repository"
+	// AnnotationKeyDefaultTTLSecondsAfterCompletion is the annotation of a namespace with the number of seconds the
+	// workflows which are submitted to it without a TTL are kept after they complete
+	AnnotationKeyDefaultTTLSecondsAfterCompletion = workflow.WorkflowFullName + "/default-ttl-seconds-after-completion"
+	// AnnotationKeyOperationPanics is the annotation of a workflow with the number of times in a row its operation
+	// panicked
+	AnnotationKeyOperationPanics = workflow.WorkflowFullName + "/operation-panics"
 
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
@@ -55,10 +86,32 @@ const (
 	LabelKeyCompleted = workflow.WorkflowFullName + "/completed"
 	// LabelKeyWorkflow is the pod metadata label to indicate the associated workflow name
 	LabelKeyWorkflow = workflow.WorkflowFullName + "/workflow"
+	// LabelKeyWorkflowNamespace is the pod metadata label to indicate the namespace of the associated workflow, for the
+	// pods which run in another cluster
+	LabelKeyWorkflowNamespace = workflow.WorkflowFullName + "/workflow-namespace"
+	// LabelKeyWorkflowUID is the pod metadata label to indicate the UID of the associated workflow, for the pods which
+	// run in another cluster
+	LabelKeyWorkflowUID = workflow.WorkflowFullName + "/workflow-uid"
 	// LabelKeyPhase is a label applied to workflows to indicate the current phase of the workflow (for filtering purposes)
 	LabelKeyPhase = workflow.WorkflowFullName + "/phase"
 	// LabelCronWorkflow is a label applied to Workflows that are started by a CronWorkflow
 	LabelCronWorkflow = workflow.WorkflowFullName + "/cron-workflow"
+	// LabelKeyEntrypoint is a label applied to workflows to indicate their entrypoint (for filtering purposes)
+	LabelKeyEntrypoint = workflow.WorkflowFullName + "/entrypoint"
+	// LabelKeyWorkflowTemplatePrefix is the prefix of the labels applied to workflows to indicate which
+	// WorkflowTemplates they refer to, e.g. workflowtemplates.argoproj.io/my-template=true (for filtering purposes)
+	LabelKeyWorkflowTemplatePrefix = workflow.WorkflowTemplateFullName + "/"
+	// LabelKeyEventBinding is the label of the ConfigMaps whose keys are WorkflowEventBindings, which submit workflows
+	// for the events received by the Argo Server
+	LabelKeyEventBinding = workflow.WorkflowFullName + "/event-binding"
+	// LabelKeyTemplateLibrary is the label of the ConfigMaps whose keys are WorkflowTemplates, which template references
+	// with a configMapKey refer to
+	LabelKeyTemplateLibrary = workflow.WorkflowFullName + "/template-library"
+
+	// FinalizerArtifactGC is the finalizer which keeps a deleted workflow until its artifacts are garbage collected
+	FinalizerArtifactGC = workflow.WorkflowFullName + "/artifact-gc"
+	// FinalizerClusterPods is the finalizer which keeps a deleted workflow until its pods in other clusters are deleted
+	FinalizerClusterPods = workflow.WorkflowFullName + "/cluster-pods"
 
 	// ExecutorArtifactBaseDir is the base directory in the init container in which artifacts will be copied to.
 	// Each artifact will be named according to its input name (e.g: /argo/inputs/artifacts/CODE)
@@ -81,6 +134,12 @@ const (
 
 	// EnvVarPodName contains the name of the pod (currently unused)
 	EnvVarPodName = "ARGO_POD_NAME"
+	// EnvVarWorkflowNamespace contains the namespace of the workflow, which the executor logs with
+	EnvVarWorkflowNamespace = "ARGO_WORKFLOW_NAMESPACE"
+	// EnvVarWorkflowName contains the name of the workflow, which the executor logs with
+	EnvVarWorkflowName = "ARGO_WORKFLOW_NAME"
+	// EnvVarWorkflowUID contains the UID of the workflow, which the executor logs with
+	EnvVarWorkflowUID = "ARGO_WORKFLOW_UID"
 	// EnvVarContainerRuntimeExecutor contains the name of the container runtime executor to use, empty is equal to "docker"
 	EnvVarContainerRuntimeExecutor = "ARGO_CONTAINER_RUNTIME_EXECUTOR"
 	// EnvVarDownwardAPINodeIP is the envvar used to get the `status.hostIP`
@@ -120,6 +179,10 @@ const (
 	GlobalVarWorkflowPriority = "workflow.priority"
 	// LocalVarPodName is a step level variable that references the name of the pod
 	LocalVarPodName = "pod.name"
+	// LocalVarStatus is a metric level variable that references the phase of the completed node
+	LocalVarStatus = "status"
+	// LocalVarDuration is a metric level variable that references the duration of the completed node in seconds
+	LocalVarDuration = "duration"
 
 	KubeConfigDefaultMountPath    = "/kube/config"
 	KubeConfigDefaultVolumeName   = "kubeconfig"
@@ -140,3 +203,15 @@ type ExecutionControl struct {
 	// IncludeScriptOutput is containing flag to include script output
 	IncludeScriptOutput bool `json:"includeScriptOutput,omitempty"`
 }
+
+// WorkflowOutputs is the value of the AnnotationKeyWorkflowOutputs annotation
+type WorkflowOutputs struct {
+	// Name of the completed workflow
+	Name string `json:"name"`
+	// Phase the workflow completed with
+	Phase wfv1.NodePhase `json:"phase"`
+	// Outputs of the workflow, if any
+	Outputs *wfv1.Outputs `json:"outputs,omitempty"`
+	// OutputsTooLarge is true if the outputs were too large to record, in which case they are read from the workflow
+	OutputsTooLarge bool `json:"outputsTooLarge,omitempty"`
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Knetic/govaluate"
+	"github.com/tidwall/gjson"
+
+	"github.com/argoproj/argo/errors"
+)
+
+// ExpressionTagPrefix marks a tag as an expression, e.g. {{=asInt([inputs.parameters.count]) + 1}}, which is evaluated
+// instead of being substituted literally
+const ExpressionTagPrefix = "="
+
+// bracketedVariable matches the variables of an expression, which are written in brackets, e.g. [steps.a.outputs.result]
+var bracketedVariable = regexp.MustCompile(`\[([^\[\]]+)\]`)
+
+// expressionFunctions are the functions which expressions can call
+var expressionFunctions = map[string]govaluate.ExpressionFunction{
+	"asInt": func(args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("asInt expects one argument")
+		}
+		i, err := strconv.ParseInt(formatExpressionValue(args[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("asInt: %v", err)
+		}
+		return float64(i), nil
+	},
+	"asFloat": func(args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("asFloat expects one argument")
+		}
+		f, err := strconv.ParseFloat(formatExpressionValue(args[0]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("asFloat: %v", err)
+		}
+		return f, nil
+	},
+	"string": func(args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("string expects one argument")
+		}
+		return formatExpressionValue(args[0]), nil
+	},
+	"toUpper": func(args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("toUpper expects one argument")
+		}
+		return strings.ToUpper(formatExpressionValue(args[0])), nil
+	},
+	"toLower": func(args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("toLower expects one argument")
+		}
+		return strings.ToLower(formatExpressionValue(args[0])), nil
+	},
+	"trim": func(args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("trim expects one argument")
+		}
+		return strings.TrimSpace(formatExpressionValue(args[0])), nil
+	},
+	"jsonpath": func(args ...interface{}) (interface{}, error) {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("jsonpath expects a JSON document and a path")
+		}
+		path := formatExpressionValue(args[1])
+		result := gjson.Get(formatExpressionValue(args[0]), path)
+		if !result.Exists() {
+			return nil, fmt.Errorf("jsonpath: no value at '%s'", path)
+		}
+		return result.Value(), nil
+	},
+}
+
+// NewExpression parses an expression which may call the functions of expression tags, e.g. jsonpath
+func NewExpression(expression string) (*govaluate.EvaluableExpression, error) {
+	return govaluate.NewEvaluableExpressionWithFunctions(expression, expressionFunctions)
+}
+
+// IsExpressionTag returns whether the tag is an expression
+func IsExpressionTag(tag string) bool {
+	return strings.HasPrefix(tag, ExpressionTagPrefix)
+}
+
+// ParseExpressionTag parses the expression of the tag. Templates are marshaled to JSON before their tags are replaced,
+// so the special characters of the expression, e.g. the quotes and ampersands, may be escaped in the tag.
+func ParseExpressionTag(tag string) (*govaluate.EvaluableExpression, string, error) {
+	expression := strings.TrimPrefix(tag, ExpressionTagPrefix)
+	if unquoted, err := strconv.Unquote(`"` + expression + `"`); err == nil {
+		expression = unquoted
+	}
+	expr, err := NewExpression(expression)
+	if err != nil {
+		return nil, expression, errors.Errorf(errors.CodeBadRequest, "invalid expression '%s': %v", expression, err)
+	}
+	return expr, expression, nil
+}
+
+// evaluateExpressionTag evaluates the expression of the tag with the variables of replaceMap. If some of its variables
+// are not in replaceMap, it is not evaluated, and it returns the expression in which the variables that are in
+// replaceMap are replaced by their values, so that the remaining ones can be resolved later, e.g. the loop items.
+func evaluateExpressionTag(tag string, replaceMap map[string]string) (string, bool, error) {
+	expr, expression, err := ParseExpressionTag(tag)
+	if err != nil {
+		return "", false, err
+	}
+	// the placeholders which validation substitutes for the variables, and the values which are themselves unresolved
+	// tags, do not have the values of the variables, e.g. numbers, so expressions which refer to them are left to be
+	// evaluated when the workflow runs
+	placeholders := NewPlaceholderGenerator()
+	lookup := func(name string) (string, bool) {
+		value, ok := replaceMap[name]
+		return value, ok && !placeholders.IsPlaceholder(value) && !strings.Contains(value, "{{")
+	}
+	parameters := make(map[string]interface{})
+	resolved := true
+	for _, name := range expr.Vars() {
+		value, ok := lookup(name)
+		if !ok {
+			resolved = false
+			continue
+		}
+		parameters[name] = parseExpressionValue(value)
+	}
+	if !resolved {
+		return ExpressionTagPrefix + bracketedVariable.ReplaceAllStringFunc(expression, func(variable string) string {
+			value, ok := lookup(strings.Trim(variable, "[]"))
+			if !ok {
+				return variable
+			}
+			return expressionLiteral(value)
+		}), false, nil
+	}
+	result, err := expr.Evaluate(parameters)
+	if err != nil {
+		return "", false, errors.Errorf(errors.CodeBadRequest, "failed to evaluate expression '%s': %v", expression, err)
+	}
+	return formatExpressionValue(result), true, nil
+}
+
+// parseExpressionValue converts the value of a variable to the type the expression operates on: numbers and booleans
+// are compared and added as such, as they are in `when` conditions, while other values are strings
+func parseExpressionValue(value string) interface{} {
+	if f, err := strconv.ParseFloat(value, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+		return f
+	}
+	if value == "true" || value == "false" {
+		return value == "true"
+	}
+	return value
+}
+
+// expressionLiteral returns the value as a literal of an expression
+func expressionLiteral(value string) string {
+	switch parseExpressionValue(value).(type) {
+	case float64, bool:
+		return value
+	}
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// formatExpressionValue formats the value of an expression the way it is substituted: numbers without a trailing
+// zero fraction, and lists and maps, e.g. those returned by jsonpath, as JSON
+func formatExpressionValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
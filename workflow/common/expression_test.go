@@ -0,0 +1,54 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestReplaceExpression(t *testing.T) {
+	replaceMap := map[string]string{
+		"inputs.parameters.count": "3",
+		"inputs.parameters.name":  "it's",
+		"steps.a.outputs.result":  "ok",
+		"steps.b.outputs.result":  `{"items": [1, 2], "name": "b"}`,
+	}
+	for tmpl, expected := range map[string]string{
+		`{{=asInt([inputs.parameters.count]) + 1}}`:                              "4",
+		`{{=[inputs.parameters.count] / 2}}`:                                     "1.5",
+		`{{=[steps.a.outputs.result] == 'ok' && [inputs.parameters.count] > 2}}`: "true",
+		`{{=[inputs.parameters.count] > 5 ? 'big' : 'small'}}`:                   "small",
+		`{{=jsonpath([steps.b.outputs.result], 'items')}}`:                       "[1,2]",
+		`{{=toUpper(jsonpath([steps.b.outputs.result], 'name'))}}`:               "B",
+		`{{=[inputs.parameters.name]}}`:                                          `it's`,
+	} {
+		replaced, err := Replace(fasttemplate.New(tmpl, "{{", "}}"), replaceMap, false)
+		if assert.NoError(t, err, tmpl) {
+			assert.Equal(t, expected, replaced, tmpl)
+		}
+	}
+
+	// the expression is evaluated once all of its variables are resolved
+	replaced, err := Replace(fasttemplate.New(`{{=[item] + ': ' + [inputs.parameters.name]}}`, "{{", "}}"), replaceMap, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{{=[item] + ': ' + 'it\\'s'}}`, replaced)
+		replaced, err = Replace(fasttemplate.New(replaced, "{{", "}}"), map[string]string{"item": "x"}, false)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "x: it's", replaced)
+		}
+	}
+	_, err = Replace(fasttemplate.New(`{{=[item] + 1}}`, "{{", "}}"), replaceMap, false)
+	assert.EqualError(t, err, "failed to resolve {{=[item] + 1}}")
+
+	// placeholders are not evaluated
+	replaced, err = Replace(fasttemplate.New(`{{=asInt([workflow.parameters.count])}}`, "{{", "}}"), map[string]string{"workflow.parameters.count": "placeholder-1"}, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{{=asInt([workflow.parameters.count])}}`, replaced)
+	}
+
+	_, err = Replace(fasttemplate.New(`{{=asInt('x')}}`, "{{", "}}"), replaceMap, true)
+	assert.Error(t, err)
+	_, err = Replace(fasttemplate.New(`{{=[inputs.parameters.count] +}}`, "{{", "}}"), replaceMap, true)
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package common
+
+import (
+	"strings"
+	"syscall"
+
+	"github.com/argoproj/argo/errors"
+)
+
+// signals are the signals which can be used to stop a container. Containers run on Linux, so the Linux signal
+// numbers are used regardless of the platform this is compiled for.
+var signals = map[string]syscall.Signal{
+	"SIGHUP":  syscall.Signal(1),
+	"SIGINT":  syscall.Signal(2),
+	"SIGQUIT": syscall.Signal(3),
+	"SIGKILL": syscall.Signal(9),
+	"SIGUSR1": syscall.Signal(10),
+	"SIGUSR2": syscall.Signal(12),
+	"SIGTERM": syscall.Signal(15),
+}
+
+// ParseSignal parses the name of a signal, e.g. SIGINT or INT. An empty name is parsed as SIGTERM.
+func ParseSignal(name string) (syscall.Signal, error) {
+	if name == "" {
+		return signals["SIGTERM"], nil
+	}
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+	sig, ok := signals[name]
+	if !ok {
+		return 0, errors.Errorf(errors.CodeBadRequest, "unsupported signal '%s'", name)
+	}
+	return sig, nil
+}
@@ -0,0 +1,25 @@
+package common
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSignal(t *testing.T) {
+	sig, err := ParseSignal("")
+	assert.NoError(t, err)
+	assert.Equal(t, syscall.Signal(15), sig)
+
+	sig, err = ParseSignal("SIGINT")
+	assert.NoError(t, err)
+	assert.Equal(t, syscall.Signal(2), sig)
+
+	sig, err = ParseSignal("quit")
+	assert.NoError(t, err)
+	assert.Equal(t, syscall.Signal(3), sig)
+
+	_, err = ParseSignal("SIGFOO")
+	assert.EqualError(t, err, "unsupported signal 'SIGFOO'")
+}
@@ -0,0 +1,135 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/errors"
+)
+
+// UnknownFields returns the paths of the fields of obj which are dropped when it is decoded into v, i.e. the fields
+// which the type of v does not know about, such as typo'd keys. Every unknown field is reported, whatever its value.
+// The fields of the types which decode themselves, e.g. an IntOrString, are not checked.
+func UnknownFields(obj map[string]interface{}, v interface{}) ([]string, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil, fmt.Errorf("cannot check the fields of nil")
+	}
+	var paths []string
+	unknownFields("", obj, t, &paths)
+	sort.Strings(paths)
+	return paths, nil
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+func unknownFields(path string, value interface{}, t reflect.Type, paths *[]string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+	switch value := value.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for key, v := range value {
+				fieldPath := key
+				if path != "" {
+					fieldPath = path + "." + key
+				}
+				fieldType, ok := fields[key]
+				if !ok {
+					// encoding/json falls back to a case-insensitive match of the field names
+					for name, ft := range fields {
+						if strings.EqualFold(name, key) {
+							fieldType, ok = ft, true
+							break
+						}
+					}
+				}
+				if !ok {
+					*paths = append(*paths, fieldPath)
+					continue
+				}
+				unknownFields(fieldPath, v, fieldType, paths)
+			}
+		case reflect.Map:
+			for key, v := range value {
+				unknownFields(path+"."+key, v, t.Elem(), paths)
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for i, v := range value {
+			unknownFields(fmt.Sprintf("%s[%d]", path, i), v, t.Elem(), paths)
+		}
+	}
+}
+
+// jsonFields returns the types of the fields of a struct by their json name, including the fields of embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for n, ft := range jsonFields(embedded) {
+					if _, ok := fields[n]; !ok {
+						fields[n] = ft
+					}
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// unmarshalManifest unmarshals a single yaml or json manifest into v. In strict mode, the manifest must not contain
+// any fields which v does not know about.
+func unmarshalManifest(manifest []byte, v interface{}, strict bool) error {
+	err := yaml.Unmarshal(manifest, v)
+	if err != nil || !strict {
+		return err
+	}
+	var obj map[string]interface{}
+	err = yaml.Unmarshal(manifest, &obj)
+	if err != nil {
+		return err
+	}
+	fields, err := UnknownFields(obj, v)
+	if err != nil {
+		return err
+	}
+	if len(fields) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "unknown field(s): %s", strings.Join(fields, ", "))
+	}
+	return nil
+}
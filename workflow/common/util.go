@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os/exec"
+	"path"
 	"regexp"
 	"strconv"
 	"strings"
@@ -22,7 +24,6 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
-	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo/pkg/apis/workflow"
 
@@ -373,6 +374,22 @@ func SubstituteParams(tmpl *wfv1.Template, globalParams, localParams map[string]
 func Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]string, allowUnresolved bool) (string, error) {
 	var unresolvedErr error
 	replacedTmpl := fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
+		if IsExpressionTag(tag) {
+			replacement, resolved, err := evaluateExpressionTag(tag, replaceMap)
+			if err != nil {
+				unresolvedErr = err
+				return 0, nil
+			}
+			if !resolved {
+				if allowUnresolved {
+					// write the expression back, with the variables resolved so far
+					return w.Write([]byte(fmt.Sprintf("{{%s}}", escapeReplacement(replacement))))
+				}
+				unresolvedErr = errors.Errorf(errors.CodeBadRequest, "failed to resolve {{%s}}", tag)
+				return 0, nil
+			}
+			return w.Write([]byte(escapeReplacement(replacement)))
+		}
 		replacement, ok := replaceMap[tag]
 		if !ok {
 			if allowUnresolved {
@@ -382,11 +399,7 @@ func Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]string, allow
 			unresolvedErr = errors.Errorf(errors.CodeBadRequest, "failed to resolve {{%s}}", tag)
 			return 0, nil
 		}
-		// The following escapes any special characters (e.g. newlines, tabs, etc...)
-		// in preparation for substitution
-		replacement = strconv.Quote(replacement)
-		replacement = replacement[1 : len(replacement)-1]
-		return w.Write([]byte(replacement))
+		return w.Write([]byte(escapeReplacement(replacement)))
 	})
 	if unresolvedErr != nil {
 		return "", unresolvedErr
@@ -394,6 +407,12 @@ func Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]string, allow
 	return replacedTmpl, nil
 }
 
+// escapeReplacement escapes any special characters (e.g. newlines, tabs, etc...) in preparation for substitution
+func escapeReplacement(replacement string) string {
+	replacement = strconv.Quote(replacement)
+	return replacement[1 : len(replacement)-1]
+}
+
 // RunCommand is a convenience function to run/log a command and log the stderr upon failure
 func RunCommand(name string, arg ...string) error {
 	cmd := exec.Command(name, arg...)
@@ -466,6 +485,16 @@ func DeletePod(c kubernetes.Interface, podName, namespace string) error {
 	return err
 }
 
+// ArtifactGCPodName returns the name of the pod which deletes the artifacts of the workflow
+func ArtifactGCPodName(wfName string) string {
+	return wfName + "-artgc"
+}
+
+// ArtifactManifestPodName returns the name of the pod which saves the artifact manifest of the workflow
+func ArtifactManifestPodName(wfName string) string {
+	return wfName + "-manifest"
+}
+
 // IsPodTemplate returns whether the template corresponds to a pod
 func IsPodTemplate(tmpl *wfv1.Template) bool {
 	if tmpl.Container != nil || tmpl.Script != nil || tmpl.Resource != nil {
@@ -476,6 +505,18 @@ func IsPodTemplate(tmpl *wfv1.Template) bool {
 
 var yamlSeparator = regexp.MustCompile(`\n---`)
 
+// SplitYAMLFile is a helper to split a body into its non-empty yaml documents
+func SplitYAMLFile(body []byte) [][]byte {
+	var documents [][]byte
+	for _, manifestStr := range yamlSeparator.Split(string(body), -1) {
+		if strings.TrimSpace(manifestStr) == "" {
+			continue
+		}
+		documents = append(documents, []byte(manifestStr))
+	}
+	return documents
+}
+
 // SplitWorkflowYAMLFile is a helper to split a body into multiple workflow objects
 func SplitWorkflowYAMLFile(body []byte, strict bool) ([]wfv1.Workflow, error) {
 	manifestsStrings := yamlSeparator.Split(string(body), -1)
@@ -485,11 +526,7 @@ func SplitWorkflowYAMLFile(body []byte, strict bool) ([]wfv1.Workflow, error) {
 			continue
 		}
 		var wf wfv1.Workflow
-		var opts []yaml.JSONOpt
-		if strict {
-			opts = append(opts, yaml.DisallowUnknownFields) // nolint
-		}
-		err := yaml.Unmarshal([]byte(manifestStr), &wf, opts...)
+		err := unmarshalManifest([]byte(manifestStr), &wf, strict)
 		if wf.Kind != "" && wf.Kind != workflow.WorkflowKind {
 			log.Warnf("%s is not a workflow", wf.Kind)
 			// If we get here, it was a k8s manifest which was not of type 'Workflow'
@@ -513,11 +550,7 @@ func SplitWorkflowTemplateYAMLFile(body []byte, strict bool) ([]wfv1.WorkflowTem
 			continue
 		}
 		var wftmpl wfv1.WorkflowTemplate
-		var opts []yaml.JSONOpt
-		if strict {
-			opts = append(opts, yaml.DisallowUnknownFields) // nolint
-		}
-		err := yaml.Unmarshal([]byte(manifestStr), &wftmpl, opts...)
+		err := unmarshalManifest([]byte(manifestStr), &wftmpl, strict)
 		if wftmpl.Kind != "" && wftmpl.Kind != workflow.WorkflowTemplateKind {
 			log.Warnf("%s is not a workflow template", wftmpl.Kind)
 			// If we get here, it was a k8s manifest which was not of type 'WorkflowTemplate'
@@ -541,11 +574,7 @@ func SplitCronWorkflowYAMLFile(body []byte, strict bool) ([]wfv1.CronWorkflow, e
 			continue
 		}
 		var cronWf wfv1.CronWorkflow
-		var opts []yaml.JSONOpt
-		if strict {
-			opts = append(opts, yaml.DisallowUnknownFields) // nolint
-		}
-		err := yaml.Unmarshal([]byte(manifestStr), &cronWf, opts...)
+		err := unmarshalManifest([]byte(manifestStr), &cronWf, strict)
 		if cronWf.Kind != "" && cronWf.Kind != workflow.CronWorkflowKind {
 			log.Warnf("%s is not a cron workflow", cronWf.Kind)
 			// If we get here, it was a k8s manifest which was not of type 'CronWorkflow'
@@ -560,6 +589,29 @@ func SplitCronWorkflowYAMLFile(body []byte, strict bool) ([]wfv1.CronWorkflow, e
 	return manifests, nil
 }
 
+// SplitConfigMapYAMLFile is a helper to split a body into multiple config map objects
+func SplitConfigMapYAMLFile(body []byte, strict bool) ([]apiv1.ConfigMap, error) {
+	manifestsStrings := yamlSeparator.Split(string(body), -1)
+	manifests := make([]apiv1.ConfigMap, 0)
+	for _, manifestStr := range manifestsStrings {
+		if strings.TrimSpace(manifestStr) == "" {
+			continue
+		}
+		var cm apiv1.ConfigMap
+		err := unmarshalManifest([]byte(manifestStr), &cm, strict)
+		if cm.Kind != "ConfigMap" {
+			// We ignore anything which is not a ConfigMap. Unlike the other kinds, a ConfigMap
+			// must always declare its kind since it is not the primary object of any file.
+			continue
+		}
+		if err != nil {
+			return nil, errors.New(errors.CodeBadRequest, err.Error())
+		}
+		manifests = append(manifests, cm)
+	}
+	return manifests, nil
+}
+
 // MergeReferredTemplate merges a referred template to the receiver template.
 func MergeReferredTemplate(tmpl *wfv1.Template, referred *wfv1.Template) (*wfv1.Template, error) {
 	// Copy the referred template to deep copy template types.
@@ -675,7 +727,7 @@ func GetTemplateHolderString(tmplHolder wfv1.TemplateHolder) string {
 	tmplName := tmplHolder.GetTemplateName()
 	tmplRef := tmplHolder.GetTemplateRef()
 	if tmplRef != nil {
-		return fmt.Sprintf("%T (%s/%s)", tmplHolder, tmplRef.Name, tmplRef.Template)
+		return fmt.Sprintf("%T (%s/%s)", tmplHolder, tmplRef.GetWorkflowTemplateName(), tmplRef.Template)
 	} else {
 		return fmt.Sprintf("%T (%s)", tmplHolder, tmplName)
 	}
@@ -692,6 +744,10 @@ func ConvertToWorkflow(cronWf *wfv1.CronWorkflow) (*wfv1.Workflow, error) {
 
 	newObjectMeta.Labels = make(map[string]string)
 	newObjectMeta.Labels[LabelCronWorkflow] = cronWf.Name
+	// the workflow is run by the controller instance which scheduled it
+	if instanceID, ok := cronWf.Labels[LabelKeyControllerInstanceID]; ok {
+		newObjectMeta.Labels[LabelKeyControllerInstanceID] = instanceID
+	}
 
 	wf := &wfv1.Workflow{
 		TypeMeta:   newTypeMeta,
@@ -701,3 +757,28 @@ func ConvertToWorkflow(cronWf *wfv1.CronWorkflow) (*wfv1.Workflow, error) {
 	wf.SetOwnerReferences(append(wf.GetOwnerReferences(), *metav1.NewControllerRef(cronWf, wfv1.SchemeGroupVersion.WithKind(workflow.CronWorkflowKind))))
 	return wf, nil
 }
+
+// SetArtifactLocationInArchive sets the location of an artifact to the file with the given name in the archive location
+func SetArtifactLocationInArchive(art *wfv1.Artifact, archiveLocation *wfv1.ArtifactLocation, fileName string) error {
+	if archiveLocation.S3 != nil {
+		shallowCopy := *archiveLocation.S3
+		art.S3 = &shallowCopy
+		art.S3.Key = path.Join(art.S3.Key, fileName)
+	} else if archiveLocation.Artifactory != nil {
+		shallowCopy := *archiveLocation.Artifactory
+		art.Artifactory = &shallowCopy
+		artifactoryURL, err := url.Parse(art.Artifactory.URL)
+		if err != nil {
+			return err
+		}
+		artifactoryURL.Path = path.Join(artifactoryURL.Path, fileName)
+		art.Artifactory.URL = artifactoryURL.String()
+	} else if archiveLocation.HDFS != nil {
+		shallowCopy := *archiveLocation.HDFS
+		art.HDFS = &shallowCopy
+		art.HDFS.Path = path.Join(art.HDFS.Path, fileName)
+	} else {
+		return errors.Errorf(errors.CodeBadRequest, "Unable to determine path to store %s. Archive location provided no information", art.Name)
+	}
+	return nil
+}
@@ -24,3 +24,110 @@ func TestFindOverlappingVolume(t *testing.T) {
 	assert.Equal(t, &volMnt, FindOverlappingVolume(templateWithVolMount, "/user-mount/subdir"))
 	assert.Nil(t, FindOverlappingVolume(templateWithVolMount, "/user-mount-coincidental-prefix"))
 }
+
+var multiKindManifest = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+data:
+  key: value
+---
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: my-template
+spec:
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+---
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: my-workflow-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: hello
+        templateRef:
+          name: my-template
+          template: whalesay
+`
+
+// TestSplitMultiKindYAMLFile verifies each kind is picked out of a multi document manifest
+func TestSplitMultiKindYAMLFile(t *testing.T) {
+	for _, strict := range []bool{false, true} {
+		cms, err := SplitConfigMapYAMLFile([]byte(multiKindManifest), strict)
+		assert.NoError(t, err)
+		if assert.Len(t, cms, 1) {
+			assert.Equal(t, "my-config", cms[0].Name)
+			assert.Equal(t, "value", cms[0].Data["key"])
+		}
+		wftmpls, err := SplitWorkflowTemplateYAMLFile([]byte(multiKindManifest), strict)
+		assert.NoError(t, err)
+		if assert.Len(t, wftmpls, 1) {
+			assert.Equal(t, "my-template", wftmpls[0].Name)
+		}
+		wfs, err := SplitWorkflowYAMLFile([]byte(multiKindManifest), strict)
+		assert.NoError(t, err)
+		if assert.Len(t, wfs, 1) {
+			assert.Equal(t, "my-workflow-", wfs[0].GenerateName)
+		}
+	}
+}
+
+var unknownFieldsManifest = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: my-workflow-
+spec:
+  entrypoint: main
+  serviceAccountName: ""
+  templates:
+  - name: main
+    contianer:
+      image: docker/whalesay:latest
+  - name: other
+    container:
+      image: docker/whalesay:latest
+      imagePullPolicy: Always
+      comand: [cowsay]
+    activeDeadlineSecond: null
+    outputs:
+      artifact: {}
+    metadata:
+      labels:
+        app: other
+      annotation: ""
+`
+
+// TestSplitWorkflowYAMLFileUnknownFields verifies strict mode reports the path of every unknown field
+func TestSplitWorkflowYAMLFileUnknownFields(t *testing.T) {
+	wfs, err := SplitWorkflowYAMLFile([]byte(unknownFieldsManifest), false)
+	assert.NoError(t, err)
+	assert.Len(t, wfs, 1)
+
+	_, err = SplitWorkflowYAMLFile([]byte(unknownFieldsManifest), true)
+	if assert.Error(t, err) {
+		assert.Equal(t, "unknown field(s): spec.templates[0].contianer, spec.templates[1].activeDeadlineSecond, spec.templates[1].container.comand, spec.templates[1].metadata.annotation, spec.templates[1].outputs.artifact", err.Error())
+	}
+}
+
+func TestConvertToWorkflow(t *testing.T) {
+	cronWf := &wfv1.CronWorkflow{}
+	cronWf.Name = "my-cron"
+	wf, err := ConvertToWorkflow(cronWf)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{LabelCronWorkflow: "my-cron"}, wf.Labels)
+
+	// workflows are run by the controller instance of their CronWorkflow
+	cronWf.Labels = map[string]string{LabelKeyControllerInstanceID: "my-instance"}
+	wf, err = ConvertToWorkflow(cronWf)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-instance", wf.Labels[LabelKeyControllerInstanceID])
+}
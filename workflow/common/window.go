@@ -0,0 +1,55 @@
+package common
+
+import (
+	"time"
+
+	"github.com/robfig/cron"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// ParseExecutionWindow parses the schedule (including its timezone) and the duration of an execution window
+func ParseExecutionWindow(window wfv1.ExecutionWindow) (cron.Schedule, time.Duration, error) {
+	spec := window.Schedule
+	if window.Timezone != "" {
+		spec = "CRON_TZ=" + window.Timezone + " " + spec
+	}
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, 0, errors.Errorf(errors.CodeBadRequest, "execution window schedule '%s' is malformed: %s", window.Schedule, err)
+	}
+	duration, err := time.ParseDuration(window.Duration)
+	if err != nil {
+		return nil, 0, errors.Errorf(errors.CodeBadRequest, "execution window duration '%s' is malformed: %s", window.Duration, err)
+	}
+	if duration <= 0 {
+		return nil, 0, errors.Errorf(errors.CodeBadRequest, "execution window duration '%s' must be positive", window.Duration)
+	}
+	return schedule, duration, nil
+}
+
+// NextExecutionWindow returns nil if now falls within any of the given windows (or if there are no windows).
+// Otherwise it returns the earliest time at which one of the windows opens next.
+func NextExecutionWindow(windows []wfv1.ExecutionWindow, now time.Time) (*time.Time, error) {
+	var next *time.Time
+	for _, window := range windows {
+		schedule, duration, err := ParseExecutionWindow(window)
+		if err != nil {
+			return nil, err
+		}
+		// The first opening after (now - duration) is either a window which is still open, or the next one to open
+		opening := schedule.Next(now.Add(-duration))
+		if opening.IsZero() {
+			// the schedule never fires
+			continue
+		}
+		if !opening.After(now) {
+			return nil, nil
+		}
+		if next == nil || opening.Before(*next) {
+			next = &opening
+		}
+	}
+	return next, nil
+}
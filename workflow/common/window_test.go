@@ -0,0 +1,48 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+func TestNextExecutionWindow(t *testing.T) {
+	// 01:00-03:00 UTC every day
+	windows := []wfv1.ExecutionWindow{{Schedule: "0 1 * * *", Duration: "2h", Timezone: "UTC"}}
+
+	next, err := NextExecutionWindow(windows, time.Date(2020, 1, 1, 2, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Nil(t, next)
+
+	next, err = NextExecutionWindow(windows, time.Date(2020, 1, 1, 4, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	if assert.NotNil(t, next) {
+		assert.Equal(t, time.Date(2020, 1, 2, 1, 0, 0, 0, time.UTC), next.UTC())
+	}
+
+	// the earliest opening wins
+	windows = append(windows, wfv1.ExecutionWindow{Schedule: "0 5 * * *", Duration: "1h", Timezone: "UTC"})
+	next, err = NextExecutionWindow(windows, time.Date(2020, 1, 1, 4, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	if assert.NotNil(t, next) {
+		assert.Equal(t, time.Date(2020, 1, 1, 5, 0, 0, 0, time.UTC), next.UTC())
+	}
+
+	next, err = NextExecutionWindow(nil, time.Now())
+	assert.NoError(t, err)
+	assert.Nil(t, next)
+}
+
+func TestParseExecutionWindow(t *testing.T) {
+	_, _, err := ParseExecutionWindow(wfv1.ExecutionWindow{Schedule: "* * * * *", Duration: "1h"})
+	assert.NoError(t, err)
+	_, _, err = ParseExecutionWindow(wfv1.ExecutionWindow{Schedule: "bad", Duration: "1h"})
+	assert.Error(t, err)
+	_, _, err = ParseExecutionWindow(wfv1.ExecutionWindow{Schedule: "* * * * *", Duration: "1h", Timezone: "Not/AZone"})
+	assert.Error(t, err)
+	_, _, err = ParseExecutionWindow(wfv1.ExecutionWindow{Schedule: "* * * * *", Duration: "-1h"})
+	assert.Error(t, err)
+}
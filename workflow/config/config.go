@@ -2,8 +2,14 @@ package config
 
 import (
 	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/argoproj/argo/errors"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	argoutil "github.com/argoproj/argo/util"
 	"github.com/argoproj/argo/workflow/metrics"
 )
 
@@ -59,11 +65,343 @@ type WorkflowControllerConfig struct {
 	// Parallelism limits the max total parallel workflows that can execute at the same time
 	Parallelism int `json:"parallelism,omitempty"`
 
+	// PodParallelism limits the total number of active (pending or running) pods of all the workflows. While it is
+	// reached, the pods are created in turns across namespaces, in proportion to their namespacePodShares.
+	PodParallelism int `json:"podParallelism,omitempty"`
+
+	// NamespacePodShares maps namespaces to their share of the pods created while podParallelism is reached, default
+	// to 1. The entry for "*" applies to namespaces without an entry of their own.
+	NamespacePodShares map[string]int `json:"namespacePodShares,omitempty"`
+
 	// Persistence contains the workflow persistence DB configuration
 	Persistence *PersistConfig `json:"persistence,omitempty"`
 
 	// Config customized Docker Sock path
 	DockerSockPath string `json:"dockerSockPath,omitempty"`
+
+	// NamespaceDeadlines maps namespaces to the default and maximum activeDeadlineSeconds of their workflows. The
+	// entry for "*" applies to namespaces without an entry of their own.
+	NamespaceDeadlines map[string]NamespaceDeadline `json:"namespaceDeadlines,omitempty"`
+
+	// StrictDecoding fails new workflows whose manifest contains fields the controller does not know about, such as
+	// typo'd keys, instead of silently ignoring them
+	StrictDecoding bool `json:"strictDecoding,omitempty"`
+
+	// NodeStatusUpdateInterval is the minimum time between two updates of the same workflow, e.g. "10s". Node changes
+	// within the interval, such as many pods of a fan-out completing at once, are coalesced into a single update. Only
+	// the updates are delayed: workflows are still operated on as soon as they change. By default, every change is
+	// persisted as soon as possible.
+	NodeStatusUpdateInterval metav1.Duration `json:"nodeStatusUpdateInterval,omitempty"`
+
+	// FailedNodeDiagnostics, if set, records the container states, events and last log lines of the pods of failed
+	// nodes in the status of the nodes, so that failures can be triaged after the pods were deleted
+	FailedNodeDiagnostics *FailedNodeDiagnostics `json:"failedNodeDiagnostics,omitempty"`
+
+	// PodSpecPatch is a default patch applied to the spec of every workflow pod, e.g. to set tolerations or a
+	// priority class. The podSpecPatch of a workflow or template is applied on top of it.
+	PodSpecPatch string `json:"podSpecPatch,omitempty"`
+
+	// NamespaceExecutorServiceAccounts maps namespaces to the service account of the executor of their workflows,
+	// unless a workflow or template sets executor.serviceAccountName. The entry for "*" applies to namespaces without
+	// an entry of their own.
+	NamespaceExecutorServiceAccounts map[string]ExecutorServiceAccount `json:"namespaceExecutorServiceAccounts,omitempty"`
+
+	// WorkflowDefaults are the settings of every workflow which does not set them itself. The deadlines of
+	// namespaceDeadlines, and the annotations of the namespace of the workflow, take precedence.
+	WorkflowDefaults *WorkflowDefaults `json:"workflowDefaults,omitempty"`
+
+	// MaxOperationPanics is the number of times in a row the operation of a workflow may panic before the workflow is
+	// quarantined, i.e. errors and is not operated anymore, default to 3
+	MaxOperationPanics int `json:"maxOperationPanics,omitempty"`
+
+	// NodeIDMaxLength is the length node IDs, and so the names of pods, are truncated to, between 32 and 253, default
+	// to 253. It applies to the workflows which start afterwards.
+	NodeIDMaxLength int `json:"nodeIDMaxLength,omitempty"`
+
+	// Policy is the policy the content of workflows must comply with before they start. A workflow which does not
+	// comply fails with the reason it was rejected.
+	Policy *PolicyConfig `json:"policy,omitempty"`
+
+	// Logging overrides the level and format of the logs of the controller set by its flags
+	Logging *LoggingConfig `json:"logging,omitempty"`
+
+	// Clusters are the other clusters the pods of templates can run in, which templates refer to by name
+	Clusters []ClusterConfig `json:"clusters,omitempty"`
+
+	// HTTPTemplates enables the HTTP templates, which are disabled by default
+	HTTPTemplates *HTTPTemplatesConfig `json:"httpTemplates,omitempty"`
+}
+
+// HTTPTemplatesConfig configures the HTTP templates, whose requests are performed by the controller, from its own
+// network, and with its own permissions to read the secrets of the headers
+type HTTPTemplatesConfig struct {
+	// Enabled lets workflows use HTTP templates
+	Enabled bool `json:"enabled"`
+	// MaxTimeoutSeconds caps the timeout of the requests, default to 300 seconds
+	MaxTimeoutSeconds int64 `json:"maxTimeoutSeconds,omitempty"`
+}
+
+// GetMaxTimeoutSeconds returns the longest time a request of an HTTP template may take
+func (c HTTPTemplatesConfig) GetMaxTimeoutSeconds() int64 {
+	if c.MaxTimeoutSeconds > 0 {
+		return c.MaxTimeoutSeconds
+	}
+	return 300
+}
+
+// ClusterConfig is a cluster the controller creates pods in, besides its own
+type ClusterConfig struct {
+	// Name of the cluster, which templates refer to
+	Name string `json:"name"`
+	// Namespace the pods run in, default to the namespace of their workflow
+	Namespace string `json:"namespace,omitempty"`
+	// SecretName of the secret in the namespace of the controller holding the kubeconfig of the cluster
+	SecretName string `json:"secretName"`
+	// SecretKey of the kubeconfig in the secret
+	SecretKey string `json:"secretKey"`
+	// Namespaces of the workflows which may run pods in the cluster, default to all namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// AllowsNamespace returns whether the workflows of a namespace may run pods in the cluster
+func (c ClusterConfig) AllowsNamespace(wfNamespace string) bool {
+	if len(c.Namespaces) == 0 {
+		return true
+	}
+	for _, namespace := range c.Namespaces {
+		if namespace == wfNamespace {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPodNamespace returns the namespace the pods of the workflows of a namespace run in, in the cluster
+func (c ClusterConfig) GetPodNamespace(wfNamespace string) string {
+	if c.Namespace != "" {
+		return c.Namespace
+	}
+	return wfNamespace
+}
+
+// NewKubeClient creates the client of the cluster from the kubeconfig in its secret, which is in the namespace of the
+// controller
+func (c ClusterConfig) NewKubeClient(kubeClient kubernetes.Interface, namespace string) (*rest.Config, kubernetes.Interface, error) {
+	kubeConfig, err := argoutil.GetSecrets(kubeClient, namespace, c.SecretName, c.SecretKey)
+	if err != nil {
+		return nil, nil, errors.Errorf(errors.CodeBadRequest, "failed to get the kubeconfig of cluster %s: %v", c.Name, err)
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeConfig)
+	if err != nil {
+		return nil, nil, errors.Errorf(errors.CodeBadRequest, "invalid kubeconfig of cluster %s: %v", c.Name, err)
+	}
+	clusterKubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, errors.Errorf(errors.CodeBadRequest, "invalid kubeconfig of cluster %s: %v", c.Name, err)
+	}
+	return restConfig, clusterKubeClient, nil
+}
+
+// GetCluster returns the cluster of the given name, if any
+func (c WorkflowControllerConfig) GetCluster(name string) *ClusterConfig {
+	for _, cluster := range c.Clusters {
+		if cluster.Name == name {
+			return &cluster
+		}
+	}
+	return nil
+}
+
+// GetClusterNames returns the names of the clusters the workflows of a namespace may run pods in
+func (c WorkflowControllerConfig) GetClusterNames(wfNamespace string) []string {
+	names := make([]string, 0, len(c.Clusters))
+	for _, cluster := range c.Clusters {
+		if cluster.AllowsNamespace(wfNamespace) {
+			names = append(names, cluster.Name)
+		}
+	}
+	return names
+}
+
+// LoggingConfig configures the logs of the controller
+type LoggingConfig struct {
+	// Level is one of debug, info, warn or error
+	Level string `json:"level,omitempty"`
+	// Format is either text or json
+	Format string `json:"format,omitempty"`
+}
+
+// GetMaxOperationPanics returns the number of times in a row the operation of a workflow may panic
+func (c WorkflowControllerConfig) GetMaxOperationPanics() int {
+	if c.MaxOperationPanics > 0 {
+		return c.MaxOperationPanics
+	}
+	return 3
+}
+
+// GetNodeIDMaxLength returns the length node IDs are truncated to
+func (c WorkflowControllerConfig) GetNodeIDMaxLength() int {
+	if c.NodeIDMaxLength >= wfv1.MinNodeIDLength && c.NodeIDMaxLength <= wfv1.MaxNodeIDLength {
+		return c.NodeIDMaxLength
+	}
+	return wfv1.MaxNodeIDLength
+}
+
+// WorkflowDefaults are the settings which the controller merges into workflows when it starts them
+type WorkflowDefaults struct {
+	// Labels are added to the workflow, except those whose key the workflow already has
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to the workflow, except those whose key the workflow already has
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ServiceAccountName is the service account of workflows which do not set spec.serviceAccountName
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ActiveDeadlineSeconds is the spec.activeDeadlineSeconds of workflows which do not set it
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// MaxActiveDeadlineSeconds is a hard cap. Workflows asking for a longer deadline, or none, are limited to it.
+	MaxActiveDeadlineSeconds *int64 `json:"maxActiveDeadlineSeconds,omitempty"`
+	// ArtifactRepositoryRef is the spec.artifactRepositoryRef of workflows which do not set it
+	ArtifactRepositoryRef *wfv1.ArtifactRepositoryRef `json:"artifactRepositoryRef,omitempty"`
+	// TTLStrategy is the spec.ttlStrategy of workflows which set neither ttlStrategy nor ttlSecondsAfterFinished
+	TTLStrategy *wfv1.TTLStrategy `json:"ttlStrategy,omitempty"`
+	// PodMetadata are the labels and annotations of the pods of every workflow. The metadata of the template of a pod
+	// takes precedence.
+	PodMetadata *wfv1.Metadata `json:"podMetadata,omitempty"`
+}
+
+// PolicyConfig configures the policy of workflows. The built-in rules are evaluated first, then the webhook.
+type PolicyConfig struct {
+	// DisallowedImages are the patterns of the images the containers of workflows may not run, where * matches any
+	// characters, e.g. "*:latest" or "docker.io/*"
+	DisallowedImages []string `json:"disallowedImages,omitempty"`
+	// RequiredLabels are the keys of the labels every workflow must have
+	RequiredLabels []string `json:"requiredLabels,omitempty"`
+	// DisallowPrivileged rejects workflows with privileged containers, containers adding capabilities other than the
+	// AllowedCapabilities, pods using the network, process or IPC namespaces of the host, and host path volumes
+	DisallowPrivileged bool `json:"disallowPrivileged,omitempty"`
+	// AllowedCapabilities are the capabilities containers may add despite DisallowPrivileged, e.g. NET_BIND_SERVICE
+	AllowedCapabilities []string `json:"allowedCapabilities,omitempty"`
+	// Webhook is an HTTP service which reviews every workflow, e.g. an Open Policy Agent evaluating rego policies
+	Webhook *PolicyWebhook `json:"webhook,omitempty"`
+}
+
+// PolicyFailurePolicy is what happens to a workflow when the policy webhook cannot review it
+type PolicyFailurePolicy string
+
+const (
+	// PolicyFailurePolicyFail holds the workflow back until the webhook reviews it
+	PolicyFailurePolicyFail PolicyFailurePolicy = "Fail"
+	// PolicyFailurePolicyIgnore lets the workflow start
+	PolicyFailurePolicyIgnore PolicyFailurePolicy = "Ignore"
+)
+
+// PolicyWebhook is an HTTP service which is posted a review of every workflow, and responds whether it is allowed
+type PolicyWebhook struct {
+	// URL of the webhook
+	URL string `json:"url"`
+	// TimeoutSeconds is the time the webhook may take to respond, default to 10 seconds
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// FailurePolicy is either Fail (default) or Ignore
+	FailurePolicy PolicyFailurePolicy `json:"failurePolicy,omitempty"`
+	// InsecureSkipVerify skips the verification of the certificate of the webhook
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// ExecutorServiceAccount is the service account of the executor of the workflows of a namespace
+type ExecutorServiceAccount struct {
+	// Name of the service account
+	Name string `json:"name"`
+	// Create the service account if it does not exist, before the first pod of a workflow in the namespace is created
+	Create bool `json:"create,omitempty"`
+	// ClusterRole a created service account is bound to in the namespace, e.g. a cluster role with the permissions of
+	// the executor. It is not bound to any role if omitted.
+	ClusterRole string `json:"clusterRole,omitempty"`
+}
+
+// FailedNodeDiagnostics configures the diagnostics collected from the pods of failed nodes
+type FailedNodeDiagnostics struct {
+	// LogLines is the number of lines at the end of the logs of the main container to collect, default to 20. Set it
+	// to 0 to not collect any logs.
+	LogLines *int64 `json:"logLines,omitempty"`
+
+	// MaxBytes is the total size of the diagnostics kept in the status of a workflow, default to 64KiB. The logs, and
+	// then the oldest events, of the nodes which fail once it is reached are dropped.
+	MaxBytes int `json:"maxBytes,omitempty"`
+}
+
+// GetLogLines returns the number of log lines to collect
+func (d FailedNodeDiagnostics) GetLogLines() int64 {
+	if d.LogLines != nil {
+		return *d.LogLines
+	}
+	return 20
+}
+
+// GetMaxBytes returns the total size of the diagnostics kept in the status of a workflow
+func (d FailedNodeDiagnostics) GetMaxBytes() int {
+	if d.MaxBytes > 0 {
+		return d.MaxBytes
+	}
+	return 64 * 1024
+}
+
+// NamespaceDeadline limits how long the workflows of a namespace may run
+type NamespaceDeadline struct {
+	// DefaultActiveDeadlineSeconds is applied to workflows which do not set activeDeadlineSeconds
+	DefaultActiveDeadlineSeconds *int64 `json:"defaultActiveDeadlineSeconds,omitempty"`
+	// MaxActiveDeadlineSeconds is a hard cap. Workflows asking for a longer deadline, or none, are limited to it.
+	MaxActiveDeadlineSeconds *int64 `json:"maxActiveDeadlineSeconds,omitempty"`
+}
+
+// GetNamespaceDeadline returns the deadlines of the workflows in the namespace, if any
+func (c WorkflowControllerConfig) GetNamespaceDeadline(namespace string) *NamespaceDeadline {
+	if deadline, ok := c.NamespaceDeadlines[namespace]; ok {
+		return &deadline
+	}
+	if deadline, ok := c.NamespaceDeadlines["*"]; ok {
+		return &deadline
+	}
+	return nil
+}
+
+// GetWorkflowDefaults returns the defaults of the workflows of the namespace, which are the workflowDefaults with the
+// deadlines of namespaceDeadlines for the namespace taking precedence. The fields of the result are shared with the
+// configuration, and must not be modified.
+func (c WorkflowControllerConfig) GetWorkflowDefaults(namespace string) WorkflowDefaults {
+	var defaults WorkflowDefaults
+	if c.WorkflowDefaults != nil {
+		defaults = *c.WorkflowDefaults
+	}
+	if deadline := c.GetNamespaceDeadline(namespace); deadline != nil {
+		if deadline.DefaultActiveDeadlineSeconds != nil {
+			defaults.ActiveDeadlineSeconds = deadline.DefaultActiveDeadlineSeconds
+		}
+		if deadline.MaxActiveDeadlineSeconds != nil {
+			defaults.MaxActiveDeadlineSeconds = deadline.MaxActiveDeadlineSeconds
+		}
+	}
+	return defaults
+}
+
+// GetNamespacePodShare returns the share of the namespace of the pods created while podParallelism is reached
+func (c WorkflowControllerConfig) GetNamespacePodShare(namespace string) int {
+	if share, ok := c.NamespacePodShares[namespace]; ok {
+		return share
+	}
+	if share, ok := c.NamespacePodShares["*"]; ok {
+		return share
+	}
+	return 1
+}
+
+// GetExecutorServiceAccount returns the default service account of the executor in the namespace, if any
+func (c WorkflowControllerConfig) GetExecutorServiceAccount(namespace string) *ExecutorServiceAccount {
+	if sa, ok := c.NamespaceExecutorServiceAccounts[namespace]; ok {
+		return &sa
+	}
+	if sa, ok := c.NamespaceExecutorServiceAccounts["*"]; ok {
+		return &sa
+	}
+	return nil
 }
 
 // KubeConfig is used for wait & init sidecar containers to communicate with a k8s apiserver by a outofcluster method,
@@ -97,8 +435,25 @@ func (a *ArtifactRepository) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
 
+// NodeStatusStorage is where the controller stores the status of the nodes of workflows
+type NodeStatusStorage string
+
+const (
+	// NodeStatusStorageKubernetes stores the nodes in the workflow resource only, compressed when they are too large. The
+	// nodes are never offloaded, even when there is a database.
+	NodeStatusStorageKubernetes NodeStatusStorage = "Kubernetes"
+	// NodeStatusStorageOffload stores the nodes in the workflow resource, and offloads them to the database when they
+	// are too large even once compressed
+	NodeStatusStorageOffload NodeStatusStorage = "Offload"
+	// NodeStatusStorageDatabase always stores the nodes in the database, the workflow resource only holds the version
+	// of the offloaded nodes
+	NodeStatusStorageDatabase NodeStatusStorage = "Database"
+)
+
 type PersistConfig struct {
 	NodeStatusOffload bool `json:"nodeStatusOffLoad,omitempty"`
+	// NodeStatusStorage is where the status of the nodes is stored, Offload by default
+	NodeStatusStorage NodeStatusStorage `json:"nodeStatusStorage,omitempty"`
 	// Archive workflows to persistence.
 	Archive        bool              `json:"archive,omitempty"`
 	ClusterName    string            `json:"clusterName,omitempty"`
@@ -107,6 +462,18 @@ type PersistConfig struct {
 	MySQL          *MySQLConfig      `json:"mysql,omitempty"`
 }
 
+// GetNodeStatusStorage returns where the status of the nodes is stored. Without persistence, the nodes can only be
+// stored in the workflow resource.
+func (c *PersistConfig) GetNodeStatusStorage() NodeStatusStorage {
+	if c == nil {
+		return NodeStatusStorageKubernetes
+	}
+	if c.NodeStatusStorage != "" {
+		return c.NodeStatusStorage
+	}
+	return NodeStatusStorageOffload
+}
+
 func (c PersistConfig) GetClusterName() string {
 	if c.ClusterName != "" {
 		return c.ClusterName
@@ -12,3 +12,43 @@ func TestArtifactRepository_IsArchiveLogs(t *testing.T) {
 	assert.False(t, (&ArtifactRepository{ArchiveLogs: pointer.BoolPtr(false)}).IsArchiveLogs())
 	assert.True(t, (&ArtifactRepository{ArchiveLogs: pointer.BoolPtr(true)}).IsArchiveLogs())
 }
+
+func TestWorkflowControllerConfig_GetCluster(t *testing.T) {
+	c := WorkflowControllerConfig{Clusters: []ClusterConfig{{Name: "compute", Namespace: "batch"}}}
+	if assert.NotNil(t, c.GetCluster("compute")) {
+		assert.Equal(t, "batch", c.GetCluster("compute").Namespace)
+	}
+	assert.Nil(t, c.GetCluster("other"))
+}
+
+func TestWorkflowControllerConfig_GetWorkflowDefaults(t *testing.T) {
+	c := WorkflowControllerConfig{
+		WorkflowDefaults: &WorkflowDefaults{ServiceAccountName: "workflows", ActiveDeadlineSeconds: pointer.Int64Ptr(3600)},
+		NamespaceDeadlines: map[string]NamespaceDeadline{
+			"tenant": {DefaultActiveDeadlineSeconds: pointer.Int64Ptr(60), MaxActiveDeadlineSeconds: pointer.Int64Ptr(120)},
+		},
+	}
+	defaults := c.GetWorkflowDefaults("tenant")
+	assert.Equal(t, "workflows", defaults.ServiceAccountName)
+	assert.Equal(t, int64(60), *defaults.ActiveDeadlineSeconds)
+	assert.Equal(t, int64(120), *defaults.MaxActiveDeadlineSeconds)
+	defaults = c.GetWorkflowDefaults("other")
+	assert.Equal(t, int64(3600), *defaults.ActiveDeadlineSeconds)
+	assert.Nil(t, defaults.MaxActiveDeadlineSeconds)
+	assert.Empty(t, WorkflowControllerConfig{}.GetWorkflowDefaults("other"))
+}
+
+func TestClusterConfig_AllowsNamespace(t *testing.T) {
+	assert.True(t, ClusterConfig{Name: "compute"}.AllowsNamespace("argo"))
+	c := ClusterConfig{Name: "compute", Namespaces: []string{"batch-jobs", "ml"}}
+	assert.True(t, c.AllowsNamespace("ml"))
+	assert.False(t, c.AllowsNamespace("argo"))
+}
+
+func TestWorkflowControllerConfig_GetClusterNames(t *testing.T) {
+	c := WorkflowControllerConfig{Clusters: []ClusterConfig{{Name: "compute"}, {Name: "gpu", Namespaces: []string{"ml"}}}}
+	assert.Equal(t, []string{"compute", "gpu"}, c.GetClusterNames("ml"))
+	assert.Equal(t, []string{"compute"}, c.GetClusterNames("argo"))
+	assert.Empty(t, WorkflowControllerConfig{}.GetClusterNames("argo"))
+	assert.NotNil(t, WorkflowControllerConfig{}.GetClusterNames("argo"))
+}
@@ -0,0 +1,351 @@
+package controller
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo/errors"
+	"github.com/argoproj/argo/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+// artifactGCRequeueDelay is how long to wait before checking the artifact GC pod of a deleted workflow again
+const artifactGCRequeueDelay = 10 * time.Second
+
+// artifactGCPodName returns the name of the pod which deletes the artifacts of the workflow
+func (woc *wfOperationCtx) artifactGCPodName() string {
+	return common.ArtifactGCPodName(woc.wf.ObjectMeta.Name)
+}
+
+// outputArtifactNodeIDs returns the IDs of the pod nodes of the workflow which have outputs, sorted. Nodes which reused
+// memoized outputs are left out, as their artifacts were saved by the node of another workflow.
+func (woc *wfOperationCtx) outputArtifactNodeIDs() []string {
+	var nodeIDs []string
+	for nodeID, node := range woc.wf.Status.Nodes {
+		if node.MemoizationStatus != nil && node.MemoizationStatus.Hit {
+			continue
+		}
+		if node.Type == wfv1.NodeTypePod && node.Outputs != nil {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	sort.Strings(nodeIDs)
+	return nodeIDs
+}
+
+// outputArtifacts returns the output artifacts saved by the pods of the workflow, ordered by node
+func (woc *wfOperationCtx) outputArtifacts() []wfv1.Artifact {
+	var artifacts []wfv1.Artifact
+	for _, nodeID := range woc.outputArtifactNodeIDs() {
+		for _, art := range woc.wf.Status.Nodes[nodeID].Outputs.Artifacts {
+			if art.HasLocation() {
+				artifacts = append(artifacts, art)
+			}
+		}
+	}
+	return artifacts
+}
+
+// garbageCollectArtifacts deletes the output artifacts of the workflow by running a pod, and returns the phase of the
+// deletion once the pod completed, or an empty phase while it runs. A pod which failed to delete the artifacts is kept
+// for its logs, but does not keep the workflow from completing or being deleted.
+func (woc *wfOperationCtx) garbageCollectArtifacts() (wfv1.NodePhase, error) {
+	artifacts := woc.outputArtifacts()
+	if len(artifacts) == 0 {
+		return wfv1.NodeSucceeded, nil
+	}
+	podName := woc.artifactGCPodName()
+	tmpl := &wfv1.Template{Outputs: wfv1.Outputs{Artifacts: artifacts}}
+	phase, err := woc.runArtifactPod(podName, "artifact-gc", tmpl, nil)
+	if err != nil {
+		return "", err
+	}
+	switch phase {
+	case apiv1.PodSucceeded:
+		woc.log.Infof("Deleted the artifacts of the workflow")
+		return wfv1.NodeSucceeded, nil
+	case apiv1.PodFailed:
+		woc.log.Warnf("Failed to delete the artifacts of the workflow, see the logs of pod %s", podName)
+		return wfv1.NodeFailed, nil
+	case "":
+		woc.log.Infof("Created pod %s to delete %d artifacts", podName, len(artifacts))
+	}
+	return "", nil
+}
+
+// runArtifactPod runs an argoexec command on the artifacts of the template in a pod, and returns the phase of the pod.
+// The files are written next to the template, by name. The phase is empty when the pod was just created. A pod which
+// succeeded is deleted, while a pod which failed is kept for its logs.
+func (woc *wfOperationCtx) runArtifactPod(podName string, command string, tmpl *wfv1.Template, files map[string]string) (apiv1.PodPhase, error) {
+	podsIf := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.ObjectMeta.Namespace)
+	pod, err := podsIf.Get(podName, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return "", woc.createArtifactPod(podName, command, tmpl, files)
+	}
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	if pod.Status.Phase == apiv1.PodPending {
+		// the template may have failed to be created with the pod
+		return pod.Status.Phase, woc.createArtifactPodTemplate(pod, tmpl, files)
+	}
+	if pod.Status.Phase == apiv1.PodSucceeded {
+		err = podsIf.Delete(podName, &metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return "", errors.InternalWrapError(err)
+		}
+	}
+	return pod.Status.Phase, nil
+}
+
+// createArtifactPod creates the pod which runs an argoexec command on the artifacts of the template. The template lists
+// every artifact of the workflow, which may not fit in an annotation, so the executor finds it in a config map owned by
+// the pod instead, which is deleted together with the pod.
+func (woc *wfOperationCtx) createArtifactPod(podName string, command string, tmpl *wfv1.Template, files map[string]string) error {
+	ctr := woc.newExecContainer(common.MainContainerName, tmpl)
+	ctr.Command = []string{"argoexec", command, "--template", common.TemplatePath}
+	secretVolumes, secretVolumeMounts := createSecretVolumes(tmpl)
+	ctr.VolumeMounts = append(ctr.VolumeMounts, secretVolumeMounts...)
+	ctr.VolumeMounts = append(ctr.VolumeMounts, apiv1.VolumeMount{
+		Name:      common.TemplateVolumeName,
+		MountPath: path.Dir(common.TemplatePath),
+	})
+	templateVolume := apiv1.Volume{
+		Name: common.TemplateVolumeName,
+		VolumeSource: apiv1.VolumeSource{
+			ConfigMap: &apiv1.ConfigMapVolumeSource{LocalObjectReference: apiv1.LocalObjectReference{Name: podName}},
+		},
+	}
+
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName,
+			Namespace: woc.wf.ObjectMeta.Namespace,
+			Labels: map[string]string{
+				common.LabelKeyWorkflow:  woc.wf.ObjectMeta.Name,
+				common.LabelKeyCompleted: "false",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		Spec: apiv1.PodSpec{
+			RestartPolicy:    apiv1.RestartPolicyNever,
+			Volumes:          append(append(woc.createVolumes(), secretVolumes...), templateVolume),
+			Containers:       []apiv1.Container{*ctr},
+			ImagePullSecrets: woc.wf.Spec.ImagePullSecrets,
+		},
+	}
+	if woc.controller.Config.InstanceID != "" {
+		pod.ObjectMeta.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
+	}
+	if woc.controller.GetContainerRuntimeExecutor() == common.ContainerRuntimeExecutorPNS {
+		pod.Spec.ShareProcessNamespace = pointer.BoolPtr(true)
+	}
+	err := woc.setupServiceAccount(woc.controller.kubeclientset, pod, tmpl)
+	if err != nil {
+		return err
+	}
+	created, err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.ObjectMeta.Namespace).Create(pod)
+	if apierr.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	return woc.createArtifactPodTemplate(created, tmpl, files)
+}
+
+// createArtifactPodTemplate creates the config map holding the template and the files of an artifact pod, unless it
+// exists. The pod does not start until it is created.
+func (woc *wfOperationCtx) createArtifactPodTemplate(pod *apiv1.Pod, tmpl *wfv1.Template, files map[string]string) error {
+	tmplBytes, err := json.Marshal(tmpl)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	data := map[string]string{common.TemplateKey: string(tmplBytes)}
+	for name, content := range files {
+		data[name] = content
+	}
+	_, err = woc.controller.kubeclientset.CoreV1().ConfigMaps(pod.Namespace).Create(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   pod.Name,
+			Labels: map[string]string{common.LabelKeyWorkflow: woc.wf.ObjectMeta.Name},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(pod, apiv1.SchemeGroupVersion.WithKind("Pod")),
+			},
+		},
+		Data: data,
+	})
+	if err != nil && !apierr.IsAlreadyExists(err) {
+		return errors.InternalWrapError(err)
+	}
+	return nil
+}
+
+// addArtifactGCFinalizer keeps the workflow from being deleted before its artifacts are garbage collected
+func (woc *wfOperationCtx) addArtifactGCFinalizer() {
+	if woc.wf.Spec.ArtifactGC == nil || woc.wf.Spec.ArtifactGC.Strategy != wfv1.ArtifactGCOnWorkflowDeletion {
+		return
+	}
+	if hasFinalizer(woc.wf.ObjectMeta.Finalizers, common.FinalizerArtifactGC) {
+		return
+	}
+	woc.wf.ObjectMeta.Finalizers = append(woc.wf.ObjectMeta.Finalizers, common.FinalizerArtifactGC)
+	woc.updated = true
+}
+
+// removeFinalizers lets the deletion of the workflow proceed once the given finalizers are done
+func (woc *wfOperationCtx) removeFinalizers(names ...string) error {
+	finalizers := []string{}
+	for _, finalizer := range woc.wf.ObjectMeta.Finalizers {
+		if !hasFinalizer(names, finalizer) {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers":      finalizers,
+			"resourceVersion": woc.wf.ObjectMeta.ResourceVersion,
+		},
+	})
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	_, err = woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.ObjectMeta.Namespace).Patch(woc.wf.ObjectMeta.Name, types.MergePatchType, patch)
+	if err != nil && !apierr.IsNotFound(err) {
+		return errors.InternalWrapError(err)
+	}
+	return nil
+}
+
+func hasFinalizer(finalizers []string, finalizer string) bool {
+	for _, f := range finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// deletedWorkflowWorker garbage collects the artifacts and deletes the pods in other clusters of the workflows which
+// are deleted while they have the finalizers of either. Completed workflows are not in the informer of the workflow
+// workers, so it has its own informer.
+func (wfc *WorkflowController) deletedWorkflowWorker(stopCh <-chan struct{}) {
+	informer := util.NewWorkflowInformer(wfc.restConfig, wfc.GetManagedNamespace(), workflowResyncPeriod, wfc.tweakWorkflowMetricslist)
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "deleted-workflows")
+	defer queue.ShutDown()
+	enqueue := func(obj interface{}) {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok || un.GetDeletionTimestamp() == nil {
+			return
+		}
+		if !hasFinalizer(un.GetFinalizers(), common.FinalizerArtifactGC) && !hasFinalizer(un.GetFinalizers(), common.FinalizerClusterPods) {
+			return
+		}
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err == nil {
+			queue.Add(key)
+		}
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(old, new interface{}) {
+			enqueue(new)
+		},
+	})
+	go informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
+		log.Error("Timed out waiting for the deleted workflows cache to sync")
+		return
+	}
+	go wait.Until(func() {
+		for wfc.processNextDeletedWorkflow(informer.GetIndexer(), queue) {
+		}
+	}, time.Second, stopCh)
+	<-stopCh
+}
+
+func (wfc *WorkflowController) processNextDeletedWorkflow(indexer cache.Indexer, queue workqueue.RateLimitingInterface) bool {
+	key, quit := queue.Get()
+	if quit {
+		return false
+	}
+	defer queue.Done(key)
+
+	obj, exists, err := indexer.GetByKey(key.(string))
+	if err != nil {
+		log.Errorf("Failed to get workflow '%s' from informer index: %+v", key, err)
+		return true
+	}
+	if !exists {
+		return true
+	}
+	wf, err := util.FromUnstructured(obj.(*unstructured.Unstructured))
+	if err != nil {
+		log.Warnf("Failed to unmarshal key '%s' to workflow object: %v", key, err)
+		return true
+	}
+	done, err := wfc.garbageCollectDeletedWorkflow(wf)
+	if err != nil {
+		log.Errorf("Failed to garbage collect deleted workflow '%s': %v", key, err)
+		queue.AddRateLimited(key)
+		return true
+	}
+	queue.Forget(key)
+	if !done {
+		queue.AddAfter(key, artifactGCRequeueDelay)
+	}
+	return true
+}
+
+// garbageCollectDeletedWorkflow deletes the pods in other clusters and the artifacts of a deleted workflow, and then
+// removes its finalizers. It returns true once the finalizers are removed.
+func (wfc *WorkflowController) garbageCollectDeletedWorkflow(wf *wfv1.Workflow) (bool, error) {
+	// the workflow workers may still be operating the workflow
+	key := wf.ObjectMeta.Namespace + "/" + wf.ObjectMeta.Name
+	wfc.keyLock.lock(key)
+	defer wfc.keyLock.unlock(key)
+	woc := newWorkflowOperationCtx(wf, wfc)
+	var finalizers []string
+	if hasFinalizer(wf.ObjectMeta.Finalizers, common.FinalizerClusterPods) {
+		// the pods are deleted again while the artifacts are, in case the workflow created more meanwhile
+		err := woc.deleteClusterPods()
+		if err != nil {
+			return false, err
+		}
+		finalizers = append(finalizers, common.FinalizerClusterPods)
+	}
+	if hasFinalizer(wf.ObjectMeta.Finalizers, common.FinalizerArtifactGC) {
+		err := wfc.getHydrator().Hydrate(woc.wf)
+		if err != nil {
+			return false, err
+		}
+		phase, err := woc.garbageCollectArtifacts()
+		if err != nil || phase == "" {
+			return false, err
+		}
+		finalizers = append(finalizers, common.FinalizerArtifactGC)
+	}
+	err := woc.removeFinalizers(finalizers...)
+	if err != nil {
+		return false, err
+	}
+	woc.log.Infof("Removed the finalizers %v", finalizers)
+	return true, nil
+}
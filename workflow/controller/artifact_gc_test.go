@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+var artifactGCWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: artifact-gc
+spec:
+  entrypoint: whalesay
+  artifactGC:
+    strategy: OnWorkflowCompletion
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+`
+
+// completePodWithArtifact marks the only pod of the workflow as succeeded, with an output artifact in S3
+func completePodWithArtifact(t *testing.T, controller *WorkflowController) {
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if !assert.Len(t, pods.Items, 1) {
+		return
+	}
+	outputs, err := json.Marshal(wfv1.Outputs{Artifacts: []wfv1.Artifact{{
+		Name: "out",
+		ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{
+			S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket", Endpoint: "minio:9000"},
+			Key:      "artifact-gc/out.tgz",
+		}},
+	}}})
+	assert.NoError(t, err)
+	pod := pods.Items[0]
+	pod.Status.Phase = apiv1.PodSucceeded
+	pod.Annotations[common.AnnotationKeyOutputs] = string(outputs)
+	_, err = podcs.Update(&pod)
+	assert.NoError(t, err)
+}
+
+func TestArtifactGCOnWorkflowCompletion(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(artifactGCWorkflow))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	completePodWithArtifact(t, controller)
+
+	// the workflow keeps running until its artifacts are deleted
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	gcPod, err := podcs.Get("artifact-gc-artgc", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"argoexec", "artifact-gc", "--template", common.TemplatePath}, gcPod.Spec.Containers[0].Command)
+		var tmpl wfv1.Template
+		cm, err := controller.kubeclientset.CoreV1().ConfigMaps("").Get("artifact-gc-artgc", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Pod", cm.OwnerReferences[0].Kind)
+			assert.NoError(t, json.Unmarshal([]byte(cm.Data[common.TemplateKey]), &tmpl))
+		}
+		if assert.Len(t, tmpl.Outputs.Artifacts, 1) {
+			assert.Equal(t, "artifact-gc/out.tgz", tmpl.Outputs.Artifacts[0].S3.Key)
+		}
+	}
+
+	gcPod.Status.Phase = apiv1.PodSucceeded
+	_, err = podcs.Update(gcPod)
+	assert.NoError(t, err)
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.ArtifactGCPhase)
+	_, err = podcs.Get("artifact-gc-artgc", metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+func TestArtifactGCOnWorkflowDeletion(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf := unmarshalWF(artifactGCWorkflow)
+	wf.Spec.ArtifactGC.Strategy = wfv1.ArtifactGCOnWorkflowDeletion
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, []string{common.FinalizerArtifactGC}, woc.wf.Finalizers)
+	completePodWithArtifact(t, controller)
+
+	// the artifacts are kept when the workflow completes
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	_, err = podcs.Get("artifact-gc-artgc", metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+
+	// and deleted before the finalizer of the deleted workflow is removed
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	done, err := controller.garbageCollectDeletedWorkflow(wf)
+	assert.NoError(t, err)
+	assert.False(t, done)
+	gcPod, err := podcs.Get("artifact-gc-artgc", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		gcPod.Status.Phase = apiv1.PodSucceeded
+		_, err = podcs.Update(gcPod)
+		assert.NoError(t, err)
+	}
+	done, err = controller.garbageCollectDeletedWorkflow(wf)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, wf.Finalizers)
+}
+
+func TestOutputArtifactsOfMemoizedNodes(t *testing.T) {
+	artifact := func(key string) *wfv1.Outputs {
+		return &wfv1.Outputs{Artifacts: []wfv1.Artifact{{
+			Name:             "out",
+			ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: key}},
+		}}}
+	}
+	wf := unmarshalWF(artifactGCWorkflow)
+	wf.Status.Nodes = wfv1.Nodes{
+		"ran": {ID: "ran", Type: wfv1.NodeTypePod, Outputs: artifact("ran.tgz"),
+			MemoizationStatus: &wfv1.MemoizationStatus{Hit: false, Key: "ran"}},
+		"reused": {ID: "reused", Type: wfv1.NodeTypePod, Outputs: artifact("other-workflow.tgz"),
+			MemoizationStatus: &wfv1.MemoizationStatus{Hit: true, Key: "reused"}},
+	}
+	woc := newWorkflowOperationCtx(wf, newController())
+	assert.Equal(t, []string{"ran"}, woc.outputArtifactNodeIDs())
+	artifacts := woc.outputArtifacts()
+	if assert.Len(t, artifacts, 1) {
+		assert.Equal(t, "ran.tgz", artifacts[0].S3.Key)
+	}
+}
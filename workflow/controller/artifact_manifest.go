@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/argo"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/manifest"
+)
+
+// maxArtifactManifestSize is the size of the largest artifact manifest which is saved. The manifest is handed to the
+// pod which saves it in a config map, which holds at most 1MiB along with the template of the pod.
+var maxArtifactManifestSize = 1000 * 1024
+
+// artifactManifestPodName returns the name of the pod which saves the artifact manifest of the workflow
+func (woc *wfOperationCtx) artifactManifestPodName() string {
+	return common.ArtifactManifestPodName(woc.wf.ObjectMeta.Name)
+}
+
+// artifactManifest returns the artifact manifest of the workflow
+func (woc *wfOperationCtx) artifactManifest() manifest.Manifest {
+	artManifest := manifest.Manifest{
+		Workflow:        woc.wf.ObjectMeta.Name,
+		Namespace:       woc.wf.ObjectMeta.Namespace,
+		UID:             string(woc.wf.ObjectMeta.UID),
+		ArtifactGCPhase: woc.wf.Status.ArtifactGCPhase,
+		Artifacts:       []manifest.Entry{},
+	}
+	for _, nodeID := range woc.outputArtifactNodeIDs() {
+		node := woc.wf.Status.Nodes[nodeID]
+		for _, art := range node.Outputs.Artifacts {
+			if !art.HasLocation() {
+				continue
+			}
+			artManifest.Artifacts = append(artManifest.Artifacts, manifest.Entry{
+				NodeID:   nodeID,
+				NodeName: node.Name,
+				Name:     art.Name,
+				Location: art.ArtifactLocation,
+				Size:     art.SizeBytes,
+				Checksum: art.Checksum,
+			})
+		}
+	}
+	return artManifest
+}
+
+// artifactManifestLocation returns the artifact where the manifest is saved, which is in the default archive location,
+// as if the manifest pod was a pod of the workflow
+func (woc *wfOperationCtx) artifactManifestLocation() (*wfv1.Artifact, error) {
+	art := wfv1.Artifact{Name: "artifact-manifest"}
+	tmpl := &wfv1.Template{Outputs: wfv1.Outputs{Artifacts: []wfv1.Artifact{art}}}
+	err := woc.addArchiveLocation(nil, tmpl)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err = common.SubstituteParams(tmpl, woc.globalParams, map[string]string{common.LocalVarPodName: woc.artifactManifestPodName()})
+	if err != nil {
+		return nil, err
+	}
+	err = common.SetArtifactLocationInArchive(&art, tmpl.ArchiveLocation, manifest.FileName)
+	if err != nil {
+		return nil, err
+	}
+	return &art, nil
+}
+
+// saveArtifactManifest saves the artifact manifest of the workflow by running a pod, and returns true once the pod
+// completed. The manifest is a file of the config map of the pod, rather than a parameter of its template. The
+// location of the manifest is recorded in the status of the workflow when the pod succeeded. A pod which failed to
+// save the manifest is kept for its logs, but does not keep the workflow from completing, nor does a manifest too large
+// to be saved.
+func (woc *wfOperationCtx) saveArtifactManifest() (bool, error) {
+	art, err := woc.artifactManifestLocation()
+	if err != nil {
+		woc.log.Warnf("Failed to determine the location of the artifact manifest: %v", err)
+		return true, nil
+	}
+	manifestBytes, err := json.Marshal(woc.artifactManifest())
+	if err != nil {
+		return false, errors.InternalWrapError(err)
+	}
+	if len(manifestBytes) > maxArtifactManifestSize {
+		msg := fmt.Sprintf("The artifact manifest of the workflow is not saved, as its size of %d bytes exceeds the limit of %d bytes", len(manifestBytes), maxArtifactManifestSize)
+		woc.log.Warn(msg)
+		woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonArtifactManifestTooLarge}, msg)
+		return true, nil
+	}
+	tmpl := &wfv1.Template{Outputs: wfv1.Outputs{Artifacts: []wfv1.Artifact{*art}}}
+	podName := woc.artifactManifestPodName()
+	phase, err := woc.runArtifactPod(podName, "artifact-manifest", tmpl, map[string]string{common.ArtifactManifestKey: string(manifestBytes)})
+	if err != nil {
+		return false, err
+	}
+	switch phase {
+	case apiv1.PodSucceeded:
+		woc.log.Infof("Saved the artifact manifest of the workflow")
+		woc.wf.Status.ArtifactManifest = art
+		woc.updated = true
+		return true, nil
+	case apiv1.PodFailed:
+		woc.log.Warnf("Failed to save the artifact manifest of the workflow, see the logs of pod %s", podName)
+		return true, nil
+	case "":
+		woc.log.Infof("Created pod %s to save the artifact manifest", podName)
+	}
+	return false, nil
+}
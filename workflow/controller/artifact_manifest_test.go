@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/manifest"
+)
+
+var artifactManifestWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: artifact-manifest
+spec:
+  entrypoint: whalesay
+  artifactManifest: true
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+`
+
+func TestSaveArtifactManifest(t *testing.T) {
+	controller := newController()
+	controller.Config.ArtifactRepository.S3 = &config.S3ArtifactRepository{
+		S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket", Endpoint: "minio:9000"},
+	}
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(artifactManifestWorkflow))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	completePodWithArtifact(t, controller)
+
+	// the workflow keeps running until its artifact manifest is saved
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	manifestPod, err := podcs.Get("artifact-manifest-manifest", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"argoexec", "artifact-manifest", "--template", common.TemplatePath}, manifestPod.Spec.Containers[0].Command)
+		var tmpl wfv1.Template
+		cm, err := controller.kubeclientset.CoreV1().ConfigMaps("").Get("artifact-manifest-manifest", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.NoError(t, json.Unmarshal([]byte(cm.Data[common.TemplateKey]), &tmpl))
+			// the manifest is a file of its own rather than a parameter of the template
+			assert.Empty(t, tmpl.Inputs.Parameters)
+			var artManifest manifest.Manifest
+			assert.NoError(t, json.Unmarshal([]byte(cm.Data[common.ArtifactManifestKey]), &artManifest))
+			assert.Equal(t, "artifact-manifest", artManifest.Workflow)
+			if assert.Len(t, artManifest.Artifacts, 1) {
+				assert.Equal(t, "artifact-manifest", artManifest.Artifacts[0].NodeName)
+				assert.Equal(t, "out", artManifest.Artifacts[0].Name)
+				assert.Equal(t, "artifact-gc/out.tgz", artManifest.Artifacts[0].Location.S3.Key)
+			}
+		}
+		if assert.Len(t, tmpl.Outputs.Artifacts, 1) {
+			assert.Equal(t, "artifact-manifest/artifact-manifest-manifest/artifact-manifest.json", tmpl.Outputs.Artifacts[0].S3.Key)
+		}
+	}
+
+	manifestPod.Status.Phase = apiv1.PodSucceeded
+	_, err = podcs.Update(manifestPod)
+	assert.NoError(t, err)
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	if assert.NotNil(t, woc.wf.Status.ArtifactManifest) {
+		assert.Equal(t, "artifact-manifest/artifact-manifest-manifest/artifact-manifest.json", woc.wf.Status.ArtifactManifest.S3.Key)
+	}
+	_, err = podcs.Get("artifact-manifest-manifest", metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+// TestSaveArtifactManifestAfterArtifactGC verifies the manifest is saved once the artifacts are garbage collected, and
+// records whether they were
+func TestSaveArtifactManifestAfterArtifactGC(t *testing.T) {
+	controller := newController()
+	controller.Config.ArtifactRepository.S3 = &config.S3ArtifactRepository{
+		S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket", Endpoint: "minio:9000"},
+	}
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf := unmarshalWF(artifactManifestWorkflow)
+	wf.Spec.ArtifactGC = &wfv1.ArtifactGC{Strategy: wfv1.ArtifactGCOnWorkflowCompletion}
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	completePodWithArtifact(t, controller)
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	_, err = podcs.Get("artifact-manifest-manifest", metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+	gcPod, err := podcs.Get("artifact-manifest-artgc", metav1.GetOptions{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	gcPod.Status.Phase = apiv1.PodFailed
+	_, err = podcs.Update(gcPod)
+	assert.NoError(t, err)
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.ArtifactGCPhase)
+	cm, err := controller.kubeclientset.CoreV1().ConfigMaps("").Get("artifact-manifest-manifest", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		var artManifest manifest.Manifest
+		assert.NoError(t, json.Unmarshal([]byte(cm.Data[common.ArtifactManifestKey]), &artManifest))
+		assert.Equal(t, wfv1.NodeFailed, artManifest.ArtifactGCPhase)
+		assert.Len(t, artManifest.Artifacts, 1)
+	}
+}
+
+// TestArtifactManifestTooLarge verifies a manifest too large to be handed to the pod is not saved, and does not keep
+// the workflow from completing
+func TestArtifactManifestTooLarge(t *testing.T) {
+	defer func(size int) { maxArtifactManifestSize = size }(maxArtifactManifestSize)
+	maxArtifactManifestSize = 10
+	controller := newController()
+	controller.Config.ArtifactRepository.S3 = &config.S3ArtifactRepository{
+		S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket", Endpoint: "minio:9000"},
+	}
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(artifactManifestWorkflow))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	completePodWithArtifact(t, controller)
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	assert.Nil(t, woc.wf.Status.ArtifactManifest)
+	_, err = podcs.Get("artifact-manifest-manifest", metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
@@ -0,0 +1,338 @@
+package controller
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+// cluster is another cluster the pods of templates run in. Its pods are not owned by their workflow, and are labeled
+// with the namespace of their workflow instead, so that its informer can wake up their workflow when they change.
+type cluster struct {
+	config        config.ClusterConfig
+	restConfig    *rest.Config
+	kubeclientset kubernetes.Interface
+	podInformer   cache.SharedIndexInformer
+	stopCh        chan struct{}
+}
+
+// podLocation is where the pod of a node runs
+type podLocation struct {
+	// cluster is the name of the cluster, empty for the cluster of the controller
+	cluster       string
+	restConfig    *rest.Config
+	kubeclientset kubernetes.Interface
+	namespace     string
+}
+
+// updateClusters connects to the clusters of the configuration, and disconnects from the clusters which were removed
+// from it. The clusters whose configuration did not change are kept as they are.
+func (wfc *WorkflowController) updateClusters(configs []config.ClusterConfig) error {
+	wfc.clustersLock.RLock()
+	current := wfc.clusters
+	wfc.clustersLock.RUnlock()
+	clusters := make(map[string]*cluster)
+	var started []*cluster
+	for _, clusterConfig := range configs {
+		if _, ok := clusters[clusterConfig.Name]; ok {
+			return errors.Errorf(errors.CodeBadRequest, "cluster %s is configured more than once", clusterConfig.Name)
+		}
+		if c, ok := current[clusterConfig.Name]; ok && reflect.DeepEqual(c.config, clusterConfig) {
+			clusters[clusterConfig.Name] = c
+			continue
+		}
+		c, err := wfc.newCluster(clusterConfig)
+		if err != nil {
+			for _, c := range started {
+				close(c.stopCh)
+			}
+			return err
+		}
+		clusters[clusterConfig.Name] = c
+		started = append(started, c)
+	}
+	for name, c := range current {
+		if clusters[name] != c {
+			log.Infof("Disconnecting from cluster %s", name)
+			close(c.stopCh)
+		}
+	}
+	for _, c := range started {
+		log.Infof("Connecting to cluster %s", c.config.Name)
+		go c.podInformer.Run(c.stopCh)
+	}
+	wfc.clustersLock.Lock()
+	wfc.clusters = clusters
+	wfc.clustersLock.Unlock()
+	return nil
+}
+
+// newCluster creates the clients of a cluster from the kubeconfig in its secret, and the informer of its pods
+func (wfc *WorkflowController) newCluster(clusterConfig config.ClusterConfig) (*cluster, error) {
+	if clusterConfig.Name == "" {
+		return nil, errors.New(errors.CodeBadRequest, "clusters must have a name")
+	}
+	restConfig, kubeclientset, err := clusterConfig.NewKubeClient(wfc.kubeclientset, wfc.namespace)
+	if err != nil {
+		return nil, err
+	}
+	c := &cluster{
+		config:        clusterConfig,
+		restConfig:    restConfig,
+		kubeclientset: kubeclientset,
+		stopCh:        make(chan struct{}),
+	}
+	c.podInformer = wfc.newClusterPodInformer(c)
+	return c, nil
+}
+
+// newClusterPodInformer creates the informer of the incomplete pods of a cluster, which wakes up their workflow
+func (wfc *WorkflowController) newClusterPodInformer(c *cluster) cache.SharedIndexInformer {
+	namespace := c.config.Namespace
+	if namespace == "" {
+		namespace = wfc.GetManagedNamespace()
+	}
+	incompleteReq, _ := labels.NewRequirement(common.LabelKeyCompleted, selection.Equals, []string{"false"})
+	workflowNamespaceReq, _ := labels.NewRequirement(common.LabelKeyWorkflowNamespace, selection.Exists, nil)
+	labelSelector := labels.NewSelector().
+		Add(*incompleteReq).
+		Add(*workflowNamespaceReq).
+		Add(util.InstanceIDRequirement(wfc.Config.InstanceID))
+	source := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = labelSelector.String()
+			return c.kubeclientset.CoreV1().Pods(namespace).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = labelSelector.String()
+			return c.kubeclientset.CoreV1().Pods(namespace).Watch(options)
+		},
+	}
+	informer := cache.NewSharedIndexInformer(source, &apiv1.Pod{}, podResyncPeriod, cache.Indexers{
+		indexWorkflow: indexByWorkflow,
+	})
+	wakeUp := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		keys, _ := indexByWorkflow(obj)
+		for _, key := range keys {
+			wfc.wfQueue.Add(key)
+		}
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: wakeUp,
+		UpdateFunc: func(old, new interface{}) {
+			wakeUp(new)
+		},
+		DeleteFunc: wakeUp,
+	})
+	return informer
+}
+
+// getCluster returns the connected cluster of the given name, nil if there is none
+func (wfc *WorkflowController) getCluster(name string) *cluster {
+	wfc.clustersLock.RLock()
+	defer wfc.clustersLock.RUnlock()
+	return wfc.clusters[name]
+}
+
+// getClusters returns the connected clusters, ordered by name
+func (wfc *WorkflowController) getClusters() []*cluster {
+	wfc.clustersLock.RLock()
+	defer wfc.clustersLock.RUnlock()
+	clusters := make([]*cluster, 0, len(wfc.clusters))
+	for _, c := range wfc.clusters {
+		clusters = append(clusters, c)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].config.Name < clusters[j].config.Name
+	})
+	return clusters
+}
+
+// namespace returns the namespace the pods of the workflows of a namespace run in, in a cluster
+func (c *cluster) namespace(wfNamespace string) string {
+	return c.config.GetPodNamespace(wfNamespace)
+}
+
+// getPodLocation returns where the pods of the workflow run in the cluster of the given name, which is the cluster of
+// the controller when the name is empty
+func (woc *wfOperationCtx) getPodLocation(clusterName string) (podLocation, error) {
+	if clusterName == "" {
+		return podLocation{
+			restConfig:    woc.controller.restConfig,
+			kubeclientset: woc.controller.kubeclientset,
+			namespace:     woc.wf.ObjectMeta.Namespace,
+		}, nil
+	}
+	c := woc.controller.getCluster(clusterName)
+	if c == nil {
+		return podLocation{}, errors.Errorf(errors.CodeBadRequest, "cluster %s is not configured", clusterName)
+	}
+	return podLocation{
+		cluster:       clusterName,
+		restConfig:    c.restConfig,
+		kubeclientset: c.kubeclientset,
+		namespace:     c.namespace(woc.wf.ObjectMeta.Namespace),
+	}, nil
+}
+
+// getNewPodLocation returns where a new pod of the workflow runs in the cluster of the given name, which the
+// configuration must allow the namespace of the workflow to use. The pods created before the configuration restricted
+// the namespaces of the cluster are still watched where they run.
+func (woc *wfOperationCtx) getNewPodLocation(clusterName string) (podLocation, error) {
+	if c := woc.controller.getCluster(clusterName); c != nil && !c.config.AllowsNamespace(woc.wf.ObjectMeta.Namespace) {
+		return podLocation{}, errors.Errorf(errors.CodeForbidden, "cluster %s is not configured for namespace %s", clusterName, woc.wf.ObjectMeta.Namespace)
+	}
+	return woc.getPodLocation(clusterName)
+}
+
+// getNodePodLocation returns where the pod of a node runs
+func (woc *wfOperationCtx) getNodePodLocation(nodeID string) (podLocation, error) {
+	return woc.getPodLocation(woc.wf.Status.Nodes[nodeID].Cluster)
+}
+
+// podKey returns the key of the pod of a node on the channels of the pods to label completed and to delete, which is
+// namespace/name for the pods of the cluster of the controller, and cluster/namespace/name for the others. It is empty
+// if the cluster of the pod is not configured anymore.
+func (woc *wfOperationCtx) podKey(podName string) string {
+	location, err := woc.getNodePodLocation(podName)
+	if err != nil {
+		woc.log.Warnf("Failed to get the location of pod %s: %v", podName, err)
+		return ""
+	}
+	if location.cluster == "" {
+		return location.namespace + "/" + podName
+	}
+	return location.cluster + "/" + location.namespace + "/" + podName
+}
+
+// parsePodKey returns the client of the cluster of a pod key, and the namespace and name of the pod
+func (wfc *WorkflowController) parsePodKey(key string) (kubernetes.Interface, string, string, error) {
+	parts := strings.Split(key, "/")
+	switch len(parts) {
+	case 2:
+		return wfc.kubeclientset, parts[0], parts[1], nil
+	case 3:
+		c := wfc.getCluster(parts[0])
+		if c == nil {
+			return nil, "", "", errors.Errorf(errors.CodeBadRequest, "cluster %s is not configured", parts[0])
+		}
+		return c.kubeclientset, parts[1], parts[2], nil
+	}
+	return nil, "", "", errors.Errorf(errors.CodeBadRequest, "invalid pod key %s", key)
+}
+
+// listClusterPods lists the pods of the workflow in the other clusters its incomplete pod nodes run in. It returns the
+// clusters which could not be listed as well, since the pods of their nodes are unknown rather than deleted.
+func (woc *wfOperationCtx) listClusterPods() ([]apiv1.Pod, map[string]bool) {
+	clusters := make(map[string]bool)
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod && node.Cluster != "" && !node.Completed() {
+			clusters[node.Cluster] = true
+		}
+	}
+	var pods []apiv1.Pod
+	unavailable := make(map[string]bool)
+	for clusterName := range clusters {
+		location, err := woc.getPodLocation(clusterName)
+		if err != nil {
+			woc.log.Warnf("Failed to list the pods in cluster %s: %v", clusterName, err)
+			unavailable[clusterName] = true
+			continue
+		}
+		podList, err := location.kubeclientset.CoreV1().Pods(location.namespace).List(metav1.ListOptions{
+			LabelSelector: woc.clusterPodsSelector(),
+		})
+		if err != nil {
+			woc.log.Warnf("Failed to list the pods in cluster %s: %v", clusterName, err)
+			unavailable[clusterName] = true
+			continue
+		}
+		pods = append(pods, podList.Items...)
+	}
+	return pods, unavailable
+}
+
+// clusterPodsSelector returns the label selector of the pods of the workflow in the other clusters
+func (woc *wfOperationCtx) clusterPodsSelector() string {
+	return labels.Set{
+		common.LabelKeyWorkflow:          woc.wf.ObjectMeta.Name,
+		common.LabelKeyWorkflowNamespace: woc.wf.ObjectMeta.Namespace,
+	}.String()
+}
+
+// isClusterPodOfWorkflow returns whether a pod of another cluster belongs to the workflow. The pods of the workflows
+// of the same name in different namespaces have the same names, and may run in the same namespace of the cluster.
+func (woc *wfOperationCtx) isClusterPodOfWorkflow(pod *apiv1.Pod) bool {
+	return pod.Labels[common.LabelKeyWorkflow] == woc.wf.ObjectMeta.Name &&
+		pod.Labels[common.LabelKeyWorkflowNamespace] == woc.wf.ObjectMeta.Namespace
+}
+
+// addClusterPodsFinalizer keeps the workflow from being deleted before its pods in other clusters are, when the
+// configuration allows its namespace to use other clusters. The pods are not owned by the workflow, and so are not
+// garbage collected along with it. The finalizer is persisted right away, since it must be there before the first pod is
+// created in another cluster.
+func (woc *wfOperationCtx) addClusterPodsFinalizer() error {
+	if hasFinalizer(woc.wf.ObjectMeta.Finalizers, common.FinalizerClusterPods) || woc.wf.ObjectMeta.DeletionTimestamp != nil {
+		return nil
+	}
+	if len(woc.controller.Config.GetClusterNames(woc.wf.ObjectMeta.Namespace)) == 0 {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers":      append(woc.wf.ObjectMeta.Finalizers, common.FinalizerClusterPods),
+			"resourceVersion": woc.wf.ObjectMeta.ResourceVersion,
+		},
+	})
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	wf, err := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.ObjectMeta.Namespace).Patch(woc.wf.ObjectMeta.Name, types.MergePatchType, patch)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	woc.log.Infof("Added the finalizer of the pods in other clusters")
+	woc.wf.ObjectMeta.Finalizers = wf.ObjectMeta.Finalizers
+	woc.wf.ObjectMeta.ResourceVersion = wf.ObjectMeta.ResourceVersion
+	return nil
+}
+
+// deleteClusterPods deletes the pods of the deleted workflow in the other clusters. They are selected by the UID of the
+// workflow, so that the pods of a new workflow of the same name are left alone. The pods created before they were
+// labeled with it are selected by the name and namespace of the workflow instead.
+func (woc *wfOperationCtx) deleteClusterPods() error {
+	uidSelector := labels.Set{common.LabelKeyWorkflowUID: string(woc.wf.ObjectMeta.UID)}.String()
+	unlabeledSelector := woc.clusterPodsSelector() + ",!" + common.LabelKeyWorkflowUID
+	for _, c := range woc.controller.getClusters() {
+		podcs := c.kubeclientset.CoreV1().Pods(c.namespace(woc.wf.ObjectMeta.Namespace))
+		for _, selector := range []string{uidSelector, unlabeledSelector} {
+			err := podcs.DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: selector})
+			if err != nil {
+				return errors.InternalWrapErrorf(err, "failed to delete the pods in cluster %s: %v", c.config.Name, err)
+			}
+		}
+	}
+	return nil
+}
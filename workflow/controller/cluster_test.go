@@ -0,0 +1,348 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/config"
+)
+
+var clusterWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: cluster
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: local
+        template: whalesay
+    - - name: remote
+        template: heavy
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+  - name: heavy
+    cluster: compute
+    container:
+      image: docker/whalesay:latest
+`
+
+// newClusterController returns a controller connected to a fake cluster named compute, whose pods run in namespace
+// batch
+func newClusterController() (*WorkflowController, *fake.Clientset) {
+	controller := newController()
+	clusterConfig := config.ClusterConfig{Name: "compute", Namespace: "batch"}
+	controller.Config.Clusters = []config.ClusterConfig{clusterConfig}
+	clientset := fake.NewSimpleClientset()
+	controller.clusters = map[string]*cluster{
+		"compute": {config: clusterConfig, kubeclientset: clientset, stopCh: make(chan struct{})},
+	}
+	return controller, clientset
+}
+
+// makePodsPhase sets the phase of all the pods of a namespace
+func makePodsPhase(t *testing.T, kubeclientset kubernetes.Interface, namespace string, phase apiv1.PodPhase) {
+	podcs := kubeclientset.CoreV1().Pods(namespace)
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	for _, pod := range pods.Items {
+		pod.Status.Phase = phase
+		_, err := podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+}
+
+func TestClusterPod(t *testing.T) {
+	controller, clusterClientset := newClusterController()
+	wf := unmarshalWF(clusterWf)
+	wf.UID = "my-uid"
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	// the finalizer which deletes the pods of the other cluster is persisted first
+	wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Get(wf.Name, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{common.FinalizerClusterPods}, wf.Finalizers)
+	}
+	assert.True(t, woc.wf.Status.NodeIDNamespaced)
+
+	// the first step runs in the cluster of the controller
+	localPods, err := controller.kubeclientset.CoreV1().Pods("argo").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(localPods.Items))
+	makePodsPhase(t, controller.kubeclientset, "argo", apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+
+	// the second step runs in the other cluster, without being owned by the workflow
+	clusterPods, err := clusterClientset.CoreV1().Pods("batch").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(clusterPods.Items)) {
+		pod := clusterPods.Items[0]
+		assert.Equal(t, "cluster", pod.Labels[common.LabelKeyWorkflow])
+		assert.Equal(t, "argo", pod.Labels[common.LabelKeyWorkflowNamespace])
+		assert.Equal(t, "my-uid", pod.Labels[common.LabelKeyWorkflowUID])
+		assert.Empty(t, pod.OwnerReferences)
+		node := woc.wf.Status.Nodes[pod.Name]
+		assert.Equal(t, "compute", node.Cluster)
+		assert.Equal(t, "compute/batch/"+pod.Name, woc.podKey(pod.Name))
+	}
+	localPods, err = controller.kubeclientset.CoreV1().Pods("argo").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(localPods.Items))
+
+	// the workflow completes once the pod of the other cluster does
+	makePodsPhase(t, clusterClientset, "batch", apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+}
+
+func TestClusterPodDeleted(t *testing.T) {
+	controller, clusterClientset := newClusterController()
+	wf := unmarshalWF(clusterWf)
+	wf.Spec.Templates[0].Steps = wf.Spec.Templates[0].Steps[1:]
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	clusterPods, err := clusterClientset.CoreV1().Pods("batch").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if !assert.Equal(t, 1, len(clusterPods.Items)) {
+		return
+	}
+	podName := clusterPods.Items[0].Name
+	err = clusterClientset.CoreV1().Pods("batch").Delete(podName, &metav1.DeleteOptions{})
+	assert.NoError(t, err)
+
+	// the pods of a cluster which is not available anymore are unknown rather than deleted
+	clusters := controller.clusters
+	controller.clusters = nil
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	assert.NotEqual(t, wfv1.NodeError, woc.wf.Status.Nodes[podName].Phase)
+	assert.True(t, woc.requeued)
+
+	// while the pods missing from an available cluster were deleted
+	controller.clusters = clusters
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeError, woc.wf.Status.Nodes[podName].Phase)
+	assert.Equal(t, "pod deleted", woc.wf.Status.Nodes[podName].Message)
+}
+
+func TestClusterPodRetried(t *testing.T) {
+	controller, clusterClientset := newClusterController()
+	wf := unmarshalWF(clusterWf)
+	wf.Spec.Templates[0].Steps = wf.Spec.Templates[0].Steps[1:]
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+
+	// the failed pod of the retried node is left behind in the other cluster
+	wf.Status.NodeIDNamespaced = true
+	podName := wf.NodeID("cluster[0].remote")
+	_, err = clusterClientset.CoreV1().Pods("batch").Create(&apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: podName, Labels: map[string]string{
+			common.LabelKeyWorkflow:          "cluster",
+			common.LabelKeyWorkflowNamespace: "argo",
+			common.LabelKeyCompleted:         "true",
+		}},
+		Status: apiv1.PodStatus{Phase: apiv1.PodFailed},
+	})
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	pod, err := clusterClientset.CoreV1().Pods("batch").Get(podName, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "false", pod.Labels[common.LabelKeyCompleted])
+		assert.Empty(t, pod.Status.Phase)
+	}
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+}
+
+func TestClusterPodOfOtherWorkflow(t *testing.T) {
+	controller, clusterClientset := newClusterController()
+	wf := unmarshalWF(clusterWf)
+	wf.Spec.Templates[0].Steps = wf.Spec.Templates[0].Steps[1:]
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+
+	// a pod of the workflow of the same name in another namespace has the name of the pod of the node, e.g. it was
+	// created before the namespaces were hashed into the IDs of the nodes
+	wf.Status.NodeIDNamespaced = true
+	podName := wf.NodeID("cluster[0].remote")
+	_, err = clusterClientset.CoreV1().Pods("batch").Create(&apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: podName, Labels: map[string]string{
+			common.LabelKeyWorkflow:          "cluster",
+			common.LabelKeyWorkflowNamespace: "other",
+			common.LabelKeyCompleted:         "true",
+		}},
+		Status: apiv1.PodStatus{Phase: apiv1.PodSucceeded},
+	})
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node := woc.wf.Status.Nodes.FindByDisplayName("remote")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Contains(t, node.Message, "belongs to workflow other/cluster")
+	}
+	pod, err := clusterClientset.CoreV1().Pods("batch").Get(podName, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "other", pod.Labels[common.LabelKeyWorkflowNamespace])
+		assert.Equal(t, apiv1.PodSucceeded, pod.Status.Phase)
+	}
+}
+
+func TestClusterPodNames(t *testing.T) {
+	controller, clusterClientset := newClusterController()
+	for _, namespace := range []string{"argo", "other"} {
+		wf := unmarshalWF(clusterWf)
+		wf.Spec.Templates[0].Steps = wf.Spec.Templates[0].Steps[1:]
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(namespace).Create(wf)
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate()
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	}
+	// the pods of the workflows of the same name in different namespaces run side by side
+	clusterPods, err := clusterClientset.CoreV1().Pods("batch").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Equal(t, 2, len(clusterPods.Items)) {
+		assert.NotEqual(t, clusterPods.Items[0].Name, clusterPods.Items[1].Name)
+	}
+}
+
+func TestClusterPodWithoutFinalizer(t *testing.T) {
+	controller, clusterClientset := newClusterController()
+	wf := unmarshalWF(clusterWf)
+	wf.Spec.Templates[0].Steps = wf.Spec.Templates[0].Steps[1:]
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+
+	// the finalizer cannot be added to a workflow which is being deleted
+	wf.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Empty(t, woc.wf.Finalizers)
+	node := woc.wf.Status.Nodes.FindByDisplayName("remote")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Contains(t, node.Message, "does not have the "+common.FinalizerClusterPods+" finalizer")
+	}
+	clusterPods, err := clusterClientset.CoreV1().Pods("batch").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, clusterPods.Items)
+}
+
+func TestDeleteClusterPods(t *testing.T) {
+	controller, clusterClientset := newClusterController()
+	var selectors []string
+	clusterClientset.PrependReactor("delete-collection", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		selectors = append(selectors, action.(k8stesting.DeleteCollectionAction).GetListRestrictions().Labels.String())
+		return true, nil, nil
+	})
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo")
+	wf := unmarshalWF(clusterWf)
+	wf.UID = "my-uid"
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	// the pods are deleted by the UID of the workflow, rather than by its name which a new workflow may reuse, before
+	// the finalizer is removed
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	wf.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	done, err := controller.garbageCollectDeletedWorkflow(wf)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, []string{
+		common.LabelKeyWorkflowUID + "=my-uid",
+		common.LabelKeyWorkflow + "=cluster," + common.LabelKeyWorkflowNamespace + "=argo,!" + common.LabelKeyWorkflowUID,
+	}, selectors)
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, wf.Finalizers)
+	}
+}
+
+func TestUnknownCluster(t *testing.T) {
+	controller := newController()
+	wf := unmarshalWF(clusterWf)
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+	assert.Contains(t, woc.wf.Status.Message, "templates.heavy.cluster 'compute' is not configured")
+}
+
+func TestClusterNamespaces(t *testing.T) {
+	controller, clusterClientset := newClusterController()
+	controller.Config.Clusters[0].Namespaces = []string{"ml"}
+	wf := unmarshalWF(clusterWf)
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+	assert.Contains(t, woc.wf.Status.Message, "templates.heavy.cluster 'compute' is not configured")
+
+	// the namespaces of the cluster are restricted while the workflow is running
+	controller, clusterClientset = newClusterController()
+	wf = unmarshalWF(clusterWf)
+	wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	controller.Config.Clusters[0].Namespaces = []string{"ml"}
+	controller.clusters["compute"].config.Namespaces = []string{"ml"}
+	makePodsPhase(t, controller.kubeclientset, "argo", apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	node := woc.wf.Status.Nodes.FindByDisplayName("remote")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Contains(t, node.Message, "cluster compute is not configured for namespace argo")
+	}
+	clusterPods, err := clusterClientset.CoreV1().Pods("batch").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, clusterPods.Items)
+}
+
+func TestParsePodKey(t *testing.T) {
+	controller, clusterClientset := newClusterController()
+	kubeclientset, namespace, name, err := controller.parsePodKey("argo/my-pod")
+	if assert.NoError(t, err) {
+		assert.Equal(t, controller.kubeclientset, kubeclientset)
+		assert.Equal(t, "argo", namespace)
+		assert.Equal(t, "my-pod", name)
+	}
+	kubeclientset, namespace, name, err = controller.parsePodKey("compute/batch/my-pod")
+	if assert.NoError(t, err) {
+		assert.Equal(t, clusterClientset, kubeclientset)
+		assert.Equal(t, "batch", namespace)
+		assert.Equal(t, "my-pod", name)
+	}
+	_, _, _, err = controller.parsePodKey("other/batch/my-pod")
+	assert.Error(t, err)
+	_, _, _, err = controller.parsePodKey("my-pod")
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"os"
 
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,8 +18,11 @@ import (
 
 	"github.com/argoproj/argo/errors"
 	"github.com/argoproj/argo/persist/sqldb"
+	"github.com/argoproj/argo/util/logging"
 	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/hydrator"
+	"github.com/argoproj/argo/workflow/policy"
 )
 
 // ResyncConfig reloads the controller config from the configmap
@@ -46,7 +50,46 @@ func (wfc *WorkflowController) updateConfig(cm *apiv1.ConfigMap) error {
 	if wfc.cliExecutorImage == "" && config.ExecutorImage == "" {
 		return errors.Errorf(errors.CodeBadRequest, "ConfigMap '%s' does not have executorImage", wfc.configMap)
 	}
+	if config.PodSpecPatch != "" {
+		// reject an invalid patch, rather than failing the creation of every pod
+		err = applyPodSpecPatch(&apiv1.Pod{}, config.PodSpecPatch)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "ConfigMap '%s' has an invalid podSpecPatch: %v", wfc.configMap, err)
+		}
+	}
+	nodeStatusStorage, err := getNodeStatusStorage(config.Persistence)
+	if err != nil {
+		return err
+	}
+	for namespace, share := range config.NamespacePodShares {
+		if share < 1 {
+			return errors.Errorf(errors.CodeBadRequest, "ConfigMap '%s' has an invalid share %d of namespace %s in namespacePodShares", wfc.configMap, share, namespace)
+		}
+	}
+	if config.KubeConfig != nil && len(config.Clusters) > 0 {
+		// the kubeconfig of the executors is of a single cluster, while the executors of the pods in the other clusters
+		// must reach their own
+		return errors.Errorf(errors.CodeBadRequest, "ConfigMap '%s' cannot have both kubeConfig and clusters", wfc.configMap)
+	}
+	wfPolicy, err := policy.New(config.Policy)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "ConfigMap '%s' has an invalid policy: %v", wfc.configMap, err)
+	}
+	// the logs revert to the level and format of the flags once they are removed from the configmap
+	logLevel, logFormat := "", ""
+	if config.Logging != nil {
+		logLevel, logFormat = config.Logging.Level, config.Logging.Format
+	}
+	err = logging.Configure(logLevel, logFormat)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "ConfigMap '%s' has an invalid logging: %v", wfc.configMap, err)
+	}
 	wfc.Config = config
+	wfc.policy = wfPolicy
+	err = wfc.updateClusters(config.Clusters)
+	if err != nil {
+		return err
+	}
 
 	if wfc.session != nil {
 		err := wfc.session.Close()
@@ -81,10 +124,27 @@ func (wfc *WorkflowController) updateConfig(cm *apiv1.ConfigMap) error {
 	} else {
 		log.Info("Persistence configuration disabled")
 	}
+	log.Infof("Node status storage: %s", nodeStatusStorage)
+	wfc.setHydrator(hydrator.New(wfc.offloadNodeStatusRepo, nodeStatusStorage))
 	wfc.throttler.SetParallelism(config.Parallelism)
+	wfc.podThrottler.setParallelism(config.PodParallelism, config.GetNamespacePodShare)
 	return nil
 }
 
+// getNodeStatusStorage returns where the status of the nodes of workflows is stored
+func getNodeStatusStorage(persistence *config.PersistConfig) (config.NodeStatusStorage, error) {
+	storage := persistence.GetNodeStatusStorage()
+	// kept for the e2e tests, which always offload the nodes when there is a database
+	if os.Getenv("ALWAYS_OFFLOAD_NODE_STATUS") == "true" && persistence != nil {
+		storage = config.NodeStatusStorageDatabase
+	}
+	switch storage {
+	case config.NodeStatusStorageKubernetes, config.NodeStatusStorageOffload, config.NodeStatusStorageDatabase:
+		return storage, nil
+	}
+	return "", errors.Errorf(errors.CodeBadRequest, "persistence.nodeStatusStorage unknown storage '%s'", storage)
+}
+
 // executorImage returns the image to use for the workflow executor
 func (wfc *WorkflowController) executorImage() string {
 	if wfc.cliExecutorImage != "" {
@@ -190,3 +250,17 @@ func getArtifactRepositoryRef(wfc *WorkflowController, configMapName string, key
 	}
 	return &config, nil
 }
+
+// getHydrator returns the hydrator of the current configuration
+func (wfc *WorkflowController) getHydrator() hydrator.Interface {
+	wfc.hydratorLock.RLock()
+	defer wfc.hydratorLock.RUnlock()
+	return wfc.hydrator
+}
+
+// setHydrator replaces the hydrator, e.g. once the configuration of the node status storage changed
+func (wfc *WorkflowController) setHydrator(h hydrator.Interface) {
+	wfc.hydratorLock.Lock()
+	defer wfc.hydratorLock.Unlock()
+	wfc.hydrator = h
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
+	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -19,7 +23,9 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -31,12 +37,17 @@ import (
 	wfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned"
 	wfextv "github.com/argoproj/argo/pkg/client/informers/externalversions"
 	wfextvv1alpha1 "github.com/argoproj/argo/pkg/client/informers/externalversions/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/logging"
 	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/hydrator"
 	"github.com/argoproj/argo/workflow/metrics"
-	"github.com/argoproj/argo/workflow/packer"
+	"github.com/argoproj/argo/workflow/policy"
+	"github.com/argoproj/argo/workflow/synchronization"
+	"github.com/argoproj/argo/workflow/templateresolution"
 	"github.com/argoproj/argo/workflow/ttlcontroller"
 	"github.com/argoproj/argo/workflow/util"
+	"github.com/argoproj/argo/workflow/validate"
 )
 
 // WorkflowController is the controller for workflow resources
@@ -62,22 +73,50 @@ type WorkflowController struct {
 	wfclientset   wfclientset.Interface
 
 	// datastructures to support the processing of workflows and workflow pods
-	wfInformer            cache.SharedIndexInformer
-	wftmplInformer        wfextvv1alpha1.WorkflowTemplateInformer
-	podInformer           cache.SharedIndexInformer
-	wfQueue               workqueue.RateLimitingInterface
-	podQueue              workqueue.RateLimitingInterface
-	completedPods         chan string
-	gcPods                chan string // pods to be deleted depend on GC strategy
-	throttler             Throttler
-	session               sqlbuilder.Database
-	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
-	wfArchive             sqldb.WorkflowArchive
+	wfInformer              cache.SharedIndexInformer
+	wftmplInformer          wfextvv1alpha1.WorkflowTemplateInformer
+	templateLibraryInformer cache.SharedIndexInformer
+	configMapInformer       cache.SharedIndexInformer
+	podInformer             cache.SharedIndexInformer
+	wfQueue                 workqueue.RateLimitingInterface
+	podQueue                workqueue.RateLimitingInterface
+	diagnosticsQueue        workqueue.RateLimitingInterface
+	completedPods           chan string
+	gcPods                  chan string // pods to be deleted depend on GC strategy
+	throttler               Throttler
+	podThrottler            *podThrottler
+	session                 sqlbuilder.Database
+	offloadNodeStatusRepo   sqldb.OffloadNodeStatusRepo
+	wfArchive               sqldb.WorkflowArchive
+	metrics                 *metrics.ControllerMetrics
+	syncManager             *synchronization.Manager
+	updateLimiter           *updateLimiter
+	statusCache             *statusCache
+	keyLock                 *keyLock
+	durationHistory         *durationHistory
+	// policy the content of workflows must comply with before they start, nil if there is none
+	policy policy.Policy
+	// clusters are the other clusters the pods of templates run in, by name
+	clusters     map[string]*cluster
+	clustersLock sync.RWMutex
+	// hydrator stores the status of the nodes as configured, and is replaced when the configuration is reloaded
+	hydrator     hydrator.Interface
+	hydratorLock sync.RWMutex
+	// httpRequests performs the requests of the HTTP templates
+	httpRequests *httpRequests
+	// executorServiceAccounts holds the keys of the created executor service accounts whose token is ready
+	executorServiceAccounts sync.Map
+	// lastProcessed is when a worker last took a workflow from the queue, in Unix nanoseconds
+	lastProcessed int64
+	// reconciliations is the number of workflow reconciliations so far, which numbers the log entries of each one
+	reconciliations int64
 }
 
 const (
 	workflowResyncPeriod         = 20 * time.Minute
 	workflowTemplateResyncPeriod = 20 * time.Minute
+	templateLibraryResyncPeriod  = 20 * time.Minute
+	configMapResyncPeriod        = 20 * time.Minute
 	workflowMetricsResyncPeriod  = 1 * time.Minute
 	podResyncPeriod              = 30 * time.Minute
 )
@@ -106,10 +145,31 @@ func NewWorkflowController(
 		containerRuntimeExecutor:   containerRuntimeExecutor,
 		wfQueue:                    workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 		podQueue:                   workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		diagnosticsQueue:           newDiagnosticsQueue(),
 		completedPods:              make(chan string, 512),
 		gcPods:                     make(chan string, 512),
+		updateLimiter:              newUpdateLimiter(),
+		statusCache:                newStatusCache(),
+		keyLock:                    newKeyLock(),
+		offloadNodeStatusRepo:      sqldb.ExplosiveOffloadNodeStatusRepo,
+		hydrator:                   hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo, config.NodeStatusStorageKubernetes),
 	}
 	wfc.throttler = NewThrottler(0, wfc.wfQueue)
+	wfc.podThrottler = newPodThrottler(wfc.wfQueue)
+	wfc.metrics = metrics.NewControllerMetrics(wfc.wfQueue.Len)
+	wfc.durationHistory = newDurationHistory(wfc.lastSuccessfulWorkflow, func(wf *wfv1.Workflow) {
+		key := wf.ObjectMeta.Namespace + "/" + wf.ObjectMeta.Name
+		wfc.statusCache.forget(key)
+		wfc.wfQueue.Add(key)
+	})
+	wfc.syncManager = synchronization.NewManager(wfc.getSemaphoreLimit, func(key string) {
+		wfc.statusCache.forget(key)
+		wfc.wfQueue.Add(key)
+	})
+	wfc.httpRequests = newHTTPRequests(func(key string) {
+		wfc.statusCache.forget(key)
+		wfc.wfQueue.Add(key)
+	})
 	return &wfc
 }
 
@@ -119,7 +179,7 @@ func (wfc *WorkflowController) MetricsServer(ctx context.Context) {
 		informer := util.NewWorkflowInformer(wfc.restConfig, wfc.GetManagedNamespace(), workflowMetricsResyncPeriod, wfc.tweakWorkflowMetricslist)
 		go informer.Run(ctx.Done())
 		registry := metrics.NewWorkflowRegistry(informer)
-		metrics.RunServer(ctx, wfc.Config.MetricsConfig, registry)
+		metrics.RunServer(ctx, wfc.Config.MetricsConfig, prometheus.Gatherers{registry, wfc.metrics.Registry()})
 	}
 }
 
@@ -149,6 +209,7 @@ func (wfc *WorkflowController) RunTTLController(ctx context.Context) {
 func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, podWorkers int) {
 	defer wfc.wfQueue.ShutDown()
 	defer wfc.podQueue.ShutDown()
+	defer wfc.diagnosticsQueue.ShutDown()
 
 	log.Infof("Workflow Controller (version: %s) starting", argo.GetVersion())
 	log.Infof("Workers: workflow: %d, pod: %d", wfWorkers, podWorkers)
@@ -161,31 +222,47 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, podWorkers in
 
 	wfc.wfInformer = util.NewWorkflowInformer(wfc.restConfig, wfc.GetManagedNamespace(), workflowResyncPeriod, wfc.tweakWorkflowlist)
 	wfc.wftmplInformer = wfc.newWorkflowTemplateInformer()
+	wfc.templateLibraryInformer = wfc.newTemplateLibraryInformer()
+	wfc.configMapInformer = wfc.newConfigMapInformer()
 
 	wfc.addWorkflowInformerHandler()
 	wfc.podInformer = wfc.newPodInformer()
 
 	go wfc.wfInformer.Run(ctx.Done())
 	go wfc.wftmplInformer.Informer().Run(ctx.Done())
+	go wfc.templateLibraryInformer.Run(ctx.Done())
+	go wfc.configMapInformer.Run(ctx.Done())
 	go wfc.podInformer.Run(ctx.Done())
 	go wfc.podLabeler(ctx.Done())
 	go wfc.podGarbageCollector(ctx.Done())
 	go wfc.periodicWorkflowGarbageCollector(ctx.Done())
+	go wfc.deletedWorkflowWorker(ctx.Done())
+	go wfc.durationHistory.run(ctx.Done())
 
 	// Wait for all involved caches to be synced, before processing items from the queue is started
-	for _, informer := range []cache.SharedIndexInformer{wfc.wfInformer, wfc.wftmplInformer.Informer(), wfc.podInformer} {
+	for _, informer := range []cache.SharedIndexInformer{wfc.wfInformer, wfc.wftmplInformer.Informer(), wfc.templateLibraryInformer, wfc.configMapInformer, wfc.podInformer} {
 		if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
 			log.Error("Timed out waiting for caches to sync")
 			return
 		}
 	}
 
+	// Restore the locks held by workflows before any workflow may acquire them
+	wfs, err := util.NewWorkflowLister(wfc.wfInformer).List()
+	if err != nil {
+		log.Errorf("Failed to list workflows: %v", err)
+		return
+	}
+	wfc.syncManager.Initialize(wfs)
+
+	wfc.markProcessing()
 	for i := 0; i < wfWorkers; i++ {
 		go wait.Until(wfc.runWorker, time.Second, ctx.Done())
 	}
 	for i := 0; i < podWorkers; i++ {
 		go wait.Until(wfc.podWorker, time.Second, ctx.Done())
 	}
+	go wait.Until(wfc.diagnosticsWorker, time.Second, ctx.Done())
 	<-ctx.Done()
 }
 
@@ -196,14 +273,12 @@ func (wfc *WorkflowController) podLabeler(stopCh <-chan struct{}) {
 		case <-stopCh:
 			return
 		case pod := <-wfc.completedPods:
-			parts := strings.Split(pod, "/")
-			if len(parts) != 2 {
-				log.Warnf("Unexpected item on completed pod channel: %s", pod)
+			kubeclientset, namespace, podName, err := wfc.parsePodKey(pod)
+			if err != nil {
+				log.Warnf("Unexpected item on completed pod channel: %s: %v", pod, err)
 				continue
 			}
-			namespace := parts[0]
-			podName := parts[1]
-			err := common.AddPodLabel(wfc.kubeclientset, podName, namespace, common.LabelKeyCompleted, "true")
+			err = common.AddPodLabel(kubeclientset, podName, namespace, common.LabelKeyCompleted, "true")
 			if err != nil {
 				if !apierr.IsNotFound(err) {
 					log.Errorf("Failed to label pod %s/%s completed: %+v", namespace, podName, err)
@@ -222,14 +297,12 @@ func (wfc *WorkflowController) podGarbageCollector(stopCh <-chan struct{}) {
 		case <-stopCh:
 			return
 		case pod := <-wfc.gcPods:
-			parts := strings.Split(pod, "/")
-			if len(parts) != 2 {
-				log.Warnf("Unexpected item on gcPods channel: %s", pod)
+			kubeclientset, namespace, podName, err := wfc.parsePodKey(pod)
+			if err != nil {
+				log.Warnf("Unexpected item on gcPods channel: %s: %v", pod, err)
 				continue
 			}
-			namespace := parts[0]
-			podName := parts[1]
-			err := common.DeletePod(wfc.kubeclientset, podName, namespace)
+			err = common.DeletePod(kubeclientset, podName, namespace)
 			if err != nil {
 				log.Errorf("Failed to delete pod %s/%s for gc: %+v", namespace, podName, err)
 			} else {
@@ -298,6 +371,16 @@ func (wfc *WorkflowController) processNextItem() bool {
 		return false
 	}
 	defer wfc.wfQueue.Done(key)
+	wfc.markProcessing()
+	wfc.keyLock.lock(key.(string))
+	defer wfc.keyLock.unlock(key.(string))
+
+	var wf *wfv1.Workflow
+	defer func() {
+		if r := recover(); r != nil {
+			wfc.processingPanicked(key.(string), wf, r)
+		}
+	}()
 
 	obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(key.(string))
 	if err != nil {
@@ -317,21 +400,41 @@ func (wfc *WorkflowController) processNextItem() bool {
 		return true
 	}
 
-	if key, ok = wfc.throttler.Next(key); !ok {
+	next, ok := wfc.throttler.Next(key)
+	if !ok {
 		log.Warnf("Workflow %s processing has been postponed due to max parallelism limit", key)
 		return true
 	}
+	if next != key {
+		// the throttler started another workflow, which has a higher priority, instead of this one: it is operated
+		// under its own key, so that it is never operated by two workers at a time
+		log.Infof("Workflow %s processing has been postponed in favor of %s", key, next)
+		wfc.wfQueue.Add(next)
+		return true
+	}
 
-	wf, err := util.FromUnstructured(un)
+	wf, err = util.FromUnstructured(un)
 	if err != nil {
-		log.Warnf("Failed to unmarshal key '%s' to workflow object: %v", key, err)
 		woc := newWorkflowOperationCtx(wf, wfc)
+		woc.log.Warnf("Failed to unmarshal key '%s' to workflow object: %v", key, err)
 		woc.markWorkflowFailed(fmt.Sprintf("invalid spec: %s", err.Error()))
 		woc.persistUpdates()
 		wfc.throttler.Remove(key)
 		return true
 	}
 
+	if wfc.Config.StrictDecoding && wf.Status.Phase == "" {
+		fields, err := common.UnknownFields(un.Object, wf)
+		if err == nil && len(fields) > 0 {
+			woc := newWorkflowOperationCtx(wf, wfc)
+			woc.log.Warnf("Workflow '%s' has unknown fields: %v", key, fields)
+			woc.markWorkflowFailed(fmt.Sprintf("invalid spec: unknown field(s): %s", strings.Join(fields, ", ")))
+			woc.persistUpdates()
+			wfc.throttler.Remove(key)
+			return true
+		}
+	}
+
 	if wf.ObjectMeta.Labels[common.LabelKeyCompleted] == "true" {
 		wfc.throttler.Remove(key)
 		// can get here if we already added the completed=true label,
@@ -339,33 +442,43 @@ func (wfc *WorkflowController) processNextItem() bool {
 		return true
 	}
 
-	woc := newWorkflowOperationCtx(wf, wfc)
-
-	// Loading running workflow from persistence storage if nodeStatusOffload enabled
-	if wf.Status.IsOffloadNodeStatus() {
-		nodes, err := wfc.offloadNodeStatusRepo.Get(string(wf.UID), wf.GetOffloadNodeStatusVersion())
-		if err != nil {
-			woc.log.Errorf("getting offloaded nodes failed: %v", err)
-			woc.markWorkflowError(err, true)
-			woc.persistUpdates()
-			wfc.throttler.Remove(key)
-			return true
-		}
-		woc.wf.Status.Nodes = nodes
+	// Skip workflows which were woken up by their pods while neither they nor their pods changed
+	status, statusErr := wfc.workflowStatus(key.(string), wf)
+	switch {
+	case statusErr != nil:
+		logging.WithWorkflow(wf.ObjectMeta.Namespace, wf.ObjectMeta.Name, string(wf.ObjectMeta.UID)).Warnf("Failed to get the pods of workflow '%s' from informer index: %v", key, statusErr)
+	case wfc.statusCache.unchanged(key.(string), status):
+		wfc.metrics.StatusCacheHit()
+		return true
+	default:
+		wfc.metrics.StatusCacheMiss()
 	}
 
-	// Decompress the node if it is compressed
-	err = packer.DecompressWorkflow(woc.wf)
+	woc := newWorkflowOperationCtx(wf, wfc)
+
+	// Loading the offloaded or compressed nodes of the workflow
+	err = wfc.getHydrator().Hydrate(woc.wf)
 	if err != nil {
-		woc.log.Errorf("workflow decompression failed: %v", err)
+		woc.log.Errorf("hydrating workflow failed: %v", err)
 		woc.markWorkflowError(err, true)
 		woc.persistUpdates()
 		wfc.throttler.Remove(key)
 		return true
 	}
+	startTime := time.Now()
 	woc.operate()
+	wfc.metrics.OperationCompleted(time.Since(startTime))
+	if statusErr == nil && !woc.updated && !woc.requeued && !woc.podsThrottled && !woc.wf.Status.Completed() {
+		wfc.statusCache.operated(key.(string), status)
+	} else {
+		wfc.statusCache.forget(key.(string))
+	}
 	if woc.wf.Status.Completed() {
 		wfc.throttler.Remove(key)
+		wfc.podThrottler.remove(key.(string))
+		wfc.updateLimiter.forget(key.(string))
+		wfc.httpRequests.forget(key.(string))
+		wfc.durationHistory.record(woc.wf)
 		// Send all completed pods to gcPods channel to delete it later depend on the PodGCStrategy.
 		var doPodGC bool
 		if woc.wf.Spec.PodGC != nil {
@@ -380,8 +493,9 @@ func (wfc *WorkflowController) processNextItem() bool {
 		}
 		if doPodGC {
 			for podName := range woc.completedPods {
-				pod := fmt.Sprintf("%s/%s", woc.wf.ObjectMeta.Namespace, podName)
-				woc.controller.gcPods <- pod
+				if pod := woc.podKey(podName); pod != "" {
+					woc.controller.gcPods <- pod
+				}
 			}
 		}
 	}
@@ -393,6 +507,22 @@ func (wfc *WorkflowController) processNextItem() bool {
 	return true
 }
 
+// processingPanicked handles a panic raised while processing a workflow outside of its operation, e.g. while hydrating
+// it, the same way as a panic of the operation itself, so that a malformed workflow cannot crash the controller
+func (wfc *WorkflowController) processingPanicked(key string, wf *wfv1.Workflow, r interface{}) {
+	stack := debug.Stack()
+	if wf == nil {
+		log.Errorf("Recovered from panic while processing workflow '%s': %+v\n%s", key, r, stack)
+		wfc.metrics.OperationPanicked()
+		wfc.wfQueue.AddRateLimited(key)
+		return
+	}
+	woc := newWorkflowOperationCtx(wf, wfc)
+	woc.log.Errorf("Recovered from panic while processing workflow '%s': %+v\n%s", key, r, stack)
+	woc.panicked(r, stack)
+	woc.persistUpdates()
+}
+
 func (wfc *WorkflowController) podWorker() {
 	for wfc.processNextPodItem() {
 	}
@@ -476,20 +606,55 @@ func getWfPriority(obj interface{}) (int32, time.Time) {
 	return int32(priority), un.GetCreationTimestamp().Time
 }
 
+// isOperationPanicsUpdate returns whether an update of a workflow only changed the annotation counting the panics of
+// its operation, which the controller patches before requeueing the workflow with a backoff. Such updates are not
+// queued, so that the workflow is not operated again before the backoff elapsed.
+func isOperationPanicsUpdate(old, new interface{}) bool {
+	oldUn, ok := old.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	newUn, ok := new.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	if oldUn.GetAnnotations()[common.AnnotationKeyOperationPanics] == newUn.GetAnnotations()[common.AnnotationKeyOperationPanics] {
+		return false
+	}
+	oldUn, newUn = oldUn.DeepCopy(), newUn.DeepCopy()
+	for _, un := range []*unstructured.Unstructured{oldUn, newUn} {
+		annotations := un.GetAnnotations()
+		delete(annotations, common.AnnotationKeyOperationPanics)
+		if len(annotations) == 0 {
+			unstructured.RemoveNestedField(un.Object, "metadata", "annotations")
+		} else {
+			un.SetAnnotations(annotations)
+		}
+		unstructured.RemoveNestedField(un.Object, "metadata", "resourceVersion")
+		unstructured.RemoveNestedField(un.Object, "metadata", "managedFields")
+	}
+	return reflect.DeepEqual(oldUn.Object, newUn.Object)
+}
+
 func (wfc *WorkflowController) addWorkflowInformerHandler() {
 	wfc.wfInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				key, err := cache.MetaNamespaceKeyFunc(obj)
 				if err == nil {
+					wfc.statusCache.forget(key)
 					wfc.wfQueue.Add(key)
 					priority, creation := getWfPriority(obj)
 					wfc.throttler.Add(key, priority, creation)
 				}
 			},
 			UpdateFunc: func(old, new interface{}) {
+				if isOperationPanicsUpdate(old, new) {
+					return
+				}
 				key, err := cache.MetaNamespaceKeyFunc(new)
 				if err == nil {
+					wfc.statusCache.forget(key)
 					wfc.wfQueue.Add(key)
 					priority, creation := getWfPriority(new)
 					wfc.throttler.Add(key, priority, creation)
@@ -502,6 +667,11 @@ func (wfc *WorkflowController) addWorkflowInformerHandler() {
 				if err == nil {
 					wfc.wfQueue.Add(key)
 					wfc.throttler.Remove(key)
+					wfc.podThrottler.remove(key)
+					wfc.syncManager.ReleaseWorkflow(key)
+					wfc.updateLimiter.forget(key)
+					wfc.httpRequests.forget(key)
+					wfc.statusCache.forget(key)
 				}
 			},
 		},
@@ -540,7 +710,9 @@ func (wfc *WorkflowController) newWorkflowPodWatch() *cache.ListWatch {
 
 func (wfc *WorkflowController) newPodInformer() cache.SharedIndexInformer {
 	source := wfc.newWorkflowPodWatch()
-	informer := cache.NewSharedIndexInformer(source, &apiv1.Pod{}, podResyncPeriod, cache.Indexers{})
+	informer := cache.NewSharedIndexInformer(source, &apiv1.Pod{}, podResyncPeriod, cache.Indexers{
+		indexWorkflow: indexByWorkflow,
+	})
 	informer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
@@ -568,10 +740,54 @@ func (wfc *WorkflowController) newPodInformer() cache.SharedIndexInformer {
 	return informer
 }
 
+// workflowStatus returns the state the workflow is about to be operated in
+func (wfc *WorkflowController) workflowStatus(key string, wf *wfv1.Workflow) (workflowStatus, error) {
+	indexers := []cache.Indexer{wfc.podInformer.GetIndexer()}
+	for _, c := range wfc.getClusters() {
+		indexers = append(indexers, c.podInformer.GetIndexer())
+	}
+	version, err := podsVersion(key, indexers...)
+	if err != nil {
+		return workflowStatus{}, err
+	}
+	return workflowStatus{uid: wf.ObjectMeta.UID, resourceVersion: wf.ObjectMeta.ResourceVersion, podsVersion: version}, nil
+}
+
 func (wfc *WorkflowController) newWorkflowTemplateInformer() wfextvv1alpha1.WorkflowTemplateInformer {
 	return wfextv.NewSharedInformerFactoryWithOptions(wfc.wfclientset, workflowTemplateResyncPeriod, wfextv.WithNamespace(wfc.GetManagedNamespace())).Argoproj().V1alpha1().WorkflowTemplates()
 }
 
+// newTemplateLibraryInformer returns the informer of the ConfigMaps labelled as template libraries, whose keys hold
+// WorkflowTemplates
+func (wfc *WorkflowController) newTemplateLibraryInformer() cache.SharedIndexInformer {
+	return coreinformers.NewFilteredConfigMapInformer(wfc.kubeclientset, wfc.GetManagedNamespace(), templateLibraryResyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, func(options *metav1.ListOptions) {
+		options.LabelSelector = common.LabelKeyTemplateLibrary
+	})
+}
+
+// newConfigMapInformer returns an informer of the ConfigMaps of the managed namespace, which holds the limits of the
+// semaphores
+func (wfc *WorkflowController) newConfigMapInformer() cache.SharedIndexInformer {
+	return coreinformers.NewConfigMapInformer(wfc.kubeclientset, wfc.GetManagedNamespace(), configMapResyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
+// getWorkflowTemplateGetter returns a getter of the WorkflowTemplates in a namespace, which is backed by the informers
+// and only hits the API server for WorkflowTemplates the informer has not observed yet. The WorkflowTemplates held by
+// ConfigMaps are validated when they are resolved.
+func (wfc *WorkflowController) getWorkflowTemplateGetter(namespace string) templateresolution.WorkflowTemplateNamespacedGetter {
+	getter := templateresolution.WithFallback(
+		wfc.wftmplInformer.Lister().WorkflowTemplates(namespace),
+		templateresolution.WrapWorkflowTemplateInterface(wfc.wfclientset.ArgoprojV1alpha1().WorkflowTemplates(namespace)),
+	)
+	configMaps := corelisters.NewConfigMapLister(wfc.templateLibraryInformer.GetIndexer()).ConfigMaps(namespace)
+	// the WorkflowTemplates a WorkflowTemplate held by a ConfigMap refers to are validated once they are resolved
+	// themselves, which keeps references between ConfigMaps from being validated endlessly
+	validateGetter := templateresolution.WithConfigMaps(getter, configMaps, nil)
+	return templateresolution.WithConfigMaps(getter, configMaps, func(wftmpl *wfv1.WorkflowTemplate) error {
+		return validate.ValidateWorkflowTemplate(validateGetter, wftmpl)
+	})
+}
+
 func (wfc *WorkflowController) GetManagedNamespace() string {
 	if wfc.managedNamespace != "" {
 		return wfc.managedNamespace
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
@@ -18,7 +19,11 @@ import (
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
 	wfextv "github.com/argoproj/argo/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/hydrator"
+	"github.com/argoproj/argo/workflow/metrics"
+	"github.com/argoproj/argo/workflow/synchronization"
 )
 
 var helloWorldWf = `
@@ -52,17 +57,36 @@ func newController() *WorkflowController {
 	if !cache.WaitForCacheSync(ctx.Done(), wftmplInformer.Informer().HasSynced) {
 		panic("Timed out waiting for caches to sync")
 	}
-	return &WorkflowController{
+	wfQueue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	wfc := &WorkflowController{
 		Config: config.WorkflowControllerConfig{
 			ExecutorImage: "executor:latest",
 		},
-		kubeclientset:  fake.NewSimpleClientset(),
-		wfclientset:    wfclientset,
-		completedPods:  make(chan string, 512),
-		wftmplInformer: wftmplInformer,
-		wfQueue:        workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
-		wfArchive:      sqldb.NullWorkflowArchive,
+		kubeclientset:    fake.NewSimpleClientset(),
+		wfclientset:      wfclientset,
+		completedPods:    make(chan string, 512),
+		wftmplInformer:   wftmplInformer,
+		wfQueue:          wfQueue,
+		hydrator:         hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo, config.NodeStatusStorageOffload),
+		wfArchive:        sqldb.NullWorkflowArchive,
+		metrics:          metrics.NewControllerMetrics(wfQueue.Len),
+		keyLock:          newKeyLock(),
+		podThrottler:     newPodThrottler(wfQueue),
+		updateLimiter:    newUpdateLimiter(),
+		diagnosticsQueue: newDiagnosticsQueue(),
 	}
+	wfc.syncManager = synchronization.NewManager(wfc.getSemaphoreLimit, func(key string) {
+		wfQueue.Add(key)
+	})
+	wfc.durationHistory = newDurationHistory(wfc.lastSuccessfulWorkflow, func(wf *wfv1.Workflow) {
+		wfQueue.Add(wf.ObjectMeta.Namespace + "/" + wf.ObjectMeta.Name)
+	})
+	wfc.httpRequests = newHTTPRequests(func(key string) {
+		wfQueue.Add(key)
+	})
+	wfc.templateLibraryInformer = wfc.newTemplateLibraryInformer()
+	wfc.configMapInformer = wfc.newConfigMapInformer()
+	return wfc
 }
 
 func unmarshalWF(yamlStr string) *wfv1.Workflow {
@@ -93,3 +117,25 @@ func makePodsRunning(t *testing.T, kubeclientset kubernetes.Interface, namespace
 		_, _ = podcs.Update(&pod)
 	}
 }
+
+func TestIsOperationPanicsUpdate(t *testing.T) {
+	newWf := func(resourceVersion string, annotations map[string]interface{}, phase string) *unstructured.Unstructured {
+		metadata := map[string]interface{}{"name": "my-wf", "resourceVersion": resourceVersion}
+		if annotations != nil {
+			metadata["annotations"] = annotations
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": metadata,
+			"status":   map[string]interface{}{"phase": phase},
+		}}
+	}
+	running := newWf("1", nil, "Running")
+	panicked := newWf("2", map[string]interface{}{common.AnnotationKeyOperationPanics: "1"}, "Running")
+	assert.True(t, isOperationPanicsUpdate(running, panicked))
+	assert.True(t, isOperationPanicsUpdate(panicked, newWf("3", map[string]interface{}{common.AnnotationKeyOperationPanics: "2"}, "Running")))
+	// the other updates of the workflow are queued
+	assert.False(t, isOperationPanicsUpdate(panicked, newWf("3", map[string]interface{}{common.AnnotationKeyOperationPanics: "1"}, "Succeeded")))
+	assert.False(t, isOperationPanicsUpdate(panicked, newWf("3", nil, "Succeeded")))
+	assert.False(t, isOperationPanicsUpdate(running, newWf("2", nil, "Running")))
+	assert.False(t, isOperationPanicsUpdate(running, newWf("2", map[string]interface{}{common.AnnotationKeyOperationPanics: "1", "other": "x"}, "Running")))
+}
@@ -201,6 +201,25 @@ func (d *dagContext) hasMoreRetries(node *wfv1.NodeStatus) bool {
 	return true
 }
 
+// failFastDAG terminates the running tasks of the DAG once one of its tasks failed
+func (woc *wfOperationCtx) failFastDAG(dagCtx *dagContext) {
+	var taskNodeIDs []string
+	var failedTask string
+	for _, task := range dagCtx.tasks {
+		taskNode := dagCtx.GetTaskNode(task.Name)
+		if taskNode == nil {
+			continue
+		}
+		taskNodeIDs = append(taskNodeIDs, taskNode.ID)
+		if failedTask == "" && taskNode.Completed() && !taskNode.Successful() && !task.ContinuesOn(taskNode.Phase) {
+			failedTask = task.Name
+		}
+	}
+	if failedTask != "" {
+		woc.terminateIncompleteDescendants(taskNodeIDs, failFastMessage("task", failedTask))
+	}
+}
+
 func (woc *wfOperationCtx) executeDAG(nodeName string, tmplCtx *templateresolution.Context, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateHolder, boundaryID string) (*wfv1.NodeStatus, error) {
 	node := woc.getNodeByName(nodeName)
 	if node == nil {
@@ -237,6 +256,10 @@ func (woc *wfOperationCtx) executeDAG(nodeName string, tmplCtx *templateresoluti
 		woc.executeDAGTask(dagCtx, taskName)
 	}
 
+	if tmpl.DAG.FailFast != nil && *tmpl.DAG.FailFast {
+		woc.failFastDAG(dagCtx)
+	}
+
 	// check if we are still running any tasks in this dag and return early if we do
 	dagPhase := dagCtx.assessDAGPhase(targetTasks, woc.wf.Status.Nodes)
 	switch dagPhase {
@@ -410,6 +433,12 @@ func (woc *wfOperationCtx) executeDAGTask(dagCtx *dagContext, taskName string) {
 		}
 	}
 
+	// If too many of the task's items failed, the items which have not been launched yet are skipped
+	thresholdMessage := ""
+	if task.FailureThreshold != nil && taskGroupNode != nil {
+		thresholdMessage = woc.failureThresholdMessage(task.FailureThreshold, taskGroupNode, nodeName)
+	}
+
 	for _, t := range expandedTasks {
 		node = dagCtx.GetTaskNode(t.Name)
 		taskNodeName := dagCtx.taskNodeName(t.Name)
@@ -418,6 +447,12 @@ func (woc *wfOperationCtx) executeDAGTask(dagCtx *dagContext, taskName string) {
 			// Add the child relationship from our dependency's outbound nodes to this node.
 			connectDependencies(taskNodeName)
 
+			if thresholdMessage != "" {
+				woc.log.Infof("Skipping %s: %s", taskNodeName, thresholdMessage)
+				woc.initializeNode(taskNodeName, wfv1.NodeTypeSkipped, task, dagCtx.boundaryID, wfv1.NodeSkipped, thresholdMessage)
+				continue
+			}
+
 			// Check the task's when clause to decide if it should execute
 			proceed, err := shouldExecute(t.When)
 			if err != nil {
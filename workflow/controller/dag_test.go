@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/test"
@@ -40,3 +44,67 @@ func TestDagDisableFailFast(t *testing.T) {
 	woc.operate()
 	assert.Equal(t, string(wfv1.NodeFailed), string(woc.wf.Status.Phase))
 }
+
+var dagFailFast = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-fail-fast
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      failFast: true
+      tasks:
+      - name: a
+        template: whalesay
+      - name: b
+        template: whalesay
+      - name: c
+        template: whalesay
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestDagFailFast verifies the running tasks are terminated once a task failed when dag.failFast is true
+func TestDagFailFast(t *testing.T) {
+	controller := newController()
+	controller.restConfig = &rest.Config{}
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(dagFailFast))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if !assert.Len(t, pods.Items, 3) {
+		return
+	}
+	podPhases := map[string]apiv1.PodPhase{"a": apiv1.PodFailed, "b": apiv1.PodRunning, "c": apiv1.PodPending}
+	for _, pod := range pods.Items {
+		pod.Status.Phase = podPhases[woc.wf.Status.Nodes[pod.Name].DisplayName]
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	message := "terminated since task 'a' failed"
+	b := woc.wf.Status.Nodes.FindByDisplayName("b")
+	if assert.NotNil(t, b) {
+		assert.Equal(t, wfv1.NodeRunning, b.Phase)
+		assert.Equal(t, message, b.Message)
+	}
+	c := woc.wf.Status.Nodes.FindByDisplayName("c")
+	if assert.NotNil(t, c) {
+		assert.Equal(t, wfv1.NodeFailed, c.Phase)
+		_, err := podcs.Get(c.ID, metav1.GetOptions{})
+		assert.True(t, apierr.IsNotFound(err))
+	}
+}
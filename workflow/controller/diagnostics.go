@@ -0,0 +1,264 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kuberetry "k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+// maxDiagnosticsLogBytes limits the size of the logs kept in the status of a node, which is part of the workflow object
+const maxDiagnosticsLogBytes = 4096
+
+// maxDiagnosticsRetries is how many times the events and logs of a node are collected again, e.g. while the failure
+// of the node is not persisted yet, before giving up
+const maxDiagnosticsRetries = 5
+
+// newDiagnosticsQueue returns the queue of the nodes whose events and logs are to be collected, keyed by
+// namespace/workflow/node
+func newDiagnosticsQueue() workqueue.RateLimitingInterface {
+	return workqueue.NewRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second, time.Minute))
+}
+
+// containerDiagnostics returns the container states of the pod of a failed node. The events and logs of the pod, which
+// take requests to the API server, are collected in the background by the diagnostics worker.
+func containerDiagnostics(pod *apiv1.Pod) *wfv1.NodeDiagnostics {
+	diagnostics := &wfv1.NodeDiagnostics{}
+	statuses := append(append([]apiv1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		container := wfv1.ContainerDiagnostics{Name: status.Name}
+		switch {
+		case status.State.Terminated != nil:
+			exitCode := status.State.Terminated.ExitCode
+			container.ExitCode = &exitCode
+			container.Reason = status.State.Terminated.Reason
+			container.Message = status.State.Terminated.Message
+		case status.State.Waiting != nil:
+			container.Reason = status.State.Waiting.Reason
+			container.Message = status.State.Waiting.Message
+		}
+		diagnostics.Containers = append(diagnostics.Containers, container)
+	}
+	return diagnostics
+}
+
+// addDiagnostics records the container states of the pod of a failed node, and queues the collection of its events
+// and logs
+func (woc *wfOperationCtx) addDiagnostics(nodeID string, pod *apiv1.Pod) {
+	node := woc.wf.Status.Nodes[nodeID]
+	node.Diagnostics = containerDiagnostics(pod)
+	fitDiagnostics(node.Diagnostics, diagnosticsBudget(woc.wf.Status.Nodes, nodeID, woc.controller.Config.FailedNodeDiagnostics.GetMaxBytes()))
+	woc.wf.Status.Nodes[nodeID] = node
+	woc.controller.diagnosticsQueue.Add(woc.wf.ObjectMeta.Namespace + "/" + woc.wf.ObjectMeta.Name + "/" + nodeID)
+}
+
+func (wfc *WorkflowController) diagnosticsWorker() {
+	for wfc.processNextDiagnosticsItem() {
+	}
+}
+
+// processNextDiagnosticsItem collects the events and logs of the pod of a failed node, and adds them to the
+// diagnostics of the node
+func (wfc *WorkflowController) processNextDiagnosticsItem() bool {
+	key, quit := wfc.diagnosticsQueue.Get()
+	if quit {
+		return false
+	}
+	defer wfc.diagnosticsQueue.Done(key)
+
+	done, err := wfc.collectDiagnostics(key.(string))
+	if err != nil {
+		log.Warnf("Failed to collect the diagnostics of '%s': %v", key, err)
+	}
+	if (err != nil || !done) && wfc.diagnosticsQueue.NumRequeues(key) < maxDiagnosticsRetries {
+		wfc.diagnosticsQueue.AddRateLimited(key)
+		return true
+	}
+	wfc.diagnosticsQueue.Forget(key)
+	return true
+}
+
+// collectDiagnostics collects the events and logs of the pod of a failed node, keyed by namespace/workflow/node, and
+// persists them in the status of the workflow. It returns false if the failure of the node is not persisted yet.
+// Diagnostics are collected on a best effort basis, so the workflows and nodes which are gone are ignored.
+func (wfc *WorkflowController) collectDiagnostics(key string) (bool, error) {
+	parts := strings.SplitN(key, "/", 3)
+	if len(parts) != 3 {
+		return true, fmt.Errorf("invalid key")
+	}
+	if wfc.Config.FailedNodeDiagnostics == nil {
+		// the configuration was reloaded in the meantime
+		return true, nil
+	}
+	namespace, name, nodeID := parts[0], parts[1], parts[2]
+	wfClient := wfc.wfclientset.ArgoprojV1alpha1().Workflows(namespace)
+	getNode := func() (*wfv1.Workflow, *wfv1.NodeStatus, error) {
+		wf, err := wfClient.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		err = wfc.getHydrator().Hydrate(wf)
+		if err != nil {
+			return nil, nil, err
+		}
+		node, ok := wf.Status.Nodes[nodeID]
+		if !ok || node.Diagnostics == nil {
+			return wf, nil, nil
+		}
+		return wf, &node, nil
+	}
+
+	wf, node, err := getNode()
+	if apierr.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil || node == nil {
+		return false, err
+	}
+	woc := newWorkflowOperationCtx(wf, wfc)
+	events, logs, logsArtifact := woc.getPodEventsAndLogs(*node)
+
+	// the workflow workers may be operating the workflow
+	wfKey := namespace + "/" + name
+	wfc.keyLock.lock(wfKey)
+	defer wfc.keyLock.unlock(wfKey)
+	err = kuberetry.RetryOnConflict(kuberetry.DefaultRetry, func() error {
+		wf, node, err := getNode()
+		if err != nil || node == nil {
+			return err
+		}
+		node.Diagnostics.Events = events
+		node.Diagnostics.Logs = logs
+		node.Diagnostics.LogsArtifact = logsArtifact
+		fitDiagnostics(node.Diagnostics, diagnosticsBudget(wf.Status.Nodes, nodeID, wfc.Config.FailedNodeDiagnostics.GetMaxBytes()))
+		wf.Status.Nodes[nodeID] = *node
+		err = wfc.getHydrator().Dehydrate(wf)
+		if err != nil {
+			return err
+		}
+		_, err = wfClient.Update(wf)
+		return err
+	})
+	if apierr.IsNotFound(err) {
+		return true, nil
+	}
+	return err == nil, err
+}
+
+// getPodEventsAndLogs returns the events and last log lines of the pod of a failed node, or the name of the artifact
+// its logs are archived in. Errors are only logged, since diagnostics are collected on a best effort basis.
+func (woc *wfOperationCtx) getPodEventsAndLogs(node wfv1.NodeStatus) ([]string, string, string) {
+	location, err := woc.getNodePodLocation(node.ID)
+	if err != nil {
+		woc.log.Warnf("Failed to get the location of pod %s: %v", node.ID, err)
+		return nil, "", ""
+	}
+	var diagnosticEvents []string
+	events, err := location.kubeclientset.CoreV1().Events(location.namespace).List(metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.name", node.ID).String(),
+	})
+	if err != nil {
+		woc.log.Warnf("Failed to list the events of pod %s: %v", node.ID, err)
+	} else {
+		items := events.Items
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].LastTimestamp.Before(&items[j].LastTimestamp)
+		})
+		for _, event := range items {
+			if event.InvolvedObject.Kind != "Pod" || event.InvolvedObject.Name != node.ID {
+				continue
+			}
+			diagnosticEvents = append(diagnosticEvents, fmt.Sprintf("%s %s: %s", event.Type, event.Reason, event.Message))
+		}
+	}
+
+	logsArtifactName := common.MainContainerName + "-logs"
+	if node.Outputs != nil && node.Outputs.GetArtifactByName(logsArtifactName) != nil {
+		return diagnosticEvents, "", logsArtifactName
+	}
+	logLines := woc.controller.Config.FailedNodeDiagnostics.GetLogLines()
+	if logLines <= 0 {
+		return diagnosticEvents, "", ""
+	}
+	logs, err := location.kubeclientset.CoreV1().Pods(location.namespace).GetLogs(node.ID, &apiv1.PodLogOptions{
+		Container: common.MainContainerName,
+		TailLines: &logLines,
+	}).DoRaw()
+	if err != nil {
+		woc.log.Warnf("Failed to get the logs of pod %s: %v", node.ID, err)
+		return diagnosticEvents, "", ""
+	}
+	return diagnosticEvents, tailLogs(string(logs), maxDiagnosticsLogBytes), ""
+}
+
+// diagnosticsSize returns the size of the text of the diagnostics of a node
+func diagnosticsSize(diagnostics *wfv1.NodeDiagnostics) int {
+	if diagnostics == nil {
+		return 0
+	}
+	size := len(diagnostics.Logs) + len(diagnostics.LogsArtifact)
+	for _, event := range diagnostics.Events {
+		size += len(event)
+	}
+	for _, container := range diagnostics.Containers {
+		size += len(container.Name) + len(container.Reason) + len(container.Message)
+	}
+	return size
+}
+
+// diagnosticsBudget returns the size the diagnostics of a node may take, which is what the diagnostics of the other
+// nodes of the workflow leave of maxBytes
+func diagnosticsBudget(nodes wfv1.Nodes, nodeID string, maxBytes int) int {
+	for id, node := range nodes {
+		if id != nodeID {
+			maxBytes -= diagnosticsSize(node.Diagnostics)
+		}
+	}
+	return maxBytes
+}
+
+// fitDiagnostics drops the logs, then the oldest events, and then the messages of the containers of the diagnostics of
+// a node, until they fit in maxBytes
+func fitDiagnostics(diagnostics *wfv1.NodeDiagnostics, maxBytes int) {
+	excess := diagnosticsSize(diagnostics) - maxBytes
+	if excess <= 0 {
+		return
+	}
+	diagnostics.Logs = tailLogs(diagnostics.Logs, len(diagnostics.Logs)-excess)
+	for len(diagnostics.Events) > 0 && diagnosticsSize(diagnostics) > maxBytes {
+		diagnostics.Events = diagnostics.Events[1:]
+	}
+	for i := range diagnostics.Containers {
+		if diagnosticsSize(diagnostics) <= maxBytes {
+			return
+		}
+		diagnostics.Containers[i].Message = ""
+	}
+}
+
+// tailLogs returns the whole lines at the end of the logs which fit in maxBytes
+func tailLogs(logs string, maxBytes int) string {
+	logs = strings.TrimRight(logs, "\n")
+	if len(logs) <= maxBytes {
+		return logs
+	}
+	if maxBytes <= 0 {
+		return ""
+	}
+	logs = logs[len(logs)-maxBytes:]
+	if i := strings.Index(logs, "\n"); i >= 0 {
+		return logs[i+1:]
+	}
+	return logs
+}
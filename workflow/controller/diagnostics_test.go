@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/config"
+)
+
+var diagnosticsWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: diagnostics
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestFailedNodeDiagnostics(t *testing.T) {
+	controller := newController()
+	logLines := int64(0)
+	controller.Config.FailedNodeDiagnostics = &config.FailedNodeDiagnostics{LogLines: &logLines}
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(diagnosticsWorkflow))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if !assert.Len(t, pods.Items, 1) {
+		return
+	}
+	pod := pods.Items[0]
+	_, err = controller.kubeclientset.CoreV1().Events("").Create(&apiv1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "event"},
+		InvolvedObject: apiv1.ObjectReference{Kind: "Pod", Name: pod.Name},
+		Type:           apiv1.EventTypeWarning,
+		Reason:         "BackOff",
+		Message:        "Back-off restarting failed container",
+	})
+	assert.NoError(t, err)
+	pod.Status.Phase = apiv1.PodFailed
+	pod.Status.ContainerStatuses = []apiv1.ContainerStatus{{
+		Name:  "main",
+		State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+	}}
+	_, err = podcs.Update(&pod)
+	assert.NoError(t, err)
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node := woc.wf.Status.Nodes[pod.Name]
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	exitCode := int32(137)
+	if assert.NotNil(t, node.Diagnostics) {
+		assert.Equal(t, []wfv1.ContainerDiagnostics{{Name: "main", ExitCode: &exitCode, Reason: "OOMKilled"}}, node.Diagnostics.Containers)
+		assert.Empty(t, node.Diagnostics.Events)
+	}
+
+	// the events are collected in the background
+	assert.Equal(t, 1, controller.diagnosticsQueue.Len())
+	assert.True(t, controller.processNextDiagnosticsItem())
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	node = wf.Status.Nodes[pod.Name]
+	if assert.NotNil(t, node.Diagnostics) {
+		assert.Equal(t, []wfv1.ContainerDiagnostics{{Name: "main", ExitCode: &exitCode, Reason: "OOMKilled"}}, node.Diagnostics.Containers)
+		assert.Equal(t, []string{"Warning BackOff: Back-off restarting failed container"}, node.Diagnostics.Events)
+		assert.Empty(t, node.Diagnostics.Logs)
+	}
+}
+
+func TestFitDiagnostics(t *testing.T) {
+	diagnostics := &wfv1.NodeDiagnostics{
+		Containers: []wfv1.ContainerDiagnostics{{Name: "main", Reason: "Error", Message: "failed"}},
+		Events:     []string{"old", "new"},
+		Logs:       "one\ntwo",
+	}
+	fitDiagnostics(diagnostics, 100)
+	assert.Equal(t, "one\ntwo", diagnostics.Logs)
+
+	fitDiagnostics(diagnostics, 24)
+	assert.Equal(t, "two", diagnostics.Logs)
+	assert.Len(t, diagnostics.Events, 2)
+
+	fitDiagnostics(diagnostics, 18)
+	assert.Empty(t, diagnostics.Logs)
+	assert.Equal(t, []string{"new"}, diagnostics.Events)
+
+	fitDiagnostics(diagnostics, 5)
+	assert.Empty(t, diagnostics.Events)
+	assert.Equal(t, []wfv1.ContainerDiagnostics{{Name: "main", Reason: "Error"}}, diagnostics.Containers)
+
+	nodes := wfv1.Nodes{"a": {Diagnostics: diagnostics}, "b": {}}
+	assert.Equal(t, 91, diagnosticsBudget(nodes, "b", 100))
+	assert.Equal(t, 100, diagnosticsBudget(nodes, "a", 100))
+}
+
+func TestTailLogs(t *testing.T) {
+	assert.Equal(t, "one\ntwo", tailLogs("one\ntwo\n", 100))
+	assert.Equal(t, "three", tailLogs("one\ntwo\nthree\n", 7))
+	assert.Equal(t, "hree", tailLogs("three", 4))
+	assert.Equal(t, "", tailLogs("three", -1))
+}
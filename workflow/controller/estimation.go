@@ -0,0 +1,377 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/logging"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+// maxArchivedBaselineCandidates is the number of the last successful archived workflows which are looked into for a
+// workflow of the same kind
+const maxArchivedBaselineCandidates = 20
+
+// durations are the durations of a successful workflow and of its nodes, from which the durations of the later
+// workflows of the same kind are estimated
+type durations struct {
+	workflow time.Duration
+	// nodes are the durations of the nodes by their name relative to the name of the workflow, e.g. ".a" or "[0].a"
+	nodes map[string]time.Duration
+	// templates are the durations of the nodes by the name of their template, for nodes which have no counterpart
+	templates map[string]time.Duration
+}
+
+func newDurations(wf *wfv1.Workflow) *durations {
+	d := &durations{
+		workflow:  wf.Status.FinishedAt.Sub(wf.Status.StartedAt.Time),
+		nodes:     make(map[string]time.Duration),
+		templates: make(map[string]time.Duration),
+	}
+	for _, node := range wf.Status.Nodes {
+		if !node.Successful() || node.FinishedAt.IsZero() {
+			continue
+		}
+		duration := node.FinishedAt.Sub(node.StartedAt.Time)
+		d.nodes[strings.TrimPrefix(node.Name, wf.ObjectMeta.Name)] = duration
+		if node.TemplateName != "" {
+			d.templates[node.TemplateName] = duration
+		}
+	}
+	return d
+}
+
+// estimateNode returns the estimated duration of a node of a workflow, or 0 if it cannot be estimated
+func (d *durations) estimateNode(wf *wfv1.Workflow, nodeName string, templateName string) wfv1.EstimatedDuration {
+	if duration, ok := d.nodes[strings.TrimPrefix(nodeName, wf.ObjectMeta.Name)]; ok {
+		return wfv1.NewEstimatedDuration(duration)
+	}
+	if duration, ok := d.templates[templateName]; ok && templateName != "" {
+		return wfv1.NewEstimatedDuration(duration)
+	}
+	return 0
+}
+
+// durationKind returns the kind of a workflow, which the durations of the workflows of the same kind are kept by:
+// the workflows created by the same CronWorkflow, or else with the same generateName, or else with the same name
+func durationKind(wf *wfv1.Workflow) string {
+	if name := wf.ObjectMeta.Labels[common.LabelCronWorkflow]; name != "" {
+		return fmt.Sprintf("%s/cron-workflow/%s", wf.ObjectMeta.Namespace, name)
+	}
+	if wf.ObjectMeta.GenerateName != "" {
+		return fmt.Sprintf("%s/generate-name/%s", wf.ObjectMeta.Namespace, wf.ObjectMeta.GenerateName)
+	}
+	return fmt.Sprintf("%s/name/%s", wf.ObjectMeta.Namespace, wf.ObjectMeta.Name)
+}
+
+const (
+	// maxDurationKinds is the number of kinds of workflows whose durations are kept, the least recently used ones being
+	// evicted beyond
+	maxDurationKinds = 1000
+	// maxPendingDurationLookups is the number of kinds whose durations may wait to be looked up, the lookups of the
+	// other kinds being attempted again by later reconciliations
+	maxPendingDurationLookups = 100
+)
+
+// durationHistory keeps the durations of the last successful workflow of each kind. The durations of a kind are
+// looked up once in the background, and are then updated as the workflows of the kind succeed.
+type durationHistory struct {
+	mutex sync.Mutex
+	// durations are the durations by kind, nil when there is no successful workflow of the kind
+	durations *lru.Cache
+	// pending are the kinds being looked up
+	pending map[string]bool
+	// lookups are the workflows whose kind is to be looked up
+	lookups chan *wfv1.Workflow
+	// lookup returns the last successful workflow of the kind of a workflow, if any
+	lookup func(wf *wfv1.Workflow) (*wfv1.Workflow, error)
+	// looked is called with a workflow once the durations of its kind are looked up, for it to be estimated
+	looked func(wf *wfv1.Workflow)
+}
+
+func newDurationHistory(lookup func(wf *wfv1.Workflow) (*wfv1.Workflow, error), looked func(wf *wfv1.Workflow)) *durationHistory {
+	durations, err := lru.New(maxDurationKinds)
+	if err != nil {
+		panic(err)
+	}
+	return &durationHistory{
+		durations: durations,
+		pending:   make(map[string]bool),
+		lookups:   make(chan *wfv1.Workflow, maxPendingDurationLookups),
+		lookup:    lookup,
+		looked:    looked,
+	}
+}
+
+// get returns the durations of the last successful workflow of the kind of a workflow, or nil if there is none or if
+// they are not looked up yet, in which case their lookup is queued
+func (h *durationHistory) get(wf *wfv1.Workflow) *durations {
+	kind := durationKind(wf)
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if d, ok := h.durations.Get(kind); ok {
+		return d.(*durations)
+	}
+	if !h.pending[kind] {
+		select {
+		case h.lookups <- wf:
+			h.pending[kind] = true
+		default:
+		}
+	}
+	return nil
+}
+
+// run looks up the durations of the queued kinds until stopped, so that the workflow workers never wait for them
+func (h *durationHistory) run(stopCh <-chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			return
+		case wf := <-h.lookups:
+			h.load(wf)
+		}
+	}
+}
+
+// load looks up the durations of the kind of a workflow
+func (h *durationHistory) load(wf *wfv1.Workflow) {
+	kind := durationKind(wf)
+	baseline, err := h.lookup(wf)
+	h.mutex.Lock()
+	delete(h.pending, kind)
+	if err != nil {
+		h.mutex.Unlock()
+		// the lookup is attempted again for the next reconciliation of a workflow of the kind
+		logging.WithWorkflow(wf.ObjectMeta.Namespace, wf.ObjectMeta.Name, string(wf.ObjectMeta.UID)).Warnf("Failed to look up the last successful workflow of %s: %v", kind, err)
+		return
+	}
+	var d *durations
+	if baseline != nil {
+		d = newDurations(baseline)
+	}
+	// unless a workflow of the kind succeeded in the meantime
+	h.durations.ContainsOrAdd(kind, d)
+	h.mutex.Unlock()
+	h.looked(wf)
+}
+
+// record records the durations of a successful workflow as the durations of its kind
+func (h *durationHistory) record(wf *wfv1.Workflow) {
+	if !wf.Status.Successful() || wf.Status.StartedAt.IsZero() || wf.Status.FinishedAt.IsZero() {
+		return
+	}
+	d := newDurations(wf)
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.durations.Add(durationKind(wf), d)
+}
+
+// lastSuccessfulWorkflow returns the last successful workflow of the kind of a workflow, among the completed
+// workflows which are still in the cluster, or else the archived workflows. It is called in the background by the
+// duration history, since the completed workflows are not in the informer.
+func (wfc *WorkflowController) lastSuccessfulWorkflow(wf *wfv1.Workflow) (*wfv1.Workflow, error) {
+	kind := durationKind(wf)
+	requirements, err := baselineRequirements(wf)
+	if err != nil {
+		return nil, err
+	}
+	selector := labels.NewSelector().Add(requirements...).Add(util.InstanceIDRequirement(wfc.Config.InstanceID))
+	list, err := wfc.wfclientset.ArgoprojV1alpha1().Workflows(wf.ObjectMeta.Namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	var baseline *wfv1.Workflow
+	for i, item := range list.Items {
+		if item.ObjectMeta.Name == wf.ObjectMeta.Name || durationKind(&item) != kind {
+			continue
+		}
+		if baseline == nil || item.Status.FinishedAt.After(baseline.Status.FinishedAt.Time) {
+			baseline = &list.Items[i]
+		}
+	}
+	if baseline != nil {
+		err = wfc.getHydrator().Hydrate(baseline)
+		if err != nil {
+			return nil, err
+		}
+		return baseline, nil
+	}
+	if wfc.wfArchive == nil {
+		return nil, nil
+	}
+	// archived workflows are listed without their spec, so only the candidates whose name may have been generated the
+	// same way are fetched
+	archived, err := wfc.wfArchive.ListWorkflows(wf.ObjectMeta.Namespace, requirements, maxArchivedBaselineCandidates, 0)
+	if err != nil {
+		return nil, err
+	}
+	cron := wf.ObjectMeta.Labels[common.LabelCronWorkflow] != ""
+	for _, item := range archived {
+		switch {
+		case cron:
+		case wf.ObjectMeta.GenerateName != "":
+			if !strings.HasPrefix(item.ObjectMeta.Name, wf.ObjectMeta.GenerateName) {
+				continue
+			}
+		case item.ObjectMeta.Name != wf.ObjectMeta.Name:
+			continue
+		}
+		candidate, err := wfc.wfArchive.GetWorkflow(string(item.ObjectMeta.UID))
+		if err != nil {
+			return nil, err
+		}
+		if candidate != nil && durationKind(candidate) == kind {
+			return candidate, nil
+		}
+	}
+	return nil, nil
+}
+
+// baselineRequirements returns the label requirements of the successful workflows of the kind of a workflow
+func baselineRequirements(wf *wfv1.Workflow) (labels.Requirements, error) {
+	values := map[string]string{
+		common.LabelKeyCompleted: "true",
+		common.LabelKeyPhase:     string(wfv1.NodeSucceeded),
+	}
+	if name := wf.ObjectMeta.Labels[common.LabelCronWorkflow]; name != "" {
+		values[common.LabelCronWorkflow] = name
+	}
+	var requirements labels.Requirements
+	for key, value := range values {
+		requirement, err := labels.NewRequirement(key, selection.Equals, []string{value})
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, *requirement)
+	}
+	return requirements, nil
+}
+
+// getDurations returns the durations of the last successful workflow of the kind of the workflow, or nil if there is
+// none
+func (woc *wfOperationCtx) getDurations() *durations {
+	if !woc.durationsLoaded {
+		woc.durations = woc.controller.durationHistory.get(woc.wf)
+		woc.durationsLoaded = true
+	}
+	return woc.durations
+}
+
+// estimateDurations sets the estimated durations of the workflow and of its nodes which are not completed, unless it is
+// estimated already. The durations of a kind are looked up in the background the first time, and the workflow is then
+// estimated when it is reconciled once they are.
+func (woc *wfOperationCtx) estimateDurations() {
+	if woc.wf.Status.EstimatedDuration != 0 {
+		return
+	}
+	d := woc.getDurations()
+	if d == nil {
+		return
+	}
+	woc.wf.Status.EstimatedDuration = wfv1.NewEstimatedDuration(d.workflow)
+	for id, node := range woc.wf.Status.Nodes {
+		if node.Completed() || node.EstimatedDuration != 0 {
+			continue
+		}
+		node.EstimatedDuration = d.estimateNode(woc.wf, node.Name, node.TemplateName)
+		woc.wf.Status.Nodes[id] = node
+	}
+	woc.updated = true
+}
+
+// estimateNodeDuration returns the estimated duration of a node, or 0 if it cannot be estimated
+func (woc *wfOperationCtx) estimateNodeDuration(nodeName string, templateName string) wfv1.EstimatedDuration {
+	d := woc.getDurations()
+	if d == nil {
+		return 0
+	}
+	return d.estimateNode(woc.wf, nodeName, templateName)
+}
+
+// updateProgress sets the progress of the workflow and of its nodes. The progress of a pod node is whether it completed,
+// and the progress of a steps or DAG node is the sum of the progress of the pods and of the steps and DAGs directly
+// within it, which is computed in a single bottom-up pass over the tree of their boundaries. The progress of a group of
+// steps or tasks, or of a retry node, is then the sum of the progress of its children.
+func (woc *wfOperationCtx) updateProgress() {
+	nodes := woc.wf.Status.Nodes
+	progress := make(map[string]wfv1.Progress, len(nodes))
+	// the pods, steps and DAGs directly within each steps or DAG node, by the ID of the latter
+	within := make(map[string][]string)
+	var roots []string
+	for id, node := range nodes {
+		if !isWorkNode(node) && !isBoundaryNode(node) {
+			continue
+		}
+		if _, ok := nodes[node.BoundaryID]; ok && node.BoundaryID != id {
+			within[node.BoundaryID] = append(within[node.BoundaryID], id)
+		} else {
+			roots = append(roots, id)
+		}
+	}
+	var sum func(id string) wfv1.Progress
+	sum = func(id string) wfv1.Progress {
+		nodeProgress := wfv1.NewProgress(0, 0)
+		if node := nodes[id]; isWorkNode(node) {
+			nodeProgress = wfv1.NewProgress(0, 1)
+			if node.Completed() {
+				nodeProgress = wfv1.NewProgress(1, 1)
+			}
+		}
+		for _, childID := range within[id] {
+			nodeProgress = nodeProgress.Add(sum(childID))
+		}
+		progress[id] = nodeProgress
+		return nodeProgress
+	}
+	workflowProgress := wfv1.NewProgress(0, 0)
+	for _, id := range roots {
+		workflowProgress = workflowProgress.Add(sum(id))
+	}
+	// the children of the groups may be retry nodes, whose children are pods, steps or DAGs
+	for _, nodeType := range []wfv1.NodeType{wfv1.NodeTypeRetry, wfv1.NodeTypeStepGroup, wfv1.NodeTypeTaskGroup} {
+		for id, node := range nodes {
+			if node.Type != nodeType {
+				continue
+			}
+			nodeProgress := wfv1.NewProgress(0, 0)
+			for _, childID := range node.Children {
+				if childProgress, ok := progress[childID]; ok {
+					nodeProgress = nodeProgress.Add(childProgress)
+				}
+			}
+			progress[id] = nodeProgress
+		}
+	}
+	for id, node := range nodes {
+		nodeProgress, ok := progress[id]
+		if !ok {
+			nodeProgress = wfv1.NewProgress(0, 0)
+		}
+		if node.Progress != nodeProgress {
+			node.Progress = nodeProgress
+			nodes[id] = node
+		}
+	}
+	woc.wf.Status.Progress = workflowProgress
+}
+
+// isWorkNode returns whether a node is a unit of work which progress is counted in
+func isWorkNode(node wfv1.NodeStatus) bool {
+	return node.Type == wfv1.NodeTypePod || node.Type == wfv1.NodeTypeHTTP
+}
+
+// isBoundaryNode returns whether a node is the boundary of the nodes within a steps or DAG template
+func isBoundaryNode(node wfv1.NodeStatus) bool {
+	return node.Type == wfv1.NodeTypeSteps || node.Type == wfv1.NodeTypeDAG
+}
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+// newSucceededWorkflow returns a successful hello world workflow which took 90 seconds, and its node 60 seconds
+func newSucceededWorkflow(name string) *wfv1.Workflow {
+	wf := unmarshalWF(helloWorldWf)
+	wf.ObjectMeta.Name = name
+	wf.ObjectMeta.GenerateName = "hello-world-"
+	wf.ObjectMeta.Labels = map[string]string{common.LabelKeyCompleted: "true", common.LabelKeyPhase: string(wfv1.NodeSucceeded)}
+	startedAt := time.Now().Add(-time.Hour)
+	wf.Status = wfv1.WorkflowStatus{
+		Phase:      wfv1.NodeSucceeded,
+		StartedAt:  metav1.Time{Time: startedAt},
+		FinishedAt: metav1.Time{Time: startedAt.Add(90 * time.Second)},
+		Nodes: wfv1.Nodes{
+			name: wfv1.NodeStatus{
+				ID:           name,
+				Name:         name,
+				TemplateName: "whalesay",
+				Type:         wfv1.NodeTypePod,
+				Phase:        wfv1.NodeSucceeded,
+				StartedAt:    metav1.Time{Time: startedAt},
+				FinishedAt:   metav1.Time{Time: startedAt.Add(60 * time.Second)},
+			},
+		},
+	}
+	return wf
+}
+
+func TestEstimateDurations(t *testing.T) {
+	controller := newController()
+	looked := make(chan *wfv1.Workflow, 1)
+	controller.durationHistory = newDurationHistory(controller.lastSuccessfulWorkflow, func(wf *wfv1.Workflow) {
+		looked <- wf
+	})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go controller.durationHistory.run(stopCh)
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	_, err := wfcset.Create(newSucceededWorkflow("hello-world-abcde"))
+	assert.NoError(t, err)
+
+	wf := unmarshalWF(helloWorldWf)
+	wf.ObjectMeta.Name = "hello-world-fghij"
+	wf.ObjectMeta.GenerateName = "hello-world-"
+	wf, err = wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	// the durations are looked up in the background, and the workflow is estimated once they are
+	assert.Equal(t, wfv1.EstimatedDuration(0), woc.wf.Status.EstimatedDuration)
+	assert.Equal(t, "hello-world-fghij", (<-looked).ObjectMeta.Name)
+
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.EstimatedDuration(90), woc.wf.Status.EstimatedDuration)
+	node := woc.wf.Status.Nodes[woc.wf.NodeID(wf.Name)]
+	assert.Equal(t, wfv1.EstimatedDuration(60), node.EstimatedDuration)
+	assert.Equal(t, wfv1.Progress("0/1"), node.Progress)
+	assert.Equal(t, wfv1.Progress("0/1"), woc.wf.Status.Progress)
+
+	// workflows of another kind are not estimated
+	wf = unmarshalWF(helloWorldWf)
+	wf, err = wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	<-looked
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.EstimatedDuration(0), woc.wf.Status.EstimatedDuration)
+}
+
+func TestDurationHistory(t *testing.T) {
+	lookups := 0
+	looked := make(chan *wfv1.Workflow, 1)
+	history := newDurationHistory(func(wf *wfv1.Workflow) (*wfv1.Workflow, error) {
+		lookups++
+		return nil, nil
+	}, func(wf *wfv1.Workflow) {
+		looked <- wf
+	})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go history.run(stopCh)
+	wf := newSucceededWorkflow("hello-world-abcde")
+	assert.Nil(t, history.get(wf))
+	assert.Equal(t, wf, <-looked)
+	assert.Nil(t, history.get(wf))
+	assert.Equal(t, 1, lookups)
+
+	// the durations of the kind are replaced by the ones of the workflow which succeeded last
+	history.record(wf)
+	d := history.get(wf)
+	if assert.NotNil(t, d) {
+		next := newSucceededWorkflow("hello-world-fghij")
+		assert.Equal(t, 90*time.Second, d.workflow)
+		assert.Equal(t, wfv1.EstimatedDuration(60), d.estimateNode(next, "hello-world-fghij", ""))
+		assert.Equal(t, wfv1.EstimatedDuration(60), d.estimateNode(next, "hello-world-fghij.b", "whalesay"))
+		assert.Equal(t, wfv1.EstimatedDuration(0), d.estimateNode(next, "hello-world-fghij.b", "other"))
+	}
+	assert.Equal(t, 1, lookups)
+}
+
+func TestDurationHistoryEviction(t *testing.T) {
+	history := newDurationHistory(func(wf *wfv1.Workflow) (*wfv1.Workflow, error) {
+		return nil, nil
+	}, func(wf *wfv1.Workflow) {})
+	for i := 0; i <= maxDurationKinds; i++ {
+		wf := newSucceededWorkflow(fmt.Sprintf("hello-world-%d", i))
+		wf.ObjectMeta.GenerateName = fmt.Sprintf("hello-world-%d-", i)
+		history.record(wf)
+	}
+	assert.Equal(t, maxDurationKinds, history.durations.Len())
+	// the least recently used kind is evicted
+	assert.False(t, history.durations.Contains("/generate-name/hello-world-0-"))
+}
+
+func TestUpdateProgress(t *testing.T) {
+	wf := unmarshalWF(helloWorldWf)
+	wf.Status.Nodes = wfv1.Nodes{
+		"steps":    wfv1.NodeStatus{ID: "steps", Type: wfv1.NodeTypeSteps, Children: []string{"group-0"}},
+		"group-0":  wfv1.NodeStatus{ID: "group-0", Type: wfv1.NodeTypeStepGroup, BoundaryID: "steps", Children: []string{"a", "retry"}},
+		"a":        wfv1.NodeStatus{ID: "a", Type: wfv1.NodeTypePod, BoundaryID: "steps", Phase: wfv1.NodeSucceeded, Children: []string{"group-1"}},
+		"retry":    wfv1.NodeStatus{ID: "retry", Type: wfv1.NodeTypeRetry, BoundaryID: "steps", Children: []string{"retry(0)", "retry(1)"}, Phase: wfv1.NodeRunning},
+		"retry(0)": wfv1.NodeStatus{ID: "retry(0)", Type: wfv1.NodeTypePod, BoundaryID: "steps", Phase: wfv1.NodeFailed, Children: []string{"group-1"}},
+		"retry(1)": wfv1.NodeStatus{ID: "retry(1)", Type: wfv1.NodeTypePod, BoundaryID: "steps", Phase: wfv1.NodeRunning, Children: []string{"group-1"}},
+		"group-1":  wfv1.NodeStatus{ID: "group-1", Type: wfv1.NodeTypeStepGroup, BoundaryID: "steps", Children: []string{"request", "dag"}},
+		"request":  wfv1.NodeStatus{ID: "request", Type: wfv1.NodeTypePod, BoundaryID: "steps", Phase: wfv1.NodePending},
+		"dag":      wfv1.NodeStatus{ID: "dag", Type: wfv1.NodeTypeDAG, BoundaryID: "steps", Children: []string{"dag.a"}, Phase: wfv1.NodeRunning},
+		"dag.a":    wfv1.NodeStatus{ID: "dag.a", Type: wfv1.NodeTypePod, BoundaryID: "dag", Children: []string{"dag.b"}, Phase: wfv1.NodeSucceeded},
+		"dag.b":    wfv1.NodeStatus{ID: "dag.b", Type: wfv1.NodeTypePod, BoundaryID: "dag", Phase: wfv1.NodeRunning},
+		"suspend":  wfv1.NodeStatus{ID: "suspend", Type: wfv1.NodeTypeSuspend, Phase: wfv1.NodeRunning},
+	}
+	woc := newWorkflowOperationCtx(wf, newController())
+	woc.updateProgress()
+	assert.Equal(t, wfv1.Progress("3/6"), woc.wf.Status.Progress)
+	for id, progress := range map[string]wfv1.Progress{
+		"steps":    "3/6",
+		"group-0":  "2/3",
+		"a":        "1/1",
+		"retry":    "1/2",
+		"retry(1)": "0/1",
+		"group-1":  "1/3",
+		"request":  "0/1",
+		"dag":      "1/2",
+		"dag.a":    "1/1",
+		"suspend":  "0/0",
+	} {
+		assert.Equal(t, progress, woc.wf.Status.Nodes[id].Progress, id)
+	}
+}
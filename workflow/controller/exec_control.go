@@ -3,10 +3,12 @@ package controller
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo/errors"
@@ -20,6 +22,12 @@ func (woc *wfOperationCtx) applyExecutionControl(pod *apiv1.Pod, wfNodesLock *sy
 	if pod == nil {
 		return nil
 	}
+	wfNodesLock.RLock()
+	cleanup := woc.isCleanupPod(pod.Name)
+	wfNodesLock.RUnlock()
+	if cleanup {
+		return nil
+	}
 	switch pod.Status.Phase {
 	case apiv1.PodSucceeded, apiv1.PodFailed:
 		// Skip any pod which are already completed
@@ -29,7 +37,7 @@ func (woc *wfOperationCtx) applyExecutionControl(pod *apiv1.Pod, wfNodesLock *sy
 		// then we should simply delete it and mark the pod as Failed
 		if woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline) {
 			woc.log.Infof("Deleting Pending pod %s/%s which has exceeded workflow deadline %s", pod.Namespace, pod.Name, woc.workflowDeadline)
-			err := woc.controller.kubeclientset.CoreV1().Pods(pod.Namespace).Delete(pod.Name, &metav1.DeleteOptions{})
+			err := woc.deleteNodePod(pod.Name)
 			if err == nil {
 				wfNodesLock.Lock()
 				defer wfNodesLock.Unlock()
@@ -102,6 +110,89 @@ func (woc *wfOperationCtx) killDaemonedChildren(nodeID string) error {
 	return firstErr
 }
 
+// failFastMessagePrefix starts the message of the nodes terminated since a sibling failed when failing fast
+const failFastMessagePrefix = "terminated since "
+
+// failFastMessage returns the message of the nodes terminated since a step or task failed
+func failFastMessage(kind, name string) string {
+	return fmt.Sprintf("%s%s '%s' failed", failFastMessagePrefix, kind, name)
+}
+
+// isTerminatedByFailFast returns whether a node was terminated since a sibling failed when failing fast
+func isTerminatedByFailFast(node *wfv1.NodeStatus) bool {
+	return strings.HasPrefix(node.Message, failFastMessagePrefix)
+}
+
+// terminateIncompleteDescendants terminates the pods of the incomplete nodes and their descendants, so that the
+// siblings of a failed step or task do not keep running when failing fast. Pending pods are deleted, while running
+// pods are signaled to terminate and keep the message until they complete, so that they are only signaled once.
+// Retry nodes keep the message too, so that they fail with their current attempt rather than retrying it.
+func (woc *wfOperationCtx) terminateIncompleteDescendants(nodeIDs []string, message string) {
+	visited := make(map[string]bool)
+	for len(nodeIDs) > 0 {
+		nodeID := nodeIDs[0]
+		nodeIDs = nodeIDs[1:]
+		if visited[nodeID] {
+			continue
+		}
+		visited[nodeID] = true
+		node, ok := woc.wf.Status.Nodes[nodeID]
+		if !ok || node.Completed() {
+			continue
+		}
+		nodeIDs = append(nodeIDs, node.Children...)
+		if node.Type == wfv1.NodeTypeRetry && node.Message != message {
+			woc.markNodePhase(node.Name, node.Phase, message)
+			continue
+		}
+		if node.Type != wfv1.NodeTypePod || node.Message == message {
+			continue
+		}
+		if node.Phase == wfv1.NodePending {
+			woc.log.Infof("Deleting pending pod %s: %s", node.ID, message)
+			err := woc.deleteNodePod(node.ID)
+			if err != nil && !apierr.IsNotFound(err) {
+				woc.log.Warnf("Failed to delete pod %s: %v", node.ID, err)
+				continue
+			}
+			woc.markNodePhase(node.Name, wfv1.NodeFailed, message)
+			continue
+		}
+		err := woc.terminateNodePod(node.ID)
+		if err != nil {
+			woc.log.Warnf("Failed to terminate pod %s: %v", node.ID, err)
+			continue
+		}
+		woc.markNodePhase(node.Name, node.Phase, message)
+	}
+}
+
+// terminateNodePod signals the pod of a node to terminate. The other execution control parameters of the pod, such as
+// whether it includes the script output, are kept.
+func (woc *wfOperationCtx) terminateNodePod(podName string) error {
+	location, err := woc.getNodePodLocation(podName)
+	if err != nil {
+		return err
+	}
+	pod, err := location.kubeclientset.CoreV1().Pods(location.namespace).Get(podName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	var execCtl common.ExecutionControl
+	if execCtlStr, ok := pod.Annotations[common.AnnotationKeyExecutionControl]; ok && execCtlStr != "" {
+		err = json.Unmarshal([]byte(execCtlStr), &execCtl)
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+	}
+	if execCtl.Deadline != nil && execCtl.Deadline.IsZero() {
+		// the pod was already signaled to terminate
+		return nil
+	}
+	execCtl.Deadline = &time.Time{}
+	return woc.updateExecutionControl(podName, execCtl)
+}
+
 // updateExecutionControl updates the execution control parameters
 func (woc *wfOperationCtx) updateExecutionControl(podName string, execCtl common.ExecutionControl) error {
 	execCtlBytes, err := json.Marshal(execCtl)
@@ -109,11 +200,15 @@ func (woc *wfOperationCtx) updateExecutionControl(podName string, execCtl common
 		return errors.InternalWrapError(err)
 	}
 
+	location, err := woc.getNodePodLocation(podName)
+	if err != nil {
+		return err
+	}
 	woc.log.Infof("Updating execution control of %s: %s", podName, execCtlBytes)
 	err = common.AddPodAnnotation(
-		woc.controller.kubeclientset,
+		location.kubeclientset,
 		podName,
-		woc.wf.ObjectMeta.Namespace,
+		location.namespace,
 		common.AnnotationKeyExecutionControl,
 		string(execCtlBytes),
 	)
@@ -128,7 +223,7 @@ func (woc *wfOperationCtx) updateExecutionControl(podName string, execCtl common
 	// using SIGUSR2 that something changed.
 	woc.log.Infof("Signalling %s of updates", podName)
 	exec, err := common.ExecPodContainer(
-		woc.controller.restConfig, woc.wf.ObjectMeta.Namespace, podName,
+		location.restConfig, location.namespace, podName,
 		common.WaitContainerName, true, true, "sh", "-c", "kill -s USR2 $(pidof argoexec)",
 	)
 	if err != nil {
@@ -147,3 +242,12 @@ func (woc *wfOperationCtx) updateExecutionControl(podName string, execCtl common
 
 	return nil
 }
+
+// deleteNodePod deletes the pod of a node, in the cluster it runs in
+func (woc *wfOperationCtx) deleteNodePod(podName string) error {
+	location, err := woc.getNodePodLocation(podName)
+	if err != nil {
+		return err
+	}
+	return location.kubeclientset.CoreV1().Pods(location.namespace).Delete(podName, &metav1.DeleteOptions{})
+}
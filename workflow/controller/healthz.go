@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// operationStallTimeout is how long workflows may wait in the queue without any of them being processed before the
+// controller is considered wedged
+const operationStallTimeout = 5 * time.Minute
+
+// HealthServer serves the liveness (/healthz) and readiness (/readyz) endpoints of the controller
+func (wfc *WorkflowController) HealthServer(ctx context.Context, port int) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", healthHandler(wfc.checkLive))
+	mux.HandleFunc("/readyz", healthHandler(wfc.checkReady))
+	srv := &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: mux}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	log.Infof("Starting health server at 0.0.0.0:%d", port)
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Errorf("Health server stopped: %v", err)
+	}
+}
+
+// healthHandler serves the result of a check, and reports a check which panics as unavailable
+func healthHandler(check func() error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Errorf("Health check panicked: %v", r)
+					err = fmt.Errorf("health check failed: %v", r)
+				}
+			}()
+			err = check()
+		}()
+		writeHealth(w, err)
+	}
+}
+
+func writeHealth(w http.ResponseWriter, err error) {
+	if err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	_, _ = w.Write([]byte("ok"))
+}
+
+// markProcessing records that a worker has taken a workflow from the queue
+func (wfc *WorkflowController) markProcessing() {
+	atomic.StoreInt64(&wfc.lastProcessed, time.Now().UnixNano())
+}
+
+// checkLive fails if workflows are waiting in the queue, but no worker has taken one for a long time. This happens
+// when every worker is stuck, and only a restart recovers the controller.
+func (wfc *WorkflowController) checkLive() error {
+	lastProcessed := atomic.LoadInt64(&wfc.lastProcessed)
+	if lastProcessed == 0 || wfc.wfQueue == nil || wfc.wfQueue.Len() == 0 {
+		return nil
+	}
+	stalled := time.Since(time.Unix(0, lastProcessed))
+	if stalled > operationStallTimeout {
+		return fmt.Errorf("%d workflows are queued, but none has been processed for %v", wfc.wfQueue.Len(), stalled.Round(time.Second))
+	}
+	return nil
+}
+
+// checkReady fails until the informers have synced, or if the Kubernetes API server cannot be reached
+func (wfc *WorkflowController) checkReady() error {
+	if wfc.wfInformer == nil || !wfc.wfInformer.HasSynced() {
+		return fmt.Errorf("workflow informer has not synced")
+	}
+	if wfc.wftmplInformer == nil || !wfc.wftmplInformer.Informer().HasSynced() {
+		return fmt.Errorf("workflow template informer has not synced")
+	}
+	if wfc.podInformer == nil || !wfc.podInformer.HasSynced() {
+		return fmt.Errorf("pod informer has not synced")
+	}
+	_, err := wfc.kubeclientset.Discovery().ServerVersion()
+	if err != nil {
+		return fmt.Errorf("cannot reach the Kubernetes API server: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckLive(t *testing.T) {
+	controller := newController()
+	assert.NoError(t, controller.checkLive(), "live before the workers start")
+
+	controller.markProcessing()
+	controller.wfQueue.Add("my-wf")
+	assert.NoError(t, controller.checkLive())
+
+	atomic.StoreInt64(&controller.lastProcessed, time.Now().Add(-2*operationStallTimeout).UnixNano())
+	assert.Error(t, controller.checkLive(), "queued workflows have not been processed")
+
+	key, _ := controller.wfQueue.Get()
+	controller.wfQueue.Done(key)
+	assert.NoError(t, controller.checkLive(), "nothing is queued")
+}
+
+func TestCheckReady(t *testing.T) {
+	controller := newController()
+	err := controller.checkReady()
+	if assert.Error(t, err) {
+		assert.Equal(t, "workflow informer has not synced", err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	writeHealth(w, err)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	w = httptest.NewRecorder()
+	writeHealth(w, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "ok", w.Body.String())
+}
+
+func TestHealthHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	healthHandler(func() error { panic("boom") })(w, httptest.NewRequest("GET", "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "health check failed: boom", w.Body.String())
+
+	w = httptest.NewRecorder()
+	healthHandler(func() error { return nil })(w, httptest.NewRequest("GET", "/healthz", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
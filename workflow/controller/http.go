@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+const (
+	// defaultHTTPTimeoutSeconds is the time an HTTP request may take unless the template says otherwise
+	defaultHTTPTimeoutSeconds = 30
+	// maxHTTPResponseBodySize is the size the body of a response is truncated to before becoming the result of the node,
+	// to keep it from bloating the workflow
+	maxHTTPResponseBodySize = 256 * 1024
+	// maxConcurrentHTTPRequests is how many requests of HTTP templates the controller performs at a time, across all
+	// workflows
+	maxConcurrentHTTPRequests = 32
+)
+
+// httpResponse is the outcome of the request of an HTTP node
+type httpResponse struct {
+	statusCode int
+	body       string
+	// err is why the request could not be performed at all, e.g. the secret of a header is missing or the server is
+	// unreachable
+	err error
+}
+
+// httpRequests performs the requests of the HTTP nodes in the background, so that the workflow workers do not wait for
+// the responses. The response of each node is kept until its workflow completes or is deleted, so that a workflow
+// whose update failed finds it again rather than sending the request twice.
+type httpRequests struct {
+	// responses are the responses of the nodes, by the key of their workflow and then by node ID, nil while in flight
+	responses map[string]map[string]*httpResponse
+	lock      *sync.Mutex
+	// slots bounds the number of requests in flight
+	slots chan struct{}
+	// done is called with the key of the workflow once the response of one of its nodes is received
+	done func(key string)
+}
+
+func newHTTPRequests(done func(key string)) *httpRequests {
+	return &httpRequests{
+		responses: make(map[string]map[string]*httpResponse),
+		lock:      &sync.Mutex{},
+		slots:     make(chan struct{}, maxConcurrentHTTPRequests),
+		done:      done,
+	}
+}
+
+// get returns the response of the node, or nil while its request is in flight. The request is performed in the
+// background by calling do unless it was already started, which is also how the request of a node which was in flight
+// when the controller restarted is sent again.
+func (r *httpRequests) get(key, nodeID string, do func() *httpResponse) *httpResponse {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	nodes, ok := r.responses[key]
+	if !ok {
+		nodes = make(map[string]*httpResponse)
+		r.responses[key] = nodes
+	}
+	resp, ok := nodes[nodeID]
+	if ok {
+		return resp
+	}
+	nodes[nodeID] = nil
+	go func() {
+		r.slots <- struct{}{}
+		resp := do()
+		<-r.slots
+		r.lock.Lock()
+		// the workflow may have been forgotten in the meantime
+		if nodes, ok := r.responses[key]; ok {
+			nodes[nodeID] = resp
+		}
+		r.lock.Unlock()
+		r.done(key)
+	}()
+	return nil
+}
+
+// forget discards the responses of the workflow, e.g. once it has completed
+func (r *httpRequests) forget(key string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.responses, key)
+}
+
+// executeHTTP performs the request of an HTTP template from the controller, rather than from a pod. The node runs until
+// the response is received in the background, which requeues the workflow, and then succeeds or fails according to the
+// success condition of the template. The timeout of the request is capped by the configuration, which must enable the
+// HTTP templates.
+func (woc *wfOperationCtx) executeHTTP(nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateHolder, boundaryID string) (*wfv1.NodeStatus, error) {
+	node := woc.getNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeHTTP, templateScope, tmpl, orgTmpl, boundaryID, wfv1.NodeRunning)
+	}
+
+	httpConfig := woc.controller.Config.HTTPTemplates
+	if httpConfig == nil || !httpConfig.Enabled {
+		return node, errors.New(errors.CodeBadRequest, "HTTP templates are not enabled in the configuration of the controller")
+	}
+
+	request := tmpl.HTTP.DeepCopy()
+	timeoutSeconds := int64(defaultHTTPTimeoutSeconds)
+	if request.TimeoutSeconds != nil {
+		timeoutSeconds = *request.TimeoutSeconds
+	}
+	if maxTimeoutSeconds := httpConfig.GetMaxTimeoutSeconds(); timeoutSeconds > maxTimeoutSeconds {
+		timeoutSeconds = maxTimeoutSeconds
+	}
+	request.TimeoutSeconds = &timeoutSeconds
+
+	wfc := woc.controller
+	namespace := woc.wf.ObjectMeta.Namespace
+	logCtx := woc.log.WithField("nodeID", node.ID)
+	resp := wfc.httpRequests.get(namespace+"/"+woc.wf.ObjectMeta.Name, node.ID, func() *httpResponse {
+		return wfc.doHTTPRequest(logCtx, namespace, request)
+	})
+	if resp == nil {
+		// the response requeues the workflow, which is also requeued once the request timed out in case it was missed,
+		// and is never skipped as unchanged meanwhile
+		woc.requeue(time.Duration(timeoutSeconds) * time.Second)
+		return woc.markNodePhase(nodeName, wfv1.NodeRunning), nil
+	}
+	if resp.err != nil {
+		return woc.markNodePhase(nodeName, wfv1.NodeFailed, resp.err.Error()), nil
+	}
+	node.Outputs = &wfv1.Outputs{Result: &resp.body}
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc.updated = true
+	succeeded, err := httpSucceeded(request.SuccessCondition, resp.statusCode, resp.body)
+	if err != nil {
+		return node, err
+	}
+	if !succeeded {
+		return woc.markNodePhase(nodeName, wfv1.NodeFailed, fmt.Sprintf("HTTP request failed with status code %d", resp.statusCode)), nil
+	}
+	return woc.markNodePhase(nodeName, wfv1.NodeSucceeded), nil
+}
+
+// doHTTPRequest performs the request and returns the status code and the (possibly truncated) body of the response.
+// The values of the headers read from secrets are read from the namespace of the workflow.
+func (wfc *WorkflowController) doHTTPRequest(logCtx *log.Entry, namespace string, tmpl *wfv1.HTTP) *httpResponse {
+	method := tmpl.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	var reqBody io.Reader
+	if tmpl.Body != "" {
+		reqBody = strings.NewReader(tmpl.Body)
+	}
+	req, err := http.NewRequest(method, tmpl.URL, reqBody)
+	if err != nil {
+		return &httpResponse{err: errors.Errorf(errors.CodeBadRequest, "invalid HTTP request: %v", err)}
+	}
+	for _, header := range tmpl.Headers {
+		value := header.Value
+		if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef != nil {
+			secretRef := header.ValueFrom.SecretKeyRef
+			data, err := util.GetSecrets(wfc.kubeclientset, namespace, secretRef.Name, secretRef.Key)
+			if err != nil {
+				return &httpResponse{err: err}
+			}
+			value = string(data)
+		}
+		req.Header.Add(header.Name, value)
+	}
+	client := &http.Client{Timeout: time.Duration(*tmpl.TimeoutSeconds) * time.Second}
+	logCtx.Infof("%s %s", method, tmpl.URL)
+	resp, err := client.Do(req)
+	if err != nil {
+		return &httpResponse{err: errors.InternalWrapError(err)}
+	}
+	defer util.Close(resp.Body)
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseBodySize))
+	if err != nil {
+		return &httpResponse{err: errors.InternalWrapError(err)}
+	}
+	return &httpResponse{statusCode: resp.StatusCode, body: string(data)}
+}
+
+// httpSucceeded evaluates the success condition of an HTTP template against a response. Without a condition, any 2xx
+// status code is a success.
+func httpSucceeded(condition string, statusCode int, body string) (bool, error) {
+	if condition == "" {
+		return statusCode >= 200 && statusCode < 300, nil
+	}
+	expression, err := common.NewExpression(condition)
+	if err != nil {
+		return false, errors.Errorf(errors.CodeBadRequest, "invalid successCondition '%s': %v", condition, err)
+	}
+	result, err := expression.Evaluate(map[string]interface{}{
+		"response.statusCode": float64(statusCode),
+		"response.body":       body,
+	})
+	if err != nil {
+		return false, errors.Errorf(errors.CodeBadRequest, "failed to evaluate successCondition '%s': %v", condition, err)
+	}
+	succeeded, ok := result.(bool)
+	if !ok {
+		return false, errors.Errorf(errors.CodeBadRequest, "successCondition '%s' does not evaluate to a boolean", condition)
+	}
+	return succeeded, nil
+}
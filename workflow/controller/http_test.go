@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/config"
+)
+
+var httpWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: http
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: request
+        template: request
+  - name: request
+    http:
+      method: POST
+      url: URL
+      body: '{"name": "argo"}'
+      timeoutSeconds: 600
+      successCondition: SUCCESS_CONDITION
+      headers:
+      - name: Content-Type
+        value: application/json
+      - name: Authorization
+        valueFrom:
+          secretKeyRef:
+            name: api-token
+            key: token
+`
+
+// newHTTPWorkflow returns the HTTP workflow requesting the given URL, with the given success condition
+func newHTTPWorkflow(url string, successCondition string) *wfv1.Workflow {
+	return unmarshalWF(strings.NewReplacer("URL", url, "SUCCESS_CONDITION", successCondition).Replace(httpWorkflow))
+}
+
+func TestExecuteHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer my-token" || string(body) != `{"name": "argo"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "done"}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name             string
+		successCondition string
+		phase            wfv1.NodePhase
+	}{
+		{"DefaultCondition", "''", wfv1.NodeSucceeded},
+		{"ConditionMet", "\"[response.statusCode] == 200 && jsonpath([response.body], 'status') == 'done'\"", wfv1.NodeSucceeded},
+		{"ConditionNotMet", "\"jsonpath([response.body], 'status') == 'pending'\"", wfv1.NodeFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := newController()
+			controller.Config.HTTPTemplates = &config.HTTPTemplatesConfig{Enabled: true, MaxTimeoutSeconds: 60}
+			responded := make(chan string, 1)
+			controller.httpRequests = newHTTPRequests(func(key string) {
+				responded <- key
+			})
+			_, err := controller.kubeclientset.CoreV1().Secrets("").Create(&apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "api-token"},
+				Data:       map[string][]byte{"token": []byte("Bearer my-token")},
+			})
+			assert.NoError(t, err)
+			wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+			wf, err := wfcset.Create(newHTTPWorkflow(server.URL, tt.successCondition))
+			assert.NoError(t, err)
+
+			// the request is performed in the background, without a pod
+			woc := newWorkflowOperationCtx(wf, controller)
+			woc.operate()
+			node := woc.wf.Status.Nodes.FindByDisplayName("request")
+			if assert.NotNil(t, node) {
+				assert.Equal(t, wfv1.NodeTypeHTTP, node.Type)
+				assert.Equal(t, wfv1.NodeRunning, node.Phase)
+			}
+			pods, err := controller.kubeclientset.CoreV1().Pods("").List(metav1.ListOptions{})
+			if assert.NoError(t, err) {
+				assert.Empty(t, pods.Items)
+			}
+
+			// the response requeues the workflow, whose node then completes
+			assert.Equal(t, "/http", <-responded)
+			wf, err = wfcset.Get(wf.ObjectMeta.Name, metav1.GetOptions{})
+			assert.NoError(t, err)
+			woc = newWorkflowOperationCtx(wf, controller)
+			woc.operate()
+			node = woc.wf.Status.Nodes.FindByDisplayName("request")
+			if assert.NotNil(t, node) {
+				assert.Equal(t, tt.phase, node.Phase)
+				if assert.NotNil(t, node.Outputs) && assert.NotNil(t, node.Outputs.Result) {
+					assert.Equal(t, `{"status": "done"}`, *node.Outputs.Result)
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteHTTPDisabled(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfcset.Create(newHTTPWorkflow("https://example.com/api", "''"))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node := woc.wf.Status.Nodes.FindByDisplayName("request")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Contains(t, node.Message, "HTTP templates are not enabled")
+	}
+}
+
+func TestHTTPRequests(t *testing.T) {
+	responded := make(chan string, 1)
+	requests := newHTTPRequests(func(key string) {
+		responded <- key
+	})
+	calls := 0
+	do := func() *httpResponse {
+		calls++
+		return &httpResponse{statusCode: http.StatusOK}
+	}
+	// the request is performed once, in the background
+	assert.Nil(t, requests.get("my-ns/my-wf", "my-node", do))
+	assert.Equal(t, "my-ns/my-wf", <-responded)
+	resp := requests.get("my-ns/my-wf", "my-node", do)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, http.StatusOK, resp.statusCode)
+	}
+	assert.Equal(t, 1, calls)
+
+	// the request of a forgotten workflow is performed again
+	requests.forget("my-ns/my-wf")
+	assert.Nil(t, requests.get("my-ns/my-wf", "my-node", do))
+	<-responded
+	assert.Equal(t, 2, calls)
+}
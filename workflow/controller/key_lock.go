@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"sync"
+)
+
+// keyLock serializes the operations on each workflow, keyed by namespace/name. The work queue never hands the same
+// key to two workflow workers at a time, but the workflows are also operated outside of it, e.g. by the artifact GC
+// worker once they are deleted, which must not create pods or write the status concurrently with a workflow worker.
+type keyLock struct {
+	locks map[string]*keyMutex
+	lock  *sync.Mutex
+}
+
+// keyMutex is the lock of a key, together with the number of goroutines which hold it or wait for it
+type keyMutex struct {
+	sync.Mutex
+	refs int
+}
+
+func newKeyLock() *keyLock {
+	return &keyLock{
+		locks: make(map[string]*keyMutex),
+		lock:  &sync.Mutex{},
+	}
+}
+
+// lock blocks until no other goroutine holds the key, and then holds it
+func (l *keyLock) lock(key string) {
+	l.lock.Lock()
+	m, ok := l.locks[key]
+	if !ok {
+		m = &keyMutex{}
+		l.locks[key] = m
+	}
+	m.refs++
+	l.lock.Unlock()
+	m.Lock()
+}
+
+// unlock releases the key, which must be held
+func (l *keyLock) unlock(key string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	m, ok := l.locks[key]
+	if !ok {
+		panic("unlock of unlocked key " + key)
+	}
+	m.refs--
+	if m.refs == 0 {
+		delete(l.locks, key)
+	}
+	m.Unlock()
+}
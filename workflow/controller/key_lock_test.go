@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyLock(t *testing.T) {
+	l := newKeyLock()
+	var active, maxActive int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.lock("argo/my-wf")
+			defer l.unlock("argo/my-wf")
+			n := atomic.AddInt32(&active, 1)
+			for {
+				max := atomic.LoadInt32(&maxActive)
+				if n <= max || atomic.CompareAndSwapInt32(&maxActive, max, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&active, -1)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), maxActive)
+	assert.Empty(t, l.locks)
+
+	// other keys are not blocked
+	l.lock("argo/my-wf")
+	done := make(chan struct{})
+	go func() {
+		l.lock("argo/other-wf")
+		l.unlock("argo/other-wf")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("other key blocked")
+	}
+	l.unlock("argo/my-wf")
+	assert.Empty(t, l.locks)
+}
+
+func TestGarbageCollectDeletedWorkflowWaitsForWorker(t *testing.T) {
+	controller := newController()
+	wf := unmarshalWF(helloWorldWf)
+	wf.Namespace = "argo"
+	controller.keyLock.lock("argo/hello-world")
+	done := make(chan struct{})
+	go func() {
+		_, _ = controller.garbageCollectDeletedWorkflow(wf)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("the workflow was garbage collected while a worker was operating it")
+	case <-time.After(50 * time.Millisecond):
+	}
+	controller.keyLock.unlock("argo/hello-world")
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the workflow was not garbage collected once the worker was done")
+	}
+}
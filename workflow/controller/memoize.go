@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	kuberetry "k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// maxMemoizeCacheSize is the size the entries of a cache config map are kept under, by evicting the oldest ones,
+// as config maps are limited to 1MiB
+var maxMemoizeCacheSize = 512 * 1024
+
+// memoizedEntry is the value cached under a memoization key in the cache config map
+type memoizedEntry struct {
+	// NodeID is the ID of the node which produced the outputs
+	NodeID            string        `json:"nodeID"`
+	Outputs           *wfv1.Outputs `json:"outputs,omitempty"`
+	CreationTimestamp metav1.Time   `json:"creationTimestamp"`
+}
+
+// getMemoized returns the cached entry for the key of a memoized template, or nil if there is none
+func (woc *wfOperationCtx) getMemoized(memoize *wfv1.Memoize) (*memoizedEntry, error) {
+	if errs := validation.IsConfigMapKey(memoize.Key); len(errs) > 0 {
+		return nil, errors.Errorf(errors.CodeBadRequest, "memoization key '%s' is invalid: %s", memoize.Key, strings.Join(errs, ", "))
+	}
+	cm, err := woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.ObjectMeta.Namespace).Get(memoize.Cache.ConfigMap.Name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	data, ok := cm.Data[memoize.Key]
+	if !ok {
+		return nil, nil
+	}
+	var entry memoizedEntry
+	err = json.Unmarshal([]byte(data), &entry)
+	if err != nil {
+		// treat the corrupt entry as a miss, so that it is overwritten once the node succeeds
+		woc.log.Warnf("Ignoring invalid memoization entry '%s' in config map %s: %v", memoize.Key, cm.Name, err)
+		return nil, nil
+	}
+	if memoize.MaxAge != "" {
+		maxAge, err := time.ParseDuration(memoize.MaxAge)
+		if err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "memoization maxAge '%s' is invalid: %v", memoize.MaxAge, err)
+		}
+		if time.Since(entry.CreationTimestamp.Time) > maxAge {
+			// treat the expired entry as a miss, so that it is overwritten once the node succeeds
+			woc.log.Infof("Ignoring memoization entry '%s' in config map %s older than %s", memoize.Key, cm.Name, memoize.MaxAge)
+			return nil, nil
+		}
+	}
+	return &entry, nil
+}
+
+// initializeMemoizedNode initializes a succeeded node which reuses the cached outputs instead of running
+func (woc *wfOperationCtx) initializeMemoizedNode(nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateHolder, boundaryID string, entry *memoizedEntry) *wfv1.NodeStatus {
+	node := woc.initializeExecutableNode(nodeName, wfv1.NodeTypePod, templateScope, tmpl, orgTmpl, boundaryID, wfv1.NodeSucceeded)
+	node.Outputs = entry.Outputs
+	node.MemoizationStatus = &wfv1.MemoizationStatus{Hit: true, Key: tmpl.Memoize.Key, CacheName: tmpl.Memoize.Cache.ConfigMap.Name}
+	node.Message = "Outputs reused from node " + entry.NodeID
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc.log.Infof("Node %s reused the memoized outputs of node %s", node.Name, entry.NodeID)
+	return node
+}
+
+// markNodeMemoized records the memoization key of a node which was not found in the cache, so that its outputs
+// are cached once it succeeds
+func (woc *wfOperationCtx) markNodeMemoized(node *wfv1.NodeStatus, memoize *wfv1.Memoize) *wfv1.NodeStatus {
+	if node.MemoizationStatus != nil {
+		return node
+	}
+	node.MemoizationStatus = &wfv1.MemoizationStatus{Hit: false, Key: memoize.Key, CacheName: memoize.Cache.ConfigMap.Name}
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc.updated = true
+	return node
+}
+
+// saveMemoized caches the outputs of a succeeded node under its memoization key, creating the cache if needed
+func (woc *wfOperationCtx) saveMemoized(node wfv1.NodeStatus) error {
+	status := node.MemoizationStatus
+	data, err := json.Marshal(memoizedEntry{NodeID: node.ID, Outputs: node.Outputs, CreationTimestamp: metav1.Now()})
+	if err != nil {
+		return err
+	}
+	cmIf := woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.ObjectMeta.Namespace)
+	return kuberetry.RetryOnConflict(kuberetry.DefaultRetry, func() error {
+		cm, err := cmIf.Get(status.CacheName, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			_, err = cmIf.Create(&apiv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: status.CacheName},
+				Data:       map[string]string{status.Key: string(data)},
+			})
+			if apierr.IsAlreadyExists(err) {
+				// another node created the cache in the meantime, so retry with an update
+				return apierr.NewConflict(schema.GroupResource{Resource: "configmaps"}, status.CacheName, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[status.Key] = string(data)
+		evicted := evictMemoized(cm.Data, status.Key)
+		_, err = cmIf.Update(cm)
+		if err == nil && len(evicted) > 0 {
+			woc.log.Infof("Evicted the oldest memoization entries %v from config map %s", evicted, cm.Name)
+		}
+		return err
+	})
+}
+
+// evictMemoized deletes the oldest entries, other than the given key, until the cache fits in maxMemoizeCacheSize.
+// It returns the evicted keys.
+func evictMemoized(data map[string]string, key string) []string {
+	size := 0
+	for k, v := range data {
+		size += len(k) + len(v)
+	}
+	if size <= maxMemoizeCacheSize {
+		return nil
+	}
+	type agedKey struct {
+		key       string
+		createdAt time.Time
+	}
+	var keys []agedKey
+	for k, v := range data {
+		if k == key {
+			continue
+		}
+		// corrupt entries have the zero time, so they are evicted first
+		var entry memoizedEntry
+		_ = json.Unmarshal([]byte(v), &entry)
+		keys = append(keys, agedKey{k, entry.CreationTimestamp.Time})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].createdAt.Equal(keys[j].createdAt) {
+			return keys[i].key < keys[j].key
+		}
+		return keys[i].createdAt.Before(keys[j].createdAt)
+	})
+	var evicted []string
+	for _, k := range keys {
+		if size <= maxMemoizeCacheSize {
+			break
+		}
+		size -= len(k.key) + len(data[k.key])
+		delete(data, k.key)
+		evicted = append(evicted, k.key)
+	}
+	return evicted
+}
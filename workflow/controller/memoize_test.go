@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+var memoizedWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: memoized
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: whalesay
+        template: whalesay
+        arguments:
+          parameters:
+          - name: message
+            value: hello
+  - name: whalesay
+    inputs:
+      parameters:
+      - name: message
+    memoize:
+      key: "{{inputs.parameters.message}}"
+      cache:
+        configMap:
+          name: whalesay-cache
+    container:
+      image: docker/whalesay:latest
+      args: ["{{inputs.parameters.message}}"]
+`
+
+// getMemoizedNode returns the node of the memoized step
+func getMemoizedNode(t *testing.T, wf *wfv1.Workflow) wfv1.NodeStatus {
+	node := wf.Status.Nodes.FindByDisplayName("whalesay")
+	if !assert.NotNil(t, node) {
+		t.FailNow()
+	}
+	return *node
+}
+
+func TestMemoize(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	// the first run is a cache miss, which caches the outputs once it succeeds
+	wf, err := wfcset.Create(unmarshalWF(memoizedWorkflow))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node := getMemoizedNode(t, woc.wf)
+	if assert.NotNil(t, node.MemoizationStatus) {
+		assert.False(t, node.MemoizationStatus.Hit)
+		assert.Equal(t, "hello", node.MemoizationStatus.Key)
+	}
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 1)
+	for _, pod := range pods.Items {
+		pod.Status.Phase = apiv1.PodSucceeded
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	cm, err := controller.kubeclientset.CoreV1().ConfigMaps("").Get("whalesay-cache", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Contains(t, cm.Data["hello"], node.ID)
+	}
+
+	// the second run reuses the cached outputs without creating a pod
+	wf = unmarshalWF(memoizedWorkflow)
+	wf.Name = "memoized-again"
+	wf, err = wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node = getMemoizedNode(t, woc.wf)
+	assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+	if assert.NotNil(t, node.MemoizationStatus) {
+		assert.True(t, node.MemoizationStatus.Hit)
+	}
+	pods, err = podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 1)
+}
+
+func TestMemoizeMaxAge(t *testing.T) {
+	controller := newController()
+	data, err := json.Marshal(memoizedEntry{NodeID: "old", CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Hour))})
+	assert.NoError(t, err)
+	_, err = controller.kubeclientset.CoreV1().ConfigMaps("").Create(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "whalesay-cache"},
+		Data:       map[string]string{"hello": string(data)},
+	})
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(unmarshalWF(memoizedWorkflow), controller)
+	memoize := &wfv1.Memoize{Key: "hello", Cache: &wfv1.Cache{ConfigMap: &apiv1.ConfigMapKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "whalesay-cache"}}}}
+
+	entry, err := woc.getMemoized(memoize)
+	assert.NoError(t, err)
+	if assert.NotNil(t, entry) {
+		assert.Equal(t, "old", entry.NodeID)
+	}
+
+	memoize.MaxAge = "1h"
+	entry, err = woc.getMemoized(memoize)
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	memoize.MaxAge = "3h"
+	entry, err = woc.getMemoized(memoize)
+	assert.NoError(t, err)
+	assert.NotNil(t, entry)
+}
+
+func TestEvictMemoized(t *testing.T) {
+	defer func(size int) { maxMemoizeCacheSize = size }(maxMemoizeCacheSize)
+	entry := func(age time.Duration) string {
+		data, err := json.Marshal(memoizedEntry{NodeID: "node", CreationTimestamp: metav1.NewTime(time.Now().Add(-age))})
+		assert.NoError(t, err)
+		return string(data)
+	}
+	data := map[string]string{
+		"oldest": entry(3 * time.Hour),
+		"old":    entry(2 * time.Hour),
+		"new":    entry(time.Hour),
+		"saved":  entry(4 * time.Hour),
+	}
+	maxMemoizeCacheSize = 2 * (len("saved") + len(data["saved"]))
+
+	assert.Equal(t, []string{"oldest", "old"}, evictMemoized(data, "saved"))
+	assert.Contains(t, data, "saved")
+	assert.Contains(t, data, "new")
+	assert.Len(t, data, 2)
+
+	assert.Empty(t, evictMemoized(data, "saved"))
+}
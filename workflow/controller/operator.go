@@ -4,7 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
-	"os"
+	"net"
 	"reflect"
 	"regexp"
 	"runtime/debug"
@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/argoproj/pkg/humanize"
@@ -24,19 +25,25 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	kuberetry "k8s.io/client-go/util/retry"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/yaml"
 
+	argoversion "github.com/argoproj/argo"
 	"github.com/argoproj/argo/errors"
 	"github.com/argoproj/argo/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
 	"github.com/argoproj/argo/util/argo"
+	"github.com/argoproj/argo/util/logging"
 	"github.com/argoproj/argo/util/retry"
 	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/config"
-	"github.com/argoproj/argo/workflow/packer"
 	"github.com/argoproj/argo/workflow/templateresolution"
 	"github.com/argoproj/argo/workflow/util"
 	"github.com/argoproj/argo/workflow/validate"
@@ -48,9 +55,13 @@ type wfOperationCtx struct {
 	wf *wfv1.Workflow
 	// orig is the original workflow object for purposes of creating a patch
 	orig *wfv1.Workflow
+	// origNodes is a snapshot of the nodes before the operation, for the purposes of logging what changed
+	origNodes wfv1.Nodes
 	// updated indicates whether or not the workflow object itself was updated
 	// and needs to be persisted back to kubernetes
 	updated bool
+	// requeued indicates whether the workflow was requeued, e.g. to be checked again once a delay passed
+	requeued bool
 	// log is an logrus logging context to corralate logs with a workflow
 	log *log.Entry
 	// controller reference to workflow controller
@@ -75,6 +86,11 @@ type wfOperationCtx struct {
 	// activePods tracks the number of active (Running/Pending) pods for controlling
 	// parallelism
 	activePods int64
+	// podsThrottled indicates whether pods were held back by the pod parallelism of the controller
+	podsThrottled bool
+	// completed indicates the workflow was marked completed, so that its outputs are reported to the workflows which
+	// own it once the update is persisted
+	completed bool
 	// workflowDeadline is the deadline which the workflow is expected to complete before we
 	// terminate the workflow.
 	workflowDeadline *time.Time
@@ -84,6 +100,11 @@ type wfOperationCtx struct {
 
 	// auditLogger is the argo audit logger
 	auditLogger *argo.AuditLogger
+
+	// durations are the durations of the last successful workflow of the same kind, which the durations of the
+	// workflow and its nodes are estimated from. They are loaded once durationsLoaded.
+	durations       *durations
+	durationsLoaded bool
 }
 
 var _ wfv1.TemplateStorage = &wfOperationCtx{}
@@ -108,10 +129,8 @@ func newWorkflowOperationCtx(wf *wfv1.Workflow, wfc *WorkflowController) *wfOper
 		wf:      wf.DeepCopyObject().(*wfv1.Workflow),
 		orig:    wf,
 		updated: false,
-		log: log.WithFields(log.Fields{
-			"workflow":  wf.ObjectMeta.Name,
-			"namespace": wf.ObjectMeta.Namespace,
-		}),
+		log: logging.WithWorkflow(wf.ObjectMeta.Namespace, wf.ObjectMeta.Name, string(wf.ObjectMeta.UID)).
+			WithField(logging.FieldReconciliation, atomic.AddInt64(&wfc.reconciliations, 1)),
 		controller:         wfc,
 		globalParams:       make(map[string]string),
 		volumes:            wf.Spec.DeepCopy().Volumes,
@@ -121,7 +140,7 @@ func newWorkflowOperationCtx(wf *wfv1.Workflow, wfc *WorkflowController) *wfOper
 		deadline:           time.Now().UTC().Add(maxOperationTime),
 		auditLogger:        argo.NewAuditLogger(wf.ObjectMeta.Namespace, wfc.kubeclientset, wf.ObjectMeta.Name),
 	}
-	woc.tmplCtx = templateresolution.NewContext(wfc.wftmplInformer.Lister().WorkflowTemplates(wf.Namespace), wf, &woc)
+	woc.tmplCtx = templateresolution.NewContext(wfc.getWorkflowTemplateGetter(wf.Namespace), wf, &woc)
 
 	if woc.wf.Status.Nodes == nil {
 		woc.wf.Status.Nodes = make(map[string]wfv1.NodeStatus)
@@ -143,37 +162,76 @@ func (woc *wfOperationCtx) operate() {
 		if woc.wf.Status.Completed() {
 			_ = woc.killDaemonedChildren("")
 		}
+		woc.releaseLocks()
 		woc.persistUpdates()
+		woc.controller.podThrottler.done(woc.key(), woc.podsThrottled)
 	}()
 	defer func() {
 		if r := recover(); r != nil {
-			if rerr, ok := r.(error); ok {
-				woc.markWorkflowError(rerr, true)
-			} else {
-				woc.markWorkflowPhase(wfv1.NodeError, true, fmt.Sprintf("%v", r))
-			}
-			woc.log.Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
+			stack := debug.Stack()
+			woc.log.Errorf("Recovered from panic: %+v\n%s", r, stack)
+			woc.panicked(r, stack)
 		}
 	}()
 
 	woc.log.Infof("Processing workflow")
+	woc.origNodes = copyNodes(woc.wf.Status.Nodes)
+	if _, ok := woc.wf.ObjectMeta.Annotations[common.AnnotationKeyOperationPanics]; ok {
+		// the count is reset once the workflow is operated without panicking
+		delete(woc.wf.ObjectMeta.Annotations, common.AnnotationKeyOperationPanics)
+		woc.updated = true
+	}
 
 	// Perform one-time workflow validation
 	if woc.wf.Status.Phase == "" {
 		woc.markWorkflowRunning()
+		if woc.wf.Status.NodeIDMaxLength == 0 {
+			woc.wf.Status.NodeIDMaxLength = int32(woc.controller.Config.GetNodeIDMaxLength())
+		}
+		if len(woc.controller.Config.GetClusterNames(woc.wf.ObjectMeta.Namespace)) > 0 {
+			// the pods of the workflows of the same name in different namespaces may run in the same namespace of
+			// another cluster
+			woc.wf.Status.NodeIDNamespaced = true
+		}
+		woc.addIndexLabels()
+		woc.applyWorkflowDefaults()
+		if woc.wf.Spec.Arguments.SetDefaults() {
+			woc.updated = true
+		}
 		woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeNormal, Reason: argo.EventReasonWorkflowRunning}, "Workflow Running")
-		validateOpts := validate.ValidateOpts{ContainerRuntimeExecutor: woc.controller.GetContainerRuntimeExecutor()}
-		wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTemplates(woc.wf.Namespace))
-		err := validate.ValidateWorkflow(wftmplGetter, woc.wf, validateOpts)
+		validateOpts := validate.ValidateOpts{
+			ContainerRuntimeExecutor: woc.controller.GetContainerRuntimeExecutor(),
+			Clusters:                 woc.controller.Config.GetClusterNames(woc.wf.ObjectMeta.Namespace),
+		}
+		err := validate.ValidateWorkflow(woc.controller.getWorkflowTemplateGetter(woc.wf.Namespace), woc.wf, validateOpts)
 		if err != nil {
 			msg := fmt.Sprintf("invalid spec: %s", err.Error())
 			woc.markWorkflowFailed(msg)
 			woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonWorkflowFailed}, msg)
 			return
 		}
+		if woc.controller.policy != nil {
+			reason, err := woc.controller.policy.Evaluate(woc.wf)
+			if err != nil {
+				// the workflow is held back until the policy can be evaluated
+				woc.log.Warnf("Failed to evaluate the policy: %v", err)
+				woc.updated = false
+				woc.requeueWithRateLimit()
+				return
+			}
+			if reason != "" {
+				msg := fmt.Sprintf("rejected by policy: %s", reason)
+				woc.markWorkflowFailed(msg)
+				woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonWorkflowRejected}, msg)
+				return
+			}
+		}
+		woc.addArtifactGCFinalizer()
+		woc.estimateDurations()
 		woc.workflowDeadline = woc.getWorkflowDeadline()
 	} else {
 		woc.workflowDeadline = woc.getWorkflowDeadline()
+		woc.estimateDurations()
 		err := woc.podReconciliation()
 		if err == nil {
 			err = woc.failSuspendedNodesAfterDeadline()
@@ -187,13 +245,54 @@ func (woc *wfOperationCtx) operate() {
 		}
 	}
 
+	if woc.wf.Spec.Synchronization != nil {
+		acquired, updated, lockName, err := woc.controller.syncManager.TryAcquire(woc.wf, "", woc.wf.Spec.Synchronization)
+		if err != nil {
+			woc.log.Errorf("Failed to acquire lock: %v", err)
+			woc.markWorkflowError(err, true)
+			return
+		}
+		if updated {
+			woc.updated = true
+		}
+		if !acquired {
+			woc.log.Infof("Workflow waiting for lock %s", lockName)
+			woc.markWorkflowPhase(wfv1.NodePending, false, fmt.Sprintf("Waiting for lock %s", lockName))
+			return
+		}
+		if woc.wf.Status.Phase == wfv1.NodePending {
+			woc.log.Infof("Workflow acquired lock %s", lockName)
+			woc.markWorkflowPhase(wfv1.NodeRunning, false, "")
+		}
+	}
+
 	if woc.wf.Spec.Suspend != nil && *woc.wf.Spec.Suspend {
 		woc.log.Infof("workflow suspended")
 		return
 	}
-	if woc.wf.Spec.Parallelism != nil {
+	ready, err := woc.ensureExecutorServiceAccount()
+	if err != nil {
+		woc.log.Errorf("Failed to create executor service account: %v", err)
+		woc.requeueWithRateLimit()
+		return
+	}
+	if !ready {
+		woc.log.Infof("Waiting for the token of the executor service account")
+		woc.requeue(time.Second)
+		return
+	}
+	err = woc.addClusterPodsFinalizer()
+	if err != nil {
+		woc.log.Errorf("Failed to add the finalizer of the pods in other clusters: %v", err)
+		woc.requeueWithRateLimit()
+		return
+	}
+	if woc.wf.Spec.Parallelism != nil || woc.controller.Config.PodParallelism > 0 {
 		woc.activePods = woc.countActivePods()
 	}
+	if woc.controller.Config.PodParallelism > 0 {
+		woc.controller.podThrottler.update(woc.key(), int(woc.activePods))
+	}
 
 	woc.setGlobalParameters()
 
@@ -211,7 +310,7 @@ func (woc *wfOperationCtx) operate() {
 		}
 	}
 
-	err := woc.substituteParamsInVolumes(woc.globalParams)
+	err = woc.substituteParamsInVolumes(woc.globalParams)
 	if err != nil {
 		msg := fmt.Sprintf("%s volumes global param substitution error: %+v", woc.wf.ObjectMeta.Name, err)
 		woc.log.Errorf(msg)
@@ -238,6 +337,8 @@ func (woc *wfOperationCtx) operate() {
 		switch err {
 		case ErrDeadlineExceeded:
 			woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonWorkflowTimedOut}, msg)
+		case ErrParallelismReached:
+			// the pod of the entry template waits for its turn, the workflow did not fail
 		default:
 			woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonWorkflowFailed}, msg)
 		}
@@ -277,6 +378,10 @@ func (woc *wfOperationCtx) operate() {
 		}
 	}
 
+	if !woc.deleteResources() {
+		return
+	}
+
 	err = woc.deletePVCs()
 	if err != nil {
 		msg := fmt.Sprintf("%s error: %+v", woc.wf.ObjectMeta.Name, err)
@@ -289,6 +394,34 @@ func (woc *wfOperationCtx) operate() {
 		return
 	}
 
+	if woc.wf.Spec.ArtifactGC != nil && woc.wf.Spec.ArtifactGC.Strategy == wfv1.ArtifactGCOnWorkflowCompletion && woc.wf.Status.ArtifactGCPhase == "" {
+		phase, err := woc.garbageCollectArtifacts()
+		if err != nil {
+			woc.log.Errorf("Failed to garbage collect artifacts: %v", err)
+			woc.requeueWithRateLimit()
+			return
+		}
+		if phase == "" {
+			return
+		}
+		woc.wf.Status.ArtifactGCPhase = phase
+		woc.updated = true
+	}
+
+	// the artifact manifest is saved once the artifacts are garbage collected, so that it records whether they are
+	// still in the artifact repository
+	if woc.wf.Spec.ArtifactManifest && woc.wf.Status.ArtifactManifest == nil {
+		done, err := woc.saveArtifactManifest()
+		if err != nil {
+			woc.log.Errorf("Failed to save the artifact manifest: %v", err)
+			woc.requeueWithRateLimit()
+			return
+		}
+		if !done {
+			return
+		}
+	}
+
 	// If we get here, the workflow completed, all PVCs were deleted successfully, and
 	// exit handlers were executed. We now need to infer the workflow phase from the
 	// node phase.
@@ -380,25 +513,28 @@ func (woc *wfOperationCtx) persistUpdates() {
 	if !woc.updated {
 		return
 	}
+	key, err := cache.MetaNamespaceKeyFunc(woc.wf)
+	if err != nil {
+		woc.log.Errorf("Failed to get key of workflow: %v", err)
+		return
+	}
+	// Coalesce the node changes of a workflow which was updated recently into a later update. The changes are not
+	// lost: they are made again from the pods when the workflow is operated on next.
+	if !woc.wf.Status.Completed() {
+		if delay := woc.controller.updateLimiter.delay(key, woc.controller.Config.NodeStatusUpdateInterval.Duration); delay > 0 {
+			woc.log.Debugf("Deferring update by %v", delay)
+			woc.requeue(delay)
+			return
+		}
+	}
+	woc.updateProgress()
 	wfClient := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.ObjectMeta.Namespace)
-	// try and compress nodes if needed
+	// compress or offload the nodes depending on the node status storage
 	nodes := woc.wf.Status.Nodes
 
-	err := packer.CompressWorkflow(woc.wf)
-	if packer.IsTooLargeError(err) || os.Getenv("ALWAYS_OFFLOAD_NODE_STATUS") == "true" {
-		if woc.controller.offloadNodeStatusRepo.IsEnabled() {
-			offloadVersion, err := woc.controller.offloadNodeStatusRepo.Save(string(woc.wf.UID), woc.wf.Namespace, nodes)
-			if err != nil {
-				woc.log.Warnf("Failed to offload node status: %v", err)
-				woc.markWorkflowError(err, true)
-			} else {
-				woc.wf.Status.Nodes = nil
-				woc.wf.Status.CompressedNodes = ""
-				woc.wf.Status.OffloadNodeStatusVersion = offloadVersion
-			}
-		}
-	} else if err != nil {
-		woc.log.Warnf("Error compressing workflow: %v", err)
+	err = woc.controller.getHydrator().Dehydrate(woc.wf)
+	if err != nil {
+		woc.log.Warnf("Failed to dehydrate workflow: %v", err)
 		woc.markWorkflowError(err, true)
 	}
 	wf, err := wfClient.Update(woc.wf)
@@ -408,24 +544,32 @@ func (woc *wfOperationCtx) persistUpdates() {
 			woc.persistWorkflowSizeLimitErr(wfClient, err)
 			return
 		}
-		if !apierr.IsConflict(err) {
+		if !retry.IsRetryableKubeAPIError(err) {
+			woc.requeueWithRateLimit()
 			return
 		}
 		woc.log.Info("Re-applying updates on latest version and retrying update")
 		wf, err := woc.reapplyUpdate(wfClient)
 		if err != nil {
 			woc.log.Infof("Failed to re-apply update: %+v", err)
+			woc.requeueWithRateLimit()
 			return
 		}
 		woc.wf = wf
 	} else {
 		woc.wf = wf
 	}
+	woc.forgetRateLimit()
+	woc.controller.updateLimiter.updated(key)
 
 	// restore to pre-compressed state
 	woc.wf.Status.Nodes = nodes
 	woc.wf.Status.CompressedNodes = ""
 	woc.log.WithFields(log.Fields{"resourceVersion": woc.wf.ResourceVersion, "phase": woc.wf.Status.Phase}).Info("Workflow update successful")
+	woc.reportNodeChanges()
+	if woc.completed {
+		woc.reportOutputsToOwners()
+	}
 
 	// HACK(jessesuen) after we successfully persist an update to the workflow, the informer's
 	// cache is now invalid. It's very common that we will need to immediately re-operate on a
@@ -445,17 +589,23 @@ func (woc *wfOperationCtx) persistUpdates() {
 		switch woc.wf.Spec.PodGC.Strategy {
 		case wfv1.PodGCOnPodSuccess:
 			for podName := range woc.succeededPods {
-				woc.controller.gcPods <- fmt.Sprintf("%s/%s", woc.wf.ObjectMeta.Namespace, podName)
+				if pod := woc.podKey(podName); pod != "" {
+					woc.controller.gcPods <- pod
+				}
 			}
 		case wfv1.PodGCOnPodCompletion:
 			for podName := range woc.completedPods {
-				woc.controller.gcPods <- fmt.Sprintf("%s/%s", woc.wf.ObjectMeta.Namespace, podName)
+				if pod := woc.podKey(podName); pod != "" {
+					woc.controller.gcPods <- pod
+				}
 			}
 		}
 	} else {
 		// label pods which will not be deleted
 		for podName := range woc.completedPods {
-			woc.controller.completedPods <- fmt.Sprintf("%s/%s", woc.wf.ObjectMeta.Namespace, podName)
+			if pod := woc.podKey(podName); pod != "" {
+				woc.controller.completedPods <- pod
+			}
 		}
 	}
 }
@@ -471,6 +621,146 @@ func (woc *wfOperationCtx) persistWorkflowSizeLimitErr(wfClient v1alpha1.Workflo
 	}
 }
 
+// nodeChange is a change of the phase of a node. From is empty for new nodes.
+type nodeChange struct {
+	id   string
+	name string
+	from wfv1.NodePhase
+	to   wfv1.NodePhase
+}
+
+// diffNodes returns the nodes which were added or changed phase, ordered by name
+func diffNodes(before, after wfv1.Nodes) []nodeChange {
+	var changes []nodeChange
+	for id, node := range after {
+		orig, ok := before[id]
+		if ok && orig.Phase == node.Phase {
+			continue
+		}
+		changes = append(changes, nodeChange{id: id, name: node.Name, from: orig.Phase, to: node.Phase})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].name < changes[j].name
+	})
+	return changes
+}
+
+// copyNodes returns a shallow copy of the nodes, which is enough to detect later phase changes
+func copyNodes(nodes wfv1.Nodes) wfv1.Nodes {
+	copied := make(wfv1.Nodes, len(nodes))
+	for id, node := range nodes {
+		copied[id] = node
+	}
+	return copied
+}
+
+// reportNodeChanges logs the node transitions which were persisted by this operation, rather than the whole status,
+// and records an event for every node which failed, but not for the nodes which failed because of it
+func (woc *wfOperationCtx) reportNodeChanges() {
+	if woc.origNodes == nil {
+		return
+	}
+	changes := diffNodes(woc.origNodes, woc.wf.Status.Nodes)
+	for _, change := range changes {
+		woc.log.WithFields(log.Fields{logging.FieldNodeID: change.id, "nodeName": change.name, "from": change.from, "to": change.to}).Info("Node phase changed")
+		node := woc.wf.Status.Nodes[change.id]
+		if node.Completed() && !(wfv1.NodeStatus{Phase: change.from}).Completed() {
+			woc.emitNodeMetrics(node)
+		}
+		if change.to == wfv1.NodeSucceeded && node.MemoizationStatus != nil && !node.MemoizationStatus.Hit {
+			err := woc.saveMemoized(node)
+			if err != nil {
+				woc.log.Warnf("Failed to cache the outputs of node %s: %v", node.ID, err)
+			}
+		}
+		if woc.failedWithinNode(node.ID) {
+			// the event was recorded at the node which failed first
+			continue
+		}
+		switch change.to {
+		case wfv1.NodeFailed:
+			woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonNodeFailed}, fmt.Sprintf("Failed node %s: %s", node.Name, node.Message))
+		case wfv1.NodeError:
+			woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonNodeError}, fmt.Sprintf("Error node %s: %s", node.Name, node.Message))
+		}
+	}
+	woc.log.WithFields(log.Fields{"nodes": len(woc.wf.Status.Nodes), "changed": len(changes)}).Debug("Node changes persisted")
+	// later updates within the same operation only report what changed since this one
+	woc.origNodes = copyNodes(woc.wf.Status.Nodes)
+}
+
+// failedWithinNode returns whether a child of a node, or a node within its boundary, failed, which then failed the node
+func (woc *wfOperationCtx) failedWithinNode(nodeID string) bool {
+	node := woc.wf.Status.Nodes[nodeID]
+	for _, other := range woc.wf.Status.Nodes {
+		if other.Phase != wfv1.NodeFailed && other.Phase != wfv1.NodeError {
+			continue
+		}
+		if other.BoundaryID == nodeID && other.ID != nodeID {
+			return true
+		}
+		for _, childID := range node.Children {
+			if other.ID == childID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// emitNodeMetrics records the duration of a completed node, and emits the custom metrics declared by its template.
+// Metrics are only emitted once the completion has been persisted, so that operations which fail to persist do not
+// emit them twice.
+func (woc *wfOperationCtx) emitNodeMetrics(node wfv1.NodeStatus) {
+	if node.Type == wfv1.NodeTypeRetry {
+		// every attempt of a retried node emits its own metrics
+		return
+	}
+	if templateName := nodeTemplateName(node); templateName != "" && !node.StartedAt.IsZero() {
+		woc.controller.metrics.NodeCompleted(templateName, node.Phase, node.FinishedAt.Sub(node.StartedAt.Time))
+	}
+	_, tmpl, err := woc.tmplCtx.ResolveTemplate(&node)
+	if err != nil || tmpl == nil || tmpl.Metrics == nil {
+		return
+	}
+	metricsBytes, err := json.Marshal(tmpl.Metrics)
+	if err != nil {
+		woc.log.Warnf("Failed to marshal metrics of node %s: %v", node.ID, err)
+		return
+	}
+	localParams := map[string]string{
+		common.LocalVarStatus:   string(node.Phase),
+		common.LocalVarDuration: fmt.Sprintf("%f", node.FinishedAt.Sub(node.StartedAt.Time).Seconds()),
+	}
+	fstTmpl := fasttemplate.New(string(metricsBytes), "{{", "}}")
+	newMetricsStr, err := common.Replace(fstTmpl, localParams, true)
+	if err != nil {
+		woc.log.Warnf("Failed to resolve metrics of node %s: %v", node.ID, err)
+		return
+	}
+	var metrics wfv1.Metrics
+	err = json.Unmarshal([]byte(newMetricsStr), &metrics)
+	if err != nil {
+		woc.log.Warnf("Failed to unmarshal metrics of node %s: %v", node.ID, err)
+		return
+	}
+	for _, metric := range metrics.Prometheus {
+		err = woc.controller.metrics.EmitCustomMetric(metric)
+		if err != nil {
+			woc.log.Warnf("Failed to emit metric of node %s: %v", node.ID, err)
+		}
+	}
+}
+
+// nodeTemplateName returns the name of the template of the node, which is <workflow template>/<template> for
+// templates referenced from workflow templates
+func nodeTemplateName(node wfv1.NodeStatus) string {
+	if node.TemplateRef != nil {
+		return node.TemplateRef.Name + "/" + node.TemplateRef.Template
+	}
+	return node.TemplateName
+}
+
 // reapplyUpdate GETs the latest version of the workflow, re-applies the updates and
 // retries the UPDATE multiple times. For reasoning behind this technique, see:
 // https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#concurrency-control-and-consistency
@@ -488,37 +778,78 @@ func (woc *wfOperationCtx) reapplyUpdate(wfClient v1alpha1.WorkflowInterface) (*
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
 	}
-	// Next get latest version of the workflow, apply the patch and retyr the Update
-	attempt := 1
-	for {
+	// Next get latest version of the workflow, apply the patch and retry the Update with an exponential backoff, which
+	// leaves the other writers of the workflow the time to finish
+	var updated *wfv1.Workflow
+	attempt := 0
+	err = wait.ExponentialBackoff(kuberetry.DefaultBackoff, func() (bool, error) {
+		attempt++
 		currWf, err := wfClient.Get(woc.wf.ObjectMeta.Name, metav1.GetOptions{})
-		if !retry.IsRetryableKubeAPIError(err) {
-			return nil, errors.InternalWrapError(err)
+		if err != nil {
+			if retry.IsRetryableKubeAPIError(err) {
+				woc.log.Warnf("Update retry attempt %d failed to get workflow: %v", attempt, err)
+				return false, nil
+			}
+			return false, errors.InternalWrapError(err)
 		}
 		currWfBytes, err := json.Marshal(currWf)
 		if err != nil {
-			return nil, errors.InternalWrapError(err)
+			return false, errors.InternalWrapError(err)
 		}
 		newWfBytes, err := jsonpatch.MergePatch(currWfBytes, patchBytes)
 		if err != nil {
-			return nil, errors.InternalWrapError(err)
+			return false, errors.InternalWrapError(err)
 		}
 		var newWf wfv1.Workflow
 		err = json.Unmarshal(newWfBytes, &newWf)
 		if err != nil {
-			return nil, errors.InternalWrapError(err)
-		}
-		wf, err := wfClient.Update(&newWf)
-		if err == nil {
-			woc.log.Infof("Update retry attempt %d successful", attempt)
-			return wf, nil
+			return false, errors.InternalWrapError(err)
 		}
-		attempt++
-		woc.log.Warnf("Update retry attempt %d failed: %v", attempt, err)
-		if attempt > 5 {
-			return nil, err
+		updated, err = wfClient.Update(&newWf)
+		if err != nil {
+			if retry.IsRetryableKubeAPIError(err) {
+				woc.log.Warnf("Update retry attempt %d failed: %v", attempt, err)
+				return false, nil
+			}
+			return false, err
 		}
+		woc.log.Infof("Update retry attempt %d successful", attempt)
+		return true, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, errors.InternalErrorf("failed to update workflow after %d attempts", attempt)
 	}
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// key returns the key of the workflow in the workqueue
+func (woc *wfOperationCtx) key() string {
+	key, _ := cache.MetaNamespaceKeyFunc(woc.wf)
+	return key
+}
+
+// requeueWithRateLimit requeues the workflow with an increasing backoff, so that an operation whose updates could
+// not be persisted is retried rather than lost until the next resync
+func (woc *wfOperationCtx) requeueWithRateLimit() {
+	key, err := cache.MetaNamespaceKeyFunc(woc.wf)
+	if err != nil {
+		woc.log.Errorf("Failed to requeue workflow %s: %v", woc.wf.ObjectMeta.Name, err)
+		return
+	}
+	woc.requeued = true
+	woc.controller.wfQueue.AddRateLimited(key)
+}
+
+// forgetRateLimit resets the backoff of the workflow once its updates were persisted
+func (woc *wfOperationCtx) forgetRateLimit() {
+	key, err := cache.MetaNamespaceKeyFunc(woc.wf)
+	if err != nil {
+		return
+	}
+	woc.controller.wfQueue.Forget(key)
 }
 
 // requeue this workflow onto the workqueue for later processing
@@ -528,6 +859,7 @@ func (woc *wfOperationCtx) requeue(afterDuration time.Duration) {
 		woc.log.Errorf("Failed to requeue workflow %s: %v", woc.wf.ObjectMeta.Name, err)
 		return
 	}
+	woc.requeued = true
 	woc.controller.wfQueue.AddAfter(key, afterDuration)
 }
 
@@ -541,6 +873,17 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		return nil, false, fmt.Errorf("Failed to find last child of node " + node.Name)
 	}
 
+	if isTerminatedByFailFast(node) && (lastChildNode == nil || !lastChildNode.Successful()) {
+		// a sibling failed when failing fast, so the node fails once its current attempt completes
+		if lastChildNode == nil {
+			return woc.markNodePhase(node.Name, wfv1.NodeFailed, node.Message), true, nil
+		}
+		if !lastChildNode.Completed() {
+			return node, false, nil
+		}
+		return woc.markNodePhase(node.Name, lastChildNode.Phase, node.Message), true, nil
+	}
+
 	if lastChildNode == nil {
 		return node, true, nil
 	}
@@ -642,9 +985,12 @@ func (woc *wfOperationCtx) podReconciliation() error {
 	if err != nil {
 		return err
 	}
+	clusterPods, unavailableClusters := woc.listClusterPods()
+	podList.Items = append(podList.Items, clusterPods...)
 	seenPods := make(map[string]bool)
 	seenPodLock := &sync.Mutex{}
 	wfNodesLock := &sync.RWMutex{}
+	failedPods := make(map[string]*apiv1.Pod)
 
 	performAssessment := func(pod *apiv1.Pod) {
 		if pod == nil {
@@ -659,10 +1005,18 @@ func (woc *wfOperationCtx) podReconciliation() error {
 		wfNodesLock.Lock()
 		defer wfNodesLock.Unlock()
 		if node, ok := woc.wf.Status.Nodes[nodeID]; ok {
-			if newState := assessNodeStatus(pod, &node); newState != nil {
+			logCtx := woc.log.WithFields(log.Fields{logging.FieldNodeID: nodeID, logging.FieldPod: pod.Name})
+			if newState := assessNodeStatus(logCtx, pod, &node); newState != nil {
+				if newState.Environment != nil {
+					woc.recordEnvironment(newState.Environment)
+				}
 				woc.wf.Status.Nodes[nodeID] = *newState
 				woc.addOutputsToScope("workflow", node.Outputs, nil)
 				woc.updated = true
+				if woc.controller.Config.FailedNodeDiagnostics != nil && newState.Diagnostics == nil &&
+					(newState.Phase == wfv1.NodeFailed || newState.Phase == wfv1.NodeError) {
+					failedPods[nodeID] = pod
+				}
 			}
 			node := woc.wf.Status.Nodes[pod.ObjectMeta.Name]
 			if node.Completed() && !node.IsDaemoned() {
@@ -683,6 +1037,10 @@ func (woc *wfOperationCtx) podReconciliation() error {
 	var wg sync.WaitGroup
 
 	for _, pod := range podList.Items {
+		if pod.Name == woc.artifactGCPodName() || pod.Name == woc.artifactManifestPodName() {
+			// the pods garbage collecting artifacts and saving the artifact manifest are not nodes of the workflow
+			continue
+		}
 		parallelPodNum <- pod.Name
 		wg.Add(1)
 		go func(tmpPod apiv1.Pod) {
@@ -698,6 +1056,10 @@ func (woc *wfOperationCtx) podReconciliation() error {
 
 	wg.Wait()
 
+	for nodeID, pod := range failedPods {
+		woc.addDiagnostics(nodeID, pod)
+	}
+
 	// Now check for deleted pods. Iterate our nodes. If any one of our nodes does not show up in
 	// the seen list it implies that the pod was deleted without the controller seeing the event.
 	// It is now impossible to infer pod status. The only thing we can do at this point is to mark
@@ -707,6 +1069,10 @@ func (woc *wfOperationCtx) podReconciliation() error {
 			// node is not a pod, it is already complete, or it can be re-run.
 			continue
 		}
+		if unavailableClusters[node.Cluster] {
+			// the pod is unknown until its cluster is available again
+			continue
+		}
 		if _, ok := seenPods[nodeID]; !ok {
 			node.Message = "pod deleted"
 			node.Phase = wfv1.NodeError
@@ -715,14 +1081,20 @@ func (woc *wfOperationCtx) podReconciliation() error {
 			woc.updated = true
 		}
 	}
+	if woc.updated {
+		woc.wf.Status.ResourcesDuration = woc.wf.Status.Nodes.GetResourcesDuration()
+	}
+	if len(unavailableClusters) > 0 {
+		woc.requeueWithRateLimit()
+	}
 	return nil
 }
 
-//fails any suspended nodes if the workflow deadline has passed
+//fails any suspended nodes, and any HTTP nodes whose request is in flight, if the workflow deadline has passed
 func (woc *wfOperationCtx) failSuspendedNodesAfterDeadline() error {
 	if woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline) {
 		for _, node := range woc.wf.Status.Nodes {
-			if node.Type == wfv1.NodeTypeSuspend && node.Phase == wfv1.NodeRunning {
+			if (node.Type == wfv1.NodeTypeSuspend || node.Type == wfv1.NodeTypeHTTP) && node.Phase == wfv1.NodeRunning {
 				var message string
 				if woc.workflowDeadline.IsZero() {
 					message = "terminated"
@@ -773,7 +1145,7 @@ func (woc *wfOperationCtx) countActiveChildren(boundaryIDs ...string) int64 {
 			continue
 		}
 		switch node.Type {
-		case wfv1.NodeTypePod, wfv1.NodeTypeSteps, wfv1.NodeTypeDAG:
+		case wfv1.NodeTypePod, wfv1.NodeTypeHTTP, wfv1.NodeTypeSteps, wfv1.NodeTypeDAG:
 		default:
 			continue
 		}
@@ -801,7 +1173,7 @@ func (woc *wfOperationCtx) getAllWorkflowPods() (*apiv1.PodList, error) {
 
 // assessNodeStatus compares the current state of a pod with its corresponding node
 // and returns the new node status if something changed
-func assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
+func assessNodeStatus(logCtx *log.Entry, pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
 	var newPhase wfv1.NodePhase
 	var newDaemonStatus *bool
 	var message string
@@ -819,7 +1191,7 @@ func assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
 		if node.IsDaemoned() {
 			newPhase = wfv1.NodeSucceeded
 		} else {
-			newPhase, message = inferFailedReason(pod)
+			newPhase, message = inferFailedReason(logCtx, pod)
 		}
 		newDaemonStatus = pointer.BoolPtr(false)
 	case apiv1.PodRunning:
@@ -831,13 +1203,13 @@ func assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
 			newPhase = wfv1.NodeRunning
 			tmplStr, ok := pod.Annotations[common.AnnotationKeyTemplate]
 			if !ok {
-				log.Warnf("%s missing template annotation", pod.ObjectMeta.Name)
+				logCtx.Warnf("%s missing template annotation", pod.ObjectMeta.Name)
 				return nil
 			}
 			var tmpl wfv1.Template
 			err := json.Unmarshal([]byte(tmplStr), &tmpl)
 			if err != nil {
-				log.Warnf("%s template annotation unreadable: %v", pod.ObjectMeta.Name, err)
+				logCtx.Warnf("%s template annotation unreadable: %v", pod.ObjectMeta.Name, err)
 				return nil
 			}
 			if tmpl.Daemon != nil && *tmpl.Daemon {
@@ -850,13 +1222,13 @@ func assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
 				// proceed to mark node status as running (and daemoned)
 				newPhase = wfv1.NodeRunning
 				newDaemonStatus = pointer.BoolPtr(true)
-				log.Infof("Processing ready daemon pod: %v", pod.ObjectMeta.SelfLink)
+				logCtx.Infof("Processing ready daemon pod: %v", pod.ObjectMeta.SelfLink)
 			}
 		}
 	default:
 		newPhase = wfv1.NodeError
 		message = fmt.Sprintf("Unexpected pod phase for %s: %s", pod.ObjectMeta.Name, pod.Status.Phase)
-		log.Error(message)
+		logCtx.Error(message)
 	}
 
 	if newDaemonStatus != nil {
@@ -866,12 +1238,12 @@ func assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
 			newDaemonStatus = nil
 		}
 		if (newDaemonStatus != nil && node.Daemoned == nil) || (newDaemonStatus == nil && node.Daemoned != nil) {
-			log.Infof("Setting node %v daemoned: %v -> %v", node, node.Daemoned, newDaemonStatus)
+			logCtx.Infof("Setting node %s daemoned: %v -> %v", node.ID, node.Daemoned, newDaemonStatus)
 			node.Daemoned = newDaemonStatus
 			updated = true
 			if pod.Status.PodIP != "" && pod.Status.PodIP != node.PodIP {
 				// only update Pod IP for daemoned nodes to reduce number of updates
-				log.Infof("Updating daemon node %s IP %s -> %s", node, node.PodIP, pod.Status.PodIP)
+				logCtx.Infof("Updating daemon node %s IP %s -> %s", node.ID, node.PodIP, pod.Status.PodIP)
 				node.PodIP = pod.Status.PodIP
 			}
 		}
@@ -879,18 +1251,17 @@ func assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
 	outputStr, ok := pod.Annotations[common.AnnotationKeyOutputs]
 	if ok && node.Outputs == nil {
 		updated = true
-		log.Infof("Setting node %v outputs", node)
+		logCtx.Infof("Setting node %s outputs", node.ID)
 		var outputs wfv1.Outputs
 		err := json.Unmarshal([]byte(outputStr), &outputs)
 		if err != nil {
-			log.Errorf("Failed to unmarshal %s outputs from pod annotation: %v", pod.Name, err)
+			logCtx.Errorf("Failed to unmarshal %s outputs from pod annotation: %v", pod.Name, err)
 			node.Phase = wfv1.NodeError
 		} else {
 			node.Outputs = &outputs
 		}
 	}
 	if node.Phase != newPhase {
-		log.Infof("Updating node %s status %s -> %s", node, node.Phase, newPhase)
 		// if we are transitioning from Pending to a different state, clear out pending message
 		if node.Phase == wfv1.NodePending {
 			node.Message = ""
@@ -899,7 +1270,7 @@ func assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
 		node.Phase = newPhase
 	}
 	if message != "" && node.Message != message {
-		log.Infof("Updating node %s message: %s", node, message)
+		logCtx.Infof("Updating node %s message: %s", node.ID, message)
 		updated = true
 		node.Message = message
 	}
@@ -914,6 +1285,8 @@ func assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatus) *wfv1.NodeStatus {
 			// finishedAt might not have been set.
 			node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
 		}
+		node.ResourcesDuration = getResourcesDuration(pod)
+		node.Environment = getNodeEnvironment(pod)
 	}
 	if updated {
 		return node
@@ -938,6 +1311,77 @@ func getLatestFinishedAt(pod *apiv1.Pod) metav1.Time {
 	return latest
 }
 
+// getResourcesDuration returns the estimated resource usage of the pod, which is the resources requested by each of
+// its containers multiplied by how long the container ran
+func getResourcesDuration(pod *apiv1.Pod) wfv1.ResourcesDuration {
+	requests := make(map[string]apiv1.ResourceList)
+	for _, ctr := range pod.Spec.InitContainers {
+		requests[ctr.Name] = ctr.Resources.Requests
+	}
+	for _, ctr := range pod.Spec.Containers {
+		requests[ctr.Name] = ctr.Resources.Requests
+	}
+	var res wfv1.ResourcesDuration
+	for _, ctrStatuses := range [][]apiv1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, ctrStatus := range ctrStatuses {
+			terminated := ctrStatus.State.Terminated
+			if terminated == nil || terminated.StartedAt.IsZero() || terminated.FinishedAt.Before(&terminated.StartedAt) {
+				continue
+			}
+			seconds := terminated.FinishedAt.Sub(terminated.StartedAt.Time).Seconds()
+			ctrRes := wfv1.ResourcesDuration{}
+			for name, quantity := range requests[ctrStatus.Name] {
+				denominator := wfv1.ResourceQuantityDenominator(name)
+				ctrRes[name] = wfv1.ResourceDuration(math.Round(seconds * float64(quantity.MilliValue()) / float64(denominator.MilliValue())))
+			}
+			res = res.Add(ctrRes)
+		}
+	}
+	return res
+}
+
+// getNodeEnvironment returns the images the containers of the pod ran, as resolved by the container runtime, and the
+// versions of the controller and of the executor, as reported by the executor with an annotation of the pod
+func getNodeEnvironment(pod *apiv1.Pod) *wfv1.NodeEnvironment {
+	imageIDs := make(map[string]string)
+	for _, ctrStatuses := range [][]apiv1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, ctrStatus := range ctrStatuses {
+			imageIDs[ctrStatus.Name] = ctrStatus.ImageID
+		}
+	}
+	env := &wfv1.NodeEnvironment{
+		ControllerVersion: argoversion.GetVersion().Version,
+		ExecutorVersion:   pod.Annotations[common.AnnotationKeyExecutorVersion],
+	}
+	for _, ctrs := range [][]apiv1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, ctr := range ctrs {
+			env.Containers = append(env.Containers, wfv1.ContainerEnvironment{
+				Name:    ctr.Name,
+				Image:   ctr.Image,
+				ImageID: imageIDs[ctr.Name],
+			})
+		}
+	}
+	return env
+}
+
+// recordEnvironment records the versions of the controller and of the executor which ran a node once in the status of
+// the workflow, when its first pod node completes, and only keeps the versions of the node which differ
+func (woc *wfOperationCtx) recordEnvironment(env *wfv1.NodeEnvironment) {
+	if woc.wf.Status.Environment == nil {
+		woc.wf.Status.Environment = &wfv1.WorkflowEnvironment{
+			ControllerVersion: env.ControllerVersion,
+			ExecutorVersion:   env.ExecutorVersion,
+		}
+	}
+	if env.ControllerVersion == woc.wf.Status.Environment.ControllerVersion {
+		env.ControllerVersion = ""
+	}
+	if env.ExecutorVersion == woc.wf.Status.Environment.ExecutorVersion {
+		env.ExecutorVersion = ""
+	}
+}
+
 func getPendingReason(pod *apiv1.Pod) string {
 	for _, ctrStatus := range pod.Status.ContainerStatuses {
 		if ctrStatus.State.Waiting != nil {
@@ -967,7 +1411,7 @@ func getPendingReason(pod *apiv1.Pod) string {
 
 // inferFailedReason returns metadata about a Failed pod to be used in its NodeStatus
 // Returns a tuple of the new phase and message
-func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
+func inferFailedReason(logCtx *log.Entry, pod *apiv1.Pod) (wfv1.NodePhase, string) {
 	if pod.Status.Message != "" {
 		// Pod has a nice error message. Use that.
 		return wfv1.NodeFailed, pod.Status.Message
@@ -979,7 +1423,7 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 	for _, ctr := range pod.Status.InitContainerStatuses {
 		if ctr.State.Terminated == nil {
 			// We should never get here
-			log.Warnf("Pod %s phase was Failed but %s did not have terminated state", pod.ObjectMeta.Name, ctr.Name)
+			logCtx.Warnf("Pod %s phase was Failed but %s did not have terminated state", pod.ObjectMeta.Name, ctr.Name)
 			continue
 		}
 		if ctr.State.Terminated.ExitCode == 0 {
@@ -999,7 +1443,7 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 	for _, ctr := range pod.Status.ContainerStatuses {
 		if ctr.State.Terminated == nil {
 			// We should never get here
-			log.Warnf("Pod %s phase was Failed but %s did not have terminated state", pod.ObjectMeta.Name, ctr.Name)
+			logCtx.Warnf("Pod %s phase was Failed but %s did not have terminated state", pod.ObjectMeta.Name, ctr.Name)
 			continue
 		}
 		if ctr.State.Terminated.ExitCode == 0 {
@@ -1041,7 +1485,7 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 				// if the sidecar was SIGKILL'd (exit code 137) assume it was because argoexec
 				// forcibly killed the container, which we ignore the error for.
 				// Java code 143 is a normal exit 128 + 15 https://github.com/elastic/elasticsearch/issues/31847
-				log.Infof("Ignoring %d exit code of sidecar '%s'", ctr.State.Terminated.ExitCode, ctr.Name)
+				logCtx.Infof("Ignoring %d exit code of sidecar '%s'", ctr.State.Terminated.ExitCode, ctr.Name)
 				continue
 			}
 			errMsg = fmt.Sprintf("sidecar '%s' %s", ctr.Name, errMsg)
@@ -1142,7 +1586,7 @@ func (woc *wfOperationCtx) createPVCs() error {
 }
 
 func (woc *wfOperationCtx) deletePVCs() error {
-	if woc.wf.Status.Phase != wfv1.NodeSucceeded {
+	if woc.wf.Spec.VolumeClaimGC.GetStrategy() == wfv1.VolumeClaimGCOnWorkflowSuccess && woc.wf.Status.Phase != wfv1.NodeSucceeded {
 		// Skip deleting PVCs to reuse them for retried failed/error workflows.
 		// PVCs are automatically deleted when corresponded owner workflows get deleted.
 		return nil
@@ -1256,11 +1700,62 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 		return woc.initializeNodeOrMarkError(node, nodeName, wfv1.NodeTypeSkipped, orgTmpl, boundaryID, err), err
 	}
 
+	// Hold back nodes which have not yet started until one of the template's execution windows is open
+	if len(processedTmpl.ExecutionWindows) > 0 && (node == nil || isWaitingForExecutionWindow(node)) {
+		nextWindow, err := common.NextExecutionWindow(processedTmpl.ExecutionWindows, time.Now())
+		if err != nil {
+			return woc.initializeNodeOrMarkError(node, nodeName, wfv1.NodeTypeSkipped, orgTmpl, boundaryID, err), err
+		}
+		if nextWindow != nil {
+			woc.requeue(time.Until(*nextWindow))
+			return woc.markNodeWaitingForExecutionWindow(node, nodeName, orgTmpl, boundaryID, *nextWindow), nil
+		}
+		if node != nil {
+			// The window is open. Discard the placeholder so that the node is executed from scratch
+			woc.log.Infof("Execution window for node %s is open", nodeName)
+			delete(woc.wf.Status.Nodes, node.ID)
+			woc.updated = true
+			node = nil
+		}
+	}
+
 	// Check if we exceeded template or workflow parallelism and immediately return if we did
 	if err := woc.checkParallelism(processedTmpl, node, boundaryID); err != nil {
 		return node, err
 	}
 
+	// Reuse the cached outputs of memoized templates rather than running them again
+	if processedTmpl.Memoize != nil && node == nil {
+		entry, err := woc.getMemoized(processedTmpl.Memoize)
+		if err != nil {
+			return woc.initializeNodeOrMarkError(node, nodeName, wfv1.NodeTypeSkipped, orgTmpl, boundaryID, err), err
+		}
+		if entry != nil {
+			return woc.initializeMemoizedNode(nodeName, templateScope, processedTmpl, orgTmpl, boundaryID, entry), nil
+		}
+	}
+
+	// Hold back nodes which have not yet started until they acquire the lock of the template
+	if processedTmpl.Synchronization != nil && (node == nil || isWaitingForLock(node)) {
+		acquired, updated, lockName, err := woc.controller.syncManager.TryAcquire(woc.wf, woc.wf.NodeID(nodeName), processedTmpl.Synchronization)
+		if err != nil {
+			return woc.initializeNodeOrMarkError(node, nodeName, wfv1.NodeTypeSkipped, orgTmpl, boundaryID, err), err
+		}
+		if updated {
+			woc.updated = true
+		}
+		if !acquired {
+			return woc.markNodeWaitingForLock(node, nodeName, orgTmpl, boundaryID, lockName), nil
+		}
+		if node != nil {
+			// The lock is acquired. Discard the placeholder so that the node is executed from scratch
+			woc.log.Infof("Node %s acquired lock %s", nodeName, lockName)
+			delete(woc.wf.Status.Nodes, node.ID)
+			woc.updated = true
+			node = nil
+		}
+	}
+
 	// If the user has specified retries, node becomes a special retry node.
 	// This node acts as a parent of all retries that will be done for
 	// the container. The status of this node should be "Success" if any
@@ -1294,20 +1789,32 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 			return retryParentNode, nil
 		}
 
-		// Create a new child node and append it to the retry node.
+		// Create a new child node, which is appended to the retry node once its pod may be created.
 		nodeName = fmt.Sprintf("%s(%d)", retryNodeName, len(retryParentNode.Children))
-		woc.addChildNode(retryNodeName, nodeName)
 		node = nil
 
 		// Change the `pod.name` variable to the new retry node name
 		if processedTmpl.IsPodType() {
 			processedTmpl, err = common.SubstituteParams(processedTmpl, map[string]string{}, map[string]string{common.LocalVarPodName: woc.wf.NodeID(nodeName)})
 			if err != nil {
+				woc.addChildNode(retryNodeName, nodeName)
 				return woc.initializeNodeOrMarkError(node, nodeName, wfv1.NodeTypeSkipped, orgTmpl, boundaryID, err), err
 			}
 		}
 	}
 
+	// Hold back new pods, including the next attempts of retried nodes, while the pod parallelism of the controller is
+	// reached
+	if processedTmpl.IsPodType() && node == nil && !woc.acquirePod() {
+		if retryNodeName != "" {
+			return woc.getNodeByName(retryNodeName), ErrParallelismReached
+		}
+		return nil, ErrParallelismReached
+	}
+	if retryNodeName != "" {
+		woc.addChildNode(retryNodeName, nodeName)
+	}
+
 	switch processedTmpl.GetType() {
 	case wfv1.TemplateTypeContainer:
 		node, err = woc.executeContainer(nodeName, templateScope, processedTmpl, orgTmpl, boundaryID)
@@ -1321,6 +1828,8 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 		node, err = woc.executeDAG(nodeName, newTmplCtx, templateScope, processedTmpl, orgTmpl, boundaryID)
 	case wfv1.TemplateTypeSuspend:
 		node, err = woc.executeSuspend(nodeName, templateScope, processedTmpl, orgTmpl, boundaryID)
+	case wfv1.TemplateTypeHTTP:
+		node, err = woc.executeHTTP(nodeName, templateScope, processedTmpl, orgTmpl, boundaryID)
 	default:
 		err = errors.Errorf(errors.CodeBadRequest, "Template '%s' missing specification", processedTmpl.Name)
 		return woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, orgTmpl, boundaryID, wfv1.NodeError, err.Error()), err
@@ -1336,6 +1845,9 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 		}
 	}
 	node = woc.getNodeByName(node.Name)
+	if processedTmpl.Memoize != nil {
+		node = woc.markNodeMemoized(node, processedTmpl.Memoize)
+	}
 
 	// Swap the node back to retry node.
 	if retryNodeName != "" {
@@ -1345,6 +1857,23 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 	return node, nil
 }
 
+// isWaitingForExecutionWindow returns whether or not the node is a placeholder for a node waiting for an execution window
+func isWaitingForExecutionWindow(node *wfv1.NodeStatus) bool {
+	return node.WaitingFor == wfv1.NodeWaitingForExecutionWindow
+}
+
+// markNodeWaitingForExecutionWindow initializes (or updates) a pending placeholder node for a node waiting for an execution window to open
+func (woc *wfOperationCtx) markNodeWaitingForExecutionWindow(node *wfv1.NodeStatus, nodeName string, orgTmpl wfv1.TemplateHolder, boundaryID string, nextWindow time.Time) *wfv1.NodeStatus {
+	message := fmt.Sprintf("Waiting for execution window opening at %s", nextWindow.UTC().Format(time.RFC3339))
+	if node == nil {
+		node = woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, orgTmpl, boundaryID, wfv1.NodePending, message)
+		node.WaitingFor = wfv1.NodeWaitingForExecutionWindow
+		woc.wf.Status.Nodes[node.ID] = *node
+		return node
+	}
+	return woc.markNodePhase(nodeName, wfv1.NodePending, message)
+}
+
 // markWorkflowPhase is a convenience method to set the phase of the workflow with optional message
 // optionally marks the workflow completed, which sets the finishedAt timestamp and completed label
 func (woc *wfOperationCtx) markWorkflowPhase(phase wfv1.NodePhase, markCompleted bool, message ...string) {
@@ -1368,11 +1897,11 @@ func (woc *wfOperationCtx) markWorkflowPhase(phase wfv1.NodePhase, markCompleted
 	}
 
 	if phase == wfv1.NodeError {
-		entryNode, ok := woc.wf.Status.Nodes[woc.wf.ObjectMeta.Name]
+		entryNode, ok := woc.wf.Status.Nodes[woc.wf.NodeID(woc.wf.ObjectMeta.Name)]
 		if ok && entryNode.Phase == wfv1.NodeRunning {
 			entryNode.Phase = wfv1.NodeError
 			entryNode.Message = "Workflow operation error"
-			woc.wf.Status.Nodes[woc.wf.ObjectMeta.Name] = entryNode
+			woc.wf.Status.Nodes[entryNode.ID] = entryNode
 			woc.updated = true
 		}
 	}
@@ -1391,11 +1920,194 @@ func (woc *wfOperationCtx) markWorkflowPhase(phase wfv1.NodePhase, markCompleted
 			if err != nil {
 				woc.log.WithField("err", err).Error("Failed to archive workflow")
 			}
+			woc.completed = true
 			woc.updated = true
 		}
 	}
 }
 
+// maxWorkflowOutputsSize is the size of the outputs reported to the workflows and CronWorkflows which own a workflow,
+// beyond which only its name and phase are reported, as the annotations of an object are limited to 256KiB in total
+var maxWorkflowOutputsSize = 128 * 1024
+
+// reportOutputsToOwners records the outputs of the completed workflow in an annotation of the workflows and
+// CronWorkflows which own it, so that they can be read without traversing its nodes
+func (woc *wfOperationCtx) reportOutputsToOwners() {
+	var owners []metav1.OwnerReference
+	for _, ref := range woc.wf.ObjectMeta.OwnerReferences {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil || gv.Group != workflow.Group {
+			continue
+		}
+		if ref.Kind == workflow.WorkflowKind || ref.Kind == workflow.CronWorkflowKind {
+			owners = append(owners, ref)
+		}
+	}
+	if len(owners) == 0 {
+		return
+	}
+	outputs := common.WorkflowOutputs{Name: woc.wf.ObjectMeta.Name, Phase: woc.wf.Status.Phase, Outputs: woc.wf.Status.Outputs}
+	value, err := json.Marshal(outputs)
+	if err == nil && len(value) > maxWorkflowOutputsSize {
+		woc.log.Warnf("Outputs of the workflow are too large to report (%d bytes), reporting its name and phase only", len(value))
+		outputs.Outputs = nil
+		outputs.OutputsTooLarge = true
+		value, err = json.Marshal(outputs)
+	}
+	if err != nil {
+		woc.log.Errorf("Failed to marshal the outputs of the workflow: %v", err)
+		return
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{common.AnnotationKeyWorkflowOutputs: string(value)},
+		},
+	})
+	if err != nil {
+		woc.log.Errorf("Failed to marshal the outputs of the workflow: %v", err)
+		return
+	}
+	namespace := woc.wf.ObjectMeta.Namespace
+	for _, owner := range owners {
+		if owner.Kind == workflow.WorkflowKind {
+			_, err = woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(namespace).Patch(owner.Name, types.MergePatchType, patch)
+		} else {
+			_, err = woc.controller.wfclientset.ArgoprojV1alpha1().CronWorkflows(namespace).Patch(owner.Name, types.MergePatchType, patch)
+		}
+		if err != nil {
+			woc.log.Warnf("Failed to report the outputs of the workflow to %s %s: %v", owner.Kind, owner.Name, err)
+		}
+	}
+}
+
+// panicked handles a panic of the operation of the workflow. The changes of the operation are discarded, and the
+// workflow is operated again from its last persisted state, after a backoff, until its operation panicked
+// maxOperationPanics times in a row. The count of the panics is patched in an annotation of the workflow, whose
+// update is not queued, so that it does not cut the backoff short. The workflow is then quarantined: it errors with the stack of the panic in its
+// message, so that a workflow which cannot be operated does not keep a worker busy nor crash the controller again.
+func (woc *wfOperationCtx) panicked(r interface{}, stack []byte) {
+	woc.controller.metrics.OperationPanicked()
+	panics, _ := strconv.Atoi(woc.orig.ObjectMeta.Annotations[common.AnnotationKeyOperationPanics])
+	panics++
+	maxPanics := woc.controller.Config.GetMaxOperationPanics()
+	if panics < maxPanics {
+		woc.log.Warnf("Operation panicked %d time(s) in a row, the workflow is quarantined after %d", panics, maxPanics)
+		woc.updated = false
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{common.AnnotationKeyOperationPanics: strconv.Itoa(panics)},
+			},
+		})
+		if err == nil {
+			_, err = woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.ObjectMeta.Namespace).Patch(woc.wf.ObjectMeta.Name, types.MergePatchType, patch)
+		}
+		if err != nil {
+			woc.log.Warnf("Failed to record the panic of the operation: %v", err)
+		}
+		woc.requeueWithRateLimit()
+		return
+	}
+	msg := fmt.Sprintf("quarantined after its operation panicked %d times in a row: %v", panics, r)
+	woc.log.Errorf("Workflow %s", msg)
+	msg = fmt.Sprintf("%s\n%s", msg, stack)
+	if woc.wf.ObjectMeta.Annotations == nil {
+		woc.wf.ObjectMeta.Annotations = make(map[string]string)
+	}
+	woc.wf.ObjectMeta.Annotations[common.AnnotationKeyOperationPanics] = strconv.Itoa(panics)
+	woc.markWorkflowPhase(wfv1.NodeError, true, msg)
+	woc.controller.metrics.WorkflowQuarantined()
+	woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonQuarantined}, msg)
+}
+
+// getWorkflowDefaults returns the defaults of the workflow: those configured for its namespace, overridden by the
+// service account, artifact repository and TTL the annotations of the namespace default to. The namespace is only read
+// if the controller is allowed to, which is not the case for namespaced installations.
+func (woc *wfOperationCtx) getWorkflowDefaults() config.WorkflowDefaults {
+	defaults := woc.controller.Config.GetWorkflowDefaults(woc.wf.ObjectMeta.Namespace)
+	namespace, err := woc.controller.kubeclientset.CoreV1().Namespaces().Get(woc.wf.ObjectMeta.Namespace, metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) && !apierr.IsForbidden(err) {
+			woc.log.Warnf("Failed to get namespace %s for its defaults: %v", woc.wf.ObjectMeta.Namespace, err)
+		}
+		return defaults
+	}
+	annotations := namespace.ObjectMeta.Annotations
+	if serviceAccount := annotations[common.AnnotationKeyDefaultServiceAccount]; serviceAccount != "" {
+		defaults.ServiceAccountName = serviceAccount
+	}
+	if repository := annotations[common.AnnotationKeyDefaultArtifactRepository]; repository != "" {
+		parts := strings.Split(repository, "/")
+		if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+			defaults.ArtifactRepositoryRef = &wfv1.ArtifactRepositoryRef{ConfigMap: parts[0], Key: parts[1]}
+		} else {
+			woc.log.Warnf("Ignoring annotation %s=%s of namespace, expected <configmap>/<key>", common.AnnotationKeyDefaultArtifactRepository, repository)
+		}
+	}
+	if ttl := annotations[common.AnnotationKeyDefaultTTLSecondsAfterCompletion]; ttl != "" {
+		seconds, err := strconv.ParseInt(ttl, 10, 32)
+		if err == nil && seconds >= 0 {
+			defaults.TTLStrategy = &wfv1.TTLStrategy{SecondsAfterCompletion: pointer.Int32Ptr(int32(seconds))}
+		} else {
+			woc.log.Warnf("Ignoring annotation %s=%s of namespace, expected a number of seconds", common.AnnotationKeyDefaultTTLSecondsAfterCompletion, ttl)
+		}
+	}
+	return defaults
+}
+
+// applyWorkflowDefaults merges the defaults of the workflow into it, the settings of the workflow taking precedence,
+// and caps its activeDeadlineSeconds to the maximum of its namespace
+func (woc *wfOperationCtx) applyWorkflowDefaults() {
+	defaults := woc.getWorkflowDefaults()
+	for key, value := range defaults.Labels {
+		if _, ok := woc.wf.ObjectMeta.Labels[key]; ok {
+			continue
+		}
+		if woc.wf.ObjectMeta.Labels == nil {
+			woc.wf.ObjectMeta.Labels = make(map[string]string)
+		}
+		woc.wf.ObjectMeta.Labels[key] = value
+		woc.updated = true
+	}
+	for key, value := range defaults.Annotations {
+		if _, ok := woc.wf.ObjectMeta.Annotations[key]; ok {
+			continue
+		}
+		if woc.wf.ObjectMeta.Annotations == nil {
+			woc.wf.ObjectMeta.Annotations = make(map[string]string)
+		}
+		woc.wf.ObjectMeta.Annotations[key] = value
+		woc.updated = true
+	}
+	if defaults.ServiceAccountName != "" && woc.wf.Spec.ServiceAccountName == "" {
+		woc.log.Infof("Setting serviceAccountName to %s as defaulted for namespace %s", defaults.ServiceAccountName, woc.wf.ObjectMeta.Namespace)
+		woc.wf.Spec.ServiceAccountName = defaults.ServiceAccountName
+		woc.updated = true
+	}
+	if defaults.ArtifactRepositoryRef != nil && woc.wf.Spec.ArtifactRepositoryRef == nil {
+		woc.log.Infof("Setting artifactRepositoryRef to %s/%s as defaulted for namespace %s", defaults.ArtifactRepositoryRef.ConfigMap, defaults.ArtifactRepositoryRef.Key, woc.wf.ObjectMeta.Namespace)
+		woc.wf.Spec.ArtifactRepositoryRef = defaults.ArtifactRepositoryRef.DeepCopy()
+		woc.updated = true
+	}
+	activeDeadlineSeconds := woc.wf.Spec.ActiveDeadlineSeconds
+	if activeDeadlineSeconds == nil {
+		activeDeadlineSeconds = defaults.ActiveDeadlineSeconds
+	}
+	if maxDeadline := defaults.MaxActiveDeadlineSeconds; maxDeadline != nil && (activeDeadlineSeconds == nil || *activeDeadlineSeconds > *maxDeadline) {
+		activeDeadlineSeconds = maxDeadline
+	}
+	if activeDeadlineSeconds != nil && (woc.wf.Spec.ActiveDeadlineSeconds == nil || *activeDeadlineSeconds != *woc.wf.Spec.ActiveDeadlineSeconds) {
+		woc.log.Infof("Setting activeDeadlineSeconds to %d as defaulted for namespace %s", *activeDeadlineSeconds, woc.wf.ObjectMeta.Namespace)
+		value := *activeDeadlineSeconds
+		woc.wf.Spec.ActiveDeadlineSeconds = &value
+		woc.updated = true
+	}
+	if defaults.TTLStrategy != nil && woc.wf.Spec.TTLStrategy == nil && woc.wf.Spec.TTLSecondsAfterFinished == nil {
+		woc.log.Infof("Setting ttlStrategy as defaulted for namespace %s", woc.wf.ObjectMeta.Namespace)
+		woc.wf.Spec.TTLStrategy = defaults.TTLStrategy.DeepCopy()
+		woc.updated = true
+	}
+}
+
 func (woc *wfOperationCtx) hasDaemonNodes() bool {
 	for _, node := range woc.wf.Status.Nodes {
 		if node.IsDaemoned() {
@@ -1409,6 +2121,88 @@ func (woc *wfOperationCtx) markWorkflowRunning() {
 	woc.markWorkflowPhase(wfv1.NodeRunning, false)
 }
 
+// addIndexLabels labels the workflow with its entrypoint, the WorkflowTemplates it refers to and the CronWorkflow
+// which created it, so that workflows can be listed using label selectors. Values which are not valid labels are
+// skipped.
+func (woc *wfOperationCtx) addIndexLabels() {
+	labels := make(map[string]string)
+	if len(validation.IsValidLabelValue(woc.wf.Spec.Entrypoint)) == 0 {
+		labels[common.LabelKeyEntrypoint] = woc.wf.Spec.Entrypoint
+	}
+	for _, name := range getWorkflowTemplateRefs(woc.wf.Spec.Templates) {
+		key := common.LabelKeyWorkflowTemplatePrefix + name
+		if len(validation.IsQualifiedName(key)) == 0 {
+			labels[key] = "true"
+		}
+	}
+	for _, ref := range woc.wf.OwnerReferences {
+		if ref.Kind == workflow.CronWorkflowKind && len(validation.IsValidLabelValue(ref.Name)) == 0 {
+			labels[common.LabelCronWorkflow] = ref.Name
+		}
+	}
+	for key, value := range labels {
+		if _, ok := woc.wf.Labels[key]; ok {
+			continue
+		}
+		if woc.wf.Labels == nil {
+			woc.wf.Labels = make(map[string]string)
+		}
+		woc.wf.Labels[key] = value
+		woc.updated = true
+	}
+}
+
+// failureThresholdMessage returns why the remaining items of an expanded step or task are skipped, if more of its
+// completed items failed than the threshold allows. Otherwise it returns an empty string. The items are the children
+// of the parent node named <itemsName>(<index>:<item>).
+func (woc *wfOperationCtx) failureThresholdMessage(threshold *wfv1.FailureThreshold, parent *wfv1.NodeStatus, itemsName string) string {
+	completed, failed := 0, 0
+	for _, childID := range parent.Children {
+		child, ok := woc.wf.Status.Nodes[childID]
+		// items skipped because of the threshold do not count
+		if !ok || !strings.HasPrefix(child.Name, itemsName+"(") || !child.Completed() || child.Phase == wfv1.NodeSkipped {
+			continue
+		}
+		completed++
+		if !child.Successful() {
+			failed++
+		}
+	}
+	if completed == 0 || completed < int(threshold.MinCompleted) || failed*100 <= int(threshold.Percent)*completed {
+		return ""
+	}
+	return fmt.Sprintf("failure threshold exceeded: %d of %d completed items failed", failed, completed)
+}
+
+// getWorkflowTemplateRefs returns the sorted names of the WorkflowTemplates directly referred to by the templates
+func getWorkflowTemplateRefs(templates []wfv1.Template) []string {
+	names := make(map[string]bool)
+	addRef := func(ref *wfv1.TemplateRef) {
+		if ref != nil && ref.Name != "" && ref.ConfigMapKey == "" {
+			names[ref.Name] = true
+		}
+	}
+	for _, tmpl := range templates {
+		addRef(tmpl.TemplateRef)
+		for _, parallelSteps := range tmpl.Steps {
+			for _, step := range parallelSteps.Steps {
+				addRef(step.TemplateRef)
+			}
+		}
+		if tmpl.DAG != nil {
+			for _, task := range tmpl.DAG.Tasks {
+				addRef(task.TemplateRef)
+			}
+		}
+	}
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
 func (woc *wfOperationCtx) markWorkflowSuccess() {
 	woc.markWorkflowPhase(wfv1.NodeSucceeded, true)
 }
@@ -1436,6 +2230,10 @@ func (woc *wfOperationCtx) initializeExecutableNode(nodeName string, nodeType wf
 
 	node.TemplateScope = templateScope
 
+	if executeTmpl.IsPodType() {
+		node.Cluster = executeTmpl.Cluster
+	}
+
 	// Update the node
 	woc.wf.Status.Nodes[node.ID] = *node
 	woc.updated = true
@@ -1483,13 +2281,16 @@ func (woc *wfOperationCtx) initializeNode(nodeName string, nodeType wfv1.NodeTyp
 	if node.Completed() && node.FinishedAt.IsZero() {
 		node.FinishedAt = node.StartedAt
 	}
+	if !node.Completed() {
+		node.EstimatedDuration = woc.estimateNodeDuration(nodeName, node.TemplateName)
+	}
 	var message string
 	if len(messages) > 0 {
 		message = fmt.Sprintf(" (message: %s)", messages[0])
 		node.Message = messages[0]
 	}
 	woc.wf.Status.Nodes[nodeID] = node
-	woc.log.Infof("%s node %v initialized %s%s", node.Type, node, node.Phase, message)
+	woc.log.Infof("%s node %s (%s) initialized %s%s", node.Type, node.Name, node.ID, node.Phase, message)
 	woc.updated = true
 	return &node
 }
@@ -1500,21 +2301,21 @@ func (woc *wfOperationCtx) markNodePhase(nodeName string, phase wfv1.NodePhase,
 	if node == nil {
 		panic(fmt.Sprintf("node %s uninitialized", nodeName))
 	}
+	// the transition is logged by reportNodeChanges once it is persisted
 	if node.Phase != phase {
-		woc.log.Infof("node %s phase %s -> %s", node, node.Phase, phase)
 		node.Phase = phase
 		woc.updated = true
 	}
 	if len(message) > 0 {
 		if message[0] != node.Message {
-			woc.log.Infof("node %s message: %s", node, message[0])
+			woc.log.Infof("node %s message: %s", node.ID, message[0])
 			node.Message = message[0]
 			woc.updated = true
 		}
 	}
 	if node.Completed() && node.FinishedAt.IsZero() {
 		node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
-		woc.log.Infof("node %s finished: %s", node, node.FinishedAt)
+		woc.log.Infof("node %s finished: %s", node.ID, node.FinishedAt)
 		woc.updated = true
 	}
 	woc.wf.Status.Nodes[node.ID] = *node
@@ -1569,6 +2370,22 @@ func (woc *wfOperationCtx) checkParallelism(tmpl *wfv1.Template, node *wfv1.Node
 	return nil
 }
 
+// acquirePod returns whether a pod may be created within the pod parallelism of the controller
+func (woc *wfOperationCtx) acquirePod() bool {
+	priority := int32(0)
+	if woc.wf.Spec.Priority != nil {
+		priority = *woc.wf.Spec.Priority
+	}
+	if woc.controller.podThrottler.acquire(woc.key(), priority, woc.wf.ObjectMeta.CreationTimestamp.Time) {
+		return true
+	}
+	if !woc.podsThrottled {
+		woc.log.Infof("Pod parallelism of the controller reached, waiting for the turn of namespace %s", woc.wf.ObjectMeta.Namespace)
+	}
+	woc.podsThrottled = true
+	return false
+}
+
 func (woc *wfOperationCtx) executeContainer(nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateHolder, boundaryID string) (*wfv1.NodeStatus, error) {
 	node := woc.getNodeByName(nodeName)
 	if node != nil {
@@ -1584,7 +2401,7 @@ func (woc *wfOperationCtx) executeContainer(nodeName string, templateScope strin
 func (woc *wfOperationCtx) getOutboundNodes(nodeID string) []string {
 	node := woc.wf.Status.Nodes[nodeID]
 	switch node.Type {
-	case wfv1.NodeTypePod, wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend:
+	case wfv1.NodeTypePod, wfv1.NodeTypeHTTP, wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend:
 		return []string{node.ID}
 	case wfv1.NodeTypeTaskGroup:
 		if len(node.Children) == 0 {
@@ -1604,7 +2421,7 @@ func (woc *wfOperationCtx) getOutboundNodes(nodeID string) []string {
 	outbound := make([]string, 0)
 	for _, outboundNodeID := range node.OutboundNodes {
 		outNode := woc.wf.Status.Nodes[outboundNodeID]
-		if outNode.Type == wfv1.NodeTypePod {
+		if outNode.Type == wfv1.NodeTypePod || outNode.Type == wfv1.NodeTypeHTTP {
 			outbound = append(outbound, outboundNodeID)
 		} else {
 			subOutIDs := woc.getOutboundNodes(outboundNodeID)
@@ -1650,7 +2467,7 @@ func getTemplateOutputsFromScope(tmpl *wfv1.Template, scope *wfScope) (*wfv1.Out
 }
 
 // hasOutputResultRef will check given template output has any reference
-func hasOutputResultRef(name string, parentTmpl *wfv1.Template) bool {
+func hasOutputResultRef(logCtx *log.Entry, name string, parentTmpl *wfv1.Template) bool {
 
 	var variableRefName string
 	if parentTmpl.DAG != nil {
@@ -1661,7 +2478,7 @@ func hasOutputResultRef(name string, parentTmpl *wfv1.Template) bool {
 
 	jsonValue, err := json.Marshal(parentTmpl)
 	if err != nil {
-		log.Warnf("Unable to marshal the template. %v, %v", parentTmpl, err)
+		logCtx.Warnf("Unable to marshal the template. %v, %v", parentTmpl, err)
 	}
 
 	return strings.Contains(string(jsonValue), variableRefName)
@@ -1696,7 +2513,7 @@ func (woc *wfOperationCtx) executeScript(nodeName string, templateScope string,
 			return node, err
 		}
 		name := getStepOrDAGTaskName(nodeName, tmpl.RetryStrategy != nil)
-		includeScriptOutput = hasOutputResultRef(name, parentTemplate)
+		includeScriptOutput = hasOutputResultRef(woc.log, name, parentTemplate)
 	}
 
 	mainCtr := tmpl.Script.Container
@@ -1711,6 +2528,7 @@ func (woc *wfOperationCtx) processNodeOutputs(scope *wfScope, prefix string, nod
 	if node.PodIP != "" {
 		key := fmt.Sprintf("%s.ip", prefix)
 		scope.addParamToScope(key, node.PodIP)
+		woc.addStreamToScope(scope, prefix, node)
 	}
 	if node.Phase != "" {
 		key := fmt.Sprintf("%s.status", prefix)
@@ -1719,6 +2537,21 @@ func (woc *wfOperationCtx) processNodeOutputs(scope *wfScope, prefix string, nod
 	woc.addOutputsToScope(prefix, node.Outputs, scope)
 }
 
+// addStreamToScope adds the address of the stream of a daemon node to the local scope, which is the IP of its pod and
+// the port of the stream of its template
+func (woc *wfOperationCtx) addStreamToScope(scope *wfScope, prefix string, node *wfv1.NodeStatus) {
+	_, tmpl, err := woc.tmplCtx.ResolveTemplate(node)
+	if err != nil {
+		woc.log.Warnf("Failed to resolve the template of daemon node %s: %v", node.ID, err)
+		return
+	}
+	if tmpl.Stream == nil {
+		return
+	}
+	key := fmt.Sprintf("%s.stream", prefix)
+	scope.addParamToScope(key, net.JoinHostPort(node.PodIP, strconv.Itoa(int(tmpl.Stream.Port))))
+}
+
 func (woc *wfOperationCtx) addOutputsToScope(prefix string, outputs *wfv1.Outputs, scope *wfScope) {
 	if outputs == nil {
 		return
@@ -1929,6 +2762,12 @@ func (woc *wfOperationCtx) executeResource(nodeName string, templateScope string
 		return node, err
 	}
 
+	// Unless told otherwise, a step creating a child workflow completes when the child workflow does
+	if tmpl.Resource.Action == "create" && isWorkflow(&obj) && tmpl.Resource.SuccessCondition == "" && tmpl.Resource.FailureCondition == "" {
+		tmpl.Resource.SuccessCondition = childWorkflowSuccessCondition
+		tmpl.Resource.FailureCondition = childWorkflowFailureCondition
+	}
+
 	if tmpl.Resource.SetOwnerReference {
 		ownerReferences := obj.GetOwnerReferences()
 		obj.SetOwnerReferences(append(ownerReferences, *metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind))))
@@ -1939,12 +2778,111 @@ func (woc *wfOperationCtx) executeResource(nodeName string, templateScope string
 		tmpl.Resource.Manifest = string(bytes)
 	}
 
+	if tmpl.Resource.HasFinalizer(wfv1.ResourceFinalizerDeleteOnWorkflowCompletion) {
+		err = woc.addResourceToDelete(&obj)
+		if err != nil {
+			return node, err
+		}
+	}
+
 	mainCtr := woc.newExecContainer(common.MainContainerName, tmpl)
 	mainCtr.Command = []string{"argoexec", "resource", tmpl.Resource.Action}
 	_, err = woc.createWorkflowPod(nodeName, *mainCtr, tmpl, false)
 	return node, err
 }
 
+// addResourceToDelete records the resource created by a resource template, so that it is deleted at the end of the
+// workflow. Only the fields identifying the resource are kept.
+func (woc *wfOperationCtx) addResourceToDelete(obj *unstructured.Unstructured) error {
+	if obj.GetName() == "" {
+		return errors.Errorf(errors.CodeBadRequest, "resource.finalizers require the manifest to set metadata.name")
+	}
+	ref := unstructured.Unstructured{}
+	ref.SetAPIVersion(obj.GetAPIVersion())
+	ref.SetKind(obj.GetKind())
+	ref.SetName(obj.GetName())
+	if obj.GetNamespace() != "" {
+		ref.SetNamespace(obj.GetNamespace())
+	}
+	bytes, err := yaml.Marshal(ref.Object)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	manifest := string(bytes)
+	for _, resource := range woc.wf.Status.ResourcesToDelete {
+		if resource == manifest {
+			return nil
+		}
+	}
+	woc.wf.Status.ResourcesToDelete = append(woc.wf.Status.ResourcesToDelete, manifest)
+	woc.updated = true
+	return nil
+}
+
+// deleteResources deletes the resources created by resource templates with the DeleteOnWorkflowCompletion finalizer,
+// whatever phase the workflow completes with. Each resource is deleted by a node running a resource template with the
+// delete action, under the cleanup node of the workflow, and it returns true once all of these nodes completed. A node
+// which failed to delete its resource does not fail the workflow, and their pods are not bound by the deadline of the
+// workflow, so that the resources are deleted even once the workflow was terminated or timed out.
+func (woc *wfOperationCtx) deleteResources() bool {
+	if len(woc.wf.Status.ResourcesToDelete) == 0 {
+		return true
+	}
+	cleanupNodeName := woc.cleanupNodeName()
+	cleanupNode := woc.getNodeByName(cleanupNodeName)
+	if cleanupNode == nil {
+		cleanupNode = woc.initializeNode(cleanupNodeName, wfv1.NodeTypeSteps, &wfv1.Template{Name: "cleanup"}, "", wfv1.NodeRunning)
+	}
+	if cleanupNode.Completed() {
+		return true
+	}
+	done := true
+	for i, manifest := range woc.wf.Status.ResourcesToDelete {
+		nodeName := fmt.Sprintf("%s(%d)", cleanupNodeName, i)
+		tmpl := &wfv1.Template{
+			Name:     "cleanup",
+			Resource: &wfv1.ResourceTemplate{Action: "delete", Manifest: manifest},
+		}
+		node, err := woc.executeResource(nodeName, "", tmpl, tmpl, cleanupNode.ID)
+		woc.addChildNode(cleanupNodeName, nodeName)
+		if err != nil {
+			woc.markNodeError(nodeName, err)
+			continue
+		}
+		if !node.Completed() {
+			done = false
+		}
+	}
+	if done {
+		woc.markNodePhase(cleanupNodeName, wfv1.NodeSucceeded)
+	}
+	return done
+}
+
+// cleanupNodeName returns the name of the node under which the resources of the workflow are deleted
+func (woc *wfOperationCtx) cleanupNodeName() string {
+	return woc.wf.ObjectMeta.Name + ".cleanup"
+}
+
+// isCleanupPod returns whether the pod deletes a resource of the workflow, which is not bound by its deadline
+func (woc *wfOperationCtx) isCleanupPod(podName string) bool {
+	node, ok := woc.wf.Status.Nodes[podName]
+	return ok && node.BoundaryID == woc.wf.NodeID(woc.cleanupNodeName())
+}
+
+const (
+	// childWorkflowSuccessCondition is the default success condition of a resource template creating a workflow
+	childWorkflowSuccessCondition = "status.phase == Succeeded"
+	// childWorkflowFailureCondition is the default failure condition of a resource template creating a workflow
+	childWorkflowFailureCondition = "status.phase in (Failed, Error)"
+)
+
+// isWorkflow returns whether or not the object is a Workflow
+func isWorkflow(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == workflow.Group && gvk.Kind == workflow.WorkflowKind
+}
+
 func (woc *wfOperationCtx) executeSuspend(nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateHolder, boundaryID string) (*wfv1.NodeStatus, error) {
 	node := woc.getNodeByName(nodeName)
 	if node == nil {
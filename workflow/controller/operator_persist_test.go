@@ -6,11 +6,18 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo/errors"
 	"github.com/argoproj/argo/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/hydrator"
 	"github.com/argoproj/argo/workflow/packer"
 )
 
@@ -51,7 +58,7 @@ func TestPersistWithoutLargeWfSupport(t *testing.T) {
 	wf := unmarshalWF(helloWorldWfPersist)
 	wf, err := wfcset.Create(wf)
 	assert.NoError(t, err)
-	controller.offloadNodeStatusRepo = getMockDBCtx(fmt.Errorf("not found"), false)
+	controller.setHydrator(hydrator.New(getMockDBCtx(fmt.Errorf("not found"), false), config.NodeStatusStorageOffload))
 	woc := newWorkflowOperationCtx(wf, controller)
 	woc.operate()
 	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
@@ -70,7 +77,7 @@ func TestPersistErrorWithoutLargeWfSupport(t *testing.T) {
 	wf := unmarshalWF(helloWorldWfPersist)
 	wf, err := wfcset.Create(wf)
 	assert.NoError(t, err)
-	controller.offloadNodeStatusRepo = getMockDBCtx(errors.New("23324", "test"), false)
+	controller.setHydrator(hydrator.New(getMockDBCtx(errors.New("23324", "test"), false), config.NodeStatusStorageOffload))
 	woc := newWorkflowOperationCtx(wf, controller)
 	woc.operate()
 	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
@@ -89,7 +96,7 @@ func TestPersistWithLargeWfSupport(t *testing.T) {
 	wf := unmarshalWF(helloWorldWfPersist)
 	wf, err := wfcset.Create(wf)
 	assert.NoError(t, err)
-	controller.offloadNodeStatusRepo = getMockDBCtx(nil, true)
+	controller.setHydrator(hydrator.New(getMockDBCtx(nil, true), config.NodeStatusStorageOffload))
 	woc := newWorkflowOperationCtx(wf, controller)
 	woc.operate()
 	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
@@ -113,7 +120,7 @@ func TestPersistErrorWithLargeWfSupport(t *testing.T) {
 	wf := unmarshalWF(helloWorldWfPersist)
 	wf, err := wfcset.Create(wf)
 	assert.NoError(t, err)
-	controller.offloadNodeStatusRepo = getMockDBCtx(errors.New("23324", "test"), true)
+	controller.setHydrator(hydrator.New(getMockDBCtx(errors.New("23324", "test"), true), config.NodeStatusStorageOffload))
 	woc := newWorkflowOperationCtx(wf, controller)
 	woc.operate()
 	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
@@ -129,6 +136,97 @@ func TestPersistErrorWithLargeWfSupport(t *testing.T) {
 	assert.Empty(t, woc.wf.Status.CompressedNodes)
 }
 
+// TestPersistClearsStaleOffloadVersion verifies the offload version is cleared once the nodes fit in the workflow
+func TestPersistClearsStaleOffloadVersion(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf := unmarshalWF(helloWorldWfPersist)
+	wf.Status.OffloadNodeStatusVersion = "my-old-version"
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	controller.setHydrator(hydrator.New(getMockDBCtx(nil, true), config.NodeStatusStorageOffload))
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.False(t, wf.Status.IsOffloadNodeStatus())
+	assert.NotEmpty(t, wf.Status.Nodes)
+	assert.False(t, woc.wf.Status.IsOffloadNodeStatus())
+}
+
+// TestPersistWithDatabaseStorage verifies the nodes are offloaded even though they fit in the workflow
+func TestPersistWithDatabaseStorage(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfcset.Create(unmarshalWF(helloWorldWfPersist))
+	assert.NoError(t, err)
+	controller.setHydrator(hydrator.New(getMockDBCtx(nil, true), config.NodeStatusStorageDatabase))
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, wf.Status.Phase)
+	assert.Equal(t, "my-offloaded-version", wf.Status.OffloadNodeStatusVersion)
+	assert.Empty(t, wf.Status.Nodes)
+	assert.NotEmpty(t, woc.wf.Status.Nodes)
+}
+
+// TestPersistWithKubernetesStorage verifies the nodes are never offloaded
+func TestPersistWithKubernetesStorage(t *testing.T) {
+	defer makeMax()()
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfcset.Create(unmarshalWF(helloWorldWfPersist))
+	assert.NoError(t, err)
+	controller.setHydrator(hydrator.New(getMockDBCtx(nil, true), config.NodeStatusStorageKubernetes))
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, wf.Status.Phase)
+	assert.False(t, wf.Status.IsOffloadNodeStatus())
+	assert.NotEmpty(t, wf.Status.CompressedNodes)
+}
+
 func makeMax() func() {
 	return packer.SetMaxWorkflowSize(50)
 }
+
+// TestPersistRetriesConflict verifies updates are re-applied on the latest version after a conflict
+func TestPersistRetriesConflict(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfcset.Create(unmarshalWF(helloWorldWfPersist))
+	assert.NoError(t, err)
+	conflicts := 1
+	controller.wfclientset.(*fakewfclientset.Clientset).PrependReactor("update", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if conflicts > 0 {
+			conflicts--
+			return true, nil, apierr.NewConflict(schema.GroupResource{Resource: "workflows"}, wf.Name, fmt.Errorf("conflict"))
+		}
+		return false, nil, nil
+	})
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, wf.Status.Phase)
+	assert.Equal(t, 0, controller.wfQueue.NumRequeues(wf.Name))
+}
+
+// TestPersistFailureRequeues verifies the workflow is requeued when its updates cannot be persisted
+func TestPersistFailureRequeues(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfcset.Create(unmarshalWF(helloWorldWfPersist))
+	assert.NoError(t, err)
+	controller.wfclientset.(*fakewfclientset.Clientset).PrependReactor("update", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierr.NewConflict(schema.GroupResource{Resource: "workflows"}, wf.Name, fmt.Errorf("conflict"))
+	})
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, wf.Status.Phase)
+	assert.Equal(t, 1, controller.wfQueue.NumRequeues(wf.Name))
+}
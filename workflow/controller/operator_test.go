@@ -7,14 +7,18 @@ import (
 	"testing"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/yaml"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/test"
+	"github.com/argoproj/argo/util/argo"
 	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/config"
 	"github.com/argoproj/argo/workflow/util"
@@ -37,6 +41,59 @@ func TestOperateWorkflowPanicRecover(t *testing.T) {
 	woc.operate()
 }
 
+func TestOperateWorkflowPanicQuarantine(t *testing.T) {
+	controller := newController()
+	controller.Config.MaxOperationPanics = 2
+	kubeclientset := controller.kubeclientset
+	wfIf := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfIf.Create(unmarshalWF(helloWorldWf))
+	assert.NoError(t, err)
+
+	// the first panic is recorded, and the workflow is operated again
+	woc := newWorkflowOperationCtx(wf, controller)
+	controller.kubeclientset = nil
+	woc.operate()
+	controller.kubeclientset = kubeclientset
+	wf, err = wfIf.Get(wf.Name, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1", wf.Annotations[common.AnnotationKeyOperationPanics])
+		assert.Equal(t, wfv1.NodePhase(""), wf.Status.Phase)
+	}
+
+	// the second one quarantines it
+	woc = newWorkflowOperationCtx(wf, controller)
+	controller.kubeclientset = nil
+	woc.operate()
+	controller.kubeclientset = kubeclientset
+	wf, err = wfIf.Get(wf.Name, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2", wf.Annotations[common.AnnotationKeyOperationPanics])
+		assert.Equal(t, wfv1.NodeError, wf.Status.Phase)
+		assert.Contains(t, wf.Status.Message, "quarantined after its operation panicked 2 times in a row")
+		assert.Contains(t, wf.Status.Message, "goroutine")
+	}
+}
+
+// TestProcessingPanicked verifies a panic raised outside of the operation is handled as one of the operation
+func TestProcessingPanicked(t *testing.T) {
+	controller := newController()
+	controller.Config.MaxOperationPanics = 1
+	wfIf := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfIf.Create(unmarshalWF(helloWorldWf))
+	assert.NoError(t, err)
+
+	// a workflow which could not be read is only retried
+	controller.processingPanicked("hello-world", nil, "boom")
+	assert.Equal(t, 1, controller.wfQueue.NumRequeues("hello-world"))
+
+	controller.processingPanicked("hello-world", wf, "boom")
+	wf, err = wfIf.Get(wf.Name, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.NodeError, wf.Status.Phase)
+		assert.Contains(t, wf.Status.Message, "quarantined after its operation panicked 1 times in a row: boom")
+	}
+}
+
 var sidecarWithVol = `
 # Verifies sidecars can reference volumeClaimTemplates
 apiVersion: argoproj.io/v1alpha1
@@ -399,12 +456,96 @@ func TestAssessNodeStatus(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := assessNodeStatus(test.pod, test.node)
+			got := assessNodeStatus(log.NewEntry(log.StandardLogger()), test.pod, test.node)
 			assert.Equal(t, test.want, got.Phase)
 		})
 	}
 }
 
+func TestGetResourcesDuration(t *testing.T) {
+	startedAt := metav1.Time{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	terminated := func(name string, d time.Duration) apiv1.ContainerStatus {
+		return apiv1.ContainerStatus{Name: name, State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{
+			StartedAt:  startedAt,
+			FinishedAt: metav1.Time{Time: startedAt.Add(d)},
+		}}}
+	}
+	requests := func(cpu, memory string) apiv1.ResourceRequirements {
+		return apiv1.ResourceRequirements{Requests: apiv1.ResourceList{
+			apiv1.ResourceCPU:    resource.MustParse(cpu),
+			apiv1.ResourceMemory: resource.MustParse(memory),
+		}}
+	}
+	pod := &apiv1.Pod{
+		Spec: apiv1.PodSpec{
+			InitContainers: []apiv1.Container{{Name: "init", Resources: requests("100m", "64Mi")}},
+			Containers: []apiv1.Container{
+				{Name: "main", Resources: requests("2", "2Gi")},
+				{Name: "wait", Resources: requests("500m", "512Mi")},
+				{Name: "unrequested"},
+			},
+		},
+		Status: apiv1.PodStatus{
+			InitContainerStatuses: []apiv1.ContainerStatus{terminated("init", 10*time.Second)},
+			ContainerStatuses: []apiv1.ContainerStatus{
+				terminated("main", time.Minute),
+				terminated("wait", 70*time.Second),
+				terminated("unrequested", time.Hour),
+			},
+		},
+	}
+	// cpu: 0.1*10 + 2*60 + 0.5*70, memory: 1/16*10 + 2*60 + 0.5*70
+	assert.Equal(t, wfv1.ResourcesDuration{apiv1.ResourceCPU: 156, apiv1.ResourceMemory: 156}, getResourcesDuration(pod))
+	assert.Nil(t, getResourcesDuration(&apiv1.Pod{}))
+}
+
+func TestGetNodeEnvironment(t *testing.T) {
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{common.AnnotationKeyExecutorVersion: "v2.5.0"},
+		},
+		Spec: apiv1.PodSpec{
+			InitContainers: []apiv1.Container{{Name: "init", Image: "argoproj/argoexec:v2.5.0"}},
+			Containers: []apiv1.Container{
+				{Name: "wait", Image: "argoproj/argoexec:v2.5.0"},
+				{Name: "main", Image: "docker/whalesay:latest"},
+			},
+		},
+		Status: apiv1.PodStatus{
+			InitContainerStatuses: []apiv1.ContainerStatus{{Name: "init", ImageID: "docker-pullable://argoproj/argoexec@sha256:1"}},
+			ContainerStatuses: []apiv1.ContainerStatus{
+				{Name: "main", ImageID: "docker-pullable://docker/whalesay@sha256:2"},
+			},
+		},
+	}
+	env := getNodeEnvironment(pod)
+	assert.NotEmpty(t, env.ControllerVersion)
+	assert.Equal(t, "v2.5.0", env.ExecutorVersion)
+	assert.Equal(t, []wfv1.ContainerEnvironment{
+		{Name: "init", Image: "argoproj/argoexec:v2.5.0", ImageID: "docker-pullable://argoproj/argoexec@sha256:1"},
+		{Name: "wait", Image: "argoproj/argoexec:v2.5.0"},
+		{Name: "main", Image: "docker/whalesay:latest", ImageID: "docker-pullable://docker/whalesay@sha256:2"},
+	}, env.Containers)
+}
+
+func TestRecordEnvironment(t *testing.T) {
+	woc := newWoc()
+
+	// the first node records the versions in the workflow
+	first := &wfv1.NodeEnvironment{ControllerVersion: "v2.5.0", ExecutorVersion: "v2.5.0"}
+	woc.recordEnvironment(first)
+	assert.Equal(t, &wfv1.WorkflowEnvironment{ControllerVersion: "v2.5.0", ExecutorVersion: "v2.5.0"}, woc.wf.Status.Environment)
+	assert.Empty(t, first.ControllerVersion)
+	assert.Empty(t, first.ExecutorVersion)
+
+	// the nodes only keep the versions which differ
+	upgraded := &wfv1.NodeEnvironment{ControllerVersion: "v2.5.0", ExecutorVersion: "v2.5.1"}
+	woc.recordEnvironment(upgraded)
+	assert.Equal(t, &wfv1.WorkflowEnvironment{ControllerVersion: "v2.5.0", ExecutorVersion: "v2.5.0"}, woc.wf.Status.Environment)
+	assert.Empty(t, upgraded.ControllerVersion)
+	assert.Equal(t, "v2.5.1", upgraded.ExecutorVersion)
+}
+
 var workflowParallelismLimit = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -463,6 +604,95 @@ func TestWorkflowParallelismLimit(t *testing.T) {
 	assert.Equal(t, 2, len(pods.Items))
 }
 
+func TestPodParallelism(t *testing.T) {
+	controller := newController()
+	controller.Config.PodParallelism = 3
+	controller.podThrottler.setParallelism(3, controller.Config.GetNamespacePodShare)
+	wf := unmarshalWF(workflowParallelismLimit)
+	wf.Spec.Parallelism = nil
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.True(t, woc.podsThrottled)
+	pods, err := controller.kubeclientset.CoreV1().Pods("argo").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(pods.Items))
+
+	// the workflow of another namespace waits for its turn
+	other := unmarshalWF(helloWorldWf)
+	other, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("other").Create(other)
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(other, controller)
+	woc.operate()
+	assert.True(t, woc.podsThrottled)
+	pods, err = controller.kubeclientset.CoreV1().Pods("other").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, pods.Items)
+	other, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("other").Get(other.ObjectMeta.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, other.Status.Phase)
+
+	// once a pod of the first workflow completes, the other is requeued and creates the next pod
+	for controller.wfQueue.Len() > 0 {
+		key, _ := controller.wfQueue.Get()
+		controller.wfQueue.Done(key)
+	}
+	controller.podThrottler.update("argo/parallelism-limit", 2)
+	if assert.Equal(t, 1, controller.wfQueue.Len()) {
+		key, _ := controller.wfQueue.Get()
+		assert.Equal(t, "other/hello-world", key)
+		controller.wfQueue.Done(key)
+	}
+	woc = newWorkflowOperationCtx(other, controller)
+	woc.operate()
+	assert.False(t, woc.podsThrottled)
+	pods, err = controller.kubeclientset.CoreV1().Pods("other").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pods.Items))
+}
+
+func TestPodParallelismRetry(t *testing.T) {
+	controller := newController()
+	controller.Config.PodParallelism = 1
+	controller.podThrottler.setParallelism(1, controller.Config.GetNamespacePodShare)
+	wf := unmarshalWF(podNameInRetries)
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	pods, err := controller.kubeclientset.CoreV1().Pods("argo").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pods.Items))
+
+	// the first attempt fails while the pod of another workflow takes its place, so the next attempt waits
+	makePodsPhase(t, controller.kubeclientset, "argo", apiv1.PodFailed)
+	controller.podThrottler.update("argo/other", 1)
+	wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Get(wf.ObjectMeta.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.True(t, woc.podsThrottled)
+	pods, err = controller.kubeclientset.CoreV1().Pods("argo").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pods.Items))
+	retryNode := woc.wf.Status.Nodes[woc.wf.ObjectMeta.Name]
+	assert.Equal(t, wfv1.NodeTypeRetry, retryNode.Type)
+	assert.Len(t, retryNode.Children, 1)
+
+	// once the pod of the other workflow completes, the next attempt is created
+	controller.podThrottler.update("argo/other", 0)
+	wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("argo").Get(wf.ObjectMeta.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.False(t, woc.podsThrottled)
+	pods, err = controller.kubeclientset.CoreV1().Pods("argo").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(pods.Items))
+	assert.Len(t, woc.wf.Status.Nodes[woc.wf.ObjectMeta.Name].Children, 2)
+}
+
 var stepsTemplateParallelismLimit = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1709,6 +1939,109 @@ func TestResourceWithOwnerReferenceTemplate(t *testing.T) {
 	}
 }
 
+var resourceWithFinalizersTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: resource-with-finalizers
+spec:
+  entrypoint: resource
+  templates:
+  - name: resource
+    resource:
+      action: create
+      finalizers: [DeleteOnWorkflowCompletion]
+      manifest: |
+        apiVersion: v1
+        kind: ConfigMap
+        metadata:
+          name: resource-cm
+          labels:
+            app: resource
+`
+
+func TestResourceWithFinalizersTemplate(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(resourceWithFinalizersTemplate))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, []string{"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: resource-cm\n"}, woc.wf.Status.ResourcesToDelete)
+
+	// the failure of the step does not keep the resource from being deleted
+	pod, err := podcs.Get("resource-with-finalizers", metav1.GetOptions{})
+	assert.NoError(t, err)
+	pod.Status.Phase = apiv1.PodFailed
+	_, err = podcs.Update(pod)
+	assert.NoError(t, err)
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	node := woc.getNodeByName("resource-with-finalizers.cleanup(0)")
+	if assert.NotNil(t, node) {
+		cleanupNode := woc.getNodeByName("resource-with-finalizers.cleanup")
+		if assert.NotNil(t, cleanupNode) {
+			assert.Equal(t, cleanupNode.ID, node.BoundaryID)
+			assert.Equal(t, []string{node.ID}, cleanupNode.Children)
+		}
+		pod, err = podcs.Get(node.ID, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"argoexec", "resource", "delete"}, pod.Spec.Containers[0].Command)
+			pod.Status.Phase = apiv1.PodSucceeded
+			_, err = podcs.Update(pod)
+			assert.NoError(t, err)
+		}
+	}
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.getNodeByName("resource-with-finalizers.cleanup").Phase)
+}
+
+// TestResourceWithFinalizersTerminated verifies the resources are deleted once the workflow was terminated
+func TestResourceWithFinalizersTerminated(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(resourceWithFinalizersTemplate))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	wf.Spec.ActiveDeadlineSeconds = pointer.Int64Ptr(0)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.getNodeByName("resource-with-finalizers").Phase)
+	node := woc.getNodeByName("resource-with-finalizers.cleanup(0)")
+	if assert.NotNil(t, node) {
+		pod, err := podcs.Get(node.ID, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Nil(t, pod.Spec.ActiveDeadlineSeconds)
+			assert.NotContains(t, pod.Annotations, common.AnnotationKeyExecutionControl)
+		}
+	}
+
+	// the pending pod deleting the resource is not deleted for the deadline
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	_, err = podcs.Get(node.ID, metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
 var artifactRepositoryRef = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1849,8 +2182,8 @@ func TestStepWFGetNodeName(t *testing.T) {
 	wf := unmarshalWF(stepScriptTmpl)
 	wf, err := wfcset.Create(wf)
 	assert.NoError(t, err)
-	assert.True(t, hasOutputResultRef("generate", &wf.Spec.Templates[0]))
-	assert.False(t, hasOutputResultRef("print-message", &wf.Spec.Templates[0]))
+	assert.True(t, hasOutputResultRef(log.NewEntry(log.StandardLogger()), "generate", &wf.Spec.Templates[0]))
+	assert.False(t, hasOutputResultRef(log.NewEntry(log.StandardLogger()), "print-message", &wf.Spec.Templates[0]))
 	woc := newWorkflowOperationCtx(wf, controller)
 	woc.operate()
 	wf, err = wfcset.Get(wf.ObjectMeta.Name, metav1.GetOptions{})
@@ -1873,8 +2206,8 @@ func TestDAGWFGetNodeName(t *testing.T) {
 	wf := unmarshalWF(dagScriptTmpl)
 	wf, err := wfcset.Create(wf)
 	assert.NoError(t, err)
-	assert.True(t, hasOutputResultRef("A", &wf.Spec.Templates[0]))
-	assert.False(t, hasOutputResultRef("B", &wf.Spec.Templates[0]))
+	assert.True(t, hasOutputResultRef(log.NewEntry(log.StandardLogger()), "A", &wf.Spec.Templates[0]))
+	assert.False(t, hasOutputResultRef(log.NewEntry(log.StandardLogger()), "B", &wf.Spec.Templates[0]))
 	woc := newWorkflowOperationCtx(wf, controller)
 	woc.operate()
 	wf, err = wfcset.Get(wf.ObjectMeta.Name, metav1.GetOptions{})
@@ -2135,6 +2468,94 @@ func TestEventInvalidSpec(t *testing.T) {
 	assert.Equal(t, "invalid spec: template name '123' undefined", invalidSpecEvent.Message)
 }
 
+func TestOperationLogFields(t *testing.T) {
+	controller := newController()
+	wf := unmarshalWF(helloWorldWf)
+	wf.ObjectMeta.UID = "4f1d6d5b-5ec4-4b4e-8b8e-6e2c1c0f2f41"
+	woc := newWorkflowOperationCtx(wf, controller)
+	assert.Equal(t, "hello-world", woc.log.Data["workflow"])
+	assert.Equal(t, types.UID("4f1d6d5b-5ec4-4b4e-8b8e-6e2c1c0f2f41"), woc.log.Data["workflowUID"])
+	assert.Equal(t, int64(1), woc.log.Data["reconciliation"])
+	woc = newWorkflowOperationCtx(wf, controller)
+	assert.Equal(t, int64(2), woc.log.Data["reconciliation"])
+}
+
+type fakePolicy struct {
+	reason    string
+	err       error
+	podReason string
+}
+
+func (p fakePolicy) Evaluate(*wfv1.Workflow) (string, error) {
+	return p.reason, p.err
+}
+
+func (p fakePolicy) EvaluatePod(*apiv1.Pod, apiv1.PodSpec) string {
+	return p.podReason
+}
+
+func TestPolicy(t *testing.T) {
+	t.Run("Rejected", func(t *testing.T) {
+		controller := newController()
+		controller.policy = fakePolicy{reason: "the label team is required"}
+		wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+		wf, err := wfcset.Create(unmarshalWF(helloWorldWf))
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate()
+		wf, err = wfcset.Get(wf.ObjectMeta.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, wfv1.NodeFailed, wf.Status.Phase)
+		assert.Equal(t, "rejected by policy: the label team is required", wf.Status.Message)
+		assert.Empty(t, wf.Status.Nodes)
+		events, err := controller.kubeclientset.CoreV1().Events("").List(metav1.ListOptions{})
+		assert.NoError(t, err)
+		if assert.Equal(t, 2, len(events.Items)) {
+			assert.Equal(t, "WorkflowRejected", events.Items[1].Reason)
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		controller := newController()
+		controller.policy = fakePolicy{err: fmt.Errorf("connection refused")}
+		wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+		wf, err := wfcset.Create(unmarshalWF(helloWorldWf))
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate()
+		assert.True(t, woc.requeued)
+		wf, err = wfcset.Get(wf.ObjectMeta.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, wf.Status.Phase)
+	})
+	t.Run("Allowed", func(t *testing.T) {
+		controller := newController()
+		controller.policy = fakePolicy{}
+		wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+		wf, err := wfcset.Create(unmarshalWF(helloWorldWf))
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate()
+		wf, err = wfcset.Get(wf.ObjectMeta.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Phase)
+	})
+	t.Run("PodRejected", func(t *testing.T) {
+		controller := newController()
+		controller.policy = fakePolicy{podReason: "pod hello-world runs a privileged container"}
+		wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+		wf, err := wfcset.Create(unmarshalWF(helloWorldWf))
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate()
+		node := woc.wf.Status.Nodes[woc.wf.NodeID(wf.ObjectMeta.Name)]
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Equal(t, "rejected by policy: pod hello-world runs a privileged container", node.Message)
+		pods, err := controller.kubeclientset.CoreV1().Pods("").List(metav1.ListOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, pods.Items)
+	})
+}
+
 var timeout = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2215,3 +2636,720 @@ func TestEventFailArtifactRepoCm(t *testing.T) {
 	assert.Equal(t, "WorkflowFailed", failEvent.Reason)
 	assert.Equal(t, "Failed to load artifact repository configMap: configmaps \"artifact-repository\" not found", failEvent.Message)
 }
+
+var executionWindow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: execution-window
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: hello
+        template: whalesay
+  - name: whalesay
+    executionWindows:
+    - schedule: "0 1 * * *"
+      duration: "1m"
+      timezone: UTC
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+      args: ["hello world"]
+`
+
+// TestExecutionWindow verifies nodes wait for their execution window to open
+func TestExecutionWindow(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	// make sure the window opens in two hours
+	opening := time.Now().UTC().Add(2 * time.Hour)
+	wf := unmarshalWF(executionWindow)
+	wf.Spec.Templates[1].ExecutionWindows[0].Schedule = fmt.Sprintf("%d %d * * *", opening.Minute(), opening.Hour())
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	node := woc.getNodeByName("execution-window[0].hello")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.Equal(t, wfv1.NodeWaitingForExecutionWindow, node.WaitingFor)
+		assert.Contains(t, node.Message, "Waiting for execution window")
+	}
+	pods, err := controller.kubeclientset.CoreV1().Pods("").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(pods.Items))
+
+	// open the window
+	wf, err = wfcset.Get(wf.ObjectMeta.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	wf.Spec.Templates[1].ExecutionWindows[0].Schedule = "* * * * *"
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	node = woc.getNodeByName("execution-window[0].hello")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeTypePod, node.Type)
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.Empty(t, node.WaitingFor)
+	}
+	pods, err = controller.kubeclientset.CoreV1().Pods("").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pods.Items))
+}
+
+func TestDiffNodes(t *testing.T) {
+	before := wfv1.Nodes{
+		"a": {ID: "a", Name: "wf.a", Phase: wfv1.NodeRunning},
+		"b": {ID: "b", Name: "wf.b", Phase: wfv1.NodeRunning},
+	}
+	after := wfv1.Nodes{
+		"a": {ID: "a", Name: "wf.a", Phase: wfv1.NodeRunning},
+		"b": {ID: "b", Name: "wf.b", Phase: wfv1.NodeSucceeded},
+		"c": {ID: "c", Name: "wf.c", Phase: wfv1.NodePending},
+	}
+	changes := diffNodes(before, after)
+	assert.Equal(t, []nodeChange{
+		{id: "b", name: "wf.b", from: wfv1.NodeRunning, to: wfv1.NodeSucceeded},
+		{id: "c", name: "wf.c", from: "", to: wfv1.NodePending},
+	}, changes)
+	assert.Empty(t, diffNodes(after, copyNodes(after)))
+}
+
+var childWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: child-workflow
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    resource:
+      action: create
+      setOwnerReference: true
+      manifest: |
+        apiVersion: argoproj.io/v1alpha1
+        kind: Workflow
+        metadata:
+          generateName: child-
+        spec:
+          entrypoint: whalesay
+          templates:
+          - name: whalesay
+            container:
+              image: docker/whalesay
+`
+
+// TestChildWorkflow verifies a resource template creating a workflow waits for the workflow to complete
+func TestChildWorkflow(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(childWorkflow)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	pods, err := controller.kubeclientset.CoreV1().Pods("").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(pods.Items)) {
+		var tmpl wfv1.Template
+		err = json.Unmarshal([]byte(pods.Items[0].Annotations[common.AnnotationKeyTemplate]), &tmpl)
+		assert.NoError(t, err)
+		assert.Equal(t, "status.phase == Succeeded", tmpl.Resource.SuccessCondition)
+		assert.Equal(t, "status.phase in (Failed, Error)", tmpl.Resource.FailureCondition)
+	}
+}
+
+var volumeClaimGC = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: volume-claim-gc
+spec:
+  entrypoint: whalesay
+  volumeClaimTemplates:
+  - metadata:
+      name: workdir
+    spec:
+      accessModes: ["ReadWriteOnce"]
+      resources:
+        requests:
+          storage: 1Gi
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay
+      volumeMounts:
+      - name: workdir
+        mountPath: /mnt/vol
+`
+
+// TestVolumeClaimGC verifies when the volumes created from volumeClaimTemplates are deleted
+func TestVolumeClaimGC(t *testing.T) {
+	for _, tt := range []struct {
+		strategy wfv1.VolumeClaimGCStrategy
+		deleted  bool
+	}{
+		{"", false},
+		{wfv1.VolumeClaimGCOnWorkflowSuccess, false},
+		{wfv1.VolumeClaimGCOnWorkflowCompletion, true},
+	} {
+		controller := newController()
+		wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+		wf := unmarshalWF(volumeClaimGC)
+		if tt.strategy != "" {
+			wf.Spec.VolumeClaimGC = &wfv1.VolumeClaimGC{Strategy: tt.strategy}
+		}
+		wf, err := wfcset.Create(wf)
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate()
+		assert.Len(t, woc.wf.Status.PersistentVolumeClaims, 1)
+
+		woc.markWorkflowFailed("failed")
+		err = woc.deletePVCs()
+		assert.NoError(t, err)
+		if tt.deleted {
+			assert.Empty(t, woc.wf.Status.PersistentVolumeClaims, tt.strategy)
+		} else {
+			assert.Len(t, woc.wf.Status.PersistentVolumeClaims, 1, tt.strategy)
+		}
+	}
+}
+
+var indexLabels = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: index-labels
+  ownerReferences:
+  - apiVersion: argoproj.io/v1alpha1
+    kind: CronWorkflow
+    name: my-cron
+    uid: 0c5f1e4e-2a28-4f6b-a6d2-4b1e8f2a6c11
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        templateRef:
+          name: templates-b
+          template: whalesay
+      - name: b
+        templateRef:
+          name: templates-a
+          template: whalesay
+      - name: c
+        templateRef:
+          name: templates-b
+          template: whalesay
+`
+
+func TestAddIndexLabels(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf, err := wfcset.Create(unmarshalWF(indexLabels))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "main", wf.Labels[common.LabelKeyEntrypoint])
+		assert.Equal(t, "true", wf.Labels[common.LabelKeyWorkflowTemplatePrefix+"templates-a"])
+		assert.Equal(t, "true", wf.Labels[common.LabelKeyWorkflowTemplatePrefix+"templates-b"])
+		assert.Equal(t, "my-cron", wf.Labels[common.LabelCronWorkflow])
+	}
+}
+
+var failureThreshold = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: failure-threshold
+spec:
+  entrypoint: main
+  parallelism: 2
+  templates:
+  - name: main
+    steps:
+    - - name: batch
+        template: whalesay
+        withItems: [1, 2, 3, 4]
+        failureThreshold:
+          percent: 50
+          minCompleted: 2
+  - name: whalesay
+    container:
+      image: docker/whalesay
+`
+
+func TestFailureThreshold(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf, err := wfcset.Create(unmarshalWF(failureThreshold))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+	for _, pod := range pods.Items {
+		pod.Status.Phase = apiv1.PodFailed
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	pods, err = podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 2, "no more items are launched")
+	skipped := 0
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypeSkipped {
+			skipped++
+			assert.Equal(t, "failure threshold exceeded: 2 of 2 completed items failed", node.Message)
+		}
+	}
+	assert.Equal(t, 2, skipped)
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+}
+
+var customMetrics = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: custom-metrics
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    metrics:
+      prometheus:
+      - name: whalesay_completed_total
+        help: Number of completed whalesay steps
+        labels:
+        - key: status
+          value: "{{status}}"
+        counter:
+          value: "1"
+    container:
+      image: docker/whalesay
+`
+
+func TestEmitNodeMetrics(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf, err := wfcset.Create(unmarshalWF(customMetrics))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	for _, pod := range pods.Items {
+		pod.Status.Phase = apiv1.PodSucceeded
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+
+	families, err := controller.metrics.Registry().Gather()
+	assert.NoError(t, err)
+	var found bool
+	for _, family := range families {
+		if family.GetName() != "whalesay_completed_total" {
+			continue
+		}
+		found = true
+		if assert.Len(t, family.GetMetric(), 1) {
+			metric := family.GetMetric()[0]
+			assert.Equal(t, "Succeeded", metric.GetLabel()[0].GetValue())
+			assert.Equal(t, float64(1), metric.GetCounter().GetValue())
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestEmitNodeDurationMetrics(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf, err := wfcset.Create(unmarshalWF(nodeFailedEvent))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	for _, pod := range pods.Items {
+		pod.Status.Phase = apiv1.PodFailed
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+
+	families, err := controller.metrics.Registry().Gather()
+	assert.NoError(t, err)
+	var found bool
+	for _, family := range families {
+		if family.GetName() != "argo_node_duration_seconds" {
+			continue
+		}
+		found = true
+		if assert.Len(t, family.GetMetric(), 1) {
+			metric := family.GetMetric()[0]
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			assert.Equal(t, map[string]string{"template_name": "whalesay", "phase": "Failed"}, labels)
+			assert.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+		}
+	}
+	assert.True(t, found)
+}
+
+var nodeFailedEvent = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: node-failed-event
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: whalesay
+        template: whalesay
+  - name: whalesay
+    container:
+      image: docker/whalesay
+`
+
+func TestNodeFailedEvent(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf, err := wfcset.Create(unmarshalWF(nodeFailedEvent))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	for _, pod := range pods.Items {
+		pod.Status.Phase = apiv1.PodFailed
+		pod.Status.Message = "oops"
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+
+	events, err := controller.kubeclientset.CoreV1().Events("").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	var messages []string
+	for _, event := range events.Items {
+		if event.Reason == argo.EventReasonNodeFailed {
+			assert.Equal(t, apiv1.EventTypeWarning, event.Type)
+			assert.Equal(t, "node-failed-event", event.InvolvedObject.Name)
+			messages = append(messages, event.Message)
+		}
+	}
+	// the steps which failed because of the pod do not record events of their own
+	assert.Equal(t, []string{"Failed node node-failed-event[0].whalesay: oops"}, messages)
+}
+
+func TestApplyNamespaceDeadline(t *testing.T) {
+	controller := newController()
+	controller.Config.NamespaceDeadlines = map[string]config.NamespaceDeadline{
+		"tenant": {DefaultActiveDeadlineSeconds: pointer.Int64Ptr(60), MaxActiveDeadlineSeconds: pointer.Int64Ptr(120)},
+		"*":      {MaxActiveDeadlineSeconds: pointer.Int64Ptr(600)},
+	}
+	for _, tt := range []struct {
+		namespace string
+		deadline  *int64
+		expected  int64
+	}{
+		{"tenant", nil, 60},
+		{"tenant", pointer.Int64Ptr(90), 90},
+		{"tenant", pointer.Int64Ptr(1000), 120},
+		{"other", nil, 600},
+		{"other", pointer.Int64Ptr(300), 300},
+	} {
+		wf := unmarshalWF(helloWorldWf)
+		wf.Namespace = tt.namespace
+		wf.Spec.ActiveDeadlineSeconds = tt.deadline
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.applyWorkflowDefaults()
+		if assert.NotNil(t, woc.wf.Spec.ActiveDeadlineSeconds) {
+			assert.Equal(t, tt.expected, *woc.wf.Spec.ActiveDeadlineSeconds)
+		}
+	}
+}
+
+func TestApplyNamespaceDefaults(t *testing.T) {
+	controller := newController()
+	_, err := controller.kubeclientset.CoreV1().Namespaces().Create(&apiv1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant",
+			Annotations: map[string]string{
+				common.AnnotationKeyDefaultServiceAccount:            "tenant-sa",
+				common.AnnotationKeyDefaultArtifactRepository:        "artifact-repositories/tenant",
+				common.AnnotationKeyDefaultTTLSecondsAfterCompletion: "3600",
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	wf := unmarshalWF(helloWorldWf)
+	wf.Namespace = "tenant"
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.applyWorkflowDefaults()
+	assert.True(t, woc.updated)
+	assert.Equal(t, "tenant-sa", woc.wf.Spec.ServiceAccountName)
+	assert.Equal(t, &wfv1.ArtifactRepositoryRef{ConfigMap: "artifact-repositories", Key: "tenant"}, woc.wf.Spec.ArtifactRepositoryRef)
+	if assert.NotNil(t, woc.wf.Spec.TTLStrategy) {
+		assert.Equal(t, int32(3600), *woc.wf.Spec.TTLStrategy.SecondsAfterCompletion)
+	}
+
+	// the workflow keeps its own settings
+	wf = unmarshalWF(helloWorldWf)
+	wf.Namespace = "tenant"
+	wf.Spec.ServiceAccountName = "my-sa"
+	wf.Spec.ArtifactRepositoryRef = &wfv1.ArtifactRepositoryRef{ConfigMap: "my-cm", Key: "my-key"}
+	wf.Spec.TTLSecondsAfterFinished = pointer.Int32Ptr(10)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.applyWorkflowDefaults()
+	assert.False(t, woc.updated)
+	assert.Equal(t, "my-sa", woc.wf.Spec.ServiceAccountName)
+	assert.Equal(t, "my-cm", woc.wf.Spec.ArtifactRepositoryRef.ConfigMap)
+	assert.Nil(t, woc.wf.Spec.TTLStrategy)
+
+	// namespaces without annotations, or which cannot be read, have no defaults
+	wf = unmarshalWF(helloWorldWf)
+	wf.Namespace = "other"
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.applyWorkflowDefaults()
+	assert.False(t, woc.updated)
+	assert.Empty(t, woc.wf.Spec.ServiceAccountName)
+}
+
+func TestApplyWorkflowDefaults(t *testing.T) {
+	controller := newController()
+	controller.Config.WorkflowDefaults = &config.WorkflowDefaults{
+		Labels:                map[string]string{"team": "data", "env": "prod"},
+		Annotations:           map[string]string{"cost-center": "1234"},
+		ServiceAccountName:    "workflows",
+		ActiveDeadlineSeconds: pointer.Int64Ptr(3600),
+		TTLStrategy:           &wfv1.TTLStrategy{SecondsAfterCompletion: pointer.Int32Ptr(600)},
+	}
+
+	wf := unmarshalWF(helloWorldWf)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.applyWorkflowDefaults()
+	assert.True(t, woc.updated)
+	assert.Equal(t, "data", woc.wf.Labels["team"])
+	assert.Equal(t, "1234", woc.wf.Annotations["cost-center"])
+	assert.Equal(t, "workflows", woc.wf.Spec.ServiceAccountName)
+	if assert.NotNil(t, woc.wf.Spec.ActiveDeadlineSeconds) {
+		assert.Equal(t, int64(3600), *woc.wf.Spec.ActiveDeadlineSeconds)
+	}
+	if assert.NotNil(t, woc.wf.Spec.TTLStrategy) {
+		assert.Equal(t, int32(600), *woc.wf.Spec.TTLStrategy.SecondsAfterCompletion)
+	}
+
+	// the workflow keeps its own settings
+	wf = unmarshalWF(helloWorldWf)
+	wf.Labels = map[string]string{"team": "ml"}
+	wf.Spec.ServiceAccountName = "my-sa"
+	wf.Spec.ActiveDeadlineSeconds = pointer.Int64Ptr(60)
+	wf.Spec.TTLSecondsAfterFinished = pointer.Int32Ptr(10)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.applyWorkflowDefaults()
+	assert.Equal(t, map[string]string{"team": "ml", "env": "prod"}, woc.wf.Labels)
+	assert.Equal(t, "my-sa", woc.wf.Spec.ServiceAccountName)
+	assert.Equal(t, int64(60), *woc.wf.Spec.ActiveDeadlineSeconds)
+	assert.Nil(t, woc.wf.Spec.TTLStrategy)
+
+	// the default deadline of the namespace takes precedence
+	controller.Config.NamespaceDeadlines = map[string]config.NamespaceDeadline{"tenant": {DefaultActiveDeadlineSeconds: pointer.Int64Ptr(120)}}
+	wf = unmarshalWF(helloWorldWf)
+	wf.Namespace = "tenant"
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.applyWorkflowDefaults()
+	assert.Equal(t, int64(120), *woc.wf.Spec.ActiveDeadlineSeconds)
+
+	// the annotations of the namespace take precedence
+	_, err := controller.kubeclientset.CoreV1().Namespaces().Create(&apiv1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tenant",
+			Annotations: map[string]string{common.AnnotationKeyDefaultServiceAccount: "tenant-sa"},
+		},
+	})
+	assert.NoError(t, err)
+	wf = unmarshalWF(helloWorldWf)
+	wf.Namespace = "tenant"
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.applyWorkflowDefaults()
+	assert.Equal(t, "tenant-sa", woc.wf.Spec.ServiceAccountName)
+	assert.Equal(t, "data", woc.wf.Labels["team"])
+}
+
+var streamWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: stream
+spec:
+  entrypoint: pipeline
+  templates:
+  - name: pipeline
+    steps:
+    - - name: consumer
+        template: consumer
+  - name: consumer
+    daemon: true
+    stream:
+      port: 9000
+    container:
+      image: busybox
+  - name: plain
+    daemon: true
+    container:
+      image: busybox
+`
+
+// TestProcessNodeOutputsStream verifies the address of the stream of a daemon is its IP and the port of its stream
+func TestProcessNodeOutputsStream(t *testing.T) {
+	wf := unmarshalWF(streamWf)
+	woc := newWorkflowOperationCtx(wf, newController())
+	scope := &wfScope{tmpl: &wf.Spec.Templates[0], scope: make(map[string]interface{})}
+
+	woc.processNodeOutputs(scope, "steps.consumer", &wfv1.NodeStatus{ID: "stream-1", TemplateName: "consumer", PodIP: "10.0.0.1", Daemoned: pointer.BoolPtr(true)})
+	address, err := scope.resolveParameter("steps.consumer.stream")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "10.0.0.1:9000", address)
+	}
+
+	woc.processNodeOutputs(scope, "steps.plain", &wfv1.NodeStatus{ID: "stream-2", TemplateName: "plain", PodIP: "10.0.0.2", Daemoned: pointer.BoolPtr(true)})
+	_, err = scope.resolveParameter("steps.plain.stream")
+	assert.Error(t, err)
+}
+
+func TestReportOutputsToOwners(t *testing.T) {
+	controller := newController()
+	cronWf, err := controller.wfclientset.ArgoprojV1alpha1().CronWorkflows("").Create(&wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cron"},
+	})
+	assert.NoError(t, err)
+	wf := unmarshalWF(helloWorldWf)
+	wf.OwnerReferences = []metav1.OwnerReference{{APIVersion: "argoproj.io/v1alpha1", Kind: "CronWorkflow", Name: cronWf.Name}}
+	wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("").Create(wf)
+	assert.NoError(t, err)
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	result := "hello"
+	woc.wf.Status.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "result", Value: &result}}}
+	woc.markWorkflowPhase(wfv1.NodeSucceeded, true)
+
+	// the outputs are reported once the completion is persisted
+	cronWf, err = controller.wfclientset.ArgoprojV1alpha1().CronWorkflows("").Get(cronWf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, cronWf.Annotations, common.AnnotationKeyWorkflowOutputs)
+
+	woc.persistUpdates()
+	cronWf, err = controller.wfclientset.ArgoprojV1alpha1().CronWorkflows("").Get(cronWf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	var outputs common.WorkflowOutputs
+	err = json.Unmarshal([]byte(cronWf.Annotations[common.AnnotationKeyWorkflowOutputs]), &outputs)
+	assert.NoError(t, err)
+	assert.Equal(t, wf.Name, outputs.Name)
+	assert.Equal(t, wfv1.NodeSucceeded, outputs.Phase)
+	assert.Equal(t, woc.wf.Status.Outputs, outputs.Outputs)
+	assert.False(t, outputs.OutputsTooLarge)
+
+	// outputs which are too large are left out
+	defer func(size int) { maxWorkflowOutputsSize = size }(maxWorkflowOutputsSize)
+	maxWorkflowOutputsSize = 10
+	woc.reportOutputsToOwners()
+	cronWf, err = controller.wfclientset.ArgoprojV1alpha1().CronWorkflows("").Get(cronWf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	outputs = common.WorkflowOutputs{}
+	err = json.Unmarshal([]byte(cronWf.Annotations[common.AnnotationKeyWorkflowOutputs]), &outputs)
+	assert.NoError(t, err)
+	assert.Equal(t, wf.Name, outputs.Name)
+	assert.Nil(t, outputs.Outputs)
+	assert.True(t, outputs.OutputsTooLarge)
+}
+
+var globalArgumentDefaultWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: global-argument-default
+spec:
+  entrypoint: whalesay
+  arguments:
+    parameters:
+    - name: message
+      default: hello world
+    - name: greeting
+      value: hi
+      default: hello
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+      command: [cowsay]
+      args: ["{{workflow.parameters.message}}"]
+`
+
+func TestGlobalArgumentDefault(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf := unmarshalWF(globalArgumentDefaultWf)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	assert.Equal(t, "hello world", *woc.wf.Spec.Arguments.Parameters[0].Value)
+	assert.Equal(t, "hi", *woc.wf.Spec.Arguments.Parameters[1].Value)
+	assert.Equal(t, "hello world", woc.globalParams["workflow.parameters.message"])
+}
@@ -0,0 +1,248 @@
+package controller
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// podThrottler limits the number of active pods of all the workflows to the pod parallelism of the controller.
+//
+// While the limit is contended, the namespaces take turns to create pods rather than the first workflows to be
+// operated taking every pod which completes: the next pod goes to the waiting namespace which created the fewest pods
+// for its share since the contention started, or else which has the fewest active pods for its share. Within a
+// namespace, the waiting workflows are served by priority, and then in the order they were created. For every pod
+// which may be created, a single waiting workflow is requeued, and the pod is reserved for it until its next
+// operation. This way, a namespace fanning out a large workflow cannot monopolize the pods while the workflows of the
+// other namespaces wait.
+type podThrottler struct {
+	queue       workqueue.RateLimitingInterface
+	lock        sync.Mutex
+	parallelism int
+	share       func(namespace string) int
+	// active is the number of active pods of each workflow, by key, activeInNamespace their sum by namespace, and
+	// total their sum
+	active            map[string]int
+	activeInNamespace map[string]int
+	total             int
+	// waiting are the workflows held back by the limit, by namespace
+	waiting map[string]*priorityQueue
+	// woken are the keys of the workflows which were requeued to create a pod, each of which holds a reservation
+	woken map[string]bool
+	// served is the number of pods created for each namespace since the contention started, divided by its share
+	served map[string]float64
+}
+
+func newPodThrottler(queue workqueue.RateLimitingInterface) *podThrottler {
+	return &podThrottler{
+		queue:             queue,
+		share:             func(string) int { return 1 },
+		active:            make(map[string]int),
+		activeInNamespace: make(map[string]int),
+		waiting:           make(map[string]*priorityQueue),
+		woken:             make(map[string]bool),
+		served:            make(map[string]float64),
+	}
+}
+
+// setParallelism updates the pod parallelism, where 0 is unlimited, and the shares of the namespaces
+func (t *podThrottler) setParallelism(parallelism int, share func(namespace string) int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.parallelism = parallelism
+	t.share = share
+	t.wake()
+}
+
+// update records the number of active pods of a workflow, which is counted again at every operation
+func (t *podThrottler) update(key string, active int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.setActive(key, active)
+	t.wake()
+}
+
+// acquire returns whether a workflow may create a pod. Otherwise the workflow waits for its turn, and is requeued when
+// it comes.
+func (t *podThrottler) acquire(key string, priority int32, creationTime time.Time) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.parallelism < 1 {
+		return true
+	}
+	namespace := namespaceOf(key)
+	if t.woken[key] {
+		// the pod was reserved for the workflow, and counted as served, when it was woken
+		delete(t.woken, key)
+		t.setActive(key, t.active[key]+1)
+		return true
+	}
+	if t.free() < 1 {
+		t.addWaiting(key, priority, creationTime)
+		return false
+	}
+	if len(t.waiting) == 0 && len(t.woken) == 0 {
+		t.setActive(key, t.active[key]+1)
+		return true
+	}
+	t.addWaiting(key, priority, creationTime)
+	if t.next() != namespace || t.waiting[namespace].peek().key != key {
+		return false
+	}
+	t.stopWaiting(key)
+	t.setActive(key, t.active[key]+1)
+	t.served[namespace] += 1 / float64(t.getShare(namespace))
+	return true
+}
+
+// done is called at the end of every operation of a workflow, with whether acquire held back any of its pods
+func (t *podThrottler) done(key string, throttled bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if !throttled {
+		t.stopWaiting(key)
+	}
+	// a reservation the workflow did not use goes to the next waiting workflow
+	delete(t.woken, key)
+	t.wake()
+}
+
+// remove forgets a workflow which completed or was deleted
+func (t *podThrottler) remove(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.setActive(key, 0)
+	t.stopWaiting(key)
+	delete(t.woken, key)
+	t.wake()
+}
+
+func (t *podThrottler) setActive(key string, active int) {
+	namespace := namespaceOf(key)
+	delta := active - t.active[key]
+	t.total += delta
+	t.activeInNamespace[namespace] += delta
+	if t.activeInNamespace[namespace] == 0 {
+		delete(t.activeInNamespace, namespace)
+	}
+	if active > 0 {
+		t.active[key] = active
+	} else {
+		delete(t.active, key)
+	}
+}
+
+// free returns the number of pods which may be created, and are not reserved for woken workflows
+func (t *podThrottler) free() int {
+	return t.parallelism - t.total - len(t.woken)
+}
+
+func (t *podThrottler) addWaiting(key string, priority int32, creationTime time.Time) {
+	namespace := namespaceOf(key)
+	pending, ok := t.waiting[namespace]
+	if !ok {
+		pending = &priorityQueue{itemByKey: make(map[interface{}]*item)}
+		t.waiting[namespace] = pending
+		// the namespace joins the turns, without the credit of the time it did not wait
+		if minServed, others := t.minServed(namespace); others && t.served[namespace] < minServed {
+			t.served[namespace] = minServed
+		}
+	}
+	pending.add(key, priority, creationTime)
+}
+
+func (t *podThrottler) stopWaiting(key string) {
+	namespace := namespaceOf(key)
+	if pending, ok := t.waiting[namespace]; ok {
+		pending.remove(key)
+		if pending.Len() == 0 {
+			delete(t.waiting, namespace)
+		}
+	}
+	if len(t.waiting) == 0 && len(t.woken) == 0 {
+		// the contention is over, the next one starts afresh
+		t.served = make(map[string]float64)
+	}
+}
+
+// minServed returns the fewest pods the waiting namespaces other than the given one were served, and whether there
+// are any
+func (t *podThrottler) minServed(namespace string) (float64, bool) {
+	others := false
+	minServed := 0.0
+	for ns := range t.waiting {
+		if ns == namespace {
+			continue
+		}
+		if !others || t.served[ns] < minServed {
+			minServed = t.served[ns]
+		}
+		others = true
+	}
+	return minServed, others
+}
+
+// wake requeues a waiting workflow for every pod which may be created, each from the namespace whose turn it is
+func (t *podThrottler) wake() {
+	if len(t.waiting) == 0 {
+		return
+	}
+	if t.parallelism < 1 {
+		for namespace, pending := range t.waiting {
+			for pending.Len() > 0 {
+				t.queue.Add(pending.pop().key)
+			}
+			delete(t.waiting, namespace)
+		}
+		t.woken = make(map[string]bool)
+		t.served = make(map[string]float64)
+		return
+	}
+	for free := t.free(); free > 0 && len(t.waiting) > 0; free-- {
+		namespace := t.next()
+		key := t.waiting[namespace].peek().key.(string)
+		t.woken[key] = true
+		t.stopWaiting(key)
+		t.served[namespace] += 1 / float64(t.getShare(namespace))
+		log.Debugf("Pod parallelism has room for workflow %s", key)
+		t.queue.Add(key)
+	}
+}
+
+// next returns the namespace whose turn it is among the waiting namespaces
+func (t *podThrottler) next() string {
+	namespaces := make([]string, 0, len(t.waiting))
+	for namespace := range t.waiting {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Slice(namespaces, func(i, j int) bool {
+		a, b := namespaces[i], namespaces[j]
+		if t.served[a] != t.served[b] {
+			return t.served[a] < t.served[b]
+		}
+		activeA := float64(t.activeInNamespace[a]) / float64(t.getShare(a))
+		activeB := float64(t.activeInNamespace[b]) / float64(t.getShare(b))
+		if activeA != activeB {
+			return activeA < activeB
+		}
+		return a < b
+	})
+	return namespaces[0]
+}
+
+func (t *podThrottler) getShare(namespace string) int {
+	share := t.share(namespace)
+	if share < 1 {
+		return 1
+	}
+	return share
+}
+
+func namespaceOf(key string) string {
+	namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+	return namespace
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// drain returns the keys in the queue, and empties it
+func drain(queue workqueue.RateLimitingInterface) []string {
+	var keys []string
+	for queue.Len() > 0 {
+		key, _ := queue.Get()
+		keys = append(keys, key.(string))
+		queue.Done(key)
+	}
+	return keys
+}
+
+func TestPodThrottlerUnlimited(t *testing.T) {
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	throttler := newPodThrottler(queue)
+	for i := 0; i < 10; i++ {
+		assert.True(t, throttler.acquire("argo/hello-world", 0, time.Time{}))
+	}
+}
+
+func TestPodThrottlerTurns(t *testing.T) {
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	throttler := newPodThrottler(queue)
+	throttler.setParallelism(2, func(string) int { return 1 })
+
+	// the fan-out of a large workflow takes every pod
+	assert.True(t, throttler.acquire("big/fan-out", 0, time.Time{}))
+	assert.True(t, throttler.acquire("big/fan-out", 0, time.Time{}))
+	assert.False(t, throttler.acquire("big/fan-out", 0, time.Time{}))
+	assert.False(t, throttler.acquire("small/hello-world", 0, time.Time{}))
+	assert.Empty(t, drain(queue))
+
+	// a pod of the large workflow completed: the namespace with fewer active pods goes next
+	throttler.update("big/fan-out", 1)
+	assert.False(t, throttler.acquire("big/fan-out", 0, time.Time{}))
+	throttler.done("big/fan-out", true)
+	assert.Equal(t, []string{"small/hello-world"}, drain(queue))
+	assert.True(t, throttler.acquire("small/hello-world", 0, time.Time{}))
+	throttler.done("small/hello-world", false)
+	assert.Empty(t, drain(queue))
+
+	// the small workflow does not need more pods, so the large one gets the next
+	throttler.update("small/hello-world", 0)
+	assert.Equal(t, []string{"big/fan-out"}, drain(queue))
+	assert.True(t, throttler.acquire("big/fan-out", 0, time.Time{}))
+	assert.False(t, throttler.acquire("big/fan-out", 0, time.Time{}))
+
+	throttler.remove("big/fan-out")
+	assert.Equal(t, 0, throttler.total)
+	assert.Empty(t, throttler.waiting)
+}
+
+func TestPodThrottlerShares(t *testing.T) {
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	throttler := newPodThrottler(queue)
+	throttler.setParallelism(1, func(namespace string) int {
+		if namespace == "a" {
+			return 2
+		}
+		return 1
+	})
+
+	assert.True(t, throttler.acquire("a/wf", 0, time.Time{}))
+	assert.False(t, throttler.acquire("b/wf", 0, time.Time{}))
+	assert.False(t, throttler.acquire("a/wf", 0, time.Time{}))
+	holder := "a/wf"
+	created := map[string]int{}
+	for i := 0; i < 6; i++ {
+		// the pod of the holder completes, and both workflows try to create as many pods as they may
+		throttler.update(holder, 0)
+		for _, key := range []string{"a/wf", "b/wf"} {
+			for throttler.acquire(key, 0, time.Time{}) {
+				holder = key
+				created[key]++
+			}
+		}
+	}
+	assert.Equal(t, map[string]int{"a/wf": 4, "b/wf": 2}, created)
+}
+
+func TestPodThrottlerWakesOnePerPod(t *testing.T) {
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	throttler := newPodThrottler(queue)
+	throttler.setParallelism(2, func(string) int { return 1 })
+	now := time.Now()
+
+	assert.True(t, throttler.acquire("argo/busy", 0, now))
+	assert.True(t, throttler.acquire("argo/busy", 0, now))
+	assert.False(t, throttler.acquire("argo/new", 0, now))
+	assert.False(t, throttler.acquire("argo/old", 0, now.Add(-time.Minute)))
+
+	// a single pod completed: only the oldest waiting workflow is requeued, and the pod is reserved for it
+	throttler.update("argo/busy", 1)
+	assert.Equal(t, []string{"argo/old"}, drain(queue))
+	assert.False(t, throttler.acquire("argo/new", 0, now))
+	assert.True(t, throttler.acquire("argo/old", 0, now.Add(-time.Minute)))
+
+	// the reservation of a workflow which did not need it goes to the next one
+	throttler.update("argo/busy", 0)
+	assert.Equal(t, []string{"argo/new"}, drain(queue))
+	throttler.done("argo/new", false)
+	assert.Empty(t, drain(queue))
+	assert.True(t, throttler.acquire("argo/other", 0, now))
+}
+
+func TestPodThrottlerPriority(t *testing.T) {
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	throttler := newPodThrottler(queue)
+	throttler.setParallelism(1, func(string) int { return 1 })
+	now := time.Now()
+
+	assert.True(t, throttler.acquire("argo/busy", 0, now))
+	assert.False(t, throttler.acquire("argo/bulk", 0, now.Add(-time.Minute)))
+	assert.False(t, throttler.acquire("argo/urgent", 10, now))
+
+	// the urgent workflow goes first, even though the bulk one has waited longer
+	throttler.update("argo/busy", 0)
+	assert.Equal(t, []string{"argo/urgent"}, drain(queue))
+}
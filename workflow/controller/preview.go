@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo/errors"
+	"github.com/argoproj/argo/persist/sqldb"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	wfextv "github.com/argoproj/argo/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/hydrator"
+	"github.com/argoproj/argo/workflow/metrics"
+	"github.com/argoproj/argo/workflow/synchronization"
+)
+
+// previewNameSuffix stands in for the random suffix the API server appends to the generated name of a workflow
+const previewNameSuffix = "xxxxx"
+
+// PreviewPods operates a workflow once against fake clients, which do not reach any cluster, and returns the pods the
+// controller creates for its first steps. The workflow templates the workflow refers to are looked up in wftmpls.
+func PreviewPods(wf *wfv1.Workflow, wftmpls []wfv1.WorkflowTemplate, controllerConfig config.WorkflowControllerConfig) ([]apiv1.Pod, error) {
+	wf = wf.DeepCopy()
+	if wf.ObjectMeta.Name == "" {
+		wf.ObjectMeta.Name = wf.ObjectMeta.GenerateName + previewNameSuffix
+	}
+	var objects []runtime.Object
+	for i := range wftmpls {
+		wftmpl := wftmpls[i].DeepCopy()
+		if wftmpl.ObjectMeta.Namespace == "" {
+			wftmpl.ObjectMeta.Namespace = wf.ObjectMeta.Namespace
+		}
+		objects = append(objects, wftmpl)
+	}
+	wfclientset := fakewfclientset.NewSimpleClientset(objects...)
+	wf, err := wfclientset.ArgoprojV1alpha1().Workflows(wf.ObjectMeta.Namespace).Create(wf)
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wftmplInformer := wfextv.NewSharedInformerFactory(wfclientset, time.Minute).Argoproj().V1alpha1().WorkflowTemplates()
+	go wftmplInformer.Informer().Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), wftmplInformer.Informer().HasSynced) {
+		return nil, errors.InternalError("Timed out waiting for the workflow template cache to sync")
+	}
+	wfQueue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer wfQueue.ShutDown()
+	diagnosticsQueue := newDiagnosticsQueue()
+	defer diagnosticsQueue.ShutDown()
+	wfc := &WorkflowController{
+		Config:           controllerConfig,
+		kubeclientset:    fake.NewSimpleClientset(),
+		wfclientset:      wfclientset,
+		completedPods:    make(chan string, 512),
+		wftmplInformer:   wftmplInformer,
+		wfQueue:          wfQueue,
+		updateLimiter:    newUpdateLimiter(),
+		diagnosticsQueue: diagnosticsQueue,
+		hydrator:         hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo, config.NodeStatusStorageKubernetes),
+		wfArchive:        sqldb.NullWorkflowArchive,
+		metrics:          metrics.NewControllerMetrics(wfQueue.Len),
+		keyLock:          newKeyLock(),
+	}
+	wfc.throttler = NewThrottler(0, wfQueue)
+	wfc.podThrottler = newPodThrottler(wfQueue)
+	wfc.durationHistory = newDurationHistory(wfc.lastSuccessfulWorkflow, func(*wfv1.Workflow) {})
+	wfc.templateLibraryInformer = wfc.newTemplateLibraryInformer()
+	wfc.configMapInformer = wfc.newConfigMapInformer()
+	wfc.syncManager = synchronization.NewManager(wfc.getSemaphoreLimit, func(key string) {})
+
+	woc := newWorkflowOperationCtx(wf, wfc)
+	woc.operate()
+	if woc.wf.Status.Phase == wfv1.NodeError || woc.wf.Status.Phase == wfv1.NodeFailed {
+		return nil, errors.Errorf(errors.CodeBadRequest, "workflow %s: %s", woc.wf.Status.Phase, woc.wf.Status.Message)
+	}
+	pods, err := wfc.kubeclientset.CoreV1().Pods(wf.ObjectMeta.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	return pods.Items, nil
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/config"
+)
+
+var previewWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: preview-
+  namespace: argo
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        templateRef:
+          name: whalesay-template
+          template: whalesay
+        arguments:
+          parameters:
+          - name: message
+            value: hello
+      - name: b
+        template: whalesay
+    - - name: c
+        template: whalesay
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+var previewWorkflowTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: whalesay-template
+spec:
+  templates:
+  - name: whalesay
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: docker/whalesay:latest
+      args: ["{{inputs.parameters.message}}"]
+`
+
+func TestPreviewPods(t *testing.T) {
+	wf := unmarshalWF(previewWorkflow)
+	wftmpl := unmarshalWFTmpl(previewWorkflowTemplate)
+	pods, err := PreviewPods(wf, []wfv1.WorkflowTemplate{*wftmpl}, config.WorkflowControllerConfig{ExecutorImage: "executor:latest"})
+	if assert.NoError(t, err) && assert.Len(t, pods, 2) {
+		var args []string
+		for _, pod := range pods {
+			assert.Equal(t, "argo", pod.Namespace)
+			for _, ctr := range pod.Spec.Containers {
+				if ctr.Name == "main" {
+					args = append(args, ctr.Args...)
+				}
+			}
+		}
+		assert.Contains(t, args, "hello")
+	}
+	assert.Empty(t, wf.Name)
+
+	_, err = PreviewPods(wf, nil, config.WorkflowControllerConfig{ExecutorImage: "executor:latest"})
+	assert.Error(t, err)
+}
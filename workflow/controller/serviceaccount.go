@@ -0,0 +1,82 @@
+package controller
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo/errors"
+)
+
+// ensureExecutorServiceAccount creates the executor service account configured for the namespace of the workflow, and
+// its role binding, if the controller is configured to create them. Each of them is created if it is missing, until
+// the service account is ready. It returns false until the token of the service account is created, since the token
+// is mounted into the pods of the workflow.
+func (woc *wfOperationCtx) ensureExecutorServiceAccount() (bool, error) {
+	sa := woc.controller.Config.GetExecutorServiceAccount(woc.wf.Namespace)
+	if sa == nil || !sa.Create {
+		return true, nil
+	}
+	key := woc.wf.Namespace + "/" + sa.Name
+	if _, ok := woc.controller.executorServiceAccounts.Load(key); ok {
+		return true, nil
+	}
+	saClient := woc.controller.kubeclientset.CoreV1().ServiceAccounts(woc.wf.Namespace)
+	serviceAccount, err := saClient.Get(sa.Name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		serviceAccount, err = saClient.Create(&apiv1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: sa.Name}})
+		if apierr.IsAlreadyExists(err) {
+			// created by another workflow in the meantime
+			serviceAccount, err = saClient.Get(sa.Name, metav1.GetOptions{})
+		} else if err == nil {
+			woc.log.Infof("Created executor service account %s", key)
+		}
+	}
+	if err != nil {
+		return false, errors.InternalWrapError(err)
+	}
+	if sa.ClusterRole != "" {
+		err = woc.ensureExecutorRoleBinding(sa.Name, sa.ClusterRole)
+		if err != nil {
+			return false, err
+		}
+	}
+	if len(serviceAccount.Secrets) == 0 {
+		return false, nil
+	}
+	woc.controller.executorServiceAccounts.Store(key, true)
+	return true, nil
+}
+
+// ensureExecutorRoleBinding binds the executor service account to its cluster role in the namespace of the workflow,
+// unless it is already bound
+func (woc *wfOperationCtx) ensureExecutorRoleBinding(serviceAccountName, clusterRole string) error {
+	rbClient := woc.controller.kubeclientset.RbacV1().RoleBindings(woc.wf.Namespace)
+	_, err := rbClient.Get(serviceAccountName, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apierr.IsNotFound(err) {
+		return errors.InternalWrapError(err)
+	}
+	_, err = rbClient.Create(&rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: clusterRole},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: serviceAccountName, Namespace: woc.wf.Namespace}},
+	})
+	if apierr.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	woc.log.Infof("Bound executor service account %s/%s to cluster role %s", woc.wf.Namespace, serviceAccountName, clusterRole)
+	return nil
+}
+
+// forgetExecutorServiceAccount drops the executor service account of a namespace from the ready ones, e.g. once its
+// token cannot be found anymore, so that it is created again if it was deleted
+func (wfc *WorkflowController) forgetExecutorServiceAccount(namespace, name string) {
+	wfc.executorServiceAccounts.Delete(namespace + "/" + name)
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/config"
+)
+
+func TestCreateExecutorServiceAccount(t *testing.T) {
+	controller := newController()
+	controller.Config.NamespaceExecutorServiceAccounts = map[string]config.ExecutorServiceAccount{
+		"*": {Name: "executor", Create: true, ClusterRole: "argo-executor"},
+	}
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	sacs := controller.kubeclientset.CoreV1().ServiceAccounts("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(helloWorldWf))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	sa, err := sacs.Get("executor", metav1.GetOptions{})
+	assert.NoError(t, err)
+	roleBinding, err := controller.kubeclientset.RbacV1().RoleBindings("").Get("executor", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "argo-executor", roleBinding.RoleRef.Name)
+	}
+	// no pod is created until the token of the service account is created
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, pods.Items)
+
+	sa.Secrets = []apiv1.ObjectReference{{Name: "executor-token"}}
+	_, err = sacs.Update(sa)
+	assert.NoError(t, err)
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	pods, err = podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, pods.Items, 1) {
+		found := false
+		for _, volume := range pods.Items[0].Spec.Volumes {
+			if volume.Name == common.ServiceAccountTokenVolumeName {
+				found = true
+				assert.Equal(t, "executor-token", volume.Secret.SecretName)
+			}
+		}
+		assert.True(t, found)
+	}
+}
+
+func TestEnsureExecutorRoleBinding(t *testing.T) {
+	controller := newController()
+	controller.Config.NamespaceExecutorServiceAccounts = map[string]config.ExecutorServiceAccount{
+		"*": {Name: "executor", Create: true, ClusterRole: "argo-executor"},
+	}
+	sacs := controller.kubeclientset.CoreV1().ServiceAccounts("")
+	_, err := sacs.Create(&apiv1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "executor"},
+		Secrets:    []apiv1.ObjectReference{{Name: "executor-token"}},
+	})
+	assert.NoError(t, err)
+
+	// the role binding is created even though the service account exists
+	woc := newWorkflowOperationCtx(unmarshalWF(helloWorldWf), controller)
+	ready, err := woc.ensureExecutorServiceAccount()
+	assert.NoError(t, err)
+	assert.True(t, ready)
+	_, err = controller.kubeclientset.RbacV1().RoleBindings("").Get("executor", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// the service account is created again once it is found to be deleted
+	err = sacs.Delete("executor", &metav1.DeleteOptions{})
+	assert.NoError(t, err)
+	controller.forgetExecutorServiceAccount("", "executor")
+	ready, err = woc.ensureExecutorServiceAccount()
+	assert.NoError(t, err)
+	assert.False(t, ready)
+	_, err = sacs.Get("executor", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
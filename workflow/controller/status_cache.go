@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo/workflow/common"
+)
+
+// indexWorkflow is the name of the index of the pod informer which indexes the pods by the key of their workflow
+const indexWorkflow = "workflow"
+
+// indexByWorkflow indexes a pod by the namespace/name key of its workflow. The pods running in another cluster are
+// labeled with the namespace of their workflow.
+func indexByWorkflow(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*apiv1.Pod)
+	if !ok {
+		return nil, nil
+	}
+	workflowName, ok := pod.Labels[common.LabelKeyWorkflow]
+	if !ok {
+		return nil, nil
+	}
+	namespace := pod.ObjectMeta.Namespace
+	if workflowNamespace, ok := pod.Labels[common.LabelKeyWorkflowNamespace]; ok {
+		namespace = workflowNamespace
+	}
+	return []string{namespace + "/" + workflowName}, nil
+}
+
+// workflowStatus identifies the state a workflow was operated in: the version of the workflow object, together with
+// the versions of its pods which are not labeled completed yet
+type workflowStatus struct {
+	uid             types.UID
+	resourceVersion string
+	podsVersion     string
+}
+
+// statusCache remembers the state of the workflows whose last operation neither updated them nor requeued them. When
+// such a workflow is woken up again by its pods, e.g. by a burst of pod events or by the resync of the pod informer,
+// while it and its pods are unchanged, operating it again would not change anything and is skipped.
+//
+// Only the pod workers wake up a workflow without invalidating its entry. Any other reason to operate a workflow, such
+// as an update of the workflow or the release of a lock it waits for, forgets the entry.
+type statusCache struct {
+	statuses map[string]workflowStatus
+	lock     *sync.Mutex
+}
+
+func newStatusCache() *statusCache {
+	return &statusCache{
+		statuses: make(map[string]workflowStatus),
+		lock:     &sync.Mutex{},
+	}
+}
+
+// unchanged returns whether the workflow is in the same state it was last operated in
+func (c *statusCache) unchanged(key string, status workflowStatus) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	cached, ok := c.statuses[key]
+	return ok && cached == status
+}
+
+// operated records the state a workflow was operated in, without being updated or requeued
+func (c *statusCache) operated(key string, status workflowStatus) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.statuses[key] = status
+}
+
+// forget drops the state of the workflow, so that it is operated the next time it is processed
+func (c *statusCache) forget(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.statuses, key)
+}
+
+// podsVersion returns a digest of the names and resource versions of the pods of the workflow which are not labeled
+// completed yet, as observed by the pod informers of the clusters. These include the pods which completed since the
+// last operation, whose completion is yet to be recorded in the workflow. The pods labeled completed are left out:
+// the informers stop watching them, and their changes do not matter to the workflow anymore.
+func podsVersion(key string, indexers ...cache.Indexer) (string, error) {
+	var versions []string
+	for _, indexer := range indexers {
+		objs, err := indexer.ByIndex(indexWorkflow, key)
+		if err != nil {
+			return "", err
+		}
+		for _, obj := range objs {
+			pod, ok := obj.(*apiv1.Pod)
+			if !ok || pod.ObjectMeta.Labels[common.LabelKeyCompleted] == "true" {
+				continue
+			}
+			versions = append(versions, pod.ObjectMeta.Name+"="+pod.ObjectMeta.ResourceVersion)
+		}
+	}
+	sort.Strings(versions)
+	h := fnv.New64a()
+	for _, version := range versions {
+		_, _ = h.Write([]byte(version))
+		_, _ = h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%d:%x", len(versions), h.Sum64()), nil
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo/workflow/common"
+)
+
+func TestStatusCache(t *testing.T) {
+	c := newStatusCache()
+	status := workflowStatus{uid: "my-uid", resourceVersion: "1", podsVersion: "0:0"}
+	assert.False(t, c.unchanged("argo/my-wf", status))
+
+	c.operated("argo/my-wf", status)
+	assert.True(t, c.unchanged("argo/my-wf", status))
+	assert.False(t, c.unchanged("argo/my-wf", workflowStatus{uid: "my-uid", resourceVersion: "2", podsVersion: "0:0"}))
+	assert.False(t, c.unchanged("argo/my-wf", workflowStatus{uid: "other-uid", resourceVersion: "1", podsVersion: "0:0"}))
+	assert.False(t, c.unchanged("argo/other-wf", status))
+
+	c.forget("argo/my-wf")
+	assert.False(t, c.unchanged("argo/my-wf", status))
+}
+
+func TestPodsVersion(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{indexWorkflow: indexByWorkflow})
+	newPod := func(name, workflowName, resourceVersion string) *apiv1.Pod {
+		return &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "argo",
+			ResourceVersion: resourceVersion,
+			Labels:          map[string]string{common.LabelKeyWorkflow: workflowName},
+		}}
+	}
+	assert.NoError(t, indexer.Add(newPod("my-wf-1", "my-wf", "1")))
+	assert.NoError(t, indexer.Add(newPod("other-wf-1", "other-wf", "1")))
+	version, err := podsVersion("argo/my-wf", indexer)
+	assert.NoError(t, err)
+
+	// pods of other workflows do not matter
+	assert.NoError(t, indexer.Update(newPod("other-wf-1", "other-wf", "2")))
+	unchanged, err := podsVersion("argo/my-wf", indexer)
+	assert.NoError(t, err)
+	assert.Equal(t, version, unchanged)
+
+	// updated, created and deleted pods of the workflow do
+	assert.NoError(t, indexer.Update(newPod("my-wf-1", "my-wf", "2")))
+	updated, err := podsVersion("argo/my-wf", indexer)
+	assert.NoError(t, err)
+	assert.NotEqual(t, version, updated)
+	assert.NoError(t, indexer.Add(newPod("my-wf-2", "my-wf", "3")))
+	created, err := podsVersion("argo/my-wf", indexer)
+	assert.NoError(t, err)
+	assert.NotEqual(t, updated, created)
+	assert.NoError(t, indexer.Delete(newPod("my-wf-2", "my-wf", "3")))
+	deleted, err := podsVersion("argo/my-wf", indexer)
+	assert.NoError(t, err)
+	assert.Equal(t, updated, deleted)
+
+	// but not the pods labeled completed
+	completedPod := newPod("my-wf-2", "my-wf", "4")
+	completedPod.Labels[common.LabelKeyCompleted] = "true"
+	assert.NoError(t, indexer.Add(completedPod))
+	completed, err := podsVersion("argo/my-wf", indexer)
+	assert.NoError(t, err)
+	assert.Equal(t, deleted, completed)
+
+	// the pods of the workflow in other clusters matter too
+	clusterIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{indexWorkflow: indexByWorkflow})
+	clusterPod := newPod("my-wf-3", "my-wf", "1")
+	clusterPod.Namespace = "batch"
+	clusterPod.Labels[common.LabelKeyWorkflowNamespace] = "argo"
+	assert.NoError(t, clusterIndexer.Add(clusterPod))
+	withCluster, err := podsVersion("argo/my-wf", indexer, clusterIndexer)
+	assert.NoError(t, err)
+	assert.NotEqual(t, deleted, withCluster)
+	assert.True(t, strings.HasPrefix(withCluster, "2:"))
+}
@@ -24,6 +24,9 @@ type stepsContext struct {
 
 	// tmplCtx is the context of template search.
 	tmplCtx *templateresolution.Context
+
+	// failFast terminates the running steps of a step group as soon as one of them fails
+	failFast bool
 }
 
 func (woc *wfOperationCtx) executeSteps(nodeName string, tmplCtx *templateresolution.Context, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateHolder, boundaryID string) (*wfv1.NodeStatus, error) {
@@ -44,7 +47,8 @@ func (woc *wfOperationCtx) executeSteps(nodeName string, tmplCtx *templateresolu
 			tmpl:  tmpl,
 			scope: make(map[string]interface{}),
 		},
-		tmplCtx: tmplCtx,
+		tmplCtx:  tmplCtx,
+		failFast: tmpl.FailFast,
 	}
 	woc.addOutputsToScope("workflow", woc.wf.Status.Outputs, stepsCtx.scope)
 
@@ -173,7 +177,7 @@ func (woc *wfOperationCtx) updateOutboundNodes(nodeName string, tmpl *wfv1.Templ
 func (woc *wfOperationCtx) executeStepGroup(stepGroup []wfv1.WorkflowStep, sgNodeName string, stepsCtx *stepsContext) *wfv1.NodeStatus {
 	node := woc.getNodeByName(sgNodeName)
 	if node.Completed() {
-		woc.log.Debugf("Step group node %v already marked completed", node)
+		woc.log.Debugf("Step group node %s already marked completed", node.ID)
 		return node
 	}
 
@@ -191,11 +195,29 @@ func (woc *wfOperationCtx) executeStepGroup(stepGroup []wfv1.WorkflowStep, sgNod
 
 	// Maps nodes to their steps
 	nodeSteps := make(map[string]wfv1.WorkflowStep)
+	// Maps expanded steps to the reason their remaining items are skipped, if their failure threshold is exceeded
+	thresholdMessages := make(map[string]string)
 
 	// Kick off all parallel steps in the group
 	for _, step := range stepGroup {
 		childNodeName := fmt.Sprintf("%s.%s", sgNodeName, step.Name)
 
+		if step.FailureThreshold != nil && woc.getNodeByName(childNodeName) == nil {
+			// expanded steps are named <step>(<index>:<item>)
+			itemsName := fmt.Sprintf("%s.%s", sgNodeName, strings.SplitN(step.Name, "(", 2)[0])
+			msg, ok := thresholdMessages[itemsName]
+			if !ok {
+				msg = woc.failureThresholdMessage(step.FailureThreshold, node, itemsName)
+				thresholdMessages[itemsName] = msg
+			}
+			if msg != "" {
+				woc.log.Infof("Skipping %s: %s", childNodeName, msg)
+				woc.initializeNode(childNodeName, wfv1.NodeTypeSkipped, &step, stepsCtx.boundaryID, wfv1.NodeSkipped, msg)
+				woc.addChildNode(sgNodeName, childNodeName)
+				continue
+			}
+		}
+
 		// Check the step's when clause to decide if it should execute
 		proceed, err := shouldExecute(step.When)
 		if err != nil {
@@ -221,7 +243,7 @@ func (woc *wfOperationCtx) executeStepGroup(stepGroup []wfv1.WorkflowStep, sgNod
 			case ErrParallelismReached:
 			default:
 				errMsg := fmt.Sprintf("child '%s' errored", childNodeName)
-				woc.log.Infof("Step group node %s deemed errored due to child %s error: %s", node, childNodeName, err.Error())
+				woc.log.Infof("Step group node %s deemed errored due to child %s error: %s", node.ID, childNodeName, err.Error())
 				woc.addChildNode(sgNodeName, childNodeName)
 				return woc.markNodePhase(node.Name, wfv1.NodeError, errMsg)
 			}
@@ -233,6 +255,16 @@ func (woc *wfOperationCtx) executeStepGroup(stepGroup []wfv1.WorkflowStep, sgNod
 	}
 
 	node = woc.getNodeByName(sgNodeName)
+	if stepsCtx.failFast {
+		for _, childNodeID := range node.Children {
+			childNode := woc.wf.Status.Nodes[childNodeID]
+			step := nodeSteps[childNode.Name]
+			if childNode.Completed() && !childNode.Successful() && !step.ContinuesOn(childNode.Phase) {
+				woc.terminateIncompleteDescendants(node.Children, failFastMessage("step", childNode.DisplayName))
+				break
+			}
+		}
+	}
 	// Return if not all children completed
 	completed := true
 	for _, childNodeID := range node.Children {
@@ -258,11 +290,11 @@ func (woc *wfOperationCtx) executeStepGroup(stepGroup []wfv1.WorkflowStep, sgNod
 		step := nodeSteps[childNode.Name]
 		if !childNode.Successful() && !step.ContinuesOn(childNode.Phase) {
 			failMessage := fmt.Sprintf("child '%s' failed", childNodeID)
-			woc.log.Infof("Step group node %s deemed failed: %s", node, failMessage)
+			woc.log.Infof("Step group node %s deemed failed: %s", node.ID, failMessage)
 			return woc.markNodePhase(node.Name, wfv1.NodeFailed, failMessage)
 		}
 	}
-	woc.log.Infof("Step group node %v successful", node)
+	woc.log.Infof("Step group node %s successful", node.ID)
 	return woc.markNodePhase(node.Name, wfv1.NodeSucceeded)
 }
 
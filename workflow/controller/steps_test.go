@@ -1,12 +1,18 @@
 package controller
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/test"
+	"github.com/argoproj/argo/workflow/common"
 )
 
 // TestStepsFailedRetries ensures a steps template will recognize exhausted retries
@@ -16,3 +22,158 @@ func TestStepsFailedRetries(t *testing.T) {
 	woc.operate()
 	assert.Equal(t, string(wfv1.NodeFailed), string(woc.wf.Status.Phase))
 }
+
+var failFastSteps = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: fail-fast
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    failFast: true
+    steps:
+    - - name: a
+        template: whalesay
+      - name: b
+        template: whalesay
+      - name: c
+        template: whalesay
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestStepsFailFast verifies the siblings of a failed step are terminated
+func TestStepsFailFast(t *testing.T) {
+	controller := newController()
+	controller.restConfig = &rest.Config{}
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(failFastSteps))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if !assert.Len(t, pods.Items, 3) {
+		return
+	}
+	podPhases := map[string]apiv1.PodPhase{"a": apiv1.PodFailed, "b": apiv1.PodRunning, "c": apiv1.PodPending}
+	for _, pod := range pods.Items {
+		node := woc.wf.Status.Nodes[pod.Name]
+		pod.Status.Phase = podPhases[node.DisplayName]
+		if node.DisplayName == "b" {
+			pod.Annotations[common.AnnotationKeyExecutionControl] = `{"includeScriptOutput":true}`
+		}
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	message := "terminated since step 'a' failed"
+	// the running pod is signaled to terminate, keeping its other execution control parameters
+	b := woc.wf.Status.Nodes.FindByDisplayName("b")
+	if assert.NotNil(t, b) {
+		assert.Equal(t, wfv1.NodeRunning, b.Phase)
+		assert.Equal(t, message, b.Message)
+		pod, err := podcs.Get(b.ID, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			var execCtl common.ExecutionControl
+			assert.NoError(t, json.Unmarshal([]byte(pod.Annotations[common.AnnotationKeyExecutionControl]), &execCtl))
+			if assert.NotNil(t, execCtl.Deadline) {
+				assert.True(t, execCtl.Deadline.IsZero())
+			}
+			assert.True(t, execCtl.IncludeScriptOutput)
+		}
+	}
+	// and the pending pod is deleted
+	c := woc.wf.Status.Nodes.FindByDisplayName("c")
+	if assert.NotNil(t, c) {
+		assert.Equal(t, wfv1.NodeFailed, c.Phase)
+		assert.Equal(t, message, c.Message)
+		_, err := podcs.Get(c.ID, metav1.GetOptions{})
+		assert.True(t, apierr.IsNotFound(err))
+	}
+}
+
+var failFastRetriedSteps = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: fail-fast-retried
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    failFast: true
+    steps:
+    - - name: a
+        template: whalesay
+      - name: b
+        template: retried
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+  - name: retried
+    retryStrategy:
+      limit: 2
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestStepsFailFastRetried verifies a retried sibling of a failed step is not retried once terminated
+func TestStepsFailFastRetried(t *testing.T) {
+	controller := newController()
+	controller.restConfig = &rest.Config{}
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf, err := wfcset.Create(unmarshalWF(failFastRetriedSteps))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	setPodPhases := func(phases map[string]apiv1.PodPhase) {
+		pods, err := podcs.List(metav1.ListOptions{})
+		assert.NoError(t, err)
+		for _, pod := range pods.Items {
+			if phase, ok := phases[woc.wf.Status.Nodes[pod.Name].DisplayName]; ok {
+				pod.Status.Phase = phase
+				_, err = podcs.Update(&pod)
+				assert.NoError(t, err)
+			}
+		}
+	}
+	setPodPhases(map[string]apiv1.PodPhase{"a": apiv1.PodFailed, "b(0)": apiv1.PodRunning})
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	b := woc.wf.Status.Nodes.FindByDisplayName("b")
+	if assert.NotNil(t, b) {
+		assert.Equal(t, wfv1.NodeRunning, b.Phase)
+		assert.Equal(t, "terminated since step 'a' failed", b.Message)
+	}
+
+	// the terminated attempt fails, and is not retried
+	setPodPhases(map[string]apiv1.PodPhase{"b(0)": apiv1.PodFailed})
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+	b = woc.wf.Status.Nodes.FindByDisplayName("b")
+	if assert.NotNil(t, b) {
+		assert.Equal(t, wfv1.NodeFailed, b.Phase)
+		assert.Len(t, b.Children, 1)
+	}
+	assert.Nil(t, woc.wf.Status.Nodes.FindByDisplayName("b(1)"))
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// getSemaphoreLimit reads the limit of a semaphore from its config map in the informer cache
+func (wfc *WorkflowController) getSemaphoreLimit(namespace string, ref *apiv1.ConfigMapKeySelector) (int, error) {
+	cm, err := corelisters.NewConfigMapLister(wfc.configMapInformer.GetIndexer()).ConfigMaps(namespace).Get(ref.Name)
+	if err != nil {
+		return 0, err
+	}
+	value, ok := cm.Data[ref.Key]
+	if !ok {
+		return 0, errors.Errorf(errors.CodeBadRequest, "key %s was not found in the config map %s of the semaphore", ref.Key, ref.Name)
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, errors.Errorf(errors.CodeBadRequest, "semaphore limit '%s' of key %s of config map %s is not an integer", value, ref.Key, ref.Name)
+	}
+	return limit, nil
+}
+
+// isWaitingForLock returns whether or not the node is a placeholder for a node waiting for a lock
+func isWaitingForLock(node *wfv1.NodeStatus) bool {
+	return node.WaitingFor == wfv1.NodeWaitingForLock
+}
+
+// markNodeWaitingForLock initializes a pending placeholder node for a node waiting for a lock
+func (woc *wfOperationCtx) markNodeWaitingForLock(node *wfv1.NodeStatus, nodeName string, orgTmpl wfv1.TemplateHolder, boundaryID string, lockName string) *wfv1.NodeStatus {
+	if node == nil {
+		node = woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, orgTmpl, boundaryID, wfv1.NodePending, fmt.Sprintf("Waiting for lock %s", lockName))
+		node.WaitingFor = wfv1.NodeWaitingForLock
+	}
+	if node.SynchronizationStatus == nil || node.SynchronizationStatus.Waiting != lockName {
+		node.SynchronizationStatus = &wfv1.NodeSynchronizationStatus{Waiting: lockName}
+		woc.wf.Status.Nodes[node.ID] = *node
+		woc.updated = true
+	}
+	return node
+}
+
+// releaseLocks releases the locks held by nodes which have completed, and every lock once the workflow has completed
+func (woc *wfOperationCtx) releaseLocks() {
+	if woc.wf.Status.Completed() {
+		if woc.controller.syncManager.ReleaseAll(woc.wf) {
+			woc.log.Info("Released the locks of the workflow")
+			woc.updated = true
+		}
+		return
+	}
+	if woc.wf.Status.Synchronization == nil {
+		return
+	}
+	for _, holding := range woc.wf.Status.Synchronization.Holding {
+		if holding.Holder == "" {
+			continue
+		}
+		node, ok := woc.wf.Status.Nodes[holding.Holder]
+		if ok && !node.Completed() {
+			continue
+		}
+		if woc.controller.syncManager.Release(woc.wf, holding.Holder) {
+			woc.log.Infof("Released lock %s of node %s", holding.Lock, holding.Holder)
+			woc.updated = true
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+var mutexWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: mutex
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: whalesay
+        template: whalesay
+  - name: whalesay
+    synchronization:
+      mutex:
+        name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestTemplateMutex(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+
+	wf1, err := wfcset.Create(unmarshalWF(mutexWorkflow))
+	assert.NoError(t, err)
+	woc1 := newWorkflowOperationCtx(wf1, controller)
+	woc1.operate()
+	if assert.NotNil(t, woc1.wf.Status.Synchronization) {
+		assert.Len(t, woc1.wf.Status.Synchronization.Holding, 1)
+	}
+
+	// the second workflow waits until the first one releases the mutex
+	wf2 := unmarshalWF(mutexWorkflow)
+	wf2.Name = "mutex-again"
+	wf2, err = wfcset.Create(wf2)
+	assert.NoError(t, err)
+	woc2 := newWorkflowOperationCtx(wf2, controller)
+	woc2.operate()
+	node := woc2.wf.Status.Nodes.FindByDisplayName("whalesay")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.Equal(t, wfv1.NodeWaitingForLock, node.WaitingFor)
+		if assert.NotNil(t, node.SynchronizationStatus) {
+			assert.Equal(t, "/Mutex/whalesay", node.SynchronizationStatus.Waiting)
+		}
+	}
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 1)
+
+	for _, pod := range pods.Items {
+		pod.Status.Phase = apiv1.PodSucceeded
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+	wf1, err = wfcset.Get(wf1.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc1 = newWorkflowOperationCtx(wf1, controller)
+	woc1.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc1.wf.Status.Phase)
+	assert.Nil(t, woc1.wf.Status.Synchronization)
+
+	wf2, err = wfcset.Get(wf2.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc2 = newWorkflowOperationCtx(wf2, controller)
+	woc2.operate()
+	node = woc2.wf.Status.Nodes.FindByDisplayName("whalesay")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeTypePod, node.Type)
+		assert.Nil(t, node.SynchronizationStatus)
+	}
+	pods, err = podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+}
+
+var semaphoreWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: semaphore
+spec:
+  entrypoint: whalesay
+  synchronization:
+    semaphore:
+      configMapKeyRef:
+        name: my-config
+        key: workflow
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestWorkflowSemaphore(t *testing.T) {
+	controller := newController()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	err := controller.configMapInformer.GetIndexer().Add(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-config"},
+		Data:       map[string]string{"workflow": "1"},
+	})
+	assert.NoError(t, err)
+
+	wf1, err := wfcset.Create(unmarshalWF(semaphoreWorkflow))
+	assert.NoError(t, err)
+	woc1 := newWorkflowOperationCtx(wf1, controller)
+	woc1.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc1.wf.Status.Phase)
+
+	wf2 := unmarshalWF(semaphoreWorkflow)
+	wf2.Name = "semaphore-again"
+	wf2, err = wfcset.Create(wf2)
+	assert.NoError(t, err)
+	woc2 := newWorkflowOperationCtx(wf2, controller)
+	woc2.operate()
+	assert.Equal(t, wfv1.NodePending, woc2.wf.Status.Phase)
+	assert.Equal(t, "Waiting for lock /ConfigMap/my-config/workflow", woc2.wf.Status.Message)
+	assert.Empty(t, woc2.wf.Status.Nodes)
+
+	// once the first workflow completes, the second one runs
+	woc1.markWorkflowFailed("failed")
+	woc1.releaseLocks()
+	wf2, err = wfcset.Get(wf2.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc2 = newWorkflowOperationCtx(wf2, controller)
+	woc2.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc2.wf.Status.Phase)
+	assert.Empty(t, woc2.wf.Status.Message)
+	assert.Len(t, woc2.wf.Status.Nodes, 1)
+}
@@ -101,6 +101,10 @@ func (pq *priorityQueue) pop() *item {
 	return heap.Pop(pq).(*item)
 }
 
+func (pq *priorityQueue) peek() *item {
+	return pq.items[0]
+}
+
 func (pq *priorityQueue) add(key interface{}, priority int32, creationTime time.Time) {
 	if res, ok := pq.itemByKey[key]; ok {
 		if res.priority != priority {
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"sync"
+	"time"
+)
+
+// updateLimiter limits how often each workflow is updated. The node changes of a workflow which was updated
+// recently, e.g. of a large fan-out completing in a burst, are coalesced into a single later update.
+type updateLimiter struct {
+	lastUpdated map[string]time.Time
+	lock        *sync.Mutex
+}
+
+func newUpdateLimiter() *updateLimiter {
+	return &updateLimiter{
+		lastUpdated: make(map[string]time.Time),
+		lock:        &sync.Mutex{},
+	}
+}
+
+// delay returns how long to wait before the workflow may be updated again, if it may be updated at most once per
+// interval
+func (l *updateLimiter) delay(key string, interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return 0
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	lastUpdated, ok := l.lastUpdated[key]
+	if !ok {
+		return 0
+	}
+	return time.Until(lastUpdated.Add(interval))
+}
+
+// updated records that the workflow was updated
+func (l *updateLimiter) updated(key string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.lastUpdated[key] = time.Now()
+}
+
+// forget stops tracking the workflow, e.g. once it has completed
+func (l *updateLimiter) forget(key string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.lastUpdated, key)
+}
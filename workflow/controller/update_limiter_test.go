@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateLimiter(t *testing.T) {
+	limiter := newUpdateLimiter()
+	assert.Equal(t, time.Duration(0), limiter.delay("argo/my-wf", time.Minute))
+
+	limiter.updated("argo/my-wf")
+	delay := limiter.delay("argo/my-wf", time.Minute)
+	assert.True(t, delay > 50*time.Second && delay <= time.Minute)
+	assert.Equal(t, time.Duration(0), limiter.delay("argo/my-wf", 0))
+	assert.Equal(t, time.Duration(0), limiter.delay("argo/other-wf", time.Minute))
+
+	limiter.forget("argo/my-wf")
+	assert.Equal(t, time.Duration(0), limiter.delay("argo/my-wf", time.Minute))
+}
+
+var twoStepsWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: two-steps
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: first
+        template: whalesay
+    - - name: second
+        template: whalesay
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestUpdateDeferred verifies the workflows updated recently are still operated on, while their update is deferred
+func TestUpdateDeferred(t *testing.T) {
+	controller := newController()
+	controller.Config.NodeStatusUpdateInterval = metav1.Duration{Duration: time.Minute}
+	wfcs := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfcs.Create(unmarshalWF(twoStepsWf))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.False(t, woc.requeued)
+	makePodsPhase(t, controller.kubeclientset, "", apiv1.PodSucceeded)
+
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.True(t, woc.requeued)
+	pods, err := controller.kubeclientset.CoreV1().Pods("").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+	wf, err = wfcs.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, wf.Status.Nodes.FindByDisplayName("second"))
+}
@@ -15,11 +15,14 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo/errors"
 	"github.com/argoproj/argo/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/argo"
+	"github.com/argoproj/argo/util/logging"
 	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/util"
 )
@@ -87,16 +90,74 @@ func (woc *wfOperationCtx) hasPodSpecPatch(tmpl *wfv1.Template) bool {
 	return woc.wf.Spec.HasPodSpecPatch() || tmpl.HasPodSpecPatch()
 }
 
+// replaceCompletedPod replaces the completed pod of a previous run of a node in another cluster, which is left behind
+// when the node is retried since the retry does not reach the other clusters. A pod of another workflow of the same
+// name is neither adopted nor replaced. Otherwise, the existing pod is the pod of the node and the error of its
+// creation is returned.
+func (woc *wfOperationCtx) replaceCompletedPod(location podLocation, pod *apiv1.Pod, createErr error) (*apiv1.Pod, error) {
+	podcs := location.kubeclientset.CoreV1().Pods(location.namespace)
+	existing, err := podcs.Get(pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, createErr
+	}
+	if !woc.isClusterPodOfWorkflow(existing) {
+		return nil, errors.Errorf(errors.CodeForbidden, "pod %s in namespace %s of cluster %s belongs to workflow %s/%s", pod.Name, location.namespace, location.cluster,
+			existing.Labels[common.LabelKeyWorkflowNamespace], existing.Labels[common.LabelKeyWorkflow])
+	}
+	if existing.Labels[common.LabelKeyCompleted] != "true" {
+		return nil, createErr
+	}
+	woc.log.WithField(logging.FieldPod, pod.Name).Infof("Replacing completed pod of a previous run in cluster %s", location.cluster)
+	err = podcs.Delete(pod.Name, &metav1.DeleteOptions{
+		GracePeriodSeconds: pointer.Int64Ptr(0),
+		Preconditions:      metav1.NewUIDPreconditions(string(existing.UID)),
+	})
+	if err != nil && !apierr.IsNotFound(err) {
+		return nil, err
+	}
+	return podcs.Create(pod)
+}
+
+// applyPodSpecPatch strategically merges a yaml or json patch into the spec of a pod
+func applyPodSpecPatch(pod *apiv1.Pod, patch string) error {
+	patchJSON, err := util.ConvertYAMLToJSON(patch)
+	if err != nil {
+		return err
+	}
+	specJSON, err := json.Marshal(pod.Spec)
+	if err != nil {
+		return err
+	}
+	modJSON, err := strategicpatch.StrategicMergePatch(specJSON, []byte(patchJSON), apiv1.PodSpec{})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(modJSON, &pod.Spec)
+}
+
 func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Container, tmpl *wfv1.Template, includeScriptOutput bool) (*apiv1.Pod, error) {
 	nodeID := woc.wf.NodeID(nodeName)
-	woc.log.Debugf("Creating Pod: %s (%s)", nodeName, nodeID)
+	logCtx := woc.log.WithField(logging.FieldNodeID, nodeID)
+	logCtx.Debugf("Creating Pod: %s (%s)", nodeName, nodeID)
 	tmpl = tmpl.DeepCopy()
 	wfSpec := woc.wf.Spec.DeepCopy()
 
+	location, err := woc.getNewPodLocation(tmpl.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if location.cluster != "" && !hasFinalizer(woc.wf.ObjectMeta.Finalizers, common.FinalizerClusterPods) {
+		// the pod would be left behind once the workflow is deleted, e.g. the workflow is already being deleted
+		return nil, errors.Errorf(errors.CodeForbidden, "workflow does not have the %s finalizer which deletes its pods in cluster %s", common.FinalizerClusterPods, location.cluster)
+	}
+
 	mainCtr.Name = common.MainContainerName
 
 	var activeDeadlineSeconds *int64
 	wfDeadline := woc.getWorkflowDeadline()
+	if woc.isCleanupPod(nodeID) {
+		wfDeadline = nil
+	}
 	if wfDeadline == nil {
 		activeDeadlineSeconds = tmpl.ActiveDeadlineSeconds
 	} else {
@@ -113,7 +174,7 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 	pod := &apiv1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      nodeID,
-			Namespace: woc.wf.ObjectMeta.Namespace,
+			Namespace: location.namespace,
 			Labels: map[string]string{
 				common.LabelKeyWorkflow:  woc.wf.ObjectMeta.Name, // Allows filtering by pods related to specific workflow
 				common.LabelKeyCompleted: "false",                // Allows filtering by incomplete workflow pods
@@ -137,6 +198,11 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 		pod.Spec.HostNetwork = *woc.wf.Spec.HostNetwork
 	}
 
+	if tmpl.TerminationGracePeriodSeconds != nil {
+		// give the main container as long to stop when the pod is deleted as when the step is terminated
+		pod.Spec.TerminationGracePeriodSeconds = tmpl.TerminationGracePeriodSeconds
+	}
+
 	if woc.wf.Spec.DNSPolicy != nil {
 		pod.Spec.DNSPolicy = *woc.wf.Spec.DNSPolicy
 	}
@@ -145,6 +211,13 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 		pod.Spec.DNSConfig = woc.wf.Spec.DNSConfig
 	}
 
+	if location.cluster != "" {
+		// the workflow cannot own a pod of another cluster, which is deleted once the workflow is instead
+		pod.ObjectMeta.OwnerReferences = nil
+		pod.ObjectMeta.Labels[common.LabelKeyWorkflowNamespace] = woc.wf.ObjectMeta.Namespace
+		pod.ObjectMeta.Labels[common.LabelKeyWorkflowUID] = string(woc.wf.ObjectMeta.UID)
+	}
+
 	if woc.controller.Config.InstanceID != "" {
 		pod.ObjectMeta.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
 	}
@@ -152,16 +225,18 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 		pod.Spec.ShareProcessNamespace = pointer.BoolPtr(true)
 	}
 
-	err := woc.addArchiveLocation(pod, tmpl)
+	err = woc.addArchiveLocation(pod, tmpl)
 	if err != nil {
 		return nil, err
 	}
 
-	err = woc.setupServiceAccount(pod, tmpl)
+	err = woc.setupServiceAccount(location.kubeclientset, pod, tmpl)
 	if err != nil {
 		return nil, err
 	}
 
+	// injectedContainers are the names of the containers of the executor the controller injects
+	var injectedContainers []string
 	if tmpl.GetType() != wfv1.TemplateTypeResource {
 		// we do not need the wait container for resource templates because
 		// argoexec runs as the main container and will perform the job of
@@ -171,6 +246,10 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 			return nil, err
 		}
 		pod.Spec.Containers = append(pod.Spec.Containers, *waitCtr)
+		injectedContainers = append(injectedContainers, waitCtr.Name)
+	}
+	if tmpl.GetType() != wfv1.TemplateTypeResource {
+		addEnvDefaults(&mainCtr, wfSpec)
 	}
 	// NOTE: the order of the container list is significant. kubelet will pull, create, and start
 	// each container sequentially in the order that they appear in this list. For PNS we want the
@@ -183,6 +262,7 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 	if len(tmpl.Inputs.Artifacts) > 0 || tmpl.GetType() == wfv1.TemplateTypeScript {
 		initCtr := woc.newInitContainer(tmpl)
 		pod.Spec.InitContainers = []apiv1.Container{initCtr}
+		injectedContainers = append(injectedContainers, initCtr.Name)
 	}
 
 	addSchedulingConstraints(pod, wfSpec, tmpl)
@@ -204,11 +284,11 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 
 	// addInitContainers, addSidecars and addOutputArtifactsVolumes should be called after all
 	// volumes have been manipulated in the main container since volumeMounts are mirrored
-	err = addInitContainers(pod, tmpl)
+	err = addInitContainers(logCtx, pod, tmpl)
 	if err != nil {
 		return nil, err
 	}
-	err = addSidecars(pod, tmpl)
+	err = addSidecars(logCtx, pod, tmpl)
 	if err != nil {
 		return nil, err
 	}
@@ -245,13 +325,20 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 		}
 	}
 
-	// Apply the patch string from template
-	if woc.hasPodSpecPatch(tmpl) {
-		jsonstr, err := json.Marshal(pod.Spec)
+	// Apply the default patch of the controller first, so that the patches of the workflow and template override it
+	if woc.controller.Config.PodSpecPatch != "" {
+		err = applyPodSpecPatch(pod, woc.controller.Config.PodSpecPatch)
 		if err != nil {
-			return nil, errors.Wrap(err, "", "Fail to marshal the Pod spec")
+			return nil, errors.Wrap(err, "", "Error occurred during strategic merge patch of the controller podSpecPatch")
 		}
+	}
 
+	// The containers and volumes injected by the controller, including its own patch, are trusted by the policy unless
+	// the workflow patches them
+	injected := woc.injectedPodSpec(pod, injectedContainers)
+
+	// Apply the patch string from template
+	if woc.hasPodSpecPatch(tmpl) {
 		tmpl.PodSpecPatch, err = util.PodSpecPatchMerge(woc.wf, tmpl)
 
 		if err != nil {
@@ -274,28 +361,36 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 			return nil, errors.New("", "Invalid PodSpecPatch String")
 		}
 
-		modJson, err := strategicpatch.StrategicMergePatch(jsonstr, []byte(tmpl.PodSpecPatch), apiv1.PodSpec{})
-
+		err = applyPodSpecPatch(pod, tmpl.PodSpecPatch)
 		if err != nil {
 			return nil, errors.Wrap(err, "", "Error occurred during strategic merge patch")
 		}
-		err = json.Unmarshal(modJson, &pod.Spec)
-		if err != nil {
-			return nil, errors.Wrap(err, "", "Error in Unmarshalling after merge the patch")
+	}
+	if woc.controller.policy != nil {
+		if reason := woc.controller.policy.EvaluatePod(pod, injected); reason != "" {
+			msg := fmt.Sprintf("rejected by policy: %s", reason)
+			woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonWorkflowRejected}, msg)
+			return nil, errors.New(errors.CodeForbidden, msg)
 		}
 	}
-	created, err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.ObjectMeta.Namespace).Create(pod)
+
+	created, err := location.kubeclientset.CoreV1().Pods(location.namespace).Create(pod)
+	if apierr.IsAlreadyExists(err) && location.cluster != "" {
+		created, err = woc.replaceCompletedPod(location, pod, err)
+	}
 	if err != nil {
 		if apierr.IsAlreadyExists(err) {
 			// workflow pod names are deterministic. We can get here if the
 			// controller fails to persist the workflow after creating the pod.
-			woc.log.Infof("Skipped pod %s (%s) creation: already exists", nodeName, nodeID)
+			logCtx.Infof("Skipped pod %s (%s) creation: already exists", nodeName, nodeID)
 			return created, nil
 		}
-		woc.log.Infof("Failed to create pod %s (%s): %v", nodeName, nodeID, err)
+		logCtx.Infof("Failed to create pod %s (%s): %v", nodeName, nodeID, err)
+		woc.controller.metrics.PodCreationFailed()
+		woc.auditLogger.LogWorkflowEvent(woc.wf, argo.EventInfo{Type: apiv1.EventTypeWarning, Reason: argo.EventReasonPodCreationError}, fmt.Sprintf("Failed to create pod %s: %v", nodeName, err))
 		return nil, errors.InternalWrapError(err)
 	}
-	woc.log.Infof("Created pod: %s (%s)", nodeName, created.Name)
+	logCtx.WithField(logging.FieldPod, created.Name).Infof("Created pod: %s (%s)", nodeName, created.Name)
 	woc.activePods++
 	return created, nil
 }
@@ -327,6 +422,30 @@ func substitutePodParams(pod *apiv1.Pod, globalParams map[string]string, tmpl *w
 	return &newSpec, nil
 }
 
+// injectedPodSpec returns the containers of a pod the controller injected, by name, as they are before the patches of
+// the workflow are applied, and the host path volumes the controller creates pods with
+func (woc *wfOperationCtx) injectedPodSpec(pod *apiv1.Pod, containerNames []string) apiv1.PodSpec {
+	spec := apiv1.PodSpec{}
+	for _, name := range containerNames {
+		for _, c := range pod.Spec.InitContainers {
+			if c.Name == name {
+				spec.InitContainers = append(spec.InitContainers, *c.DeepCopy())
+			}
+		}
+		for _, c := range pod.Spec.Containers {
+			if c.Name == name {
+				spec.Containers = append(spec.Containers, *c.DeepCopy())
+			}
+		}
+	}
+	for _, v := range woc.createVolumes() {
+		if v.HostPath != nil {
+			spec.Volumes = append(spec.Volumes, v)
+		}
+	}
+	return spec
+}
+
 func (woc *wfOperationCtx) newInitContainer(tmpl *wfv1.Template) apiv1.Container {
 	ctr := woc.newExecContainer(common.InitContainerName, tmpl)
 	ctr.Command = []string{"argoexec", "init"}
@@ -387,6 +506,15 @@ func (woc *wfOperationCtx) createEnvVars() []apiv1.EnvVar {
 				FieldPath:  "metadata.name",
 			},
 		},
+	}, apiv1.EnvVar{
+		Name:  common.EnvVarWorkflowNamespace,
+		Value: woc.wf.ObjectMeta.Namespace,
+	}, apiv1.EnvVar{
+		Name:  common.EnvVarWorkflowName,
+		Value: woc.wf.ObjectMeta.Name,
+	}, apiv1.EnvVar{
+		Name:  common.EnvVarWorkflowUID,
+		Value: string(woc.wf.ObjectMeta.UID),
 	})
 	if woc.controller.Config.Executor != nil {
 		execEnvVars = append(execEnvVars, woc.controller.Config.Executor.Env...)
@@ -494,13 +622,7 @@ func (woc *wfOperationCtx) newExecContainer(name string, tmpl *wfv1.Template) *a
 		})
 		exec.Args = append(exec.Args, "--kubeconfig="+path)
 	}
-	executorServiceAccountName := ""
-	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = tmpl.Executor.ServiceAccountName
-	} else if woc.wf.Spec.Executor != nil && woc.wf.Spec.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = woc.wf.Spec.Executor.ServiceAccountName
-	}
-	if executorServiceAccountName != "" {
+	if woc.executorServiceAccountName(tmpl) != "" {
 		exec.VolumeMounts = append(exec.VolumeMounts, apiv1.VolumeMount{
 			Name:      common.ServiceAccountTokenVolumeName,
 			MountPath: common.ServiceAccountTokenMountPath,
@@ -514,8 +636,22 @@ func isResourcesSpecified(ctr *apiv1.Container) bool {
 	return ctr != nil && (ctr.Resources.Limits.Cpu() != nil || ctr.Resources.Limits.Memory() != nil)
 }
 
-// addMetadata applies metadata specified in the template
+// addMetadata applies the pod metadata of the workflowDefaults of the controller configuration, and the metadata
+// specified in the template
 func (woc *wfOperationCtx) addMetadata(pod *apiv1.Pod, tmpl *wfv1.Template, includeScriptOutput bool) {
+	// the defaults do not override the labels and annotations the controller sets, e.g. the name of the workflow
+	if podMetadata := woc.controller.Config.GetWorkflowDefaults(woc.wf.ObjectMeta.Namespace).PodMetadata; podMetadata != nil {
+		for k, v := range podMetadata.Annotations {
+			if _, ok := pod.ObjectMeta.Annotations[k]; !ok {
+				pod.ObjectMeta.Annotations[k] = v
+			}
+		}
+		for k, v := range podMetadata.Labels {
+			if _, ok := pod.ObjectMeta.Labels[k]; !ok {
+				pod.ObjectMeta.Labels[k] = v
+			}
+		}
+	}
 	for k, v := range tmpl.Metadata.Annotations {
 		pod.ObjectMeta.Annotations[k] = v
 	}
@@ -527,11 +663,15 @@ func (woc *wfOperationCtx) addMetadata(pod *apiv1.Pod, tmpl *wfv1.Template, incl
 		IncludeScriptOutput: includeScriptOutput,
 	}
 
-	if woc.workflowDeadline != nil {
-		execCtl.Deadline = woc.workflowDeadline
+	deadline := woc.workflowDeadline
+	if woc.isCleanupPod(pod.ObjectMeta.Name) {
+		deadline = nil
+	}
+	if deadline != nil {
+		execCtl.Deadline = deadline
 
 	}
-	if woc.workflowDeadline != nil || includeScriptOutput {
+	if deadline != nil || includeScriptOutput {
 		execCtlBytes, err := json.Marshal(execCtl)
 		if err != nil {
 			panic(err)
@@ -541,6 +681,29 @@ func (woc *wfOperationCtx) addMetadata(pod *apiv1.Pod, tmpl *wfv1.Template, incl
 	}
 }
 
+// addEnvDefaults adds the workflow level env and envFrom to the main container. Anything defined by the template's
+// container takes precedence.
+func addEnvDefaults(ctr *apiv1.Container, wfSpec *wfv1.WorkflowSpec) {
+	if len(wfSpec.EnvFrom) > 0 {
+		// when a key exists in multiple sources, the last source takes precedence
+		ctr.EnvFrom = append(append([]apiv1.EnvFromSource{}, wfSpec.EnvFrom...), ctr.EnvFrom...)
+	}
+	if len(wfSpec.Env) > 0 {
+		defined := make(map[string]bool)
+		for _, env := range ctr.Env {
+			defined[env.Name] = true
+		}
+		var env []apiv1.EnvVar
+		for _, wfEnv := range wfSpec.Env {
+			if !defined[wfEnv.Name] {
+				env = append(env, wfEnv)
+			}
+		}
+		// the workflow's variables go first, so that the template's variables can refer to them
+		ctr.Env = append(env, ctr.Env...)
+	}
+}
+
 // addSchedulingConstraints applies any node selectors or affinity rules to the pod, either set in the workflow or the template
 func addSchedulingConstraints(pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, tmpl *wfv1.Template) {
 	// Set nodeSelector (if specified)
@@ -894,8 +1057,8 @@ func (woc *wfOperationCtx) addArchiveLocation(pod *apiv1.Pod, tmpl *wfv1.Templat
 	return nil
 }
 
-// setupServiceAccount sets up service account and token.
-func (woc *wfOperationCtx) setupServiceAccount(pod *apiv1.Pod, tmpl *wfv1.Template) error {
+// setupServiceAccount sets up service account and token, in the cluster of the given client.
+func (woc *wfOperationCtx) setupServiceAccount(kubeclientset kubernetes.Interface, pod *apiv1.Pod, tmpl *wfv1.Template) error {
 	if tmpl.ServiceAccountName != "" {
 		pod.Spec.ServiceAccountName = tmpl.ServiceAccountName
 	} else if woc.wf.Spec.ServiceAccountName != "" {
@@ -912,15 +1075,11 @@ func (woc *wfOperationCtx) setupServiceAccount(pod *apiv1.Pod, tmpl *wfv1.Templa
 		pod.Spec.AutomountServiceAccountToken = automountServiceAccountToken
 	}
 
-	executorServiceAccountName := ""
-	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = tmpl.Executor.ServiceAccountName
-	} else if woc.wf.Spec.Executor != nil && woc.wf.Spec.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = woc.wf.Spec.Executor.ServiceAccountName
-	}
+	executorServiceAccountName := woc.executorServiceAccountName(tmpl)
 	if executorServiceAccountName != "" {
-		tokenName, err := common.GetServiceAccountTokenName(woc.controller.kubeclientset, pod.Namespace, executorServiceAccountName)
+		tokenName, err := common.GetServiceAccountTokenName(kubeclientset, pod.Namespace, executorServiceAccountName)
 		if err != nil {
+			woc.controller.forgetExecutorServiceAccount(pod.Namespace, executorServiceAccountName)
 			return err
 		}
 		pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{
@@ -937,6 +1096,21 @@ func (woc *wfOperationCtx) setupServiceAccount(pod *apiv1.Pod, tmpl *wfv1.Templa
 	return nil
 }
 
+// executorServiceAccountName returns the service account of the executor of the template, which defaults to the one
+// of the workflow, and then to the one configured for the namespace
+func (woc *wfOperationCtx) executorServiceAccountName(tmpl *wfv1.Template) string {
+	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
+		return tmpl.Executor.ServiceAccountName
+	}
+	if woc.wf.Spec.Executor != nil && woc.wf.Spec.Executor.ServiceAccountName != "" {
+		return woc.wf.Spec.Executor.ServiceAccountName
+	}
+	if sa := woc.controller.Config.GetExecutorServiceAccount(woc.wf.Namespace); sa != nil {
+		return sa.Name
+	}
+	return ""
+}
+
 // addScriptStagingVolume sets up a shared staging volume between the init container
 // and main container for the purpose of holding the script source code for script templates
 func addScriptStagingVolume(pod *apiv1.Pod) {
@@ -980,7 +1154,7 @@ func addScriptStagingVolume(pod *apiv1.Pod) {
 
 // addInitContainers adds all init containers to the pod spec of the step
 // Optionally volume mounts from the main container to the init containers
-func addInitContainers(pod *apiv1.Pod, tmpl *wfv1.Template) error {
+func addInitContainers(logCtx *log.Entry, pod *apiv1.Pod, tmpl *wfv1.Template) error {
 	if len(tmpl.InitContainers) == 0 {
 		return nil
 	}
@@ -989,9 +1163,9 @@ func addInitContainers(pod *apiv1.Pod, tmpl *wfv1.Template) error {
 		panic("Unable to locate main container")
 	}
 	for _, ctr := range tmpl.InitContainers {
-		log.Debugf("Adding init container %s", ctr.Name)
+		logCtx.Debugf("Adding init container %s", ctr.Name)
 		if ctr.MirrorVolumeMounts != nil && *ctr.MirrorVolumeMounts {
-			mirrorVolumeMounts(mainCtr, &ctr.Container)
+			mirrorVolumeMounts(logCtx, mainCtr, &ctr.Container)
 		}
 		pod.Spec.InitContainers = append(pod.Spec.InitContainers, ctr.Container)
 	}
@@ -1000,7 +1174,7 @@ func addInitContainers(pod *apiv1.Pod, tmpl *wfv1.Template) error {
 
 // addSidecars adds all sidecars to the pod spec of the step.
 // Optionally volume mounts from the main container to the sidecar
-func addSidecars(pod *apiv1.Pod, tmpl *wfv1.Template) error {
+func addSidecars(logCtx *log.Entry, pod *apiv1.Pod, tmpl *wfv1.Template) error {
 	if len(tmpl.Sidecars) == 0 {
 		return nil
 	}
@@ -1009,9 +1183,9 @@ func addSidecars(pod *apiv1.Pod, tmpl *wfv1.Template) error {
 		panic("Unable to locate main container")
 	}
 	for _, sidecar := range tmpl.Sidecars {
-		log.Debugf("Adding sidecar container %s", sidecar.Name)
+		logCtx.Debugf("Adding sidecar container %s", sidecar.Name)
 		if sidecar.MirrorVolumeMounts != nil && *sidecar.MirrorVolumeMounts {
-			mirrorVolumeMounts(mainCtr, &sidecar.Container)
+			mirrorVolumeMounts(logCtx, mainCtr, &sidecar.Container)
 		}
 		pod.Spec.Containers = append(pod.Spec.Containers, sidecar.Container)
 	}
@@ -1145,12 +1319,12 @@ func findMainContainer(pod *apiv1.Pod) *apiv1.Container {
 }
 
 // mirrorVolumeMounts mirrors volumeMounts of source container to target container
-func mirrorVolumeMounts(sourceContainer, targetContainer *apiv1.Container) {
+func mirrorVolumeMounts(logCtx *log.Entry, sourceContainer, targetContainer *apiv1.Container) {
 	for _, volMnt := range sourceContainer.VolumeMounts {
 		if targetContainer.VolumeMounts == nil {
 			targetContainer.VolumeMounts = make([]apiv1.VolumeMount, 0)
 		}
-		log.Debugf("Adding volume mount %v to container %v", volMnt.Name, targetContainer.Name)
+		logCtx.Debugf("Adding volume mount %v to container %v", volMnt.Name, targetContainer.Name)
 		targetContainer.VolumeMounts = append(targetContainer.VolumeMounts, volMnt)
 
 	}
@@ -313,6 +313,25 @@ func TestTolerations(t *testing.T) {
 	assert.Equal(t, pod.Spec.Tolerations[0].Key, "nvidia.com/gpu")
 }
 
+// TestTmplLevelSchedulingConstraints verifies that template level scheduling constraints replace the workflow level ones
+func TestTmplLevelSchedulingConstraints(t *testing.T) {
+	woc := newWoc()
+	woc.wf.Spec.NodeSelector = map[string]string{"node-pool": "standard"}
+	woc.wf.Spec.Tolerations = []apiv1.Toleration{{Key: "dedicated", Operator: "Exists"}}
+	woc.wf.Spec.Templates[0].NodeSelector = map[string]string{"node-pool": "gpu"}
+	woc.wf.Spec.Templates[0].Tolerations = []apiv1.Toleration{{Key: "nvidia.com/gpu", Operator: "Exists", Effect: "NoSchedule"}}
+	_, err := woc.executeContainer(woc.wf.Spec.Entrypoint, woc.tmplCtx.GetCurrentTemplateBase().GetTemplateScope(), &woc.wf.Spec.Templates[0], &woc.wf.Spec.Templates[0], "")
+	assert.NoError(t, err)
+	pods, err := woc.controller.kubeclientset.CoreV1().Pods("").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+	assert.Equal(t, map[string]string{"node-pool": "gpu"}, pod.Spec.NodeSelector)
+	if assert.Len(t, pod.Spec.Tolerations, 1) {
+		assert.Equal(t, "nvidia.com/gpu", pod.Spec.Tolerations[0].Key)
+	}
+}
+
 // TestMetadata verifies ability to carry forward annotations and labels
 func TestMetadata(t *testing.T) {
 	woc := newWoc()
@@ -333,6 +352,22 @@ func TestMetadata(t *testing.T) {
 	}
 }
 
+func TestDefaultPodMetadata(t *testing.T) {
+	woc := newWoc()
+	woc.controller.Config.WorkflowDefaults = &config.WorkflowDefaults{PodMetadata: &wfv1.Metadata{
+		Labels:      map[string]string{"team": "data", common.LabelKeyWorkflow: "other"},
+		Annotations: map[string]string{"cost-center": "1234"},
+	}}
+	woc.wf.Spec.Templates[0].Metadata = wfv1.Metadata{Labels: map[string]string{"team": "ml"}}
+	mainCtr := woc.wf.Spec.Templates[0].Container
+	pod, err := woc.createWorkflowPod(woc.wf.Name, *mainCtr, &woc.wf.Spec.Templates[0], false)
+	assert.NoError(t, err)
+	assert.Equal(t, "1234", pod.ObjectMeta.Annotations["cost-center"])
+	// the metadata of the template, and the labels of the controller, take precedence
+	assert.Equal(t, "ml", pod.ObjectMeta.Labels["team"])
+	assert.Equal(t, woc.wf.Name, pod.ObjectMeta.Labels[common.LabelKeyWorkflow])
+}
+
 // TestWorkflowControllerArchiveConfig verifies archive location substitution of workflow
 func TestWorkflowControllerArchiveConfig(t *testing.T) {
 	woc := newWoc()
@@ -892,6 +927,31 @@ func TestTmplLevelSecurityContext(t *testing.T) {
 	assert.Equal(t, runAsUser, *pod.Spec.SecurityContext.RunAsUser)
 }
 
+// TestWFLevelEnv verifies the ability to carry forward workflow level env and envFrom to the main container
+func TestWFLevelEnv(t *testing.T) {
+	woc := newWoc()
+	woc.wf.Spec.Env = []apiv1.EnvVar{{Name: "REGION", Value: "us-west-2"}, {Name: "LOG_LEVEL", Value: "info"}}
+	woc.wf.Spec.EnvFrom = []apiv1.EnvFromSource{{SecretRef: &apiv1.SecretEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "credentials"}}}}
+	woc.wf.Spec.Templates[0].Container.Env = []apiv1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}}
+	_, err := woc.executeContainer(woc.wf.Spec.Entrypoint, woc.tmplCtx.GetCurrentTemplateBase().GetTemplateScope(), &woc.wf.Spec.Templates[0], &woc.wf.Spec.Templates[0], "")
+	assert.NoError(t, err)
+	pods, err := woc.controller.kubeclientset.CoreV1().Pods("").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+	for _, ctr := range pod.Spec.Containers {
+		if ctr.Name != common.MainContainerName {
+			assert.Empty(t, ctr.EnvFrom)
+			continue
+		}
+		assert.Equal(t, []apiv1.EnvVar{{Name: "REGION", Value: "us-west-2"}, {Name: "LOG_LEVEL", Value: "debug"}}, ctr.Env)
+		if assert.Len(t, ctr.EnvFrom, 1) {
+			assert.Equal(t, "credentials", ctr.EnvFrom[0].SecretRef.Name)
+		}
+	}
+	assert.Len(t, woc.wf.Spec.Templates[0].Container.Env, 1, "the template is not modified")
+}
+
 var helloWorldWfWithPatch = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -968,3 +1028,26 @@ func TestPodSpecPatch(t *testing.T) {
 	assert.Equal(t, "104857600", pod.Spec.Containers[1].Resources.Limits.Memory().AsDec().String())
 
 }
+
+func TestControllerPodSpecPatch(t *testing.T) {
+	wf := unmarshalWF(helloWorldWfWithWFYAMLPatch)
+	woc := newWoc(*wf)
+	woc.controller.Config.PodSpecPatch = `
+priorityClassName: workflows
+containers:
+- name: main
+  resources:
+    limits:
+      cpu: "500m"
+`
+	mainCtr := woc.wf.Spec.Templates[0].Container
+	pod, err := woc.createWorkflowPod(wf.Name, *mainCtr, &wf.Spec.Templates[0], false)
+	assert.NoError(t, err)
+	assert.Equal(t, "workflows", pod.Spec.PriorityClassName)
+	// the patch of the workflow overrides the one of the controller
+	assert.Equal(t, "0.800", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
+
+	woc.controller.Config.PodSpecPatch = "invalid"
+	_, err = woc.createWorkflowPod(wf.Name, *mainCtr, &wf.Spec.Templates[0], false)
+	assert.Error(t, err)
+}
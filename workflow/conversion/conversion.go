@@ -0,0 +1,141 @@
+package conversion
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo/errors"
+	"github.com/argoproj/argo/pkg/apis/workflow"
+)
+
+// HubVersion is the version the resources are stored in, which every other version is converted to and from
+const HubVersion = workflow.Group + "/v1alpha1"
+
+// Func converts the content of a resource between a version and the hub version, in place
+type Func func(obj map[string]interface{}) error
+
+// version is a version of the resources, with the functions converting them to the hub version and back, which are
+// nil for the hub version itself
+type version struct {
+	toHub   Func
+	fromHub Func
+}
+
+// Converter converts workflows, workflow templates and cron workflows between the versions registered with it. Every
+// conversion goes through the hub version, so that each version only needs to be convertible to and from the hub
+// version, rather than to and from every other version. Converting a resource to a version and back must return the
+// same resource, since the API server converts the stored resources to the version of every request and the resources
+// of every request to the hub version.
+type Converter struct {
+	versions map[string]version
+}
+
+// NewConverter returns a converter which only knows of the hub version
+func NewConverter() *Converter {
+	return &Converter{versions: map[string]version{HubVersion: {}}}
+}
+
+// Register adds a version, e.g. argoproj.io/v1beta1, with the functions converting its resources to the hub version
+// and back
+func (c *Converter) Register(apiVersion string, toHub, fromHub Func) {
+	c.versions[apiVersion] = version{toHub: toHub, fromHub: fromHub}
+}
+
+// Versions returns the versions the converter converts between, sorted
+func (c *Converter) Versions() []string {
+	versions := make([]string, 0, len(c.versions))
+	for apiVersion := range c.versions {
+		versions = append(versions, apiVersion)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// Convert converts a resource to the given version, in place
+func (c *Converter) Convert(obj *unstructured.Unstructured, apiVersion string) error {
+	from := obj.GetAPIVersion()
+	if from == apiVersion {
+		return nil
+	}
+	source, ok := c.versions[from]
+	if !ok {
+		return errors.Errorf(errors.CodeBadRequest, "cannot convert %s %s from unknown version %s", obj.GetKind(), obj.GetName(), from)
+	}
+	target, ok := c.versions[apiVersion]
+	if !ok {
+		return errors.Errorf(errors.CodeBadRequest, "cannot convert %s %s to unknown version %s", obj.GetKind(), obj.GetName(), apiVersion)
+	}
+	for _, convert := range []Func{source.toHub, target.fromHub} {
+		if convert == nil {
+			continue
+		}
+		err := convert(obj.Object)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "failed to convert %s %s from %s to %s: %v", obj.GetKind(), obj.GetName(), from, apiVersion, err)
+		}
+	}
+	obj.SetAPIVersion(apiVersion)
+	return nil
+}
+
+// ForEachTemplate calls fn with every template of a workflow, workflow template or cron workflow, including the
+// templates stored in the status of a workflow
+func ForEachTemplate(obj map[string]interface{}, fn func(tmpl map[string]interface{}) error) error {
+	for _, path := range [][]string{{"spec", "templates"}, {"spec", "workflowSpec", "templates"}} {
+		tmpls, _, err := unstructured.NestedSlice(obj, path...)
+		if err != nil {
+			return err
+		}
+		for _, tmpl := range tmpls {
+			if tmpl, ok := tmpl.(map[string]interface{}); ok {
+				if err := fn(tmpl); err != nil {
+					return err
+				}
+			}
+		}
+		if tmpls != nil {
+			// NestedSlice returns a copy of the slice, whose maps were changed in place
+			if err := unstructured.SetNestedSlice(obj, tmpls, path...); err != nil {
+				return err
+			}
+		}
+	}
+	storedTmpls, _, err := unstructured.NestedMap(obj, "status", "storedTemplates")
+	if err != nil {
+		return err
+	}
+	for _, tmpl := range storedTmpls {
+		if tmpl, ok := tmpl.(map[string]interface{}); ok {
+			if err := fn(tmpl); err != nil {
+				return err
+			}
+		}
+	}
+	if storedTmpls != nil {
+		return unstructured.SetNestedMap(obj, storedTmpls, "status", "storedTemplates")
+	}
+	return nil
+}
+
+// RenameTemplateField returns the functions converting a version whose templates name a field of the hub version
+// differently, e.g. a field renamed in a later version
+func RenameTemplateField(hubName, name string) (toHub Func, fromHub Func) {
+	rename := func(from, to string) Func {
+		return func(obj map[string]interface{}) error {
+			return ForEachTemplate(obj, func(tmpl map[string]interface{}) error {
+				value, ok := tmpl[from]
+				if !ok {
+					return nil
+				}
+				if _, ok := tmpl[to]; ok {
+					return errors.Errorf(errors.CodeBadRequest, "template %v sets both %s and %s", tmpl["name"], from, to)
+				}
+				delete(tmpl, from)
+				tmpl[to] = value
+				return nil
+			})
+		}
+	}
+	return rename(name, hubName), rename(hubName, name)
+}
@@ -0,0 +1,109 @@
+package conversion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// the next version renames podSpecPatch to podPatch
+const nextVersion = "argoproj.io/v1beta1"
+
+func newTestConverter() *Converter {
+	c := NewConverter()
+	toHub, fromHub := RenameTemplateField("podSpecPatch", "podPatch")
+	c.Register(nextVersion, toHub, fromHub)
+	return c
+}
+
+func unmarshal(t *testing.T, text string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	err := yaml.Unmarshal([]byte(text), &obj.Object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+var hubWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: patched
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    podSpecPatch: '{"terminationGracePeriodSeconds": 5}'
+    container:
+      image: busybox
+status:
+  storedTemplates:
+    namespaced/main:
+      name: main
+      podSpecPatch: '{"terminationGracePeriodSeconds": 5}'
+`
+
+func TestConvert(t *testing.T) {
+	c := newTestConverter()
+	assert.Equal(t, []string{"argoproj.io/v1alpha1", "argoproj.io/v1beta1"}, c.Versions())
+
+	obj := unmarshal(t, hubWorkflow)
+	err := c.Convert(obj, nextVersion)
+	if assert.NoError(t, err) {
+		assert.Equal(t, nextVersion, obj.GetAPIVersion())
+		tmpls, _, _ := unstructured.NestedSlice(obj.Object, "spec", "templates")
+		tmpl := tmpls[0].(map[string]interface{})
+		assert.NotContains(t, tmpl, "podSpecPatch")
+		assert.Equal(t, `{"terminationGracePeriodSeconds": 5}`, tmpl["podPatch"])
+		storedTmpl, _, _ := unstructured.NestedMap(obj.Object, "status", "storedTemplates", "namespaced/main")
+		assert.Contains(t, storedTmpl, "podPatch")
+	}
+
+	// a round trip returns the same resource
+	err = c.Convert(obj, HubVersion)
+	if assert.NoError(t, err) {
+		assert.Equal(t, unmarshal(t, hubWorkflow), obj)
+	}
+}
+
+func TestConvertCronWorkflow(t *testing.T) {
+	obj := unmarshal(t, `
+apiVersion: argoproj.io/v1beta1
+kind: CronWorkflow
+metadata:
+  name: patched
+spec:
+  schedule: "* * * * *"
+  workflowSpec:
+    templates:
+    - name: main
+      podPatch: '{}'
+`)
+	err := newTestConverter().Convert(obj, HubVersion)
+	if assert.NoError(t, err) {
+		tmpls, _, _ := unstructured.NestedSlice(obj.Object, "spec", "workflowSpec", "templates")
+		assert.Equal(t, map[string]interface{}{"name": "main", "podSpecPatch": "{}"}, tmpls[0])
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	c := newTestConverter()
+	err := c.Convert(unmarshal(t, hubWorkflow), "argoproj.io/v2")
+	assert.EqualError(t, err, "cannot convert Workflow patched to unknown version argoproj.io/v2")
+
+	obj := unmarshal(t, hubWorkflow)
+	obj.SetAPIVersion("argoproj.io/v2")
+	err = c.Convert(obj, HubVersion)
+	assert.EqualError(t, err, "cannot convert Workflow patched from unknown version argoproj.io/v2")
+
+	obj = unmarshal(t, hubWorkflow)
+	obj.SetAPIVersion(nextVersion)
+	tmpls, _, _ := unstructured.NestedSlice(obj.Object, "spec", "templates")
+	tmpls[0].(map[string]interface{})["podPatch"] = "{}"
+	_ = unstructured.SetNestedSlice(obj.Object, tmpls, "spec", "templates")
+	err = c.Convert(obj, HubVersion)
+	assert.EqualError(t, err, "failed to convert Workflow patched from argoproj.io/v1beta1 to argoproj.io/v1alpha1: template main sets both podPatch and podSpecPatch")
+}
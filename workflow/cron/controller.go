@@ -28,6 +28,7 @@ import (
 type Controller struct {
 	namespace        string
 	managedNamespace string
+	instanceID       string
 	cron             *cron.Cron
 	nameEntryIDMap   map[string]cron.EntryID
 	wfClientset      versioned.Interface
@@ -49,11 +50,13 @@ func NewCronController(
 	restConfig *rest.Config,
 	namespace string,
 	managedNamespace string,
+	instanceID string,
 ) *Controller {
 	return &Controller{
 		wfClientset:      wfclientset,
 		namespace:        namespace,
 		managedNamespace: managedNamespace,
+		instanceID:       instanceID,
 		cron:             cron.New(),
 		restConfig:       restConfig,
 		nameEntryIDMap:   make(map[string]cron.EntryID),
@@ -67,10 +70,10 @@ func (cc *Controller) Run(ctx context.Context) {
 	defer cc.wfQueue.ShutDown()
 	log.Infof("Starting CronWorkflow controller")
 
-	cc.cronWfInformer = externalversions.NewSharedInformerFactoryWithOptions(cc.wfClientset, cronWorkflowResyncPeriod, externalversions.WithNamespace(cc.managedNamespace)).Argoproj().V1alpha1().CronWorkflows()
+	cc.cronWfInformer = externalversions.NewSharedInformerFactoryWithOptions(cc.wfClientset, cronWorkflowResyncPeriod, externalversions.WithNamespace(cc.managedNamespace), externalversions.WithTweakListOptions(cc.cronWfInformerListOptionsFunc)).Argoproj().V1alpha1().CronWorkflows()
 	cc.addCronWorkflowInformerHandler()
 
-	cc.wfInformer = util.NewWorkflowInformer(cc.restConfig, cc.managedNamespace, cronWorkflowResyncPeriod, wfInformerListOptionsFunc)
+	cc.wfInformer = util.NewWorkflowInformer(cc.restConfig, cc.managedNamespace, cronWorkflowResyncPeriod, cc.wfInformerListOptionsFunc)
 	cc.addWorkflowInformerHandler()
 
 	cc.cron.Start()
@@ -267,12 +270,21 @@ func (cc *Controller) addWorkflowInformerHandler() {
 	)
 }
 
-func wfInformerListOptionsFunc(options *v1.ListOptions) {
+// cronWfInformerListOptionsFunc limits the CronWorkflows to the ones of the controller instance, so that several
+// controllers in the same cluster do not all schedule them
+func (cc *Controller) cronWfInformerListOptionsFunc(options *v1.ListOptions) {
+	labelSelector := labels.NewSelector().Add(util.InstanceIDRequirement(cc.instanceID))
+	options.LabelSelector = labelSelector.String()
+}
+
+func (cc *Controller) wfInformerListOptionsFunc(options *v1.ListOptions) {
 	options.FieldSelector = fields.Everything().String()
 	isCronWorkflowChildReq, err := labels.NewRequirement(common.LabelCronWorkflow, selection.Exists, []string{})
 	if err != nil {
 		panic(err)
 	}
-	labelSelector := labels.NewSelector().Add(*isCronWorkflowChildReq)
+	labelSelector := labels.NewSelector().
+		Add(*isCronWorkflowChildReq).
+		Add(util.InstanceIDRequirement(cc.instanceID))
 	options.LabelSelector = labelSelector.String()
 }
@@ -89,14 +89,14 @@ func TerminatePodWithContainerID(c KubernetesClientInterface, containerID string
 	return c.KillContainer(pod, container, sig)
 }
 
-// KillGracefully kills a container gracefully.
-func KillGracefully(c KubernetesClientInterface, containerID string) error {
-	log.Infof("SIGTERM containerID %q: %s", containerID, syscall.SIGTERM.String())
-	err := TerminatePodWithContainerID(c, containerID, syscall.SIGTERM)
+// KillGracefully kills a container gracefully, sending the given signal and then SIGKILL after the grace period.
+func KillGracefully(c KubernetesClientInterface, containerID string, sig syscall.Signal, gracePeriod time.Duration) error {
+	log.Infof("Signal containerID %q: %s", containerID, sig.String())
+	err := TerminatePodWithContainerID(c, containerID, sig)
 	if err != nil {
 		return err
 	}
-	err = WaitForTermination(c, containerID, time.Second*KillGracePeriod)
+	err = WaitForTermination(c, containerID, gracePeriod)
 	if err == nil {
 		log.Infof("ContainerID %q successfully killed", containerID)
 		return nil
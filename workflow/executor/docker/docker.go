@@ -7,6 +7,8 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"strconv"
+	"syscall"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -15,7 +17,6 @@ import (
 	"github.com/argoproj/argo/util"
 	"github.com/argoproj/argo/util/file"
 	"github.com/argoproj/argo/workflow/common"
-	execcommon "github.com/argoproj/argo/workflow/executor/common"
 )
 
 type DockerExecutor struct{}
@@ -95,9 +96,9 @@ func (d *DockerExecutor) Wait(containerID string) error {
 	return common.RunCommand("docker", "wait", containerID)
 }
 
-// killContainers kills a list of containerIDs first with a SIGTERM then with a SIGKILL after a grace period
-func (d *DockerExecutor) Kill(containerIDs []string) error {
-	killArgs := append([]string{"kill", "--signal", "TERM"}, containerIDs...)
+// Kill kills a list of containerIDs first with the given signal then with a SIGKILL after the grace period
+func (d *DockerExecutor) Kill(containerIDs []string, sig syscall.Signal, gracePeriod time.Duration) error {
+	killArgs := append([]string{"kill", "--signal", strconv.Itoa(int(sig))}, containerIDs...)
 	err := common.RunCommand("docker", killArgs...)
 	if err != nil {
 		return errors.InternalWrapError(err)
@@ -111,8 +112,8 @@ func (d *DockerExecutor) Kill(containerIDs []string) error {
 	// waitCmd.Wait() might return error "signal: killed" when we SIGKILL the process
 	// We ignore errors in this case
 	//ignoreWaitError := false
-	timer := time.AfterFunc(execcommon.KillGracePeriod*time.Second, func() {
-		log.Infof("Timed out (%ds) for containers to terminate gracefully. Killing forcefully", execcommon.KillGracePeriod)
+	timer := time.AfterFunc(gracePeriod, func() {
+		log.Infof("Timed out (%v) for containers to terminate gracefully. Killing forcefully", gracePeriod)
 		forceKillArgs := append([]string{"kill", "--signal", "KILL"}, containerIDs...)
 		forceKillCmd := exec.Command("docker", forceKillArgs...)
 		log.Info(forceKillCmd.Args)
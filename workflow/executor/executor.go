@@ -4,11 +4,11 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"net/url"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 
+	argoversion "github.com/argoproj/argo"
 	"github.com/argoproj/argo/errors"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/util"
@@ -35,6 +36,7 @@ import (
 	"github.com/argoproj/argo/util/retry"
 	artifact "github.com/argoproj/argo/workflow/artifacts"
 	"github.com/argoproj/argo/workflow/common"
+	execcommon "github.com/argoproj/argo/workflow/executor/common"
 )
 
 const (
@@ -61,6 +63,8 @@ type WorkflowExecutor struct {
 	// list of errors that occurred during execution.
 	// the first of these is used as the overall message of the node
 	errors []error
+	// log has the fields of the workflow and of the pod
+	log *log.Entry
 }
 
 // ContainerRuntimeExecutor is the interface for interacting with a container runtime (e.g. docker)
@@ -82,12 +86,12 @@ type ContainerRuntimeExecutor interface {
 	// Wait waits for the container to complete
 	Wait(containerID string) error
 
-	// Kill a list of containerIDs first with a SIGTERM then with a SIGKILL after a grace period
-	Kill(containerIDs []string) error
+	// Kill a list of containerIDs first with the given signal then with a SIGKILL after the grace period
+	Kill(containerIDs []string, sig syscall.Signal, gracePeriod time.Duration) error
 }
 
 // NewExecutor instantiates a new workflow executor
-func NewExecutor(clientset kubernetes.Interface, podName, namespace, podAnnotationsPath string, cre ContainerRuntimeExecutor, template wfv1.Template) WorkflowExecutor {
+func NewExecutor(clientset kubernetes.Interface, podName, namespace, podAnnotationsPath string, cre ContainerRuntimeExecutor, template wfv1.Template, logCtx *log.Entry) WorkflowExecutor {
 	return WorkflowExecutor{
 		PodName:            podName,
 		ClientSet:          clientset,
@@ -98,6 +102,7 @@ func NewExecutor(clientset kubernetes.Interface, podName, namespace, podAnnotati
 		memoizedConfigMaps: map[string]string{},
 		memoizedSecrets:    map[string][]byte{},
 		errors:             []error{},
+		log:                logCtx,
 	}
 }
 
@@ -106,7 +111,7 @@ func (we *WorkflowExecutor) HandleError() {
 	if r := recover(); r != nil {
 		_ = we.AddAnnotation(common.AnnotationKeyNodeMessage, fmt.Sprintf("%v", r))
 		util.WriteTeriminateMessage(fmt.Sprintf("%v", r))
-		log.Fatalf("executor panic: %+v\n%s", r, debug.Stack())
+		we.log.Fatalf("executor panic: %+v\n%s", r, debug.Stack())
 	} else {
 		if len(we.errors) > 0 {
 			util.WriteTeriminateMessage(we.errors[0].Error())
@@ -117,15 +122,15 @@ func (we *WorkflowExecutor) HandleError() {
 
 // LoadArtifacts loads artifacts from location to a container path
 func (we *WorkflowExecutor) LoadArtifacts() error {
-	log.Infof("Start loading input artifacts...")
+	we.log.Infof("Start loading input artifacts...")
 
 	for _, art := range we.Template.Inputs.Artifacts {
 
-		log.Infof("Downloading artifact: %s", art.Name)
+		we.log.Infof("Downloading artifact: %s", art.Name)
 
 		if !art.HasLocation() {
 			if art.Optional {
-				log.Warnf("Ignoring optional artifact '%s' which was not supplied", art.Name)
+				we.log.Warnf("Ignoring optional artifact '%s' which was not supplied", art.Name)
 				continue
 			} else {
 				return errors.New("required artifact %s not supplied", art.Name)
@@ -149,7 +154,7 @@ func (we *WorkflowExecutor) LoadArtifacts() error {
 			// mounts, we need to load the artifact into the user specified volume mount,
 			// as opposed to the `input-artifacts` volume that is an implementation detail
 			// unbeknownst to the user.
-			log.Infof("Specified artifact path %s overlaps with volume mount at %s. Extracting to volume mount", art.Path, mnt.MountPath)
+			we.log.Infof("Specified artifact path %s overlaps with volume mount at %s. Extracting to volume mount", art.Path, mnt.MountPath)
 			artPath = path.Join(common.ExecutorMainFilesystemDir, art.Path)
 		}
 
@@ -161,7 +166,7 @@ func (we *WorkflowExecutor) LoadArtifacts() error {
 		if err != nil {
 			return err
 		}
-		if isTarball(tempArtPath) {
+		if isTarball(we.log, tempArtPath) {
 			err = untar(tempArtPath, artPath)
 			_ = os.Remove(tempArtPath)
 		} else {
@@ -171,7 +176,7 @@ func (we *WorkflowExecutor) LoadArtifacts() error {
 			return err
 		}
 
-		log.Infof("Successfully download file: %s", artPath)
+		we.log.Infof("Successfully download file: %s", artPath)
 		if art.Mode != nil {
 			err = os.Chmod(artPath, os.FileMode(*art.Mode))
 			if err != nil {
@@ -188,11 +193,11 @@ func (we *WorkflowExecutor) StageFiles() error {
 	var body []byte
 	switch we.Template.GetType() {
 	case wfv1.TemplateTypeScript:
-		log.Infof("Loading script source to %s", common.ExecutorScriptSourcePath)
+		we.log.Infof("Loading script source to %s", common.ExecutorScriptSourcePath)
 		filePath = common.ExecutorScriptSourcePath
 		body = []byte(we.Template.Script.Source)
 	case wfv1.TemplateTypeResource:
-		log.Infof("Loading manifest to %s", common.ExecutorResourceManifestPath)
+		we.log.Infof("Loading manifest to %s", common.ExecutorResourceManifestPath)
 		filePath = common.ExecutorResourceManifestPath
 		body = []byte(we.Template.Resource.Manifest)
 	default:
@@ -208,10 +213,10 @@ func (we *WorkflowExecutor) StageFiles() error {
 // SaveArtifacts uploads artifacts to the archive location
 func (we *WorkflowExecutor) SaveArtifacts() error {
 	if len(we.Template.Outputs.Artifacts) == 0 {
-		log.Infof("No output artifacts")
+		we.log.Infof("No output artifacts")
 		return nil
 	}
-	log.Infof("Saving output artifacts")
+	we.log.Infof("Saving output artifacts")
 	mainCtrID, err := we.GetMainContainerID()
 	if err != nil {
 		return err
@@ -240,7 +245,7 @@ func (we *WorkflowExecutor) saveArtifact(mainCtrID string, art *wfv1.Artifact) e
 	fileName, localArtPath, err := we.stageArchiveFile(mainCtrID, art)
 	if err != nil {
 		if art.Optional && errors.IsCode(errors.CodeNotFound, err) {
-			log.Warnf("Ignoring optional artifact '%s' which does not exist in path '%s': %v", art.Name, art.Path, err)
+			we.log.Warnf("Ignoring optional artifact '%s' which does not exist in path '%s': %v", art.Name, art.Path, err)
 			return nil
 		}
 		return err
@@ -251,27 +256,15 @@ func (we *WorkflowExecutor) saveArtifact(mainCtrID string, art *wfv1.Artifact) e
 		if we.Template.ArchiveLocation == nil {
 			return errors.Errorf(errors.CodeBadRequest, "Unable to determine path to store %s. No archive location", art.Name)
 		}
-		if we.Template.ArchiveLocation.S3 != nil {
-			shallowCopy := *we.Template.ArchiveLocation.S3
-			art.S3 = &shallowCopy
-			art.S3.Key = path.Join(art.S3.Key, fileName)
-		} else if we.Template.ArchiveLocation.Artifactory != nil {
-			shallowCopy := *we.Template.ArchiveLocation.Artifactory
-			art.Artifactory = &shallowCopy
-			artifactoryURL, urlParseErr := url.Parse(art.Artifactory.URL)
-			if urlParseErr != nil {
-				return urlParseErr
-			}
-			artifactoryURL.Path = path.Join(artifactoryURL.Path, fileName)
-			art.Artifactory.URL = artifactoryURL.String()
-		} else if we.Template.ArchiveLocation.HDFS != nil {
-			shallowCopy := *we.Template.ArchiveLocation.HDFS
-			art.HDFS = &shallowCopy
-			art.HDFS.Path = path.Join(art.HDFS.Path, fileName)
-		} else {
-			return errors.Errorf(errors.CodeBadRequest, "Unable to determine path to store %s. Archive location provided no information", art.Name)
+		err = common.SetArtifactLocationInArchive(art, we.Template.ArchiveLocation, fileName)
+		if err != nil {
+			return err
 		}
 	}
+	art.SizeBytes, art.Checksum, err = sizeAndChecksum(localArtPath)
+	if err != nil {
+		return err
+	}
 
 	artDriver, err := we.InitDriver(art)
 	if err != nil {
@@ -285,19 +278,42 @@ func (we *WorkflowExecutor) saveArtifact(mainCtrID string, art *wfv1.Artifact) e
 	// we just want reduce peak space usage
 	err = os.Remove(localArtPath)
 	if err != nil {
-		log.Warnf("Failed to remove %s: %v", localArtPath, err)
+		we.log.Warnf("Failed to remove %s: %v", localArtPath, err)
 	}
-	log.Infof("Successfully saved file: %s", localArtPath)
+	we.log.Infof("Successfully saved file: %s", localArtPath)
 	return nil
 }
 
+// sizeAndChecksum returns the size and SHA-256 checksum of the file saved as an artifact. Artifacts which are saved as
+// directories have neither.
+func sizeAndChecksum(localArtPath string) (*int64, string, error) {
+	info, err := os.Stat(localArtPath)
+	if err != nil {
+		return nil, "", errors.InternalWrapError(err)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, "", nil
+	}
+	f, err := os.Open(localArtPath)
+	if err != nil {
+		return nil, "", errors.InternalWrapError(err)
+	}
+	defer func() { _ = f.Close() }()
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return nil, "", errors.InternalWrapError(err)
+	}
+	return &size, fmt.Sprintf("sha256:%x", hash.Sum(nil)), nil
+}
+
 // stageArchiveFile stages a path in a container for archiving from the wait sidecar.
 // Returns a filename and a local path for the upload.
 // The filename is incorporated into the final path when uploading it to the artifact repo.
 // The local path is the final staging location of the file (or directory) which we will pass
 // to the SaveArtifacts call and may be a directory or file.
 func (we *WorkflowExecutor) stageArchiveFile(mainCtrID string, art *wfv1.Artifact) (string, string, error) {
-	log.Infof("Staging artifact: %s", art.Name)
+	we.log.Infof("Staging artifact: %s", art.Name)
 	strategy := art.Archive
 	if strategy == nil {
 		// If no strategy is specified, default to the tar strategy
@@ -311,10 +327,10 @@ func (we *WorkflowExecutor) stageArchiveFile(mainCtrID string, art *wfv1.Artifac
 		// sidecar has direct access to. We can upload directly from the shared volume mount,
 		// instead of copying it from the container.
 		mountedArtPath := filepath.Join(common.ExecutorMainFilesystemDir, art.Path)
-		log.Infof("Staging %s from mirrored volume mount %s", art.Path, mountedArtPath)
+		we.log.Infof("Staging %s from mirrored volume mount %s", art.Path, mountedArtPath)
 		if strategy.None != nil {
 			fileName := filepath.Base(art.Path)
-			log.Infof("No compression strategy needed. Staging skipped")
+			we.log.Infof("No compression strategy needed. Staging skipped")
 			return fileName, mountedArtPath, nil
 		}
 		fileName := fmt.Sprintf("%s.tgz", art.Name)
@@ -328,13 +344,13 @@ func (we *WorkflowExecutor) stageArchiveFile(mainCtrID string, art *wfv1.Artifac
 		if err != nil {
 			return "", "", err
 		}
-		log.Infof("Successfully staged %s from mirrored volume mount %s", art.Path, mountedArtPath)
+		we.log.Infof("Successfully staged %s from mirrored volume mount %s", art.Path, mountedArtPath)
 		return fileName, localArtPath, nil
 	}
 
 	fileName := fmt.Sprintf("%s.tgz", art.Name)
 	localArtPath := filepath.Join(tempOutArtDir, fileName)
-	log.Infof("Copying %s from container base image layer to %s", art.Path, localArtPath)
+	we.log.Infof("Copying %s from container base image layer to %s", art.Path, localArtPath)
 
 	err := we.RuntimeExecutor.CopyFile(mainCtrID, art.Path, localArtPath)
 	if err != nil {
@@ -345,7 +361,7 @@ func (we *WorkflowExecutor) stageArchiveFile(mainCtrID string, art *wfv1.Artifac
 		return fileName, localArtPath, nil
 	}
 	// localArtPath now points to a .tgz file, and the archive strategy is *not* tar. We need to untar it
-	log.Infof("Untaring %s archive before upload", localArtPath)
+	we.log.Infof("Untaring %s archive before upload", localArtPath)
 	unarchivedArtPath := path.Join(filepath.Dir(localArtPath), art.Name)
 	err = untar(localArtPath, unarchivedArtPath)
 	if err != nil {
@@ -400,17 +416,17 @@ func (we *WorkflowExecutor) isBaseImagePath(path string) bool {
 // SaveParameters will save the content in the specified file path as output parameter value
 func (we *WorkflowExecutor) SaveParameters() error {
 	if len(we.Template.Outputs.Parameters) == 0 {
-		log.Infof("No output parameters")
+		we.log.Infof("No output parameters")
 		return nil
 	}
-	log.Infof("Saving output parameters")
+	we.log.Infof("Saving output parameters")
 	mainCtrID, err := we.GetMainContainerID()
 	if err != nil {
 		return err
 	}
 
 	for i, param := range we.Template.Outputs.Parameters {
-		log.Infof("Saving path output parameter: %s", param.Name)
+		we.log.Infof("Saving path output parameter: %s", param.Name)
 		// Determine the file path of where to find the parameter
 		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
 			continue
@@ -418,13 +434,13 @@ func (we *WorkflowExecutor) SaveParameters() error {
 
 		var output string
 		if we.isBaseImagePath(param.ValueFrom.Path) {
-			log.Infof("Copying %s from base image layer", param.ValueFrom.Path)
+			we.log.Infof("Copying %s from base image layer", param.ValueFrom.Path)
 			output, err = we.RuntimeExecutor.GetFileContents(mainCtrID, param.ValueFrom.Path)
 			if err != nil {
 				return err
 			}
 		} else {
-			log.Infof("Copying %s from from volume mount", param.ValueFrom.Path)
+			we.log.Infof("Copying %s from from volume mount", param.ValueFrom.Path)
 			mountedPath := filepath.Join(common.ExecutorMainFilesystemDir, param.ValueFrom.Path)
 			out, err := ioutil.ReadFile(mountedPath)
 			if err != nil {
@@ -439,17 +455,18 @@ func (we *WorkflowExecutor) SaveParameters() error {
 			output = output[0 : outputLen-1]
 		}
 		we.Template.Outputs.Parameters[i].Value = &output
-		log.Infof("Successfully saved output parameter: %s", param.Name)
+		we.log.Infof("Successfully saved output parameter: %s", param.Name)
 	}
 	return nil
 }
 
-// SaveLogs saves logs
-func (we *WorkflowExecutor) SaveLogs() (*wfv1.Artifact, error) {
+// SaveLogs saves the logs of the main container, and of the init containers and sidecars, up to the completion of the
+// main container, when the template archives logs
+func (we *WorkflowExecutor) SaveLogs() ([]wfv1.Artifact, error) {
 	if we.Template.ArchiveLocation == nil || we.Template.ArchiveLocation.ArchiveLogs == nil || !*we.Template.ArchiveLocation.ArchiveLogs {
 		return nil, nil
 	}
-	log.Infof("Saving logs")
+	we.log.Infof("Saving logs")
 	mainCtrID, err := we.GetMainContainerID()
 	if err != nil {
 		return nil, err
@@ -459,45 +476,82 @@ func (we *WorkflowExecutor) SaveLogs() (*wfv1.Artifact, error) {
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
 	}
-	fileName := "main.log"
-	mainLog := path.Join(tempLogsDir, fileName)
-	err = we.saveLogToFile(mainCtrID, mainLog)
+	// the logs of the main container are read by the runtime executor, which follows them until the container completes
+	reader, err := we.RuntimeExecutor.GetOutputStream(mainCtrID, true)
+	if err != nil {
+		return nil, err
+	}
+	mainLogArt, err := we.saveContainerLogs(tempLogsDir, common.MainContainerName, reader)
+	if err != nil {
+		return nil, err
+	}
+	logArts := []wfv1.Artifact{*mainLogArt}
+
+	pod, err := we.getPod()
+	if err != nil {
+		return nil, err
+	}
+	for _, containerName := range logContainerNames(pod) {
+		// the logs of the other containers are read from the Kubernetes API by container name, since the runtime
+		// executors only know about the main container, and saved on a best effort basis
+		reader, err := we.ClientSet.CoreV1().Pods(we.Namespace).GetLogs(we.PodName, &apiv1.PodLogOptions{Container: containerName}).Stream()
+		if err != nil {
+			we.log.Warnf("Failed to get the logs of container %s: %v", containerName, err)
+			continue
+		}
+		logArt, err := we.saveContainerLogs(tempLogsDir, containerName, reader)
+		if err != nil {
+			we.log.Warnf("Failed to save the logs of container %s: %v", containerName, err)
+			continue
+		}
+		logArts = append(logArts, *logArt)
+	}
+	return logArts, nil
+}
+
+// logContainerNames returns the names of the init containers and sidecars of the pod which started, whose logs are
+// saved along with the logs of the main container. The containers of the executor are left out.
+func logContainerNames(pod *apiv1.Pod) []string {
+	var names []string
+	statuses := append(append([]apiv1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, ctrStatus := range statuses {
+		switch ctrStatus.Name {
+		case common.MainContainerName, common.WaitContainerName, common.InitContainerName:
+			continue
+		}
+		if ctrStatus.ContainerID == "" {
+			continue
+		}
+		names = append(names, ctrStatus.Name)
+	}
+	return names
+}
+
+// saveContainerLogs saves the logs of a container, read from reader, as the <container>-logs artifact, in the
+// <container>.log file of the archive location
+func (we *WorkflowExecutor) saveContainerLogs(tempLogsDir, containerName string, reader io.ReadCloser) (*wfv1.Artifact, error) {
+	fileName := containerName + ".log"
+	localLogPath := path.Join(tempLogsDir, fileName)
+	err := saveLogToFile(reader, localLogPath)
 	if err != nil {
 		return nil, err
 	}
 	art := wfv1.Artifact{
-		Name:             "main-logs",
+		Name:             containerName + "-logs",
 		ArtifactLocation: *we.Template.ArchiveLocation,
 	}
-	if we.Template.ArchiveLocation.S3 != nil {
-		shallowCopy := *we.Template.ArchiveLocation.S3
-		art.S3 = &shallowCopy
-		art.S3.Key = path.Join(art.S3.Key, fileName)
-	} else if we.Template.ArchiveLocation.Artifactory != nil {
-		shallowCopy := *we.Template.ArchiveLocation.Artifactory
-		art.Artifactory = &shallowCopy
-		artifactoryURL, urlParseErr := url.Parse(art.Artifactory.URL)
-		if urlParseErr != nil {
-			return nil, urlParseErr
-		}
-		artifactoryURL.Path = path.Join(artifactoryURL.Path, fileName)
-		art.Artifactory.URL = artifactoryURL.String()
-	} else if we.Template.ArchiveLocation.HDFS != nil {
-		shallowCopy := *we.Template.ArchiveLocation.HDFS
-		art.HDFS = &shallowCopy
-		art.HDFS.Path = path.Join(art.HDFS.Path, fileName)
-	} else {
-		return nil, errors.Errorf(errors.CodeBadRequest, "Unable to determine path to store %s. Archive location provided no information", art.Name)
+	err = common.SetArtifactLocationInArchive(&art, we.Template.ArchiveLocation, fileName)
+	if err != nil {
+		return nil, err
 	}
 	artDriver, err := we.InitDriver(&art)
 	if err != nil {
 		return nil, err
 	}
-	err = artDriver.Save(mainLog, &art)
+	err = artDriver.Save(localLogPath, &art)
 	if err != nil {
 		return nil, err
 	}
-
 	return &art, nil
 }
 
@@ -510,22 +564,76 @@ func (we *WorkflowExecutor) GetSecret(accessKeyName string, accessKey string) (s
 	return string(file), nil
 }
 
-// saveLogToFile saves the entire log output of a container to a local file
-func (we *WorkflowExecutor) saveLogToFile(mainCtrID, path string) error {
+// saveLogToFile saves the entire log output of a container, read from reader, to a local file
+func saveLogToFile(reader io.ReadCloser, path string) error {
+	defer func() { _ = reader.Close() }()
 	outFile, err := os.Create(path)
 	if err != nil {
 		return errors.InternalWrapError(err)
 	}
 	defer func() { _ = outFile.Close() }()
-	reader, err := we.RuntimeExecutor.GetOutputStream(mainCtrID, true)
+	_, err = io.Copy(outFile, reader)
 	if err != nil {
-		return err
+		return errors.InternalWrapError(err)
 	}
-	defer func() { _ = reader.Close() }()
-	_, err = io.Copy(outFile, reader)
+	return nil
+}
+
+// DeleteArtifacts deletes the output artifacts of the template, which are the artifacts of a workflow to garbage
+// collect. Artifacts of drivers which are unable to delete them are left in place.
+func (we *WorkflowExecutor) DeleteArtifacts() error {
+	we.log.Infof("Start deleting artifacts...")
+	var failed []string
+	for _, art := range we.Template.Outputs.Artifacts {
+		if !art.HasLocation() {
+			continue
+		}
+		// an artifact which cannot be deleted does not keep the others from being deleted
+		artDriver, err := we.InitDriver(&art)
+		if err != nil {
+			we.log.Errorf("Failed to delete artifact %s: %v", art.Name, err)
+			failed = append(failed, art.Name)
+			continue
+		}
+		deleter, ok := artDriver.(artifact.ArtifactDeleter)
+		if !ok {
+			we.log.Warnf("Artifact driver of %s does not support deleting artifacts", art.Name)
+			continue
+		}
+		we.log.Infof("Deleting artifact: %s", art.Name)
+		err = deleter.Delete(&art)
+		if err != nil {
+			we.log.Errorf("Failed to delete artifact %s: %v", art.Name, err)
+			failed = append(failed, art.Name)
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "failed to delete %d artifact(s): %s", len(failed), strings.Join(failed, ", "))
+	}
+	we.log.Infof("Finished deleting artifacts")
+	return nil
+}
+
+// SaveArtifactManifest saves the manifest of the artifacts of the workflow, which is the manifest file next to the
+// template, as the only output artifact of the template
+func (we *WorkflowExecutor) SaveArtifactManifest() error {
+	if len(we.Template.Outputs.Artifacts) != 1 {
+		return errors.InternalError("Template does not have an artifact manifest")
+	}
+	_, err := os.Stat(common.ArtifactManifestPath)
 	if err != nil {
 		return errors.InternalWrapError(err)
 	}
+	art := &we.Template.Outputs.Artifacts[0]
+	artDriver, err := we.InitDriver(art)
+	if err != nil {
+		return err
+	}
+	err = artDriver.Save(common.ArtifactManifestPath, art)
+	if err != nil {
+		return err
+	}
+	we.log.Infof("Saved the artifact manifest")
 	return nil
 }
 
@@ -546,7 +654,7 @@ func (we *WorkflowExecutor) getPod() (*apiv1.Pod, error) {
 	_ = wait.ExponentialBackoff(retry.DefaultRetry, func() (bool, error) {
 		pod, err = podsIf.Get(we.PodName, metav1.GetOptions{})
 		if err != nil {
-			log.Warnf("Failed to get pod '%s': %v", we.PodName, err)
+			we.log.Warnf("Failed to get pod '%s': %v", we.PodName, err)
 			if !retry.IsRetryableKubeAPIError(err) {
 				return false, err
 			}
@@ -573,7 +681,7 @@ func (we *WorkflowExecutor) GetConfigMapKey(name, key string) (string, error) {
 	_ = wait.ExponentialBackoff(retry.DefaultRetry, func() (bool, error) {
 		configmap, err = configmapsIf.Get(name, metav1.GetOptions{})
 		if err != nil {
-			log.Warnf("Failed to get configmap '%s': %v", name, err)
+			we.log.Warnf("Failed to get configmap '%s': %v", name, err)
 			if !retry.IsRetryableKubeAPIError(err) {
 				return false, err
 			}
@@ -608,7 +716,7 @@ func (we *WorkflowExecutor) GetSecrets(namespace, name, key string) ([]byte, err
 	_ = wait.ExponentialBackoff(retry.DefaultRetry, func() (bool, error) {
 		secret, err = secretsIf.Get(name, metav1.GetOptions{})
 		if err != nil {
-			log.Warnf("Failed to get secret '%s': %v", name, err)
+			we.log.Warnf("Failed to get secret '%s': %v", name, err)
 			if !retry.IsRetryableKubeAPIError(err) {
 				return false, err
 			}
@@ -665,13 +773,13 @@ func (we *WorkflowExecutor) GetMainContainerID() (string, error) {
 func (we *WorkflowExecutor) CaptureScriptResult() error {
 
 	if we.ExecutionControl == nil || !we.ExecutionControl.IncludeScriptOutput {
-		log.Infof("No Script output reference in workflow. Capturing script output ignored")
+		we.log.Infof("No Script output reference in workflow. Capturing script output ignored")
 		return nil
 	}
 	if we.Template.Script == nil {
 		return nil
 	}
-	log.Infof("Capturing script output")
+	we.log.Infof("Capturing script output")
 	mainContainerID, err := we.GetMainContainerID()
 	if err != nil {
 		return err
@@ -696,16 +804,14 @@ func (we *WorkflowExecutor) CaptureScriptResult() error {
 }
 
 // AnnotateOutputs annotation to the pod indicating all the outputs.
-func (we *WorkflowExecutor) AnnotateOutputs(logArt *wfv1.Artifact) error {
+func (we *WorkflowExecutor) AnnotateOutputs(logArts []wfv1.Artifact) error {
 	outputs := we.Template.Outputs.DeepCopy()
-	if logArt != nil {
-		outputs.Artifacts = append(outputs.Artifacts, *logArt)
-	}
+	outputs.Artifacts = append(outputs.Artifacts, logArts...)
 
 	if !outputs.HasOutputs() {
 		return nil
 	}
-	log.Infof("Annotating pod with output")
+	we.log.Infof("Annotating pod with output")
 	outputBytes, err := json.Marshal(outputs)
 	if err != nil {
 		return errors.InternalWrapError(err)
@@ -713,9 +819,15 @@ func (we *WorkflowExecutor) AnnotateOutputs(logArt *wfv1.Artifact) error {
 	return we.AddAnnotation(common.AnnotationKeyOutputs, string(outputBytes))
 }
 
+// AnnotateVersion annotates the pod with the version of the executor, which the controller records in the
+// environment of the node
+func (we *WorkflowExecutor) AnnotateVersion() error {
+	return we.AddAnnotation(common.AnnotationKeyExecutorVersion, argoversion.GetVersion().Version)
+}
+
 // AddError adds an error to the list of encountered errors durign execution
 func (we *WorkflowExecutor) AddError(err error) {
-	log.Errorf("executor error: %+v", err)
+	we.log.Errorf("executor error: %+v", err)
 	we.errors = append(we.errors, err)
 }
 
@@ -725,9 +837,9 @@ func (we *WorkflowExecutor) AddAnnotation(key, value string) error {
 }
 
 // isTarball returns whether or not the file is a tarball
-func isTarball(filePath string) bool {
+func isTarball(logCtx *log.Entry, filePath string) bool {
 	cmd := exec.Command("tar", "-tf", filePath)
-	log.Info(cmd.Args)
+	logCtx.Info(cmd.Args)
 	err := cmd.Run()
 	return err == nil
 }
@@ -791,12 +903,12 @@ func (we *WorkflowExecutor) Wait() error {
 	if err != nil {
 		return err
 	}
-	log.Infof("Waiting on main container")
+	we.log.Infof("Waiting on main container")
 	mainContainerID, err := we.waitMainContainerStart()
 	if err != nil {
 		return err
 	}
-	log.Infof("main container started with container ID: %s", mainContainerID)
+	we.log.Infof("main container started with container ID: %s", mainContainerID)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -806,7 +918,7 @@ func (we *WorkflowExecutor) Wait() error {
 	_ = wait.ExponentialBackoff(retry.DefaultRetry, func() (bool, error) {
 		err = we.RuntimeExecutor.Wait(mainContainerID)
 		if err != nil {
-			log.Warnf("Failed to wait for container id '%s': %v", mainContainerID, err)
+			we.log.Warnf("Failed to wait for container id '%s': %v", mainContainerID, err)
 			return false, err
 		}
 		return true, nil
@@ -814,7 +926,7 @@ func (we *WorkflowExecutor) Wait() error {
 	if err != nil {
 		return err
 	}
-	log.Infof("Main container completed")
+	we.log.Infof("Main container completed")
 	return nil
 }
 
@@ -836,12 +948,12 @@ func (we *WorkflowExecutor) waitMainContainerStart() (string, error) {
 			}
 			pod, ok := watchEv.Object.(*apiv1.Pod)
 			if !ok {
-				log.Warnf("Pod watch returned non pod object: %v", watchEv.Object)
+				we.log.Warnf("Pod watch returned non pod object: %v", watchEv.Object)
 				continue
 			}
 			for _, ctrStatus := range pod.Status.ContainerStatuses {
 				if ctrStatus.Name == common.MainContainerName {
-					log.Debug(ctrStatus)
+					we.log.Debug(ctrStatus)
 					if ctrStatus.ContainerID != "" {
 						we.mainContainerID = containerID(ctrStatus.ContainerID)
 						return containerID(ctrStatus.ContainerID), nil
@@ -856,11 +968,11 @@ func (we *WorkflowExecutor) waitMainContainerStart() (string, error) {
 				}
 			}
 		}
-		log.Warnf("Pod watch closed unexpectedly")
+		we.log.Warnf("Pod watch closed unexpectedly")
 	}
 }
 
-func watchFileChanges(ctx context.Context, pollInterval time.Duration, filePath string) <-chan struct{} {
+func watchFileChanges(ctx context.Context, logCtx *log.Entry, pollInterval time.Duration, filePath string) <-chan struct{} {
 	res := make(chan struct{})
 	go func() {
 		defer close(res)
@@ -875,7 +987,7 @@ func watchFileChanges(ctx context.Context, pollInterval time.Duration, filePath
 
 			file, err := os.Stat(filePath)
 			if err != nil {
-				log.Fatal(err)
+				logCtx.Fatal(err)
 			}
 			newModTime := file.ModTime()
 			if modTime != nil && !modTime.Equal(file.ModTime()) {
@@ -891,7 +1003,7 @@ func watchFileChanges(ctx context.Context, pollInterval time.Duration, filePath
 // monitorAnnotations starts a goroutine which monitors for any changes to the pod annotations.
 // Emits an event on the returned channel upon any updates
 func (we *WorkflowExecutor) monitorAnnotations(ctx context.Context) <-chan struct{} {
-	log.Infof("Starting annotations monitor")
+	we.log.Infof("Starting annotations monitor")
 
 	// Create a channel to listen for a SIGUSR2. Upon receiving of the signal, we force reload our annotations
 	// directly from kubernetes API. The controller uses this to fast-track notification of annotations
@@ -904,29 +1016,29 @@ func (we *WorkflowExecutor) monitorAnnotations(ctx context.Context) <-chan struc
 	// Create a channel which will notify a listener on new updates to the annotations
 	annotationUpdateCh := make(chan struct{})
 
-	annotationChanges := watchFileChanges(ctx, 10*time.Second, we.PodAnnotationsPath)
+	annotationChanges := watchFileChanges(ctx, we.log, 10*time.Second, we.PodAnnotationsPath)
 	go func() {
 		for {
 			select {
 			case <-ctx.Done():
-				log.Infof("Annotations monitor stopped")
+				we.log.Infof("Annotations monitor stopped")
 				signal.Stop(sigs)
 				close(sigs)
 				close(annotationUpdateCh)
 				return
 			case <-sigs:
-				log.Infof("Received update signal. Reloading annotations from API")
+				we.log.Infof("Received update signal. Reloading annotations from API")
 				annotationUpdateCh <- struct{}{}
 				we.setExecutionControl()
 			case <-annotationChanges:
-				log.Infof("%s updated", we.PodAnnotationsPath)
+				we.log.Infof("%s updated", we.PodAnnotationsPath)
 				err := we.LoadExecutionControl()
 				if err != nil {
-					log.Warnf("Failed to reload execution control from annotations: %v", err)
+					we.log.Warnf("Failed to reload execution control from annotations: %v", err)
 					continue
 				}
 				if we.ExecutionControl != nil {
-					log.Infof("Execution control reloaded from annotations: %v", *we.ExecutionControl)
+					we.log.Infof("Execution control reloaded from annotations: %v", *we.ExecutionControl)
 				}
 				annotationUpdateCh <- struct{}{}
 			}
@@ -939,7 +1051,7 @@ func (we *WorkflowExecutor) monitorAnnotations(ctx context.Context) <-chan struc
 func (we *WorkflowExecutor) setExecutionControl() {
 	pod, err := we.getPod()
 	if err != nil {
-		log.Warnf("Failed to set execution control from API server: %v", err)
+		we.log.Warnf("Failed to set execution control from API server: %v", err)
 		return
 	}
 	execCtlString, ok := pod.ObjectMeta.Annotations[common.AnnotationKeyExecutionControl]
@@ -949,22 +1061,22 @@ func (we *WorkflowExecutor) setExecutionControl() {
 		var execCtl common.ExecutionControl
 		err = json.Unmarshal([]byte(execCtlString), &execCtl)
 		if err != nil {
-			log.Errorf("Error unmarshalling '%s': %v", execCtlString, err)
+			we.log.Errorf("Error unmarshalling '%s': %v", execCtlString, err)
 			return
 		}
 		we.ExecutionControl = &execCtl
-		log.Infof("Execution control set from API: %v", *we.ExecutionControl)
+		we.log.Infof("Execution control set from API: %v", *we.ExecutionControl)
 	}
 }
 
 // monitorDeadline checks to see if we exceeded the deadline for the step and
 // terminates the main container if we did
 func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, annotationsUpdate <-chan struct{}) {
-	log.Infof("Starting deadline monitor")
+	we.log.Infof("Starting deadline monitor")
 	for {
 		select {
 		case <-ctx.Done():
-			log.Info("Deadline monitor stopped")
+			we.log.Info("Deadline monitor stopped")
 			return
 		case <-annotationsUpdate:
 		default:
@@ -981,13 +1093,14 @@ func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, annotationsUpda
 					} else {
 						message = fmt.Sprintf("step exceeded workflow deadline %s", *we.ExecutionControl.Deadline)
 					}
-					log.Info(message)
+					we.log.Info(message)
 					_ = we.AddAnnotation(common.AnnotationKeyNodeMessage, message)
-					log.Infof("Killing main container")
+					we.log.Infof("Killing main container")
 					mainContainerID, _ := we.GetMainContainerID()
-					err := we.RuntimeExecutor.Kill([]string{mainContainerID})
+					sig, gracePeriod := we.getStopSignal()
+					err := we.RuntimeExecutor.Kill([]string{mainContainerID}, sig, gracePeriod)
 					if err != nil {
-						log.Warnf("Failed to kill main container: %v", err)
+						we.log.Warnf("Failed to kill main container: %v", err)
 					}
 					return
 				}
@@ -999,7 +1112,7 @@ func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, annotationsUpda
 
 // KillSidecars kills any sidecars to the main container
 func (we *WorkflowExecutor) KillSidecars() error {
-	log.Infof("Killing sidecars")
+	we.log.Infof("Killing sidecars")
 	pod, err := we.getPod()
 	if err != nil {
 		return err
@@ -1013,13 +1126,28 @@ func (we *WorkflowExecutor) KillSidecars() error {
 			continue
 		}
 		containerID := containerID(ctrStatus.ContainerID)
-		log.Infof("Killing sidecar %s (%s)", ctrStatus.Name, containerID)
+		we.log.Infof("Killing sidecar %s (%s)", ctrStatus.Name, containerID)
 		sidecarIDs = append(sidecarIDs, containerID)
 	}
 	if len(sidecarIDs) == 0 {
 		return nil
 	}
-	return we.RuntimeExecutor.Kill(sidecarIDs)
+	return we.RuntimeExecutor.Kill(sidecarIDs, syscall.SIGTERM, execcommon.KillGracePeriod*time.Second)
+}
+
+// getStopSignal returns the signal used to stop the main container and the grace period before it is killed, as
+// configured in the template
+func (we *WorkflowExecutor) getStopSignal() (syscall.Signal, time.Duration) {
+	sig, err := common.ParseSignal(we.Template.StopSignal)
+	if err != nil {
+		we.log.Warnf("Using SIGTERM as stop signal: %v", err)
+		sig = syscall.SIGTERM
+	}
+	gracePeriod := execcommon.KillGracePeriod * time.Second
+	if we.Template.TerminationGracePeriodSeconds != nil {
+		gracePeriod = time.Duration(*we.Template.TerminationGracePeriodSeconds) * time.Second
+	}
+	return sig, gracePeriod
 }
 
 // LoadExecutionControl reads the execution control definition from the the Kubernetes downward api annotations volume file
@@ -1044,6 +1172,20 @@ func LoadTemplate(path string) (*wfv1.Template, error) {
 	return &tmpl, nil
 }
 
+// LoadTemplateFile reads the template from a json file, e.g. mounted from a config map
+func LoadTemplateFile(path string) (*wfv1.Template, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	var tmpl wfv1.Template
+	err = json.Unmarshal(data, &tmpl)
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	return &tmpl, nil
+}
+
 // unmarshalAnnotationField unmarshals the value of an annotation key into the supplied interface
 // from the downward api annotation volume file
 func unmarshalAnnotationField(filePath string, key string, into interface{}) error {
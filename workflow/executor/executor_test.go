@@ -1,11 +1,19 @@
 package executor
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/workflow/executor/mocks"
@@ -34,6 +42,7 @@ func TestSaveParameters(t *testing.T) {
 		},
 	}
 	we := WorkflowExecutor{
+		log:                log.NewEntry(log.StandardLogger()),
 		PodName:            fakePodName,
 		Template:           templateWithOutParam,
 		ClientSet:          fakeClientset,
@@ -73,6 +82,7 @@ func TestIsBaseImagePath(t *testing.T) {
 	}
 
 	we := WorkflowExecutor{
+		log:      log.NewEntry(log.StandardLogger()),
 		Template: templateWithSameDir,
 	}
 	// 1. unrelated dir/file should be captured from base image layer
@@ -104,3 +114,151 @@ func TestIsBaseImagePath(t *testing.T) {
 	assert.False(t, we.isBaseImagePath("/user-mount/some-path/foo"))
 	assert.True(t, we.isBaseImagePath("/user-mount-coincidence"))
 }
+
+// TestParseResourceCondition verifies JSONPath style condition keys are normalized to gjson paths
+func TestParseResourceCondition(t *testing.T) {
+	ls := gjsonLabels{json: []byte(`{"status":{"succeeded":1,"conditions":[{"type":"Complete","status":"True"}]}}`)}
+	for _, condition := range []string{
+		"status.conditions.0.type == Complete",
+		"$.status.conditions[0].type == Complete",
+		".status.conditions[0].type == Complete,.status.succeeded > 0",
+	} {
+		selector, err := parseResourceCondition(condition)
+		if assert.NoError(t, err, condition) {
+			assert.True(t, selector.Matches(ls), condition)
+		}
+	}
+	selector, err := parseResourceCondition("!$.status.failed")
+	if assert.NoError(t, err) {
+		assert.True(t, selector.Matches(ls))
+	}
+}
+
+func TestGetStopSignal(t *testing.T) {
+	we := WorkflowExecutor{log: log.NewEntry(log.StandardLogger())}
+	sig, gracePeriod := we.getStopSignal()
+	assert.Equal(t, syscall.SIGTERM, sig)
+	assert.Equal(t, 10*time.Second, gracePeriod)
+
+	we.Template = wfv1.Template{StopSignal: "SIGINT", TerminationGracePeriodSeconds: pointer.Int64Ptr(30)}
+	sig, gracePeriod = we.getStopSignal()
+	assert.Equal(t, syscall.SIGINT, sig)
+	assert.Equal(t, 30*time.Second, gracePeriod)
+}
+
+func TestSizeAndChecksum(t *testing.T) {
+	dir, err := ioutil.TempDir("", "size-and-checksum")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	filePath := filepath.Join(dir, "hello.txt")
+	assert.NoError(t, ioutil.WriteFile(filePath, []byte("hello"), 0644))
+
+	size, checksum, err := sizeAndChecksum(filePath)
+	if assert.NoError(t, err) && assert.NotNil(t, size) {
+		assert.Equal(t, int64(5), *size)
+		assert.Equal(t, "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", checksum)
+	}
+
+	size, checksum, err = sizeAndChecksum(dir)
+	assert.NoError(t, err)
+	assert.Nil(t, size)
+	assert.Empty(t, checksum)
+}
+
+// TestDeleteArtifacts verifies an artifact which cannot be deleted does not keep the others from being deleted
+func TestDeleteArtifacts(t *testing.T) {
+	we := WorkflowExecutor{
+		log:       log.NewEntry(log.StandardLogger()),
+		PodName:   fakePodName,
+		ClientSet: fake.NewSimpleClientset(),
+		Namespace: fakeNamespace,
+		Template: wfv1.Template{
+			Outputs: wfv1.Outputs{
+				Artifacts: []wfv1.Artifact{
+					{
+						Name: "missing-secret",
+						ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{
+							S3Bucket: wfv1.S3Bucket{
+								Bucket:          "my-bucket",
+								AccessKeySecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "accesskey"},
+								SecretKeySecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "secretkey"},
+							},
+							Key: "my-key",
+						}},
+					},
+					{
+						Name:             "raw",
+						ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "data"}},
+					},
+				},
+			},
+		},
+	}
+	err := we.DeleteArtifacts()
+	if assert.Error(t, err) {
+		assert.Equal(t, "failed to delete 1 artifact(s): missing-secret", err.Error())
+	}
+}
+
+// TestSaveLogs verifies no logs are saved unless the template archives logs
+func TestSaveLogs(t *testing.T) {
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	we := WorkflowExecutor{
+		log:             log.NewEntry(log.StandardLogger()),
+		PodName:         fakePodName,
+		ClientSet:       fake.NewSimpleClientset(),
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: &mockRuntimeExecutor,
+		mainContainerID: fakeContainerID,
+		Template: wfv1.Template{
+			ArchiveLocation: &wfv1.ArtifactLocation{ArchiveLogs: pointer.BoolPtr(false)},
+		},
+	}
+	logArts, err := we.SaveLogs()
+	assert.NoError(t, err)
+	assert.Empty(t, logArts)
+	mockRuntimeExecutor.AssertNotCalled(t, "GetOutputStream", fakeContainerID, true)
+}
+
+// TestLogContainerNames verifies the logs of the init containers and sidecars which started are saved, but not the
+// logs of the containers of the executor
+func TestLogContainerNames(t *testing.T) {
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "init", ContainerID: "docker://init"},
+				{Name: "setup", ContainerID: "docker://setup"},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "main", ContainerID: "docker://main"},
+				{Name: "wait", ContainerID: "docker://wait"},
+				{Name: "nginx", ContainerID: "docker://nginx"},
+				{Name: "pending"},
+			},
+		},
+	}
+	assert.Equal(t, []string{"setup", "nginx"}, logContainerNames(pod))
+}
+
+// TestSaveContainerLogs verifies the logs of a container are written to <container>.log before being saved
+func TestSaveContainerLogs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "save-container-logs")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	we := WorkflowExecutor{
+		log:       log.NewEntry(log.StandardLogger()),
+		PodName:   fakePodName,
+		ClientSet: fake.NewSimpleClientset(),
+		Namespace: fakeNamespace,
+		Template: wfv1.Template{
+			// raw artifacts cannot be saved
+			ArchiveLocation: &wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{}},
+		},
+	}
+	_, err = we.saveContainerLogs(dir, "nginx", ioutil.NopCloser(strings.NewReader("started\nstopped\n")))
+	assert.Error(t, err)
+	logs, err := ioutil.ReadFile(filepath.Join(dir, "nginx.log"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "started\nstopped\n", string(logs))
+	}
+}
@@ -2,6 +2,8 @@ package k8sapi
 
 import (
 	"io"
+	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/kubernetes"
@@ -51,11 +53,11 @@ func (k *K8sAPIExecutor) Wait(containerID string) error {
 	return k.client.waitForTermination(containerID, 0)
 }
 
-// Kill kills a list of containerIDs first with a SIGTERM then with a SIGKILL after a grace period
-func (k *K8sAPIExecutor) Kill(containerIDs []string) error {
+// Kill kills a list of containerIDs first with the given signal then with a SIGKILL after the grace period
+func (k *K8sAPIExecutor) Kill(containerIDs []string, sig syscall.Signal, gracePeriod time.Duration) error {
 	log.Infof("Killing containers %s", containerIDs)
 	for _, containerID := range containerIDs {
-		err := k.client.killGracefully(containerID)
+		err := k.client.killGracefully(containerID, sig, gracePeriod)
 		if err != nil {
 			return err
 		}
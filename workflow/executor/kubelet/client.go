@@ -294,8 +294,8 @@ func (k *kubeletClient) KillContainer(pod *corev1.Pod, container *corev1.Contain
 	return err
 }
 
-func (k *kubeletClient) KillGracefully(containerID string) error {
-	return execcommon.KillGracefully(k, containerID)
+func (k *kubeletClient) KillGracefully(containerID string, sig syscall.Signal, gracePeriod time.Duration) error {
+	return execcommon.KillGracefully(k, containerID, sig, gracePeriod)
 }
 
 func (k *kubeletClient) CopyArchive(containerID, sourcePath, destPath string) error {
@@ -2,6 +2,8 @@ package kubelet
 
 import (
 	"io"
+	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -47,10 +49,10 @@ func (k *KubeletExecutor) Wait(containerID string) error {
 	return k.cli.WaitForTermination(containerID, 0)
 }
 
-// Kill kills a list of containerIDs first with a SIGTERM then with a SIGKILL after a grace period
-func (k *KubeletExecutor) Kill(containerIDs []string) error {
+// Kill kills a list of containerIDs first with the given signal then with a SIGKILL after the grace period
+func (k *KubeletExecutor) Kill(containerIDs []string, sig syscall.Signal, gracePeriod time.Duration) error {
 	for _, containerID := range containerIDs {
-		err := k.cli.KillGracefully(containerID)
+		err := k.cli.KillGracefully(containerID, sig, gracePeriod)
 		if err != nil {
 			return err
 		}
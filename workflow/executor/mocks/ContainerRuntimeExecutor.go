@@ -4,6 +4,8 @@ package mocks
 
 import io "io"
 import mock "github.com/stretchr/testify/mock"
+import syscall "syscall"
+import time "time"
 
 // ContainerRuntimeExecutor is an autogenerated mock type for the ContainerRuntimeExecutor type
 type ContainerRuntimeExecutor struct {
@@ -68,13 +70,13 @@ func (_m *ContainerRuntimeExecutor) GetOutputStream(containerID string, combined
 	return r0, r1
 }
 
-// Kill provides a mock function with given fields: containerIDs
-func (_m *ContainerRuntimeExecutor) Kill(containerIDs []string) error {
-	ret := _m.Called(containerIDs)
+// Kill provides a mock function with given fields: containerIDs, sig, gracePeriod
+func (_m *ContainerRuntimeExecutor) Kill(containerIDs []string, sig syscall.Signal, gracePeriod time.Duration) error {
+	ret := _m.Called(containerIDs, sig, gracePeriod)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]string) error); ok {
-		r0 = rf(containerIDs)
+	if rf, ok := ret.Get(0).(func([]string, syscall.Signal, time.Duration) error); ok {
+		r0 = rf(containerIDs, sig, gracePeriod)
 	} else {
 		r0 = ret.Error(0)
 	}
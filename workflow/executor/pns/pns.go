@@ -15,11 +15,11 @@ import (
 	gops "github.com/mitchellh/go-ps"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo/errors"
 	"github.com/argoproj/argo/util/archive"
-	"github.com/argoproj/argo/workflow/common"
 	execcommon "github.com/argoproj/argo/workflow/executor/common"
 )
 
@@ -218,21 +218,40 @@ func (p *PNSExecutor) GetOutputStream(containerID string, combinedOutput bool) (
 	if !combinedOutput {
 		log.Warn("non combined output unsupported")
 	}
+	containerName, err := p.getContainerName(containerID)
+	if err != nil {
+		return nil, err
+	}
 	opts := v1.PodLogOptions{
-		Container: common.MainContainerName,
+		Container: containerName,
 		Follow:    true,
 	}
 	return p.clientset.CoreV1().Pods(p.namespace).GetLogs(p.podName, &opts).Stream()
 }
 
-// Kill a list of containerIDs first with a SIGTERM then with a SIGKILL after a grace period
-func (p *PNSExecutor) Kill(containerIDs []string) error {
+// getContainerName returns the name of the container of the pod with the given ID
+func (p *PNSExecutor) getContainerName(containerID string) (string, error) {
+	pod, err := p.clientset.CoreV1().Pods(p.namespace).Get(p.podName, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	statuses := append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, ctrStatus := range statuses {
+		if execcommon.GetContainerID(&ctrStatus) == containerID {
+			return ctrStatus.Name, nil
+		}
+	}
+	return "", errors.Errorf(errors.CodeNotFound, "containerID %q is not found in the pod %s", containerID, p.podName)
+}
+
+// Kill a list of containerIDs first with the given signal then with a SIGKILL after the grace period
+func (p *PNSExecutor) Kill(containerIDs []string, sig syscall.Signal, gracePeriod time.Duration) error {
 	var asyncErr error
 	wg := sync.WaitGroup{}
 	for _, cid := range containerIDs {
 		wg.Add(1)
 		go func(containerID string) {
-			err := p.killContainer(containerID)
+			err := p.killContainer(containerID, sig, gracePeriod)
 			if err != nil && asyncErr != nil {
 				asyncErr = err
 			}
@@ -243,7 +262,7 @@ func (p *PNSExecutor) Kill(containerIDs []string) error {
 	return asyncErr
 }
 
-func (p *PNSExecutor) killContainer(containerID string) error {
+func (p *PNSExecutor) killContainer(containerID string, sig syscall.Signal, gracePeriod time.Duration) error {
 	pid, err := p.getContainerPID(containerID)
 	if err != nil {
 		log.Warnf("Ignoring kill container failure of %s: %v. Process assumed to have completed", containerID, err)
@@ -252,13 +271,13 @@ func (p *PNSExecutor) killContainer(containerID string) error {
 	// On Unix systems, FindProcess always succeeds and returns a Process
 	// for the given pid, regardless of whether the process exists.
 	proc, _ := os.FindProcess(pid)
-	log.Infof("Sending SIGTERM to pid %d", pid)
-	err = proc.Signal(syscall.SIGTERM)
+	log.Infof("Sending %s to pid %d", sig, pid)
+	err = proc.Signal(sig)
 	if err != nil {
-		log.Warnf("Failed to SIGTERM pid %d: %v", pid, err)
+		log.Warnf("Failed to send %s to pid %d: %v", sig, pid, err)
 	}
 
-	waitPIDOpts := executil.WaitPIDOpts{Timeout: execcommon.KillGracePeriod * time.Second}
+	waitPIDOpts := executil.WaitPIDOpts{Timeout: gracePeriod}
 	err = executil.WaitPID(pid, waitPIDOpts)
 	if err == nil {
 		log.Infof("PID %d completed", pid)
@@ -267,7 +286,7 @@ func (p *PNSExecutor) killContainer(containerID string) error {
 	if err != executil.ErrWaitPIDTimeout {
 		return err
 	}
-	log.Warnf("Timed out (%v) waiting for pid %d to complete after SIGTERM. Issing SIGKILL", waitPIDOpts.Timeout, pid)
+	log.Warnf("Timed out (%v) waiting for pid %d to complete after %s. Issing SIGKILL", waitPIDOpts.Timeout, pid, sig)
 	time.Sleep(30 * time.Minute)
 	err = proc.Signal(syscall.SIGKILL)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os/exec"
+	"regexp"
 	"strings"
 	"time"
 
@@ -55,7 +56,7 @@ func (we *WorkflowExecutor) ExecResource(action string, manifestPath string) (st
 	args = append(args, "-o")
 	args = append(args, output)
 	cmd := exec.Command("kubectl", args...)
-	log.Info(strings.Join(cmd.Args, " "))
+	we.log.Info(strings.Join(cmd.Args, " "))
 	out, err := cmd.Output()
 	if err != nil {
 		exErr := err.(*exec.ExitError)
@@ -71,7 +72,7 @@ func (we *WorkflowExecutor) ExecResource(action string, manifestPath string) (st
 		return "", "", err
 	}
 	resourceName := fmt.Sprintf("%s.%s/%s", obj.GroupVersionKind().Kind, obj.GroupVersionKind().Group, obj.GetName())
-	log.Infof("%s/%s", obj.GetNamespace(), resourceName)
+	we.log.Infof("%s/%s", obj.GetNamespace(), resourceName)
 	return obj.GetNamespace(), resourceName, nil
 }
 
@@ -92,6 +93,23 @@ func (g gjsonLabels) Get(label string) string {
 	return gjson.GetBytes(g.json, label).String()
 }
 
+var (
+	// conditionJSONPathRoot matches a JSONPath style root (e.g. `$.` or `.`) at the beginning of a requirement
+	conditionJSONPathRoot = regexp.MustCompile(`(^|,)(\s*!?\s*)\$?\.`)
+	// conditionJSONPathIndex matches a JSONPath style array index (e.g. `[0]`)
+	conditionJSONPathIndex = regexp.MustCompile(`\[(\d+)\]`)
+)
+
+// parseResourceCondition parses a success or failure condition into a selector. In addition to the
+// gjson style keys (e.g. `status.conditions.0.type`), JSONPath style keys are accepted for
+// convenience (e.g. `$.status.conditions[0].type` or `.status.conditions[0].type`) and are
+// normalized to their gjson equivalent before parsing.
+func parseResourceCondition(condition string) (labels.Selector, error) {
+	condition = conditionJSONPathRoot.ReplaceAllString(condition, "$1$2")
+	condition = conditionJSONPathIndex.ReplaceAllString(condition, ".$1")
+	return labels.Parse(condition)
+}
+
 // WaitResource waits for a specific resource to satisfy either the success or failure condition
 func (we *WorkflowExecutor) WaitResource(resourceNamespace string, resourceName string) error {
 	if we.Template.Resource.SuccessCondition == "" && we.Template.Resource.FailureCondition == "" {
@@ -99,21 +117,21 @@ func (we *WorkflowExecutor) WaitResource(resourceNamespace string, resourceName
 	}
 	var successReqs labels.Requirements
 	if we.Template.Resource.SuccessCondition != "" {
-		successSelector, err := labels.Parse(we.Template.Resource.SuccessCondition)
+		successSelector, err := parseResourceCondition(we.Template.Resource.SuccessCondition)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "success condition '%s' failed to parse: %v", we.Template.Resource.SuccessCondition, err)
 		}
-		log.Infof("Waiting for conditions: %s", successSelector)
+		we.log.Infof("Waiting for conditions: %s", successSelector)
 		successReqs, _ = successSelector.Requirements()
 	}
 
 	var failReqs labels.Requirements
 	if we.Template.Resource.FailureCondition != "" {
-		failSelector, err := labels.Parse(we.Template.Resource.FailureCondition)
+		failSelector, err := parseResourceCondition(we.Template.Resource.FailureCondition)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "fail condition '%s' failed to parse: %v", we.Template.Resource.FailureCondition, err)
 		}
-		log.Infof("Failing for conditions: %s", failSelector)
+		we.log.Infof("Failing for conditions: %s", failSelector)
 		failReqs, _ = failSelector.Requirements()
 	}
 
@@ -121,27 +139,27 @@ func (we *WorkflowExecutor) WaitResource(resourceNamespace string, resourceName
 	// Poll intervall of 5 seconds serves as a backoff intervall in case of immediate result reader failure
 	err := wait.PollImmediateInfinite(time.Second*5,
 		func() (bool, error) {
-			isErrRetry, err := checkResourceState(resourceNamespace, resourceName, successReqs, failReqs)
+			isErrRetry, err := checkResourceState(we.log, resourceNamespace, resourceName, successReqs, failReqs)
 
 			if err == nil {
-				log.Infof("Returning from successful wait for resource %s", resourceName)
+				we.log.Infof("Returning from successful wait for resource %s", resourceName)
 				return true, nil
 			}
 
 			if isErrRetry {
-				log.Infof("Waiting for resource %s resulted in retryable error %v", resourceName, err)
+				we.log.Infof("Waiting for resource %s resulted in retryable error %v", resourceName, err)
 				return false, nil
 			}
 
-			log.Warnf("Waiting for resource %s resulted in non-retryable error %v", resourceName, err)
+			we.log.Warnf("Waiting for resource %s resulted in non-retryable error %v", resourceName, err)
 			return false, err
 		})
 
 	if err != nil {
 		if err == wait.ErrWaitTimeout {
-			log.Warnf("Waiting for resource %s resulted in timeout due to repeated errors", resourceName)
+			we.log.Warnf("Waiting for resource %s resulted in timeout due to repeated errors", resourceName)
 		} else {
-			log.Warnf("Waiting for resource %s resulted in error %v", resourceName, err)
+			we.log.Warnf("Waiting for resource %s resulted in error %v", resourceName, err)
 		}
 	}
 
@@ -149,9 +167,9 @@ func (we *WorkflowExecutor) WaitResource(resourceNamespace string, resourceName
 }
 
 // Function to do the kubectl get -w command and then waiting on json reading.
-func checkResourceState(resourceNamespace string, resourceName string, successReqs labels.Requirements, failReqs labels.Requirements) (bool, error) {
+func checkResourceState(logCtx *log.Entry, resourceNamespace string, resourceName string, successReqs labels.Requirements, failReqs labels.Requirements) (bool, error) {
 
-	cmd, reader, err := startKubectlWaitCmd(resourceNamespace, resourceName)
+	cmd, reader, err := startKubectlWaitCmd(logCtx, resourceNamespace, resourceName)
 	if err != nil {
 		return false, err
 	}
@@ -164,7 +182,7 @@ func checkResourceState(resourceNamespace string, resourceName string, successRe
 
 		if err != nil {
 			resultErr := err
-			log.Warnf("Json reader returned error %v. Calling kill (usually superfluous)", err)
+			logCtx.Warnf("Json reader returned error %v. Calling kill (usually superfluous)", err)
 			// We don't want to write OS specific code so we don't want to call syscall package code. But that means
 			// there is no way to figure out if a process is running or not in an asynchronous manner. exec.Wait will
 			// always block and we need to call that to get the exit code of the process. So we will unconditionally
@@ -175,24 +193,24 @@ func checkResourceState(resourceNamespace string, resourceName string, successRe
 			//    and don't retry
 			_ = cmd.Process.Kill()
 
-			log.Warnf("Command for kubectl get -w for %s exited. Getting return value using Wait", resourceName)
+			logCtx.Warnf("Command for kubectl get -w for %s exited. Getting return value using Wait", resourceName)
 			err = cmd.Wait()
 			if err != nil {
-				log.Warnf("cmd.Wait for kubectl get -w command for resource %s returned error %v",
+				logCtx.Warnf("cmd.Wait for kubectl get -w command for resource %s returned error %v",
 					resourceName, err)
 				resultErr = err
 			} else {
-				log.Infof("readJSon failed for resource %s but cmd.Wait for kubectl get -w command did not error", resourceName)
+				logCtx.Infof("readJSon failed for resource %s but cmd.Wait for kubectl get -w command did not error", resourceName)
 			}
 			return true, resultErr
 		}
 
-		log.Info(string(jsonBytes))
+		logCtx.Info(string(jsonBytes))
 		ls := gjsonLabels{json: jsonBytes}
 		for _, req := range failReqs {
 			failed := req.Matches(ls)
 			msg := fmt.Sprintf("failure condition '%s' evaluated %v", req, failed)
-			log.Infof(msg)
+			logCtx.Infof(msg)
 			if failed {
 				// TODO: need a better error code instead of BadRequest
 				return false, errors.Errorf(errors.CodeBadRequest, msg)
@@ -201,12 +219,12 @@ func checkResourceState(resourceNamespace string, resourceName string, successRe
 		numMatched := 0
 		for _, req := range successReqs {
 			matched := req.Matches(ls)
-			log.Infof("success condition '%s' evaluated %v", req, matched)
+			logCtx.Infof("success condition '%s' evaluated %v", req, matched)
 			if matched {
 				numMatched++
 			}
 		}
-		log.Infof("%d/%d success conditions matched", numMatched, len(successReqs))
+		logCtx.Infof("%d/%d success conditions matched", numMatched, len(successReqs))
 		if numMatched >= len(successReqs) {
 			return false, nil
 		}
@@ -214,7 +232,7 @@ func checkResourceState(resourceNamespace string, resourceName string, successRe
 }
 
 // Start Kubectl command Get with -w return error if unable to start command
-func startKubectlWaitCmd(resourceNamespace string, resourceName string) (*exec.Cmd, *bufio.Reader, error) {
+func startKubectlWaitCmd(logCtx *log.Entry, resourceNamespace string, resourceName string) (*exec.Cmd, *bufio.Reader, error) {
 	args := []string{"get", resourceName, "-w", "-o", "json"}
 	if resourceNamespace != "" {
 		args = append(args, "-n", resourceNamespace)
@@ -225,7 +243,7 @@ func startKubectlWaitCmd(resourceNamespace string, resourceName string) (*exec.C
 		return nil, nil, errors.InternalWrapError(err)
 	}
 	reader := bufio.NewReader(stdout)
-	log.Info(strings.Join(cmd.Args, " "))
+	logCtx.Info(strings.Join(cmd.Args, " "))
 	if err := cmd.Start(); err != nil {
 		return nil, nil, errors.InternalWrapError(err)
 	}
@@ -257,10 +275,10 @@ func readJSON(reader *bufio.Reader) ([]byte, error) {
 // SaveResourceParameters will save any resource output parameters
 func (we *WorkflowExecutor) SaveResourceParameters(resourceNamespace string, resourceName string) error {
 	if len(we.Template.Outputs.Parameters) == 0 {
-		log.Infof("No output parameters")
+		we.log.Infof("No output parameters")
 		return nil
 	}
-	log.Infof("Saving resource output parameters")
+	we.log.Infof("Saving resource output parameters")
 	for i, param := range we.Template.Outputs.Parameters {
 		if param.ValueFrom == nil {
 			continue
@@ -282,17 +300,17 @@ func (we *WorkflowExecutor) SaveResourceParameters(resourceNamespace string, res
 		} else {
 			continue
 		}
-		log.Info(cmd.Args)
+		we.log.Info(cmd.Args)
 		out, err := cmd.Output()
 		if err != nil {
 			if exErr, ok := err.(*exec.ExitError); ok {
-				log.Errorf("`%s` stderr:\n%s", cmd.Args, string(exErr.Stderr))
+				we.log.Errorf("`%s` stderr:\n%s", cmd.Args, string(exErr.Stderr))
 			}
 			return errors.InternalWrapError(err)
 		}
 		output := string(out)
 		we.Template.Outputs.Parameters[i].Value = &output
-		log.Infof("Saved output parameter: %s, value: %s", param.Name, output)
+		we.log.Infof("Saved output parameter: %s, value: %s", param.Name, output)
 	}
 	err := we.AnnotateOutputs(nil)
 	return err
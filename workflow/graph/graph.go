@@ -0,0 +1,176 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// phaseColors are the fill colors of the nodes, by phase
+var phaseColors = map[wfv1.NodePhase]string{
+	wfv1.NodePending:   "#d8d8d8",
+	wfv1.NodeRunning:   "#0dadea",
+	wfv1.NodeSucceeded: "#18be94",
+	wfv1.NodeSkipped:   "#f4f4f4",
+	wfv1.NodeFailed:    "#e96d76",
+	wfv1.NodeError:     "#e96d76",
+}
+
+// graph is the structure of the nodes of a workflow: the nodes are grouped by the steps or DAG template they run in,
+// and linked to their children
+type graph struct {
+	nodes wfv1.Nodes
+	// members are the IDs of the nodes of each boundary, keyed by the ID of the steps or DAG node of the boundary. The
+	// nodes outside of any boundary, e.g. the root node, are keyed by the empty string.
+	members map[string][]string
+}
+
+func newGraph(wf *wfv1.Workflow) *graph {
+	g := &graph{nodes: wf.Status.Nodes, members: make(map[string][]string)}
+	for id, node := range wf.Status.Nodes {
+		boundaryID := node.BoundaryID
+		if boundary, ok := wf.Status.Nodes[boundaryID]; !ok || boundaryID == id || !isBoundaryType(boundary.Type) {
+			boundaryID = ""
+		}
+		g.members[boundaryID] = append(g.members[boundaryID], id)
+	}
+	for _, ids := range g.members {
+		sort.Strings(ids)
+	}
+	return g
+}
+
+func isBoundaryType(nodeType wfv1.NodeType) bool {
+	return nodeType == wfv1.NodeTypeSteps || nodeType == wfv1.NodeTypeDAG
+}
+
+// isBoundary returns whether the node is a steps or DAG node, which the nodes of its template are grouped under
+func (g *graph) isBoundary(id string) bool {
+	return isBoundaryType(g.nodes[id].Type) && len(g.members[id]) > 0
+}
+
+// edges returns the links from the nodes to their children, sorted by parent
+func (g *graph) edges() [][2]string {
+	var ids []string
+	for id := range g.nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var edges [][2]string
+	for _, id := range ids {
+		for _, child := range g.nodes[id].Children {
+			if _, ok := g.nodes[child]; ok {
+				edges = append(edges, [2]string{id, child})
+			}
+		}
+	}
+	return edges
+}
+
+func label(node wfv1.NodeStatus) string {
+	label := node.DisplayName
+	if label == "" {
+		label = node.Name
+	}
+	if node.Phase != "" {
+		label = fmt.Sprintf("%s (%s)", label, node.Phase)
+	}
+	return label
+}
+
+func phase(node wfv1.NodeStatus) wfv1.NodePhase {
+	if node.Phase == "" {
+		return wfv1.NodePending
+	}
+	return node.Phase
+}
+
+func color(phase wfv1.NodePhase) string {
+	color, ok := phaseColors[phase]
+	if !ok {
+		return phaseColors[wfv1.NodePending]
+	}
+	return color
+}
+
+// Dot returns the nodes of the workflow as a Graphviz DOT graph, e.g. to be rendered with `dot -Tsvg`. The nodes of each
+// steps or DAG template are clustered together with the node of the template.
+func Dot(wf *wfv1.Workflow) string {
+	g := newGraph(wf)
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", wf.ObjectMeta.Name)
+	b.WriteString("  node [shape=box, style=\"rounded,filled\"];\n")
+	var writeMembers func(boundaryID string, indent string)
+	writeMembers = func(boundaryID string, indent string) {
+		for _, id := range g.members[boundaryID] {
+			node := g.nodes[id]
+			if g.isBoundary(id) {
+				fmt.Fprintf(&b, "%ssubgraph %q {\n", indent, "cluster_"+id)
+				fmt.Fprintf(&b, "%s  label=%q;\n", indent, node.DisplayName)
+				fmt.Fprintf(&b, "%s  %q [label=%q, fillcolor=%q];\n", indent, id, label(node), color(phase(node)))
+				writeMembers(id, indent+"  ")
+				fmt.Fprintf(&b, "%s}\n", indent)
+				continue
+			}
+			fmt.Fprintf(&b, "%s%q [label=%q, fillcolor=%q];\n", indent, id, label(node), color(phase(node)))
+		}
+	}
+	writeMembers("", "  ")
+	for _, edge := range g.edges() {
+		fmt.Fprintf(&b, "  %q -> %q;\n", edge[0], edge[1])
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid returns the nodes of the workflow as a Mermaid flowchart, e.g. to be embedded in Markdown. The nodes of each
+// steps or DAG template are grouped in a subgraph together with the node of the template.
+func Mermaid(wf *wfv1.Workflow) string {
+	g := newGraph(wf)
+	// node IDs are replaced by short ones, since Mermaid does not allow all the characters of node IDs
+	var ids []string
+	for id := range g.nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	mermaidIDs := make(map[string]string, len(ids))
+	for i, id := range ids {
+		mermaidIDs[id] = fmt.Sprintf("n%d", i)
+	}
+	escape := strings.NewReplacer(`"`, "#quot;").Replace
+
+	var b strings.Builder
+	b.WriteString("graph TD\n")
+	phases := make(map[wfv1.NodePhase]bool)
+	var writeMembers func(boundaryID string, indent string)
+	writeMembers = func(boundaryID string, indent string) {
+		for _, id := range g.members[boundaryID] {
+			node := g.nodes[id]
+			phases[phase(node)] = true
+			nodeLine := fmt.Sprintf("%s[\"%s\"]:::%s\n", mermaidIDs[id], escape(label(node)), phase(node))
+			if g.isBoundary(id) {
+				fmt.Fprintf(&b, "%ssubgraph %s_group [\"%s\"]\n", indent, mermaidIDs[id], escape(node.DisplayName))
+				b.WriteString(indent + "  " + nodeLine)
+				writeMembers(id, indent+"  ")
+				fmt.Fprintf(&b, "%send\n", indent)
+				continue
+			}
+			b.WriteString(indent + nodeLine)
+		}
+	}
+	writeMembers("", "  ")
+	for _, edge := range g.edges() {
+		fmt.Fprintf(&b, "  %s --> %s\n", mermaidIDs[edge[0]], mermaidIDs[edge[1]])
+	}
+	var classes []string
+	for phase := range phases {
+		classes = append(classes, string(phase))
+	}
+	sort.Strings(classes)
+	for _, phase := range classes {
+		fmt.Fprintf(&b, "  classDef %s fill:%s\n", phase, color(wfv1.NodePhase(phase)))
+	}
+	return b.String()
+}
@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+var stepsWorkflow = &wfv1.Workflow{
+	ObjectMeta: metav1.ObjectMeta{Name: "steps"},
+	Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+		"steps":   {ID: "steps", Name: "steps", DisplayName: "steps", Type: wfv1.NodeTypeSteps, Phase: wfv1.NodeFailed, Children: []string{"steps-0"}},
+		"steps-0": {ID: "steps-0", Name: "steps[0]", DisplayName: "[0]", Type: wfv1.NodeTypeStepGroup, Phase: wfv1.NodeFailed, BoundaryID: "steps", Children: []string{"steps-1", "steps-2"}},
+		"steps-1": {ID: "steps-1", Name: "steps[0].hello", DisplayName: "hello", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, BoundaryID: "steps"},
+		"steps-2": {ID: "steps-2", Name: "steps[0].say \"hi\"", DisplayName: "say \"hi\"", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed, BoundaryID: "steps"},
+	}},
+}
+
+func TestDot(t *testing.T) {
+	dot := Dot(stepsWorkflow)
+	assert.Contains(t, dot, `digraph "steps" {`)
+	assert.Contains(t, dot, `  subgraph "cluster_steps" {
+    label="steps";
+    "steps" [label="steps (Failed)", fillcolor="#e96d76"];
+    "steps-0" [label="[0] (Failed)", fillcolor="#e96d76"];
+    "steps-1" [label="hello (Succeeded)", fillcolor="#18be94"];
+    "steps-2" [label="say \"hi\" (Failed)", fillcolor="#e96d76"];
+  }`)
+	assert.Contains(t, dot, `  "steps" -> "steps-0";`)
+	assert.Contains(t, dot, `  "steps-0" -> "steps-1";`)
+	assert.Contains(t, dot, `  "steps-0" -> "steps-2";`)
+}
+
+func TestMermaid(t *testing.T) {
+	mermaid := Mermaid(stepsWorkflow)
+	assert.Contains(t, mermaid, `graph TD
+  subgraph n0_group ["steps"]
+    n0["steps (Failed)"]:::Failed
+    n1["[0] (Failed)"]:::Failed
+    n2["hello (Succeeded)"]:::Succeeded
+    n3["say #quot;hi#quot; (Failed)"]:::Failed
+  end
+`)
+	assert.Contains(t, mermaid, "  n0 --> n1\n  n1 --> n2\n  n1 --> n3\n")
+	assert.Contains(t, mermaid, "  classDef Failed fill:#e96d76\n  classDef Succeeded fill:#18be94\n")
+}
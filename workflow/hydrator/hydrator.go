@@ -0,0 +1,66 @@
+package hydrator
+
+import (
+	"github.com/argoproj/argo/persist/sqldb"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/packer"
+)
+
+// Interface abstracts where the status of the nodes of a workflow is stored. A hydrated workflow has its nodes in
+// Status.Nodes, a dehydrated one may have them compressed, or offloaded to the database.
+type Interface interface {
+	// Hydrate loads the nodes of the workflow, whether compressed or offloaded
+	Hydrate(wf *wfv1.Workflow) error
+	// Dehydrate prepares the workflow to be saved, compressing or offloading its nodes depending on the storage
+	Dehydrate(wf *wfv1.Workflow) error
+}
+
+type hydrator struct {
+	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
+	storage               config.NodeStatusStorage
+}
+
+// New returns a hydrator which stores the nodes as configured by storage. Nodes are only offloaded when the
+// repository is enabled.
+func New(offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, storage config.NodeStatusStorage) Interface {
+	return &hydrator{offloadNodeStatusRepo, storage}
+}
+
+func (h *hydrator) Hydrate(wf *wfv1.Workflow) error {
+	// the nodes may have been offloaded with another storage, so they are loaded whatever the storage is now
+	if wf.Status.IsOffloadNodeStatus() {
+		nodes, err := h.offloadNodeStatusRepo.Get(string(wf.UID), wf.GetOffloadNodeStatusVersion())
+		if err != nil {
+			return err
+		}
+		wf.Status.Nodes = nodes
+	}
+	return packer.DecompressWorkflow(wf)
+}
+
+func (h *hydrator) Dehydrate(wf *wfv1.Workflow) error {
+	nodes := wf.Status.Nodes
+	err := packer.CompressWorkflow(wf)
+	offload := h.storage == config.NodeStatusStorageDatabase || (h.storage == config.NodeStatusStorageOffload && packer.IsTooLargeError(err))
+	if offload && h.offloadNodeStatusRepo.IsEnabled() {
+		offloadVersion, err := h.offloadNodeStatusRepo.Save(string(wf.UID), wf.Namespace, nodes)
+		if err != nil {
+			return err
+		}
+		wf.Status.Nodes = nil
+		wf.Status.CompressedNodes = ""
+		wf.Status.OffloadNodeStatusVersion = offloadVersion
+		return nil
+	}
+	if packer.IsTooLargeError(err) {
+		// without offloading, the workflow is saved as is and may be rejected by the API server for its size
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// the nodes fit in the workflow again, so any previously offloaded version is stale
+	wf.Status.OffloadNodeStatusVersion = ""
+	return nil
+}
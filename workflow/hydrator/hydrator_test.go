@@ -0,0 +1,86 @@
+package hydrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/argoproj/argo/persist/sqldb"
+	"github.com/argoproj/argo/persist/sqldb/mocks"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/config"
+	"github.com/argoproj/argo/workflow/packer"
+)
+
+func newWorkflow() *wfv1.Workflow {
+	return &wfv1.Workflow{Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{"foo": wfv1.NodeStatus{}, "bar": wfv1.NodeStatus{}}}}
+}
+
+func TestHydrator(t *testing.T) {
+	repo := &mocks.OffloadNodeStatusRepo{}
+	repo.On("IsEnabled").Return(true)
+	repo.On("Save", mock.Anything, mock.Anything, mock.Anything).Return("my-version", nil)
+	repo.On("Get", mock.Anything, "my-version").Return(wfv1.Nodes{"foo": wfv1.NodeStatus{}}, nil)
+
+	t.Run("Kubernetes", func(t *testing.T) {
+		defer packer.SetMaxWorkflowSize(50)()
+		wf := newWorkflow()
+		err := New(repo, config.NodeStatusStorageKubernetes).Dehydrate(wf)
+		if assert.NoError(t, err) {
+			assert.False(t, wf.Status.IsOffloadNodeStatus())
+			assert.NotEmpty(t, wf.Status.CompressedNodes)
+		}
+	})
+	t.Run("Offload", func(t *testing.T) {
+		h := New(repo, config.NodeStatusStorageOffload)
+		wf := newWorkflow()
+		err := h.Dehydrate(wf)
+		if assert.NoError(t, err) {
+			assert.False(t, wf.Status.IsOffloadNodeStatus())
+			assert.Len(t, wf.Status.Nodes, 2)
+		}
+		defer packer.SetMaxWorkflowSize(50)()
+		err = h.Dehydrate(wf)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-version", wf.Status.OffloadNodeStatusVersion)
+			assert.Empty(t, wf.Status.Nodes)
+			assert.Empty(t, wf.Status.CompressedNodes)
+		}
+	})
+	t.Run("Database", func(t *testing.T) {
+		h := New(repo, config.NodeStatusStorageDatabase)
+		wf := newWorkflow()
+		err := h.Dehydrate(wf)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-version", wf.Status.OffloadNodeStatusVersion)
+			assert.Empty(t, wf.Status.Nodes)
+		}
+		err = h.Hydrate(wf)
+		if assert.NoError(t, err) {
+			assert.Len(t, wf.Status.Nodes, 1)
+		}
+	})
+	t.Run("DatabaseDisabled", func(t *testing.T) {
+		wf := newWorkflow()
+		err := New(sqldb.ExplosiveOffloadNodeStatusRepo, config.NodeStatusStorageDatabase).Dehydrate(wf)
+		if assert.NoError(t, err) {
+			assert.False(t, wf.Status.IsOffloadNodeStatus())
+			assert.Len(t, wf.Status.Nodes, 2)
+		}
+	})
+	t.Run("Compressed", func(t *testing.T) {
+		defer packer.SetMaxWorkflowSize(50)()
+		h := New(sqldb.ExplosiveOffloadNodeStatusRepo, config.NodeStatusStorageOffload)
+		wf := newWorkflow()
+		err := h.Dehydrate(wf)
+		if assert.NoError(t, err) {
+			assert.NotEmpty(t, wf.Status.CompressedNodes)
+		}
+		err = h.Hydrate(wf)
+		if assert.NoError(t, err) {
+			assert.Len(t, wf.Status.Nodes, 2)
+			assert.Empty(t, wf.Status.CompressedNodes)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+// Package manifest holds the types of the manifest of the output artifacts of a workflow, which the controller saves
+// in the artifact repository, so that the tools reading it do not depend on the controller.
+package manifest
+
+import (
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// FileName is the name of the artifact manifest in the archive location of the workflow
+const FileName = "artifact-manifest.json"
+
+// Manifest lists the output artifacts of a workflow
+type Manifest struct {
+	Workflow  string `json:"workflow"`
+	Namespace string `json:"namespace"`
+	UID       string `json:"uid"`
+	// ArtifactGCPhase is the phase of the deletion of the artifacts when the workflow completed, if they were garbage
+	// collected then, in which case their locations may not exist anymore
+	ArtifactGCPhase wfv1.NodePhase `json:"artifactGCPhase,omitempty"`
+	Artifacts       []Entry        `json:"artifacts"`
+}
+
+// Entry is an output artifact in the artifact manifest
+type Entry struct {
+	NodeID   string                `json:"nodeID"`
+	NodeName string                `json:"nodeName"`
+	Name     string                `json:"name"`
+	Location wfv1.ArtifactLocation `json:"location"`
+	Size     *int64                `json:"size,omitempty"`
+	Checksum string                `json:"checksum,omitempty"`
+}
@@ -44,6 +44,12 @@ var (
 		append(descWorkflowDefaultLabels, "phase"),
 		nil,
 	)
+	descWorkflowsCount = prometheus.NewDesc(
+		"argo_workflows_count",
+		"Number of workflows in each phase.",
+		[]string{"phase"},
+		nil,
+	)
 )
 
 func boolFloat64(b bool) float64 {
@@ -81,6 +87,7 @@ func (wc *workflowCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descWorkflowFinishedAt
 	ch <- descWorkflowCreated
 	ch <- descWorkflowStatusPhase
+	ch <- descWorkflowsCount
 }
 
 // Collect implements the prometheus.Collector interface
@@ -89,8 +96,24 @@ func (wc *workflowCollector) Collect(ch chan<- prometheus.Metric) {
 	if err != nil {
 		return
 	}
+	counts := map[wfv1.NodePhase]int{
+		wfv1.NodePending:   0,
+		wfv1.NodeRunning:   0,
+		wfv1.NodeSucceeded: 0,
+		wfv1.NodeSkipped:   0,
+		wfv1.NodeFailed:    0,
+		wfv1.NodeError:     0,
+	}
 	for _, wf := range workflows {
 		wc.collectWorkflow(ch, *wf)
+		phase := wf.Status.Phase
+		if phase == "" {
+			phase = wfv1.NodePending
+		}
+		counts[phase]++
+	}
+	for phase, count := range counts {
+		ch <- prometheus.MustNewConstMetric(descWorkflowsCount, prometheus.GaugeValue, float64(count), string(phase))
 	}
 }
 
@@ -0,0 +1,217 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// ControllerMetrics are the metrics about the operation of the workflow controller itself, together with the
+// custom metrics declared by workflows
+type ControllerMetrics struct {
+	registry           *prometheus.Registry
+	operationDurations prometheus.Histogram
+	nodeDurations      *prometheus.HistogramVec
+	podCreationErrors  prometheus.Counter
+	statusCacheHits    prometheus.Counter
+	statusCacheMisses  prometheus.Counter
+	operationPanics    prometheus.Counter
+	quarantined        prometheus.Counter
+
+	// custom metrics are created on first use, and keyed by metric name
+	customMetrics map[string]*customMetric
+	mutex         sync.Mutex
+}
+
+type customMetric struct {
+	kind      string
+	labelKeys []string
+	collector prometheus.Collector
+}
+
+// NewControllerMetrics creates the controller metrics. queueDepth is called on every scrape to report the number of
+// workflows waiting to be processed.
+func NewControllerMetrics(queueDepth func() int) *ControllerMetrics {
+	m := &ControllerMetrics{
+		registry: prometheus.NewRegistry(),
+		operationDurations: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "argo_workflow_operation_duration_seconds",
+			Help:    "Time taken to reconcile a workflow.",
+			Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}),
+		nodeDurations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "argo_node_duration_seconds",
+			Help:    "Duration of the completed nodes of all workflows, by template and phase.",
+			Buckets: []float64{1, 5, 15, 30, 60, 300, 900, 1800, 3600, 10800},
+		}, []string{"template_name", "phase"}),
+		podCreationErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "argo_pod_creation_errors_total",
+			Help: "Number of pods the controller failed to create.",
+		}),
+		statusCacheHits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "argo_workflow_status_cache_hits_total",
+			Help: "Number of reconciliations skipped because neither the workflow nor its pods changed.",
+		}),
+		statusCacheMisses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "argo_workflow_status_cache_misses_total",
+			Help: "Number of reconciliations of workflows which, or whose pods, changed.",
+		}),
+		operationPanics: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "argo_workflow_operation_panics_total",
+			Help: "Number of reconciliations of workflows which panicked.",
+		}),
+		quarantined: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "argo_workflows_quarantined_total",
+			Help: "Number of workflows which errored because their reconciliation panicked repeatedly.",
+		}),
+		customMetrics: make(map[string]*customMetric),
+	}
+	m.registry.MustRegister(m.operationDurations)
+	m.registry.MustRegister(m.nodeDurations)
+	m.registry.MustRegister(m.podCreationErrors)
+	m.registry.MustRegister(m.statusCacheHits)
+	m.registry.MustRegister(m.statusCacheMisses)
+	m.registry.MustRegister(m.operationPanics)
+	m.registry.MustRegister(m.quarantined)
+	m.registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "argo_workflow_queue_depth",
+		Help: "Number of workflows waiting to be processed.",
+	}, func() float64 {
+		return float64(queueDepth())
+	}))
+	return m
+}
+
+// Registry returns the registry the controller metrics are registered in
+func (m *ControllerMetrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// OperationCompleted records the time taken by a single reconciliation of a workflow
+func (m *ControllerMetrics) OperationCompleted(duration time.Duration) {
+	m.operationDurations.Observe(duration.Seconds())
+}
+
+// NodeCompleted records the duration of a completed node of a template. The count of the histogram is the number of
+// completed nodes.
+func (m *ControllerMetrics) NodeCompleted(templateName string, phase wfv1.NodePhase, duration time.Duration) {
+	m.nodeDurations.WithLabelValues(templateName, string(phase)).Observe(duration.Seconds())
+}
+
+// PodCreationFailed records a failure to create a pod
+func (m *ControllerMetrics) PodCreationFailed() {
+	m.podCreationErrors.Inc()
+}
+
+// StatusCacheHit records a reconciliation which was skipped because neither the workflow nor its pods changed
+func (m *ControllerMetrics) StatusCacheHit() {
+	m.statusCacheHits.Inc()
+}
+
+// StatusCacheMiss records a reconciliation which could not be skipped
+func (m *ControllerMetrics) StatusCacheMiss() {
+	m.statusCacheMisses.Inc()
+}
+
+// OperationPanicked records a reconciliation of a workflow which panicked
+func (m *ControllerMetrics) OperationPanicked() {
+	m.operationPanics.Inc()
+}
+
+// WorkflowQuarantined records a workflow which errored because its reconciliation panicked repeatedly
+func (m *ControllerMetrics) WorkflowQuarantined() {
+	m.quarantined.Inc()
+}
+
+// EmitCustomMetric updates a custom metric whose value and labels were already resolved. The first use of a metric
+// name determines its type, help and labels; later uses which disagree are rejected.
+func (m *ControllerMetrics) EmitCustomMetric(metric wfv1.Prometheus) error {
+	kind, valueStr := customMetricValue(metric)
+	if kind == "" {
+		return fmt.Errorf("metric '%s' must be one of gauge, counter or histogram", metric.Name)
+	}
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		return fmt.Errorf("metric '%s' has invalid value '%s': %v", metric.Name, valueStr, err)
+	}
+	labels := prometheus.Labels{}
+	var labelKeys []string
+	for _, label := range metric.Labels {
+		labels[label.Key] = label.Value
+		labelKeys = append(labelKeys, label.Key)
+	}
+	sort.Strings(labelKeys)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	custom, ok := m.customMetrics[metric.Name]
+	if !ok {
+		custom, err = m.newCustomMetric(metric, kind, labelKeys)
+		if err != nil {
+			return err
+		}
+		m.customMetrics[metric.Name] = custom
+	}
+	if custom.kind != kind || !equalStrings(custom.labelKeys, labelKeys) {
+		return fmt.Errorf("metric '%s' was already declared with a different type or labels", metric.Name)
+	}
+	switch collector := custom.collector.(type) {
+	case *prometheus.GaugeVec:
+		collector.With(labels).Set(value)
+	case *prometheus.CounterVec:
+		if value < 0 {
+			return fmt.Errorf("counter '%s' cannot be decremented", metric.Name)
+		}
+		collector.With(labels).Add(value)
+	case *prometheus.HistogramVec:
+		collector.With(labels).Observe(value)
+	}
+	return nil
+}
+
+func (m *ControllerMetrics) newCustomMetric(metric wfv1.Prometheus, kind string, labelKeys []string) (*customMetric, error) {
+	var collector prometheus.Collector
+	switch kind {
+	case "gauge":
+		collector = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: metric.Name, Help: metric.Help}, labelKeys)
+	case "counter":
+		collector = prometheus.NewCounterVec(prometheus.CounterOpts{Name: metric.Name, Help: metric.Help}, labelKeys)
+	case "histogram":
+		collector = prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: metric.Name, Help: metric.Help, Buckets: metric.Histogram.Buckets}, labelKeys)
+	}
+	err := m.registry.Register(collector)
+	if err != nil {
+		return nil, fmt.Errorf("metric '%s' cannot be registered: %v", metric.Name, err)
+	}
+	return &customMetric{kind: kind, labelKeys: labelKeys, collector: collector}, nil
+}
+
+func customMetricValue(metric wfv1.Prometheus) (string, string) {
+	switch {
+	case metric.Gauge != nil:
+		return "gauge", metric.Gauge.Value
+	case metric.Counter != nil:
+		return "counter", metric.Counter.Value
+	case metric.Histogram != nil:
+		return "histogram", metric.Histogram.Value
+	}
+	return "", ""
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
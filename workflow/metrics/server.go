@@ -18,9 +18,9 @@ type PrometheusConfig struct {
 }
 
 // RunServer starts a metrics server
-func RunServer(ctx context.Context, config PrometheusConfig, registry *prometheus.Registry) {
+func RunServer(ctx context.Context, config PrometheusConfig, gatherer prometheus.Gatherer) {
 	mux := http.NewServeMux()
-	mux.Handle(config.Path, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.Handle(config.Path, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
 	srv := &http.Server{Addr: fmt.Sprintf(":%s", config.Port), Handler: mux}
 
 	defer func() {
@@ -0,0 +1,62 @@
+package policy
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/config"
+)
+
+// Policy decides whether a workflow may start, based on its content
+type Policy interface {
+	// Evaluate returns the reason the workflow is rejected, or an empty string if it is allowed. An error means the
+	// policy could not be evaluated.
+	Evaluate(wf *wfv1.Workflow) (string, error)
+	// EvaluatePod returns the reason a pod of a workflow is rejected, or an empty string if it is allowed. Pods are
+	// evaluated once their spec is final, since their images and security contexts may come from parameters, from
+	// referenced templates or from patches. The containers and volumes the controller injected, i.e. the init and wait
+	// containers of the executor and their volumes, are trusted as long as the workflow did not patch them.
+	EvaluatePod(pod *apiv1.Pod, injected apiv1.PodSpec) string
+}
+
+// New returns the policy of a configuration, or nil if there is none
+func New(c *config.PolicyConfig) (Policy, error) {
+	if c == nil {
+		return nil, nil
+	}
+	r, err := newRules(c)
+	if err != nil {
+		return nil, err
+	}
+	p := policies{r}
+	if c.Webhook != nil {
+		w, err := newWebhook(*c.Webhook)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, w)
+	}
+	return p, nil
+}
+
+// policies reject the workflows which any of them rejects. They are evaluated in order.
+type policies []Policy
+
+func (p policies) Evaluate(wf *wfv1.Workflow) (string, error) {
+	for _, policy := range p {
+		reason, err := policy.Evaluate(wf)
+		if err != nil || reason != "" {
+			return reason, err
+		}
+	}
+	return "", nil
+}
+
+func (p policies) EvaluatePod(pod *apiv1.Pod, injected apiv1.PodSpec) string {
+	for _, policy := range p {
+		if reason := policy.EvaluatePod(pod, injected); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
@@ -0,0 +1,223 @@
+package policy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/config"
+)
+
+var helloWorldWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: hello-world
+  labels:
+    team: data
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+    sidecars:
+    - name: proxy
+      image: envoyproxy/envoy:v1.14.1
+`
+
+func unmarshalWF(t *testing.T) *wfv1.Workflow {
+	var wf wfv1.Workflow
+	err := yaml.Unmarshal([]byte(helloWorldWf), &wf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &wf
+}
+
+func TestNew(t *testing.T) {
+	p, err := New(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+
+	_, err = New(&config.PolicyConfig{DisallowedImages: []string{""}})
+	assert.EqualError(t, err, "policy.disallowedImages cannot contain an empty pattern")
+
+	_, err = New(&config.PolicyConfig{Webhook: &config.PolicyWebhook{URL: "opa:8181"}})
+	assert.EqualError(t, err, "policy.webhook.url must be an absolute http or https URL")
+
+	_, err = New(&config.PolicyConfig{Webhook: &config.PolicyWebhook{URL: "http://opa:8181", FailurePolicy: "Retry"}})
+	assert.EqualError(t, err, "policy.webhook.failurePolicy must be one of: Fail, Ignore")
+}
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		config config.PolicyConfig
+		modify func(wf *wfv1.Workflow)
+		reason string
+	}{
+		{"Allowed", config.PolicyConfig{DisallowedImages: []string{"*:master"}, RequiredLabels: []string{"team"}, DisallowPrivileged: true}, nil, ""},
+		{"MissingLabel", config.PolicyConfig{RequiredLabels: []string{"team", "owner"}}, nil, "the label owner is required"},
+		{"DisallowedImage", config.PolicyConfig{DisallowedImages: []string{"*:latest"}}, nil, "template whalesay runs the image docker/whalesay:latest, which matches the disallowed images *:latest"},
+		{"DisallowedSidecarImage", config.PolicyConfig{DisallowedImages: []string{"envoyproxy/*"}}, nil, "template whalesay runs the image envoyproxy/envoy:v1.14.1, which matches the disallowed images envoyproxy/*"},
+		{"Privileged", config.PolicyConfig{DisallowPrivileged: true}, func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Sidecars[0].SecurityContext = &apiv1.SecurityContext{Privileged: pointer.BoolPtr(true)}
+		}, "template whalesay runs a privileged container"},
+		{"Capability", config.PolicyConfig{DisallowPrivileged: true}, func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Sidecars[0].SecurityContext = &apiv1.SecurityContext{Capabilities: &apiv1.Capabilities{Add: []apiv1.Capability{"NET_ADMIN"}}}
+		}, "template whalesay runs a container adding the capability NET_ADMIN"},
+		{"AllowedCapability", config.PolicyConfig{DisallowPrivileged: true, AllowedCapabilities: []string{"NET_ADMIN"}}, func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Sidecars[0].SecurityContext = &apiv1.SecurityContext{Capabilities: &apiv1.Capabilities{Add: []apiv1.Capability{"NET_ADMIN"}}}
+		}, ""},
+		{"HostNetwork", config.PolicyConfig{DisallowPrivileged: true}, func(wf *wfv1.Workflow) {
+			wf.Spec.HostNetwork = pointer.BoolPtr(true)
+		}, "the workflow uses the network of the host"},
+		{"HostPath", config.PolicyConfig{DisallowPrivileged: true}, func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Volumes = []apiv1.Volume{{Name: "host", VolumeSource: apiv1.VolumeSource{HostPath: &apiv1.HostPathVolumeSource{Path: "/var/lib"}}}}
+		}, "template whalesay mounts the host path /var/lib"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(&tt.config)
+			if !assert.NoError(t, err) {
+				return
+			}
+			wf := unmarshalWF(t)
+			if tt.modify != nil {
+				tt.modify(wf)
+			}
+			reason, err := p.Evaluate(wf)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+}
+
+func TestRulesPod(t *testing.T) {
+	p, err := New(&config.PolicyConfig{DisallowedImages: []string{"*:latest"}, DisallowPrivileged: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	dockerSock := apiv1.Volume{Name: "docker-sock", VolumeSource: apiv1.VolumeSource{HostPath: &apiv1.HostPathVolumeSource{Path: "/var/run/docker.sock"}}}
+	newPod := func() *apiv1.Pod {
+		return &apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "hello-world"},
+			Spec: apiv1.PodSpec{
+				InitContainers: []apiv1.Container{{Name: "init", Image: "argoproj/argoexec:latest"}},
+				Containers: []apiv1.Container{
+					{Name: "wait", Image: "argoproj/argoexec:latest", SecurityContext: &apiv1.SecurityContext{Privileged: pointer.BoolPtr(true)}},
+					{Name: "main", Image: "docker/whalesay:v1"},
+				},
+				Volumes: []apiv1.Volume{*dockerSock.DeepCopy()},
+			},
+		}
+	}
+	pod := newPod()
+	injected := apiv1.PodSpec{
+		InitContainers: []apiv1.Container{pod.Spec.InitContainers[0]},
+		Containers:     []apiv1.Container{pod.Spec.Containers[0]},
+		Volumes:        []apiv1.Volume{dockerSock},
+	}
+	// the containers and volumes injected by the controller are trusted
+	assert.Empty(t, p.EvaluatePod(pod, injected))
+
+	// but not once patched by the workflow
+	pod.Spec.Containers[0].Command = []string{"sh", "-c", "cat /etc/shadow"}
+	assert.Equal(t, "pod hello-world runs the image argoproj/argoexec:latest, which matches the disallowed images *:latest", p.EvaluatePod(pod, injected))
+	pod = newPod()
+	pod.Spec.Volumes[0].HostPath.Path = "/"
+	assert.Equal(t, "pod hello-world mounts the host path /", p.EvaluatePod(pod, injected))
+
+	// nor is the executor image run by other containers
+	pod = newPod()
+	pod.Spec.Containers[1].Image = "argoproj/argoexec:latest"
+	assert.Equal(t, "pod hello-world runs the image argoproj/argoexec:latest, which matches the disallowed images *:latest", p.EvaluatePod(pod, injected))
+
+	// the image of a container is only known once its parameters are substituted
+	pod = newPod()
+	pod.Spec.Containers[1].Image = "docker/whalesay:latest"
+	assert.Equal(t, "pod hello-world runs the image docker/whalesay:latest, which matches the disallowed images *:latest", p.EvaluatePod(pod, injected))
+
+	// and its security context may be patched
+	pod = newPod()
+	pod.Spec.Containers[1].SecurityContext = &apiv1.SecurityContext{Privileged: pointer.BoolPtr(true)}
+	assert.Equal(t, "pod hello-world runs a privileged container", p.EvaluatePod(pod, injected))
+	pod = newPod()
+	pod.Spec.Containers[1].SecurityContext = &apiv1.SecurityContext{Capabilities: &apiv1.Capabilities{Add: []apiv1.Capability{"SYS_ADMIN"}}}
+	assert.Equal(t, "pod hello-world runs a container adding the capability SYS_ADMIN", p.EvaluatePod(pod, injected))
+
+	// as may the namespaces of the pod
+	pod = newPod()
+	pod.Spec.HostPID = true
+	assert.Equal(t, "pod hello-world shares the process namespace of the host", p.EvaluatePod(pod, injected))
+	pod = newPod()
+	pod.Spec.HostNetwork = true
+	assert.Equal(t, "pod hello-world uses the network of the host", p.EvaluatePod(pod, injected))
+	pod = newPod()
+	pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{Name: "host", VolumeSource: apiv1.VolumeSource{HostPath: &apiv1.HostPathVolumeSource{Path: "/var/lib"}}})
+	assert.Equal(t, "pod hello-world mounts the host path /var/lib", p.EvaluatePod(pod, injected))
+}
+
+func TestWebhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		review := &Review{}
+		err := json.NewDecoder(r.Body).Decode(review)
+		if err != nil || review.Workflow == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch review.Workflow.ObjectMeta.Name {
+		case "error":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		case "rejected":
+			review.Reason = "workflows named rejected are rejected"
+		default:
+			review.Allowed = true
+		}
+		review.Workflow = nil
+		_ = json.NewEncoder(w).Encode(review)
+	}))
+	defer server.Close()
+
+	// the built-in rules are evaluated before the webhook
+	p, err := New(&config.PolicyConfig{RequiredLabels: []string{"team"}, Webhook: &config.PolicyWebhook{URL: server.URL}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	wf := unmarshalWF(t)
+	reason, err := p.Evaluate(wf)
+	assert.NoError(t, err)
+	assert.Empty(t, reason)
+
+	wf.ObjectMeta.Name = "rejected"
+	reason, err = p.Evaluate(wf)
+	assert.NoError(t, err)
+	assert.Equal(t, "workflows named rejected are rejected", reason)
+
+	wf.ObjectMeta.Labels = nil
+	reason, err = p.Evaluate(wf)
+	assert.NoError(t, err)
+	assert.Equal(t, "the label team is required", reason)
+
+	wf = unmarshalWF(t)
+	wf.ObjectMeta.Name = "error"
+	_, err = p.Evaluate(wf)
+	assert.EqualError(t, err, "policy webhook responded with status code 500")
+
+	p, err = New(&config.PolicyConfig{Webhook: &config.PolicyWebhook{URL: server.URL, FailurePolicy: config.PolicyFailurePolicyIgnore}})
+	if assert.NoError(t, err) {
+		reason, err = p.Evaluate(wf)
+		assert.NoError(t, err)
+		assert.Empty(t, reason)
+	}
+}
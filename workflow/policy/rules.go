@@ -0,0 +1,179 @@
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/config"
+)
+
+// rules are the built-in policy: disallowed images, required labels and privileged pods
+type rules struct {
+	disallowedImages    []string
+	disallowedRegexps   []*regexp.Regexp
+	requiredLabels      []string
+	disallowPrivileged  bool
+	allowedCapabilities []string
+}
+
+func newRules(c *config.PolicyConfig) (*rules, error) {
+	r := &rules{
+		disallowedImages:    c.DisallowedImages,
+		requiredLabels:      c.RequiredLabels,
+		disallowPrivileged:  c.DisallowPrivileged,
+		allowedCapabilities: c.AllowedCapabilities,
+	}
+	for _, pattern := range c.DisallowedImages {
+		if pattern == "" {
+			return nil, fmt.Errorf("policy.disallowedImages cannot contain an empty pattern")
+		}
+		r.disallowedRegexps = append(r.disallowedRegexps, regexp.MustCompile("^"+strings.Replace(regexp.QuoteMeta(pattern), `\*`, ".*", -1)+"$"))
+	}
+	return r, nil
+}
+
+func (r *rules) Evaluate(wf *wfv1.Workflow) (string, error) {
+	for _, key := range r.requiredLabels {
+		if _, ok := wf.ObjectMeta.Labels[key]; !ok {
+			return fmt.Sprintf("the label %s is required", key), nil
+		}
+	}
+	if r.disallowPrivileged && wf.Spec.HostNetwork != nil && *wf.Spec.HostNetwork {
+		return "the workflow uses the network of the host", nil
+	}
+	if reason := r.evaluateVolumes(wf.Spec.Volumes, nil); reason != "" {
+		return fmt.Sprintf("the workflow %s", reason), nil
+	}
+	for _, tmpl := range wf.Spec.Templates {
+		for _, c := range containers(tmpl) {
+			if reason := r.evaluateContainer(c); reason != "" {
+				return fmt.Sprintf("template %s %s", tmpl.Name, reason), nil
+			}
+		}
+		if reason := r.evaluateVolumes(tmpl.Volumes, nil); reason != "" {
+			return fmt.Sprintf("template %s %s", tmpl.Name, reason), nil
+		}
+	}
+	return "", nil
+}
+
+func (r *rules) EvaluatePod(pod *apiv1.Pod, injected apiv1.PodSpec) string {
+	for _, cs := range [][]apiv1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, c := range cs {
+			if isInjectedContainer(c, injected) {
+				continue
+			}
+			if reason := r.evaluateContainer(c); reason != "" {
+				return fmt.Sprintf("pod %s %s", pod.ObjectMeta.Name, reason)
+			}
+		}
+	}
+	if r.disallowPrivileged {
+		switch {
+		case pod.Spec.HostPID:
+			return fmt.Sprintf("pod %s shares the process namespace of the host", pod.ObjectMeta.Name)
+		case pod.Spec.HostIPC:
+			return fmt.Sprintf("pod %s shares the IPC namespace of the host", pod.ObjectMeta.Name)
+		case pod.Spec.HostNetwork:
+			return fmt.Sprintf("pod %s uses the network of the host", pod.ObjectMeta.Name)
+		}
+	}
+	if reason := r.evaluateVolumes(pod.Spec.Volumes, injected.Volumes); reason != "" {
+		return fmt.Sprintf("pod %s %s", pod.ObjectMeta.Name, reason)
+	}
+	return ""
+}
+
+// evaluateContainer returns what a container does which is disallowed, or an empty string if it is allowed
+func (r *rules) evaluateContainer(c apiv1.Container) string {
+	for i, re := range r.disallowedRegexps {
+		if re.MatchString(c.Image) {
+			return fmt.Sprintf("runs the image %s, which matches the disallowed images %s", c.Image, r.disallowedImages[i])
+		}
+	}
+	if !r.disallowPrivileged || c.SecurityContext == nil {
+		return ""
+	}
+	if c.SecurityContext.Privileged != nil && *c.SecurityContext.Privileged {
+		return "runs a privileged container"
+	}
+	if c.SecurityContext.Capabilities != nil {
+		for _, capability := range c.SecurityContext.Capabilities.Add {
+			if !r.allowsCapability(capability) {
+				return fmt.Sprintf("runs a container adding the capability %s", capability)
+			}
+		}
+	}
+	return ""
+}
+
+// allowsCapability returns whether containers may add a capability
+func (r *rules) allowsCapability(capability apiv1.Capability) bool {
+	for _, allowed := range r.allowedCapabilities {
+		if strings.EqualFold(strings.TrimPrefix(string(capability), "CAP_"), strings.TrimPrefix(allowed, "CAP_")) {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluateVolumes returns which of the volumes is disallowed, or an empty string if they are allowed. The host paths
+// among the injected volumes are allowed.
+func (r *rules) evaluateVolumes(volumes []apiv1.Volume, injected []apiv1.Volume) string {
+	if !r.disallowPrivileged {
+		return ""
+	}
+	for _, v := range volumes {
+		if v.HostPath != nil && !isInjectedVolume(v, injected) {
+			return fmt.Sprintf("mounts the host path %s", v.HostPath.Path)
+		}
+	}
+	return ""
+}
+
+// isInjectedContainer returns whether a container of a pod is one the controller injected, with the same name and
+// unchanged since, e.g. not patched by the workflow
+func isInjectedContainer(c apiv1.Container, injected apiv1.PodSpec) bool {
+	for _, cs := range [][]apiv1.Container{injected.InitContainers, injected.Containers} {
+		for _, i := range cs {
+			if i.Name == c.Name {
+				return apiequality.Semantic.DeepEqual(i, c)
+			}
+		}
+	}
+	return false
+}
+
+// isInjectedVolume returns whether a volume of a pod is one the controller injected, with the same name and unchanged
+// since
+func isInjectedVolume(v apiv1.Volume, injected []apiv1.Volume) bool {
+	for _, i := range injected {
+		if i.Name == v.Name {
+			return apiequality.Semantic.DeepEqual(i, v)
+		}
+	}
+	return false
+}
+
+// containers returns the containers of the pod of a template
+func containers(tmpl wfv1.Template) []apiv1.Container {
+	var cs []apiv1.Container
+	if tmpl.Container != nil {
+		cs = append(cs, *tmpl.Container)
+	}
+	if tmpl.Script != nil {
+		cs = append(cs, tmpl.Script.Container)
+	}
+	for _, c := range tmpl.InitContainers {
+		cs = append(cs, c.Container)
+	}
+	for _, c := range tmpl.Sidecars {
+		cs = append(cs, c.Container)
+	}
+	return cs
+}
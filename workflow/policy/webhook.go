@@ -0,0 +1,111 @@
+package policy
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util"
+	"github.com/argoproj/argo/workflow/config"
+)
+
+// defaultWebhookTimeout is the time the webhook may take to respond unless configured otherwise
+const defaultWebhookTimeout = 10 * time.Second
+
+// Review is posted to the webhook with the workflow to review, and the webhook responds with a review which says
+// whether the workflow is allowed
+type Review struct {
+	// Workflow to review, in the request
+	Workflow *wfv1.Workflow `json:"workflow,omitempty"`
+	// Allowed is whether the workflow may start, in the response
+	Allowed bool `json:"allowed"`
+	// Reason the workflow is rejected, in the response
+	Reason string `json:"reason,omitempty"`
+}
+
+// webhook is a policy delegated to an HTTP service
+type webhook struct {
+	url           string
+	client        *http.Client
+	ignoreFailure bool
+}
+
+func newWebhook(c config.PolicyWebhook) (*webhook, error) {
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("policy.webhook.url must be an absolute http or https URL")
+	}
+	switch c.FailurePolicy {
+	case "", config.PolicyFailurePolicyFail, config.PolicyFailurePolicyIgnore:
+	default:
+		return nil, fmt.Errorf("policy.webhook.failurePolicy must be one of: %s, %s", config.PolicyFailurePolicyFail, config.PolicyFailurePolicyIgnore)
+	}
+	timeout := defaultWebhookTimeout
+	if c.TimeoutSeconds != nil {
+		timeout = time.Duration(*c.TimeoutSeconds) * time.Second
+	}
+	return &webhook{
+		url: c.URL,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify},
+			},
+		},
+		ignoreFailure: c.FailurePolicy == config.PolicyFailurePolicyIgnore,
+	}, nil
+}
+
+func (w *webhook) Evaluate(wf *wfv1.Workflow) (string, error) {
+	review, err := w.review(wf)
+	if err != nil {
+		if w.ignoreFailure {
+			log.WithField("workflow", wf.ObjectMeta.Name).Warnf("Ignoring the failure of the policy webhook: %v", err)
+			return "", nil
+		}
+		return "", err
+	}
+	if review.Allowed {
+		return "", nil
+	}
+	if review.Reason == "" {
+		return "rejected by the policy webhook", nil
+	}
+	return review.Reason, nil
+}
+
+// EvaluatePod allows every pod, since the webhook reviews the workflows as a whole
+func (w *webhook) EvaluatePod(*apiv1.Pod, apiv1.PodSpec) string {
+	return ""
+}
+
+// review posts the workflow to the webhook and returns its response
+func (w *webhook) review(wf *wfv1.Workflow) (*Review, error) {
+	data, err := json.Marshal(Review{Workflow: wf})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("policy webhook responded with status code %d", resp.StatusCode)
+	}
+	review := &Review{}
+	err = json.NewDecoder(resp.Body).Decode(review)
+	if err != nil {
+		return nil, fmt.Errorf("policy webhook responded with an invalid review: %v", err)
+	}
+	return review, nil
+}
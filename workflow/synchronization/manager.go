@@ -0,0 +1,233 @@
+package synchronization
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	lockKindConfigMap = "ConfigMap"
+	lockKindMutex     = "Mutex"
+)
+
+// GetSemaphoreLimit returns the limit of a semaphore, i.e. the value of a config map key in a namespace
+type GetSemaphoreLimit func(namespace string, ref *apiv1.ConfigMapKeySelector) (int, error)
+
+// NextWorkflow is called with the key of a workflow which may now acquire a lock it is waiting for
+type NextWorkflow func(key string)
+
+// Manager grants the locks of the semaphores and mutexes which workflows and templates synchronize on. Locks are held
+// in memory, and recorded in the status of the workflows so that they can be restored after a restart.
+type Manager struct {
+	locks        map[string]*semaphore
+	lock         *sync.Mutex
+	getLimit     GetSemaphoreLimit
+	nextWorkflow NextWorkflow
+}
+
+func NewManager(getLimit GetSemaphoreLimit, nextWorkflow NextWorkflow) *Manager {
+	return &Manager{
+		locks:        make(map[string]*semaphore),
+		lock:         &sync.Mutex{},
+		getLimit:     getLimit,
+		nextWorkflow: nextWorkflow,
+	}
+}
+
+// Initialize restores the locks recorded in the status of the workflows
+func (m *Manager) Initialize(wfs []*wfv1.Workflow) {
+	for _, wf := range wfs {
+		if wf.Status.Synchronization == nil {
+			continue
+		}
+		for _, holding := range wf.Status.Synchronization.Holding {
+			sem, err := m.getSemaphore(holding.Lock)
+			if err != nil {
+				log.Warnf("Failed to restore lock %s of workflow %s/%s: %v", holding.Lock, wf.Namespace, wf.Name, err)
+				continue
+			}
+			m.lock.Lock()
+			sem.acquire(holderKey(wf, holding.Holder))
+			m.lock.Unlock()
+			log.Infof("Restored lock %s of workflow %s/%s", holding.Lock, wf.Namespace, wf.Name)
+		}
+	}
+}
+
+// TryAcquire tries to acquire the lock of the workflow, or of one of its nodes if nodeID is not empty. It returns
+// whether the lock is held, whether the status of the workflow was updated, and the name of the lock.
+func (m *Manager) TryAcquire(wf *wfv1.Workflow, nodeID string, synchronization *wfv1.Synchronization) (bool, bool, string, error) {
+	lockName, err := getLockName(wf.Namespace, synchronization)
+	if err != nil {
+		return false, false, "", err
+	}
+	sem, err := m.getSemaphore(lockName)
+	if err != nil {
+		return false, false, lockName, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if !sem.tryAcquire(holderKey(wf, nodeID), getPriority(wf), wf.CreationTimestamp.Time) {
+		return false, false, lockName, nil
+	}
+	if wf.Status.Synchronization == nil {
+		wf.Status.Synchronization = &wfv1.SynchronizationStatus{}
+	}
+	for _, holding := range wf.Status.Synchronization.Holding {
+		if holding.Lock == lockName && holding.Holder == nodeID {
+			return true, false, lockName, nil
+		}
+	}
+	wf.Status.Synchronization.Holding = append(wf.Status.Synchronization.Holding, wfv1.LockHolding{Lock: lockName, Holder: nodeID})
+	return true, true, lockName, nil
+}
+
+// Release releases the locks held by the workflow, or by one of its nodes if nodeID is not empty. It returns whether
+// the status of the workflow was updated.
+func (m *Manager) Release(wf *wfv1.Workflow, nodeID string) bool {
+	if wf.Status.Synchronization == nil {
+		return false
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var holding []wfv1.LockHolding
+	for _, h := range wf.Status.Synchronization.Holding {
+		if h.Holder != nodeID {
+			holding = append(holding, h)
+			continue
+		}
+		if sem, ok := m.locks[h.Lock]; ok {
+			sem.release(holderKey(wf, nodeID))
+			m.notify(sem)
+		}
+	}
+	if len(holding) == len(wf.Status.Synchronization.Holding) {
+		return false
+	}
+	wf.Status.Synchronization.Holding = holding
+	if len(holding) == 0 {
+		wf.Status.Synchronization = nil
+	}
+	return true
+}
+
+// ReleaseAll releases every lock held by the workflow and its nodes, and stops them waiting for any lock. It returns
+// whether the status of the workflow was updated.
+func (m *Manager) ReleaseAll(wf *wfv1.Workflow) bool {
+	m.ReleaseWorkflow(wf.Namespace + "/" + wf.Name)
+	if wf.Status.Synchronization == nil {
+		return false
+	}
+	wf.Status.Synchronization = nil
+	return true
+}
+
+// ReleaseWorkflow releases every lock held by the workflow with the given key and its nodes, and stops them waiting
+// for any lock, e.g. once the workflow was deleted
+func (m *Manager) ReleaseWorkflow(key string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, sem := range m.locks {
+		var holderKeys []string
+		for holder := range sem.holders {
+			holderKeys = append(holderKeys, holder)
+		}
+		for _, holder := range sem.pending {
+			holderKeys = append(holderKeys, holder.key)
+		}
+		released := false
+		for _, holder := range holderKeys {
+			if holder == key || strings.HasPrefix(holder, key+"/") {
+				sem.release(holder)
+				released = true
+			}
+		}
+		if released {
+			m.notify(sem)
+		}
+	}
+}
+
+// getSemaphore returns the semaphore of a lock, creating it or updating its limit if needed
+func (m *Manager) getSemaphore(lockName string) (*semaphore, error) {
+	limit, err := m.getLockLimit(lockName)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	sem, ok := m.locks[lockName]
+	if !ok {
+		sem = newSemaphore(lockName, limit)
+		m.locks[lockName] = sem
+	} else if sem.limit != limit {
+		log.Infof("Updated limit of lock %s from %d to %d", lockName, sem.limit, limit)
+		sem.limit = limit
+		m.notify(sem)
+	}
+	return sem, nil
+}
+
+func (m *Manager) getLockLimit(lockName string) (int, error) {
+	parts := strings.Split(lockName, "/")
+	switch {
+	case len(parts) == 3 && parts[1] == lockKindMutex:
+		return 1, nil
+	case len(parts) == 4 && parts[1] == lockKindConfigMap:
+		return m.getLimit(parts[0], &apiv1.ConfigMapKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: parts[2]}, Key: parts[3]})
+	}
+	return 0, errors.Errorf(errors.CodeBadRequest, "invalid lock name '%s'", lockName)
+}
+
+// notify requeues the workflows waiting for the lock which may acquire it now
+func (m *Manager) notify(sem *semaphore) {
+	for _, holder := range sem.next() {
+		m.nextWorkflow(workflowKey(holder))
+	}
+}
+
+// getLockName returns the name of a lock, which is unique within the cluster, e.g. "argo/Mutex/my-mutex"
+func getLockName(namespace string, synchronization *wfv1.Synchronization) (string, error) {
+	switch {
+	case synchronization.Mutex != nil:
+		return fmt.Sprintf("%s/%s/%s", namespace, lockKindMutex, synchronization.Mutex.Name), nil
+	case synchronization.Semaphore != nil && synchronization.Semaphore.ConfigMapKeyRef != nil:
+		ref := synchronization.Semaphore.ConfigMapKeyRef
+		return fmt.Sprintf("%s/%s/%s/%s", namespace, lockKindConfigMap, ref.Name, ref.Key), nil
+	}
+	return "", errors.New(errors.CodeBadRequest, "synchronization must have either a semaphore or a mutex")
+}
+
+// getPriority returns the priority of a workflow, which orders the holders waiting for the same lock
+func getPriority(wf *wfv1.Workflow) int32 {
+	if wf.Spec.Priority != nil {
+		return *wf.Spec.Priority
+	}
+	return 0
+}
+
+// holderKey identifies the holder of a lock, which is either a workflow ("<namespace>/<name>") or one of its nodes
+// ("<namespace>/<name>/<nodeID>")
+func holderKey(wf *wfv1.Workflow, nodeID string) string {
+	key := wf.Namespace + "/" + wf.Name
+	if nodeID != "" {
+		key += "/" + nodeID
+	}
+	return key
+}
+
+// workflowKey returns the key of the workflow of a holder
+func workflowKey(holderKey string) string {
+	parts := strings.SplitN(holderKey, "/", 3)
+	if len(parts) < 2 {
+		return holderKey
+	}
+	return parts[0] + "/" + parts[1]
+}
@@ -0,0 +1,158 @@
+package synchronization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+func newTestManager(limit *int, next *[]string) *Manager {
+	return NewManager(func(namespace string, ref *apiv1.ConfigMapKeySelector) (int, error) {
+		return *limit, nil
+	}, func(key string) {
+		*next = append(*next, key)
+	})
+}
+
+func newTestWorkflow(name string) *wfv1.Workflow {
+	return &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: "argo", Name: name}}
+}
+
+var semaphoreSync = &wfv1.Synchronization{
+	Semaphore: &wfv1.SemaphoreRef{
+		ConfigMapKeyRef: &apiv1.ConfigMapKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-config"}, Key: "workflow"},
+	},
+}
+
+var mutexSync = &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "my-mutex"}}
+
+func TestSemaphore(t *testing.T) {
+	limit := 2
+	var next []string
+	m := newTestManager(&limit, &next)
+	wf1, wf2, wf3 := newTestWorkflow("one"), newTestWorkflow("two"), newTestWorkflow("three")
+
+	acquired, updated, lockName, err := m.TryAcquire(wf1, "", semaphoreSync)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	assert.True(t, updated)
+	assert.Equal(t, "argo/ConfigMap/my-config/workflow", lockName)
+	assert.Equal(t, []wfv1.LockHolding{{Lock: lockName}}, wf1.Status.Synchronization.Holding)
+
+	// acquiring a held lock again does not update the status
+	acquired, updated, _, _ = m.TryAcquire(wf1, "", semaphoreSync)
+	assert.True(t, acquired)
+	assert.False(t, updated)
+
+	acquired, _, _, _ = m.TryAcquire(wf2, "", semaphoreSync)
+	assert.True(t, acquired)
+	acquired, updated, _, _ = m.TryAcquire(wf3, "", semaphoreSync)
+	assert.False(t, acquired)
+	assert.False(t, updated)
+	assert.Nil(t, wf3.Status.Synchronization)
+
+	assert.True(t, m.Release(wf1, ""))
+	assert.Nil(t, wf1.Status.Synchronization)
+	assert.Equal(t, []string{"argo/three"}, next)
+	acquired, _, _, _ = m.TryAcquire(wf3, "", semaphoreSync)
+	assert.True(t, acquired)
+
+	// raising the limit wakes up waiting workflows
+	acquired, _, _, _ = m.TryAcquire(wf1, "", semaphoreSync)
+	assert.False(t, acquired)
+	next = nil
+	limit = 3
+	acquired, _, _, _ = m.TryAcquire(wf1, "", semaphoreSync)
+	assert.True(t, acquired)
+	assert.Equal(t, []string{"argo/one"}, next)
+}
+
+func TestMutexFirstComeFirstServed(t *testing.T) {
+	limit := 0
+	var next []string
+	m := newTestManager(&limit, &next)
+	wf1, wf2 := newTestWorkflow("one"), newTestWorkflow("two")
+
+	acquired, _, lockName, err := m.TryAcquire(wf1, "node-a", mutexSync)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	assert.Equal(t, "argo/Mutex/my-mutex", lockName)
+	acquired, _, _, _ = m.TryAcquire(wf2, "node-b", mutexSync)
+	assert.False(t, acquired)
+	acquired, _, _, _ = m.TryAcquire(wf1, "node-c", mutexSync)
+	assert.False(t, acquired)
+
+	// releasing the workflow lock does not release the locks of its nodes
+	assert.False(t, m.Release(wf1, ""))
+	assert.True(t, m.Release(wf1, "node-a"))
+	assert.Equal(t, []string{"argo/two"}, next)
+
+	// node-b asked first, so node-c keeps waiting
+	acquired, _, _, _ = m.TryAcquire(wf1, "node-c", mutexSync)
+	assert.False(t, acquired)
+	acquired, _, _, _ = m.TryAcquire(wf2, "node-b", mutexSync)
+	assert.True(t, acquired)
+}
+
+func TestReleaseAll(t *testing.T) {
+	limit := 0
+	var next []string
+	m := newTestManager(&limit, &next)
+	wf1, wf2 := newTestWorkflow("one"), newTestWorkflow("two")
+
+	acquired, _, _, _ := m.TryAcquire(wf1, "node-a", mutexSync)
+	assert.True(t, acquired)
+	acquired, _, _, _ = m.TryAcquire(wf1, "node-b", mutexSync)
+	assert.False(t, acquired)
+	acquired, _, _, _ = m.TryAcquire(wf2, "", mutexSync)
+	assert.False(t, acquired)
+
+	assert.True(t, m.ReleaseAll(wf1))
+	assert.Nil(t, wf1.Status.Synchronization)
+	assert.Equal(t, []string{"argo/two"}, next)
+	acquired, _, _, _ = m.TryAcquire(wf2, "", mutexSync)
+	assert.True(t, acquired)
+}
+
+func TestInitialize(t *testing.T) {
+	limit := 0
+	var next []string
+	m := newTestManager(&limit, &next)
+	wf1, wf2 := newTestWorkflow("one"), newTestWorkflow("two")
+	wf1.Status.Synchronization = &wfv1.SynchronizationStatus{Holding: []wfv1.LockHolding{{Lock: "argo/Mutex/my-mutex", Holder: "node-a"}}}
+
+	m.Initialize([]*wfv1.Workflow{wf1, wf2})
+	acquired, _, _, _ := m.TryAcquire(wf2, "", mutexSync)
+	assert.False(t, acquired)
+	acquired, updated, _, _ := m.TryAcquire(wf1, "node-a", mutexSync)
+	assert.True(t, acquired)
+	assert.False(t, updated)
+}
+
+func TestPriority(t *testing.T) {
+	limit := 0
+	var next []string
+	m := newTestManager(&limit, &next)
+	wf1, wf2, wf3 := newTestWorkflow("one"), newTestWorkflow("two"), newTestWorkflow("three")
+	priority := int32(10)
+	wf3.Spec.Priority = &priority
+
+	acquired, _, _, _ := m.TryAcquire(wf1, "", mutexSync)
+	assert.True(t, acquired)
+	acquired, _, _, _ = m.TryAcquire(wf2, "", mutexSync)
+	assert.False(t, acquired)
+	acquired, _, _, _ = m.TryAcquire(wf3, "", mutexSync)
+	assert.False(t, acquired)
+
+	// the workflow with the higher priority acquires the lock first, although it asked for it last
+	assert.True(t, m.Release(wf1, ""))
+	assert.Equal(t, []string{"argo/three"}, next)
+	acquired, _, _, _ = m.TryAcquire(wf2, "", mutexSync)
+	assert.False(t, acquired)
+	acquired, _, _, _ = m.TryAcquire(wf3, "", mutexSync)
+	assert.True(t, acquired)
+}
@@ -0,0 +1,100 @@
+package synchronization
+
+import (
+	"time"
+)
+
+// semaphore is a lock which may be held by up to limit holders at the same time. Holders waiting for the lock
+// acquire it in the order of their priority, and then in the order their workflows were created.
+type semaphore struct {
+	name    string
+	limit   int
+	holders map[string]bool
+	pending []*pendingHolder
+}
+
+type pendingHolder struct {
+	key          string
+	priority     int32
+	creationTime time.Time
+}
+
+func newSemaphore(name string, limit int) *semaphore {
+	return &semaphore{
+		name:    name,
+		limit:   limit,
+		holders: make(map[string]bool),
+	}
+}
+
+// tryAcquire acquires the lock for the holder if it is free and no holder which comes first is waiting for it.
+// Otherwise, the holder waits for the lock.
+func (s *semaphore) tryAcquire(holderKey string, priority int32, creationTime time.Time) bool {
+	if s.holders[holderKey] {
+		return true
+	}
+	index := s.pendingIndex(holderKey)
+	if index < 0 {
+		index = s.addPending(&pendingHolder{key: holderKey, priority: priority, creationTime: creationTime})
+	}
+	if len(s.holders)+index >= s.limit {
+		return false
+	}
+	s.pending = append(s.pending[:index], s.pending[index+1:]...)
+	s.holders[holderKey] = true
+	return true
+}
+
+// acquire acquires the lock for the holder regardless of the limit, to restore a lock which was already held
+func (s *semaphore) acquire(holderKey string) {
+	s.removePending(holderKey)
+	s.holders[holderKey] = true
+}
+
+// release releases the lock held by the holder, or stops the holder waiting for it
+func (s *semaphore) release(holderKey string) {
+	delete(s.holders, holderKey)
+	s.removePending(holderKey)
+}
+
+// next returns the waiting holders which may acquire the lock now
+func (s *semaphore) next() []string {
+	var keys []string
+	for _, holder := range s.pending {
+		if len(s.holders)+len(keys) >= s.limit {
+			break
+		}
+		keys = append(keys, holder.key)
+	}
+	return keys
+}
+
+// addPending inserts the holder after the holders which come before it, and returns its index
+func (s *semaphore) addPending(holder *pendingHolder) int {
+	index := len(s.pending)
+	for i, other := range s.pending {
+		if holder.priority > other.priority || (holder.priority == other.priority && holder.creationTime.Before(other.creationTime)) {
+			index = i
+			break
+		}
+	}
+	s.pending = append(s.pending, nil)
+	copy(s.pending[index+1:], s.pending[index:])
+	s.pending[index] = holder
+	return index
+}
+
+func (s *semaphore) pendingIndex(holderKey string) int {
+	for i, holder := range s.pending {
+		if holder.key == holderKey {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *semaphore) removePending(holderKey string) {
+	if index := s.pendingIndex(holderKey); index >= 0 {
+		s.pending = append(s.pending[:index], s.pending[index+1:]...)
+	}
+}
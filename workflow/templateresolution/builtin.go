@@ -0,0 +1,93 @@
+package templateresolution
+
+import (
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// BuiltinWorkflowTemplateName is the name of the WorkflowTemplate of built-in templates, which every workflow can
+// refer to without defining them, e.g. `templateRef: {name: "argo:builtin", template: sleep}`. The name is not a valid
+// object name, so the Kubernetes API rejects a WorkflowTemplate of the same name, which the built-in templates would
+// otherwise shadow.
+const BuiltinWorkflowTemplateName = "argo:builtin"
+
+var builtinWorkflowTemplateYaml = `
+metadata:
+  name: argo:builtin
+spec:
+  templates:
+  # sleep waits for a duration without running a pod
+  - name: sleep
+    inputs:
+      parameters:
+      - name: duration
+        value: "60"
+    suspend:
+      duration: "{{inputs.parameters.duration}}"
+
+  # approve waits until the workflow is resumed, e.g. with "argo resume"
+  - name: approve
+    suspend: {}
+
+  # poll-http-until polls a URL until it responds with the expected status code. The parameters are passed in the
+  # environment rather than substituted in the script, so that they are never interpreted by the shell.
+  - name: poll-http-until
+    inputs:
+      parameters:
+      - name: url
+      - name: status
+        value: "200"
+      - name: interval
+        value: "10"
+    container:
+      image: curlimages/curl:7.69.1
+      command: [sh, -c]
+      args:
+      - |
+        until [ "$(curl -s -o /dev/null -w '%{http_code}' -- "$URL")" = "$STATUS" ]; do
+          sleep "$INTERVAL"
+        done
+      env:
+      - name: URL
+        value: "{{inputs.parameters.url}}"
+      - name: STATUS
+        value: "{{inputs.parameters.status}}"
+      - name: INTERVAL
+        value: "{{inputs.parameters.interval}}"
+`
+
+var builtinWorkflowTemplate = func() *wfv1.WorkflowTemplate {
+	var wftmpl wfv1.WorkflowTemplate
+	err := yaml.Unmarshal([]byte(builtinWorkflowTemplateYaml), &wftmpl)
+	if err != nil {
+		panic(err)
+	}
+	return &wftmpl
+}()
+
+// builtinGetter gets the built-in WorkflowTemplate, and every other WorkflowTemplate from another getter
+type builtinGetter struct {
+	getter WorkflowTemplateNamespacedGetter
+}
+
+// WithBuiltins returns a getter which gets the built-in WorkflowTemplate, and every other WorkflowTemplate from getter
+func WithBuiltins(getter WorkflowTemplateNamespacedGetter) WorkflowTemplateNamespacedGetter {
+	if _, ok := getter.(*builtinGetter); ok {
+		return getter
+	}
+	return &builtinGetter{getter: getter}
+}
+
+// Get retrieves the WorkflowTemplate of a given name.
+func (g *builtinGetter) Get(name string) (*wfv1.WorkflowTemplate, error) {
+	if name == BuiltinWorkflowTemplateName {
+		return GetBuiltinWorkflowTemplate(), nil
+	}
+	return g.getter.Get(name)
+}
+
+// GetBuiltinWorkflowTemplate returns a copy of the WorkflowTemplate of built-in templates
+func GetBuiltinWorkflowTemplate() *wfv1.WorkflowTemplate {
+	return builtinWorkflowTemplate.DeepCopy()
+}
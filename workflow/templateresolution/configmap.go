@@ -0,0 +1,73 @@
+package templateresolution
+
+import (
+	"strings"
+	"sync"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// configMapGetter gets the WorkflowTemplates held by ConfigMaps, and every other WorkflowTemplate from another getter
+type configMapGetter struct {
+	getter     WorkflowTemplateNamespacedGetter
+	configMaps corelisters.ConfigMapNamespaceLister
+	validate   func(*wfv1.WorkflowTemplate) error
+	// validated memoizes the WorkflowTemplates which were parsed and validated, keyed by name and by resource version of
+	// their ConfigMap
+	validated     map[string]*wfv1.WorkflowTemplate
+	validatedLock sync.Mutex
+}
+
+// WithConfigMaps returns a getter which also gets the WorkflowTemplates held by the keys of ConfigMaps, which template
+// references with a configMapKey refer to. This lets template libraries be shared without the WorkflowTemplate CRD.
+// The ConfigMaps are listed from a cache, and the WorkflowTemplates they hold are checked by validate, if not nil,
+// since they are not validated when they are created.
+func WithConfigMaps(getter WorkflowTemplateNamespacedGetter, configMaps corelisters.ConfigMapNamespaceLister, validate func(*wfv1.WorkflowTemplate) error) WorkflowTemplateNamespacedGetter {
+	return &configMapGetter{getter: getter, configMaps: configMaps, validate: validate, validated: make(map[string]*wfv1.WorkflowTemplate)}
+}
+
+// Get retrieves the WorkflowTemplate of a given name.
+func (g *configMapGetter) Get(name string) (*wfv1.WorkflowTemplate, error) {
+	if !strings.HasPrefix(name, wfv1.ConfigMapWorkflowTemplatePrefix) {
+		return g.getter.Get(name)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(name, wfv1.ConfigMapWorkflowTemplatePrefix), "/", 2)
+	if len(parts) != 2 {
+		return nil, errors.Errorf(errors.CodeBadRequest, "invalid name of a workflow template held by a config map: %s", name)
+	}
+	configMapName, key := parts[0], parts[1]
+	cm, err := g.configMaps.Get(configMapName)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := cm.Data[key]
+	if !ok {
+		return nil, apierr.NewNotFound(wfv1.Resource("workflowtemplates"), name)
+	}
+	validatedKey := name + "@" + cm.ObjectMeta.ResourceVersion
+	g.validatedLock.Lock()
+	defer g.validatedLock.Unlock()
+	if wftmpl, ok := g.validated[validatedKey]; ok {
+		return wftmpl.DeepCopy(), nil
+	}
+	var wftmpl wfv1.WorkflowTemplate
+	err = yaml.Unmarshal([]byte(value), &wftmpl)
+	if err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "failed to parse the workflow template in key %s of config map %s: %v", key, configMapName, err)
+	}
+	wftmpl.ObjectMeta.Name = name
+	wftmpl.ObjectMeta.Namespace = cm.ObjectMeta.Namespace
+	if g.validate != nil {
+		err = g.validate(&wftmpl)
+		if err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "invalid workflow template in key %s of config map %s: %v", key, configMapName, err)
+		}
+	}
+	g.validated[validatedKey] = wftmpl.DeepCopy()
+	return &wftmpl, nil
+}
@@ -30,6 +30,30 @@ func (wrapper *workflowTemplateInterfaceWrapper) Get(name string) (*wfv1.Workflo
 	return wrapper.clientset.Get(name, metav1.GetOptions{})
 }
 
+// workflowTemplateFallbackGetter is an internal struct to get WorkflowTemplates from a cache, falling back to
+// another getter (typically the API server) for WorkflowTemplates which the cache has not observed yet.
+type workflowTemplateFallbackGetter struct {
+	cached   WorkflowTemplateNamespacedGetter
+	fallback WorkflowTemplateNamespacedGetter
+}
+
+// WithFallback returns a getter which gets WorkflowTemplates from the cached getter, and only uses the fallback getter
+// if the WorkflowTemplate is not found in the cache. This avoids failing workflows which were submitted right after
+// the WorkflowTemplates they refer to.
+func WithFallback(cached WorkflowTemplateNamespacedGetter, fallback WorkflowTemplateNamespacedGetter) WorkflowTemplateNamespacedGetter {
+	return &workflowTemplateFallbackGetter{cached: cached, fallback: fallback}
+}
+
+// Get retrieves the WorkflowTemplate of a given name.
+func (getter *workflowTemplateFallbackGetter) Get(name string) (*wfv1.WorkflowTemplate, error) {
+	wftmpl, err := getter.cached.Get(name)
+	if apierr.IsNotFound(err) {
+		log.WithField("name", name).Debug("WorkflowTemplate not found in cache, falling back")
+		return getter.fallback.Get(name)
+	}
+	return wftmpl, err
+}
+
 // WorkflowTemplateNamespaceLister helps get WorkflowTemplates.
 type WorkflowTemplateNamespacedGetter interface {
 	// Get retrieves the WorkflowTemplate from the indexer for a given name.
@@ -48,10 +72,10 @@ type Context struct {
 	log *logrus.Entry
 }
 
-// NewContext returns new Context.
+// NewContext returns new Context. The built-in templates are available in addition to those of wftmplGetter.
 func NewContext(wftmplGetter WorkflowTemplateNamespacedGetter, tmplBase wfv1.TemplateGetter, storage wfv1.TemplateStorage) *Context {
 	return &Context{
-		wftmplGetter: wftmplGetter,
+		wftmplGetter: WithBuiltins(wftmplGetter),
 		tmplBase:     tmplBase,
 		storage:      storage,
 		log:          log.WithFields(logrus.Fields{}),
@@ -61,7 +85,7 @@ func NewContext(wftmplGetter WorkflowTemplateNamespacedGetter, tmplBase wfv1.Tem
 // NewContext returns new Context.
 func NewContextFromClientset(clientset typed.WorkflowTemplateInterface, tmplBase wfv1.TemplateGetter, storage wfv1.TemplateStorage) *Context {
 	return &Context{
-		wftmplGetter: WrapWorkflowTemplateInterface(clientset),
+		wftmplGetter: WithBuiltins(WrapWorkflowTemplateInterface(clientset)),
 		tmplBase:     tmplBase,
 		storage:      storage,
 		log:          log.WithFields(logrus.Fields{}),
@@ -83,16 +107,17 @@ func (ctx *Context) GetTemplateByName(name string) (*wfv1.Template, error) {
 func (ctx *Context) GetTemplateFromRef(tmplRef *wfv1.TemplateRef) (*wfv1.Template, error) {
 	ctx.log.Debug("Getting the template from ref")
 
-	wftmpl, err := ctx.wftmplGetter.Get(tmplRef.Name)
+	wftmplName := tmplRef.GetWorkflowTemplateName()
+	wftmpl, err := ctx.wftmplGetter.Get(wftmplName)
 	if err != nil {
 		if apierr.IsNotFound(err) {
-			return nil, errors.Errorf(errors.CodeNotFound, "workflow template %s not found", tmplRef.Name)
+			return nil, errors.Errorf(errors.CodeNotFound, "workflow template %s not found", wftmplName)
 		}
 		return nil, err
 	}
 	tmpl := wftmpl.GetTemplateByName(tmplRef.Template)
 	if tmpl == nil {
-		return nil, errors.Errorf(errors.CodeNotFound, "template %s not found in workflow template %s", tmplRef.Template, tmplRef.Name)
+		return nil, errors.Errorf(errors.CodeNotFound, "template %s not found in workflow template %s", tmplRef.Template, wftmplName)
 	}
 	return tmpl.DeepCopy(), nil
 }
@@ -202,7 +227,7 @@ func (ctx *Context) resolveTemplateImpl(tmplHolder wfv1.TemplateHolder, depth in
 func (ctx *Context) WithTemplateHolder(tmplHolder wfv1.TemplateHolder) (*Context, error) {
 	tmplRef := tmplHolder.GetTemplateRef()
 	if tmplRef != nil {
-		return ctx.WithLazyWorkflowTemplate(ctx.tmplBase.GetNamespace(), tmplRef.Name)
+		return ctx.WithLazyWorkflowTemplate(ctx.tmplBase.GetNamespace(), tmplRef.GetWorkflowTemplateName())
 	} else {
 		return ctx.WithTemplateBase(ctx.tmplBase), nil
 	}
@@ -1,11 +1,16 @@
 package templateresolution
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/yaml"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -412,3 +417,107 @@ func TestOnWorkflowTemplate(t *testing.T) {
 	tmpl := newCtx.tmplBase.GetTemplateByName("whalesay")
 	assert.NotNil(t, tmpl)
 }
+
+func TestWithFallback(t *testing.T) {
+	cachedClientset := fakewfclientset.NewSimpleClientset()
+	fallbackClientset := fakewfclientset.NewSimpleClientset()
+	err := createWorkflowTemplate(cachedClientset, someWorkflowTemplateYaml)
+	assert.NoError(t, err)
+	err = createWorkflowTemplate(fallbackClientset, anotherWorkflowTemplateYaml)
+	assert.NoError(t, err)
+
+	getter := WithFallback(
+		WrapWorkflowTemplateInterface(cachedClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault)),
+		WrapWorkflowTemplateInterface(fallbackClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault)),
+	)
+
+	wftmpl, err := getter.Get("some-workflow-template")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "some-workflow-template", wftmpl.Name)
+	}
+	wftmpl, err = getter.Get("another-workflow-template")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "another-workflow-template", wftmpl.Name)
+	}
+	_, err = getter.Get("unknown")
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+func TestGetBuiltinTemplate(t *testing.T) {
+	wfClientset := fakewfclientset.NewSimpleClientset()
+	wftmpl := unmarshalWftmpl(baseWorkflowTemplateYaml)
+	ctx := NewContextFromClientset(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault), wftmpl, nil)
+
+	tmpl, err := ctx.GetTemplateFromRef(&wfv1.TemplateRef{Name: BuiltinWorkflowTemplateName, Template: "sleep"})
+	if assert.NoError(t, err) {
+		assert.NotNil(t, tmpl.Suspend)
+	}
+	_, err = ctx.GetTemplateFromRef(&wfv1.TemplateRef{Name: BuiltinWorkflowTemplateName, Template: "unknown"})
+	assert.EqualError(t, err, "template unknown not found in workflow template argo:builtin")
+
+	// the parameters are never substituted in the scripts run by the shell
+	for _, tmpl := range GetBuiltinWorkflowTemplate().Spec.Templates {
+		if tmpl.Container != nil {
+			assert.NotContains(t, strings.Join(tmpl.Container.Args, " "), "{{")
+		}
+	}
+}
+
+func TestWithConfigMaps(t *testing.T) {
+	wfClientset := fakewfclientset.NewSimpleClientset()
+	err := createWorkflowTemplate(wfClientset, anotherWorkflowTemplateYaml)
+	assert.NoError(t, err)
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	err = indexer.Add(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "template-library", Namespace: metav1.NamespaceDefault, ResourceVersion: "1"},
+		Data: map[string]string{
+			"whalesay": `
+spec:
+  templates:
+  - name: whalesay
+    templateRef:
+      name: another-workflow-template
+      template: whalesay
+`,
+			"invalid":  "spec: [",
+			"rejected": "spec: {templates: [{name: whalesay}]}",
+		},
+	})
+	assert.NoError(t, err)
+	var validated []string
+	getter := WithConfigMaps(
+		WrapWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault)),
+		corelisters.NewConfigMapLister(indexer).ConfigMaps(metav1.NamespaceDefault),
+		func(wftmpl *wfv1.WorkflowTemplate) error {
+			validated = append(validated, wftmpl.Name)
+			tmpl := wftmpl.Spec.Templates[0]
+			if tmpl.GetType() == wfv1.TemplateTypeUnknown && tmpl.TemplateRef == nil {
+				return fmt.Errorf("template %s is empty", tmpl.Name)
+			}
+			return nil
+		},
+	)
+	ctx := NewContext(getter, unmarshalWftmpl(baseWorkflowTemplateYaml), nil)
+
+	tmplRef := &wfv1.TemplateRef{Name: "template-library", ConfigMapKey: "whalesay", Template: "whalesay"}
+	newCtx, tmpl, err := ctx.ResolveTemplate(&wfv1.Template{TemplateRef: tmplRef})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "docker/whalesay", tmpl.Container.Image)
+		assert.Equal(t, "another-workflow-template", newCtx.tmplBase.GetName())
+	}
+	wftmpl, err := getter.Get(tmplRef.GetWorkflowTemplateName())
+	if assert.NoError(t, err) {
+		assert.Equal(t, "configmap/template-library/whalesay", wftmpl.Name)
+	}
+	// templates are validated once per version of their config map
+	assert.Equal(t, []string{"configmap/template-library/whalesay"}, validated)
+
+	_, err = ctx.GetTemplateFromRef(&wfv1.TemplateRef{Name: "template-library", ConfigMapKey: "unknown", Template: "whalesay"})
+	assert.EqualError(t, err, "workflow template configmap/template-library/unknown not found")
+	_, err = ctx.GetTemplateFromRef(&wfv1.TemplateRef{Name: "template-library", ConfigMapKey: "invalid", Template: "whalesay"})
+	assert.Error(t, err)
+	_, err = ctx.GetTemplateFromRef(&wfv1.TemplateRef{Name: "template-library", ConfigMapKey: "rejected", Template: "whalesay"})
+	assert.EqualError(t, err, "invalid workflow template in key rejected of config map template-library: template whalesay is empty")
+	_, err = ctx.GetTemplateFromRef(&wfv1.TemplateRef{Name: "another-library", ConfigMapKey: "whalesay", Template: "whalesay"})
+	assert.EqualError(t, err, "workflow template configmap/another-library/whalesay not found")
+}
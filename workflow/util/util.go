@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers/internalinterfaces"
 	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
@@ -447,6 +449,11 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 	// Iterate the previous nodes.
 	replaceRegexp := regexp.MustCompile("^" + wf.ObjectMeta.Name)
 	newWF.Status.Nodes = make(map[string]wfv1.NodeStatus)
+	// the IDs of the nodes carried forward are truncated and namespaced like those of the previous workflow, whose
+	// namespace the new workflow is submitted to
+	newWF.ObjectMeta.Namespace = wf.ObjectMeta.Namespace
+	newWF.Status.NodeIDMaxLength = wf.Status.NodeIDMaxLength
+	newWF.Status.NodeIDNamespaced = wf.Status.NodeIDNamespaced
 	onExitNodeName := wf.ObjectMeta.Name + ".onExit"
 	err := packer.DecompressWorkflow(wf)
 	if err != nil {
@@ -481,6 +488,9 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 		}
 		newNode.OutboundNodes = newOutboundNodes
 		if newNode.Successful() && newNode.Type == wfv1.NodeTypePod {
+			if wf.Status.ArtifactGCPhase != "" && hasOutputArtifacts(node) {
+				return nil, errors.Errorf(errors.CodeBadRequest, "the output artifacts of node %s were garbage collected when workflow %s completed", node.Name, wf.ObjectMeta.Name)
+			}
 			newNode.Phase = wfv1.NodeSkipped
 			newNode.Type = wfv1.NodeTypeSkipped
 			newNode.Message = fmt.Sprintf("original pod: %s", originalID)
@@ -518,19 +528,9 @@ func RetryWorkflow(kubeClient kubernetes.Interface, wfClient v1alpha1.WorkflowIn
 	newWF := wf.DeepCopy()
 	podIf := kubeClient.CoreV1().Pods(wf.ObjectMeta.Namespace)
 
-	// Delete/reset fields which indicate workflow completed
-	delete(newWF.Labels, common.LabelKeyCompleted)
-	newWF.ObjectMeta.Labels[common.LabelKeyPhase] = string(wfv1.NodeRunning)
-	newWF.Status.Phase = wfv1.NodeRunning
-	newWF.Status.Message = ""
-	newWF.Status.FinishedAt = metav1.Time{}
-	if newWF.Spec.ActiveDeadlineSeconds != nil && *newWF.Spec.ActiveDeadlineSeconds == 0 {
-		// if it was terminated, unset the deadline
-		newWF.Spec.ActiveDeadlineSeconds = nil
-	}
-
 	// Iterate the previous nodes. If it was successful Pod carry it forward
 	newWF.Status.Nodes = make(map[string]wfv1.NodeStatus)
+	var podsToDelete []string
 	onExitNodeName := wf.ObjectMeta.Name + ".onExit"
 	for _, node := range wf.Status.Nodes {
 		switch node.Phase {
@@ -554,11 +554,7 @@ func RetryWorkflow(kubeClient kubernetes.Interface, wfClient v1alpha1.WorkflowIn
 			return nil, errors.InternalErrorf("Workflow cannot be retried with node %s in %s phase", node.Name, node.Phase)
 		}
 		if node.Type == wfv1.NodeTypePod {
-			log.Infof("Deleting pod: %s", node.ID)
-			err := podIf.Delete(node.ID, &metav1.DeleteOptions{})
-			if err != nil && !apierr.IsNotFound(err) {
-				return nil, errors.InternalWrapError(err)
-			}
+			podsToDelete = append(podsToDelete, node.ID)
 		} else if node.Name == wf.ObjectMeta.Name {
 			newNode := node.DeepCopy()
 			newNode.Phase = wfv1.NodeRunning
@@ -569,6 +565,22 @@ func RetryWorkflow(kubeClient kubernetes.Interface, wfClient v1alpha1.WorkflowIn
 		}
 	}
 
+	err := markWorkflowRunning(newWF)
+	if err != nil {
+		return nil, err
+	}
+	err = deleteArtifactPods(podIf, wf)
+	if err != nil {
+		return nil, err
+	}
+	for _, podName := range podsToDelete {
+		log.Infof("Deleting pod: %s", podName)
+		err := podIf.Delete(podName, &metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return nil, errors.InternalWrapError(err)
+		}
+	}
+
 	newWF.Status.StoredTemplates = make(map[string]wfv1.Template)
 	for id, tmpl := range wf.Status.StoredTemplates {
 		newWF.Status.StoredTemplates[id] = tmpl
@@ -577,6 +589,300 @@ func RetryWorkflow(kubeClient kubernetes.Interface, wfClient v1alpha1.WorkflowIn
 	return wfClient.Update(newWF)
 }
 
+// hasOutputArtifacts returns whether the pod of a node saved output artifacts, which are garbage collected when the
+// workflow completes with the OnWorkflowCompletion strategy. The artifacts of memoized nodes were saved by the node
+// of another workflow.
+func hasOutputArtifacts(node wfv1.NodeStatus) bool {
+	if node.Type != wfv1.NodeTypePod || node.Outputs == nil || (node.MemoizationStatus != nil && node.MemoizationStatus.Hit) {
+		return false
+	}
+	for _, art := range node.Outputs.Artifacts {
+		if art.HasLocation() {
+			return true
+		}
+	}
+	return false
+}
+
+// markWorkflowRunning deletes/resets the fields which indicate the workflow completed, once its nodes are the nodes
+// to keep. It fails if the artifacts of any of these nodes were garbage collected when the workflow completed, since
+// the nodes which run again could not use them.
+func markWorkflowRunning(wf *wfv1.Workflow) error {
+	if wf.Status.ArtifactGCPhase != "" {
+		for _, node := range wf.Status.Nodes {
+			if hasOutputArtifacts(node) {
+				return errors.Errorf(errors.CodeBadRequest, "the output artifacts of node %s were garbage collected when workflow %s completed", node.Name, wf.ObjectMeta.Name)
+			}
+		}
+	}
+	delete(wf.Labels, common.LabelKeyCompleted)
+	if wf.ObjectMeta.Labels == nil {
+		wf.ObjectMeta.Labels = make(map[string]string)
+	}
+	wf.ObjectMeta.Labels[common.LabelKeyPhase] = string(wfv1.NodeRunning)
+	wf.Status.Phase = wfv1.NodeRunning
+	wf.Status.Message = ""
+	wf.Status.FinishedAt = metav1.Time{}
+	if wf.Spec.ActiveDeadlineSeconds != nil && *wf.Spec.ActiveDeadlineSeconds == 0 {
+		// if it was terminated, unset the deadline
+		wf.Spec.ActiveDeadlineSeconds = nil
+	}
+	wf.Status.ArtifactGCPhase = ""
+	wf.Status.ArtifactManifest = nil
+	return nil
+}
+
+// deleteArtifactPods deletes the pods which garbage collected the artifacts of a completed workflow and saved its
+// artifact manifest, which are kept when they fail, so that they run again when the workflow completes again
+func deleteArtifactPods(podIf corev1.PodInterface, wf *wfv1.Workflow) error {
+	for _, podName := range []string{common.ArtifactGCPodName(wf.ObjectMeta.Name), common.ArtifactManifestPodName(wf.ObjectMeta.Name)} {
+		err := podIf.Delete(podName, &metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return errors.InternalWrapError(err)
+		}
+	}
+	return nil
+}
+
+// SelectNodes returns the IDs of the nodes of the workflow whose ID is the selector, or whose name matches the selector
+// as a glob, in which `*` matches any characters and `?` any single character, e.g. `my-wf[1].build-*`. The brackets
+// of node names are matched literally.
+func SelectNodes(wf *wfv1.Workflow, selector string) ([]string, error) {
+	if selector == "" {
+		return nil, errors.Errorf(errors.CodeBadRequest, "node selector is required")
+	}
+	if wf.Status.IsOffloadNodeStatus() {
+		return nil, errors.Errorf(errors.CodeBadRequest, "the nodes of workflow %s are offloaded, and cannot be changed", wf.ObjectMeta.Name)
+	}
+	glob := regexp.MustCompile("^" + strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(regexp.QuoteMeta(selector)) + "$")
+	var ids []string
+	for id, node := range wf.Status.Nodes {
+		if id == selector || glob.MatchString(node.Name) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, errors.Errorf(errors.CodeBadRequest, "no node of workflow %s matches '%s'", wf.ObjectMeta.Name, selector)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// PodsGetter returns the pods of the cluster of the given name, in the namespace the pods of the workflows of a namespace
+// run in there. The cluster of the workflows has no name.
+type PodsGetter func(cluster, namespace string) (corev1.PodInterface, error)
+
+// NewPodsGetter returns the getter of the pods of the cluster of the client, which fails to get the pods of other clusters
+func NewPodsGetter(kubeClient kubernetes.Interface) PodsGetter {
+	return func(cluster, namespace string) (corev1.PodInterface, error) {
+		if cluster != "" {
+			return nil, errors.Errorf(errors.CodeBadRequest, "the pods of cluster %s can only be deleted via the Argo Server", cluster)
+		}
+		return kubeClient.CoreV1().Pods(namespace), nil
+	}
+}
+
+// ResetWorkflowNodes resets the nodes matching the selector, so that the controller runs them again without retrying
+// the whole workflow: they are removed from the status of the workflow together with the nodes downstream of them,
+// and the pods of those nodes are deleted. The steps, DAG and retry nodes containing them are set back to Running, as
+// is the workflow if it completed, in which case its onExit nodes are removed, as they are by RetryWorkflow.
+func ResetWorkflowNodes(pods PodsGetter, wfClient v1alpha1.WorkflowInterface, wf *wfv1.Workflow, selector string) (*wfv1.Workflow, error) {
+	ids, err := SelectNodes(wf, selector)
+	if err != nil {
+		return nil, err
+	}
+	newWF := wf.DeepCopy()
+	removed := make(map[string]bool)
+	var remove func(id string)
+	remove = func(id string) {
+		if removed[id] {
+			return
+		}
+		removed[id] = true
+		for _, child := range newWF.Status.Nodes[id].Children {
+			remove(child)
+		}
+	}
+	for _, id := range ids {
+		remove(id)
+	}
+	return updateWorkflowNodes(pods, wfClient, newWF, ids, removed)
+}
+
+// SetWorkflowNodesPhase sets the phase of the nodes matching the selector to Succeeded or Failed, e.g. to fail a daemon
+// node which is stuck, and deletes their pods. The nodes downstream of them are kept. The steps, DAG and retry nodes
+// containing them are set back to Running, as is the workflow if it completed, so that the controller assesses them
+// again with the new phase.
+func SetWorkflowNodesPhase(pods PodsGetter, wfClient v1alpha1.WorkflowInterface, wf *wfv1.Workflow, selector string, phase wfv1.NodePhase, message string) (*wfv1.Workflow, error) {
+	switch phase {
+	case wfv1.NodeSucceeded, wfv1.NodeFailed:
+	default:
+		return nil, errors.Errorf(errors.CodeBadRequest, "nodes can only be set to %s or %s", wfv1.NodeSucceeded, wfv1.NodeFailed)
+	}
+	ids, err := SelectNodes(wf, selector)
+	if err != nil {
+		return nil, err
+	}
+	newWF := wf.DeepCopy()
+	for _, id := range ids {
+		node := newWF.Status.Nodes[id]
+		node.Phase = phase
+		node.Message = message
+		node.Daemoned = nil
+		if node.FinishedAt.IsZero() {
+			node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
+		}
+		newWF.Status.Nodes[id] = node
+	}
+	return updateWorkflowNodes(pods, wfClient, newWF, ids, map[string]bool{})
+}
+
+// updateWorkflowNodes removes the nodes to remove, sets the nodes containing the changed nodes back to Running and
+// updates the workflow, before deleting the pods of the changed and removed nodes. The pods are only deleted once the
+// workflow is updated, so that they are not deleted if it is not, e.g. because it changed meanwhile.
+func updateWorkflowNodes(pods PodsGetter, wfClient v1alpha1.WorkflowInterface, wf *wfv1.Workflow, changed []string, removed map[string]bool) (*wfv1.Workflow, error) {
+	completed := wf.Status.Completed()
+	if completed {
+		onExitNodeName := wf.ObjectMeta.Name + ".onExit"
+		for id, node := range wf.Status.Nodes {
+			if strings.HasPrefix(node.Name, onExitNodeName) {
+				removed[id] = true
+			}
+		}
+	}
+
+	visited := make(map[string]bool)
+	var reopen func(id string)
+	reopen = func(id string) {
+		child := wf.Status.Nodes[id]
+		for parentID, node := range wf.Status.Nodes {
+			if visited[parentID] || removed[parentID] || !containsNode(node, child) {
+				continue
+			}
+			visited[parentID] = true
+			if node.Completed() {
+				node.Phase = wfv1.NodeRunning
+				node.Message = ""
+				node.FinishedAt = metav1.Time{}
+				wf.Status.Nodes[parentID] = node
+			}
+			reopen(parentID)
+		}
+	}
+	for _, id := range changed {
+		reopen(id)
+	}
+
+	var deleted []wfv1.NodeStatus
+	for _, id := range changed {
+		if !removed[id] {
+			deleted = append(deleted, wf.Status.Nodes[id])
+		}
+	}
+	for id := range removed {
+		deleted = append(deleted, wf.Status.Nodes[id])
+		delete(wf.Status.Nodes, id)
+	}
+	for id, node := range wf.Status.Nodes {
+		node.Children = withoutNodes(node.Children, removed)
+		node.OutboundNodes = withoutNodes(node.OutboundNodes, removed)
+		wf.Status.Nodes[id] = node
+	}
+	if completed {
+		err := markWorkflowRunning(wf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	wf, err := wfClient.Update(wf)
+	if err != nil {
+		return nil, err
+	}
+
+	if completed {
+		podIf, err := pods("", wf.ObjectMeta.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		err = deleteArtifactPods(podIf, wf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, node := range deleted {
+		if node.Type != wfv1.NodeTypePod {
+			continue
+		}
+		podIf, err := pods(node.Cluster, wf.ObjectMeta.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		err = deleteNodePod(podIf, wf, node)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return wf, nil
+}
+
+// deleteNodePod deletes the pod of a node. The pods of other clusters are only deleted if they are labeled with the
+// workflow, since the pods of the workflows of the same name in different namespaces may have the same names there.
+func deleteNodePod(podIf corev1.PodInterface, wf *wfv1.Workflow, node wfv1.NodeStatus) error {
+	options := &metav1.DeleteOptions{}
+	if node.Cluster != "" {
+		pod, err := podIf.Get(node.ID, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+		if pod.Labels[common.LabelKeyWorkflow] != wf.ObjectMeta.Name || pod.Labels[common.LabelKeyWorkflowNamespace] != wf.ObjectMeta.Namespace {
+			log.Warnf("Not deleting pod %s in cluster %s, which belongs to another workflow", node.ID, node.Cluster)
+			return nil
+		}
+		options.Preconditions = metav1.NewUIDPreconditions(string(pod.UID))
+	}
+	log.Infof("Deleting pod: %s", node.ID)
+	err := podIf.Delete(node.ID, options)
+	if err != nil && !apierr.IsNotFound(err) {
+		return errors.InternalWrapError(err)
+	}
+	return nil
+}
+
+// containsNode returns whether a node contains another node, which is the case of its boundary node, i.e. the steps or
+// DAG node of the template it is a step or task of, and of the step group, task group or retry node it is part of
+// within that template, whose name its name starts with. The other nodes it is a child of, e.g. the nodes of the
+// previous steps or of the tasks it depends on, do not contain it, and so are not set back to Running.
+func containsNode(parent, child wfv1.NodeStatus) bool {
+	if parent.ID == child.BoundaryID {
+		return true
+	}
+	switch parent.Type {
+	case wfv1.NodeTypeStepGroup, wfv1.NodeTypeTaskGroup, wfv1.NodeTypeRetry:
+	default:
+		return false
+	}
+	if parent.BoundaryID != child.BoundaryID || !strings.HasPrefix(child.Name, parent.Name) {
+		return false
+	}
+	rest := strings.TrimPrefix(child.Name, parent.Name)
+	return rest != "" && strings.ContainsAny(rest[:1], ".[(")
+}
+
+// withoutNodes returns the IDs which are not removed
+func withoutNodes(ids []string, removed map[string]bool) []string {
+	var res []string
+	for _, id := range ids {
+		if !removed[id] {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
 var errSuspendedCompletedWorkflow = errors.Errorf(errors.CodeBadRequest, "cannot suspend completed workflows")
 
 // IsWorkflowSuspended returns whether or not a workflow is considered suspended
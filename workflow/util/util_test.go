@@ -11,9 +11,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	fakeClientset "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo/workflow/common"
 )
 
 // TestSubmitDryRun
@@ -69,6 +72,195 @@ func TestResubmitWorkflowWithOnExit(t *testing.T) {
 	assert.False(t, ok)
 }
 
+var failedStepsWf = `
+metadata:
+  name: steps
+  labels:
+    workflows.argoproj.io/completed: "true"
+    workflows.argoproj.io/phase: Failed
+status:
+  phase: Failed
+  nodes:
+    steps:
+      id: steps
+      name: steps
+      type: Steps
+      phase: Failed
+      children: [steps-0]
+      outboundNodes: [steps-2, steps-3]
+    steps-0:
+      id: steps-0
+      name: steps[0]
+      type: StepGroup
+      phase: Succeeded
+      boundaryID: steps
+      children: [steps-1]
+    steps-1:
+      id: steps-1
+      name: steps[0].a
+      type: Pod
+      phase: Succeeded
+      boundaryID: steps
+      children: [steps-4]
+    steps-4:
+      id: steps-4
+      name: steps[1]
+      type: StepGroup
+      phase: Failed
+      boundaryID: steps
+      children: [steps-2, steps-3]
+    steps-2:
+      id: steps-2
+      name: steps[1].b
+      type: Pod
+      phase: Failed
+      boundaryID: steps
+    steps-3:
+      id: steps-3
+      name: steps[1].c
+      type: Pod
+      phase: Succeeded
+      boundaryID: steps
+    steps-5:
+      id: steps-5
+      name: steps.onExit
+      type: Pod
+      phase: Succeeded
+`
+
+func TestSelectNodes(t *testing.T) {
+	wf := unmarshalWF(failedStepsWf)
+	ids, err := SelectNodes(wf, "steps[1].*")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"steps-2", "steps-3"}, ids)
+	}
+	ids, err = SelectNodes(wf, "steps-1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"steps-1"}, ids)
+	}
+	_, err = SelectNodes(wf, "steps[2].*")
+	assert.EqualError(t, err, "no node of workflow steps matches 'steps[2].*'")
+}
+
+func TestResetWorkflowNodes(t *testing.T) {
+	wf := unmarshalWF(failedStepsWf)
+	wfIf := fakeClientset.NewSimpleClientset(wf).ArgoprojV1alpha1().Workflows("")
+	kubeClient := fake.NewSimpleClientset(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "steps-2"}}, &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "steps-3"}})
+
+	// the pods are not deleted when the workflow cannot be updated
+	_, err := ResetWorkflowNodes(NewPodsGetter(kubeClient), fakeClientset.NewSimpleClientset().ArgoprojV1alpha1().Workflows(""), wf, "steps-4")
+	assert.Error(t, err)
+	pods, err := kubeClient.CoreV1().Pods("").List(metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 2)
+	}
+
+	wf, err = ResetWorkflowNodes(NewPodsGetter(kubeClient), wfIf, wf, "steps-4")
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Phase)
+		assert.NotContains(t, wf.Labels, common.LabelKeyCompleted)
+		// the reset step group, the steps downstream of it and the onExit node are removed
+		assert.Len(t, wf.Status.Nodes, 3)
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["steps"].Phase)
+		assert.Empty(t, wf.Status.Nodes["steps"].OutboundNodes)
+		// the previous steps are kept as they are
+		assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes["steps-0"].Phase)
+		assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes["steps-1"].Phase)
+		assert.Empty(t, wf.Status.Nodes["steps-1"].Children)
+		pods, err := kubeClient.CoreV1().Pods("").List(metav1.ListOptions{})
+		if assert.NoError(t, err) {
+			assert.Empty(t, pods.Items)
+		}
+	}
+}
+
+func TestResetWorkflowNodesWithCollectedArtifacts(t *testing.T) {
+	wf := unmarshalWF(failedStepsWf)
+	wf.Status.ArtifactGCPhase = wfv1.NodeFailed
+	wf.Status.ArtifactManifest = &wfv1.Artifact{Name: "manifest"}
+	wfIf := fakeClientset.NewSimpleClientset(wf).ArgoprojV1alpha1().Workflows("")
+	kubeClient := fake.NewSimpleClientset(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "steps-artgc"}})
+
+	// the artifacts are garbage collected again when the workflow completes again
+	reset, err := ResetWorkflowNodes(NewPodsGetter(kubeClient), wfIf, wf, "steps-4")
+	if assert.NoError(t, err) {
+		assert.Empty(t, reset.Status.ArtifactGCPhase)
+		assert.Nil(t, reset.Status.ArtifactManifest)
+		pods, err := kubeClient.CoreV1().Pods("").List(metav1.ListOptions{})
+		if assert.NoError(t, err) {
+			assert.Empty(t, pods.Items)
+		}
+	}
+
+	// the nodes whose artifacts were garbage collected cannot be kept
+	node := wf.Status.Nodes["steps-1"]
+	node.Outputs = &wfv1.Outputs{Artifacts: []wfv1.Artifact{{
+		Name:             "out",
+		ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "out.tgz"}},
+	}}}
+	wf.Status.Nodes["steps-1"] = node
+	_, err = ResetWorkflowNodes(NewPodsGetter(kubeClient), wfIf, wf, "steps-4")
+	assert.EqualError(t, err, "the output artifacts of node steps[0].a were garbage collected when workflow steps completed")
+	_, err = FormulateResubmitWorkflow(wf, true)
+	assert.EqualError(t, err, "the output artifacts of node steps[0].a were garbage collected when workflow steps completed")
+}
+
+func TestResetWorkflowNodesInOtherCluster(t *testing.T) {
+	wf := unmarshalWF(failedStepsWf)
+	for _, id := range []string{"steps-2", "steps-3"} {
+		node := wf.Status.Nodes[id]
+		node.Cluster = "other"
+		wf.Status.Nodes[id] = node
+	}
+	wfIf := fakeClientset.NewSimpleClientset(wf).ArgoprojV1alpha1().Workflows("")
+	kubeClient := fake.NewSimpleClientset()
+	// the pod of steps-3 belongs to the workflow of the same name in another namespace
+	otherKubeClient := fake.NewSimpleClientset(
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "steps-2", Namespace: "other-ns", Labels: map[string]string{
+			common.LabelKeyWorkflow:          "steps",
+			common.LabelKeyWorkflowNamespace: "",
+		}}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "steps-3", Namespace: "other-ns", Labels: map[string]string{
+			common.LabelKeyWorkflow:          "steps",
+			common.LabelKeyWorkflowNamespace: "argo",
+		}}},
+	)
+	pods := func(cluster, namespace string) (corev1.PodInterface, error) {
+		if cluster == "other" {
+			return otherKubeClient.CoreV1().Pods("other-ns"), nil
+		}
+		return kubeClient.CoreV1().Pods(namespace), nil
+	}
+
+	_, err := ResetWorkflowNodes(pods, wfIf, wf, "steps-4")
+	if assert.NoError(t, err) {
+		otherPods, err := otherKubeClient.CoreV1().Pods("other-ns").List(metav1.ListOptions{})
+		if assert.NoError(t, err) && assert.Len(t, otherPods.Items, 1) {
+			assert.Equal(t, "steps-3", otherPods.Items[0].Name)
+		}
+	}
+}
+
+func TestSetWorkflowNodesPhase(t *testing.T) {
+	wf := unmarshalWF(failedStepsWf)
+	wfIf := fakeClientset.NewSimpleClientset(wf).ArgoprojV1alpha1().Workflows("")
+	kubeClient := fake.NewSimpleClientset()
+
+	_, err := SetWorkflowNodesPhase(NewPodsGetter(kubeClient), wfIf, wf, "steps[1].b", wfv1.NodeRunning, "")
+	assert.Error(t, err)
+
+	wf, err = SetWorkflowNodesPhase(NewPodsGetter(kubeClient), wfIf, wf, "steps[1].b", wfv1.NodeSucceeded, "skipped by hand")
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Phase)
+		assert.Len(t, wf.Status.Nodes, 6)
+		assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes["steps-2"].Phase)
+		assert.Equal(t, "skipped by hand", wf.Status.Nodes["steps-2"].Message)
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["steps-4"].Phase)
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["steps"].Phase)
+		assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes["steps-0"].Phase)
+	}
+}
+
 // TestReadFromSingleorMultiplePath ensures we can read the content of a single file or multiple files correctly using the ReadFromFilePathsOrUrls function
 func TestReadFromSingleorMultiplePath(t *testing.T) {
 	tests := map[string]struct {
@@ -4,10 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/robfig/cron"
 
@@ -33,6 +36,9 @@ type ValidateOpts struct {
 	// types of executors. For example, the inability of kubelet/k8s executors to copy artifacts
 	// out of the base image layer. If unspecified, will use docker executor validation
 	ContainerRuntimeExecutor string
+	// Clusters are the names of the clusters templates may run their pods in, besides the cluster of the controller. If
+	// nil, the clusters of templates are not validated, e.g. when linting without the configuration of the controller
+	Clusters []string
 }
 
 // templateValidationCtx is the context for validating a workflow spec
@@ -47,6 +53,8 @@ type templateValidationCtx struct {
 	// wf is the Workflow resource which is used to validate templates.
 	// It will be omitted in WorkflowTemplate validation.
 	wf *wfv1.Workflow
+	// metrics tracks the first declaration of each custom metric name, which the other declarations must agree with
+	metrics map[string]wfv1.Prometheus
 }
 
 func newTemplateValidationCtx(wf *wfv1.Workflow, opts ValidateOpts) *templateValidationCtx {
@@ -59,6 +67,7 @@ func newTemplateValidationCtx(wf *wfv1.Workflow, opts ValidateOpts) *templateVal
 		globalParams: globalParams,
 		results:      make(map[string]bool),
 		wf:           wf,
+		metrics:      make(map[string]wfv1.Prometheus),
 	}
 }
 
@@ -95,17 +104,20 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "spec.templates%s", err.Error())
 	}
+	// the controller sets the parameters without a value to their default before it runs the workflow
+	args := wf.Spec.Arguments.DeepCopy()
+	args.SetDefaults()
 	if ctx.Lint {
 		// if we are just linting we don't care if spec.arguments.parameters.XXX doesn't have an
 		// explicit value. workflows without a default value is a desired use case
-		err = validateArgumentsFieldNames("spec.arguments.", wf.Spec.Arguments)
+		err = validateArgumentsFieldNames("spec.arguments.", *args)
 	} else {
-		err = validateArguments("spec.arguments.", wf.Spec.Arguments)
+		err = validateArguments("spec.arguments.", *args)
 	}
 	if err != nil {
 		return err
 	}
-	for _, param := range wf.Spec.Arguments.Parameters {
+	for _, param := range args.Parameters {
 		if param.Name != "" {
 			if param.Value != nil {
 				ctx.globalParams["workflow.parameters."+param.Name] = *param.Value
@@ -129,14 +141,14 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if wf.Spec.Entrypoint == "" {
 		return errors.New(errors.CodeBadRequest, "spec.entrypoint is required")
 	}
-	_, err = ctx.validateTemplateHolder(&wfv1.Template{Template: wf.Spec.Entrypoint}, tmplCtx, &wf.Spec.Arguments, map[string]interface{}{})
+	_, err = ctx.validateTemplateHolder(&wfv1.Template{Template: wf.Spec.Entrypoint}, tmplCtx, args, map[string]interface{}{})
 	if err != nil {
 		return err
 	}
 	if wf.Spec.OnExit != "" {
 		// now when validating onExit, {{workflow.status}} is now available as a global
 		ctx.globalParams[common.GlobalVarWorkflowStatus] = placeholderGenerator.NextPlaceholder()
-		_, err = ctx.validateTemplateHolder(&wfv1.Template{Template: wf.Spec.OnExit}, tmplCtx, &wf.Spec.Arguments, map[string]interface{}{})
+		_, err = ctx.validateTemplateHolder(&wfv1.Template{Template: wf.Spec.OnExit}, tmplCtx, args, map[string]interface{}{})
 		if err != nil {
 			return err
 		}
@@ -150,6 +162,26 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 		}
 	}
 
+	if err := validateSynchronization(wf.Spec.Synchronization); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "spec.synchronization %s", err.Error())
+	}
+
+	if wf.Spec.VolumeClaimGC != nil {
+		switch wf.Spec.VolumeClaimGC.Strategy {
+		case wfv1.VolumeClaimGCOnWorkflowCompletion, wfv1.VolumeClaimGCOnWorkflowSuccess, "":
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "volumeClaimGC.strategy unknown strategy '%s'", wf.Spec.VolumeClaimGC.Strategy)
+		}
+	}
+
+	if wf.Spec.ArtifactGC != nil {
+		switch wf.Spec.ArtifactGC.Strategy {
+		case wfv1.ArtifactGCOnWorkflowCompletion, wfv1.ArtifactGCOnWorkflowDeletion:
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "artifactGC.strategy unknown strategy '%s'", wf.Spec.ArtifactGC.Strategy)
+		}
+	}
+
 	// Check if all templates can be resolved.
 	for _, template := range wf.Spec.Templates {
 		_, err := ctx.validateTemplateHolder(&wfv1.Template{Template: template.Name}, tmplCtx, &FakeArguments{}, map[string]interface{}{})
@@ -220,6 +252,46 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 		return err
 	}
 
+	for _, window := range tmpl.ExecutionWindows {
+		if _, _, err := common.ParseExecutionWindow(window); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.executionWindows %s", tmpl.Name, err.Error())
+		}
+	}
+
+	if _, err := common.ParseSignal(tmpl.StopSignal); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.stopSignal %s", tmpl.Name, err.Error())
+	}
+	if tmpl.TerminationGracePeriodSeconds != nil && *tmpl.TerminationGracePeriodSeconds < 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.terminationGracePeriodSeconds must not be negative", tmpl.Name)
+	}
+	if tmpl.Stream != nil {
+		if tmpl.Daemon == nil || !*tmpl.Daemon || (tmpl.Container == nil && tmpl.Script == nil) {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.stream is only supported by daemon container and script templates", tmpl.Name)
+		}
+		if tmpl.Stream.Port < 1 || tmpl.Stream.Port > 65535 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.stream.port must be between 1 and 65535", tmpl.Name)
+		}
+	}
+
+	if err := ctx.validateMetrics(tmpl.Metrics); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.metrics %s", tmpl.Name, err.Error())
+	}
+
+	if err := validateMemoize(tmpl); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize %s", tmpl.Name, err.Error())
+	}
+
+	if err := validateSynchronization(tmpl.Synchronization); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.synchronization %s", tmpl.Name, err.Error())
+	}
+
+	if tmpl.FailFast && tmpl.Steps == nil {
+		if tmpl.DAG != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.failFast is only valid for steps templates, DAG templates set dag.failFast", tmpl.Name)
+		}
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.failFast is only valid for steps templates", tmpl.Name)
+	}
+
 	scope, err := validateInputs(tmpl, extraScope)
 	if err != nil {
 		return err
@@ -292,7 +364,7 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 		if tmplRef.Template == "" {
 			return nil, errors.New(errors.CodeBadRequest, "template name is required")
 		}
-		if tmplRef.RuntimeResolution {
+		if tmplRef.RuntimeResolution || tmplRef.ConfigMapKey != "" {
 			// Let's see if the template exists at runtime.
 			return nil, nil
 		}
@@ -340,7 +412,7 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
-	for _, tmplType := range []interface{}{tmpl.TemplateRef, tmpl.Container, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend} {
+	for _, tmplType := range []interface{}{tmpl.TemplateRef, tmpl.Container, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.HTTP} {
 		if !reflect.ValueOf(tmplType).IsNil() {
 			numTypes++
 		}
@@ -350,10 +422,10 @@ func validateTemplateType(tmpl *wfv1.Template) error {
 	}
 	switch numTypes {
 	case 0:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, steps, script, resource, dag, suspend, http, template, template ref", tmpl.Name)
 	case 1:
 	default:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, steps, script, resource, dag, suspend, http, template, template ref", tmpl.Name)
 	}
 	return nil
 }
@@ -426,26 +498,47 @@ func resolveAllVariables(scope map[string]interface{}, tmplStr string) error {
 	fstTmpl := fasttemplate.New(tmplStr, "{{", "}}")
 
 	fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
-
-		// Skip the custom variable references
-		if !checkValidWorkflowVariablePrefix(tag) {
+		if common.IsExpressionTag(tag) {
+			// an expression resolves if all of its variables do
+			expr, _, err := common.ParseExpressionTag(tag)
+			if err != nil {
+				if unresolvedErr == nil {
+					unresolvedErr = err
+				}
+				return 0, nil
+			}
+			for _, variable := range expr.Vars() {
+				if !resolveVariable(scope, variable, allowAllItemRefs) && unresolvedErr == nil {
+					unresolvedErr = fmt.Errorf("failed to resolve %s in {{%s}}", variable, tag)
+				}
+			}
 			return 0, nil
 		}
-		_, ok := scope[tag]
-		if !ok && unresolvedErr == nil {
-			if (tag == "item" || strings.HasPrefix(tag, "item.")) && allowAllItemRefs {
-				// we are *probably* referencing a undetermined item using withParam
-				// NOTE: this is far from foolproof.
-			} else if strings.HasPrefix(tag, common.GlobalVarWorkflowCreationTimestamp) {
-			} else {
-				unresolvedErr = fmt.Errorf("failed to resolve {{%s}}", tag)
-			}
+		if !resolveVariable(scope, tag, allowAllItemRefs) && unresolvedErr == nil {
+			unresolvedErr = fmt.Errorf("failed to resolve {{%s}}", tag)
 		}
 		return 0, nil
 	})
 	return unresolvedErr
 }
 
+// resolveVariable returns whether the variable is resolveable from current scope
+func resolveVariable(scope map[string]interface{}, variable string, allowAllItemRefs bool) bool {
+	// Skip the custom variable references
+	if !checkValidWorkflowVariablePrefix(variable) {
+		return true
+	}
+	if _, ok := scope[variable]; ok {
+		return true
+	}
+	if (variable == "item" || strings.HasPrefix(variable, "item.")) && allowAllItemRefs {
+		// we are *probably* referencing a undetermined item using withParam
+		// NOTE: this is far from foolproof.
+		return true
+	}
+	return strings.HasPrefix(variable, common.GlobalVarWorkflowCreationTimestamp)
+}
+
 // checkValidWorkflowVariablePrefix is a helper methood check variable starts workflow root elements
 func checkValidWorkflowVariablePrefix(tag string) bool {
 	for _, rootTag := range common.GlobalVarValidWorkflowVariablePrefix {
@@ -507,8 +600,32 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.manifest must be a valid yaml", tmpl.Name)
 			}
+			if len(tmpl.Resource.Finalizers) > 0 && obj.GetName() == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.finalizers require the manifest to set metadata.name", tmpl.Name)
+			}
+		}
+		for _, finalizer := range tmpl.Resource.Finalizers {
+			if finalizer != wfv1.ResourceFinalizerDeleteOnWorkflowCompletion {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.finalizers unknown finalizer '%s'", tmpl.Name, finalizer)
+			}
+		}
+		if len(tmpl.Resource.Finalizers) > 0 && !placeholderGenerator.IsPlaceholder(tmpl.Resource.Action) {
+			switch tmpl.Resource.Action {
+			case "create", "apply":
+			default:
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.finalizers are only valid for the create and apply actions", tmpl.Name)
+			}
 		}
 	}
+	if tmpl.HTTP != nil {
+		err = validateHTTP(tmpl)
+		if err != nil {
+			return err
+		}
+	}
+	if tmpl.Cluster != "" && ctx.Clusters != nil && !placeholderGenerator.IsPlaceholder(tmpl.Cluster) && !hasCluster(ctx.Clusters, tmpl.Cluster) {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.cluster '%s' is not configured for the namespace of the workflow", tmpl.Name, tmpl.Cluster)
+	}
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if *tmpl.ActiveDeadlineSeconds <= 0 {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds must be a positive integer > 0", tmpl.Name)
@@ -598,6 +715,10 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
+			err = validateFailureThreshold(step.FailureThreshold, step.WithItems, step.WithParam, step.WithSequence)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+			}
 			stepBytes, err := json.Marshal(stepGroup)
 			if err != nil {
 				return errors.InternalWrapError(err)
@@ -632,6 +753,145 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 	return nil
 }
 
+func validateFailureThreshold(threshold *wfv1.FailureThreshold, withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence) error {
+	if threshold == nil {
+		return nil
+	}
+	if len(withItems) == 0 && withParam == "" && withSequence == nil {
+		return fmt.Errorf("failureThreshold requires one of withItems, withParam, withSequence")
+	}
+	if threshold.Percent < 0 || threshold.Percent > 100 {
+		return fmt.Errorf("failureThreshold.percent must be between 0 and 100")
+	}
+	if threshold.MinCompleted < 0 {
+		return fmt.Errorf("failureThreshold.minCompleted must not be negative")
+	}
+	return nil
+}
+
+// validateMetrics validates the custom metrics of a template. A metric name is registered once by the controller, so
+// all the declarations of a name must have the same type, help, label keys and buckets.
+func (ctx *templateValidationCtx) validateMetrics(metrics *wfv1.Metrics) error {
+	if metrics == nil {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, metric := range metrics.Prometheus {
+		if !metricNameRegex.MatchString(metric.Name) {
+			return fmt.Errorf("name '%s' must consist of alpha-numeric characters, '_' or ':', and must not start with a digit", metric.Name)
+		}
+		if strings.HasPrefix(metric.Name, reservedMetricPrefix) {
+			return fmt.Errorf("name '%s' must not start with '%s', which is reserved for the metrics of the controller", metric.Name, reservedMetricPrefix)
+		}
+		if names[metric.Name] {
+			return fmt.Errorf("'%s' is declared more than once", metric.Name)
+		}
+		names[metric.Name] = true
+		if metric.Help == "" {
+			return fmt.Errorf("'%s' must have help", metric.Name)
+		}
+		defined := 0
+		if metric.Gauge != nil {
+			defined++
+		}
+		if metric.Counter != nil {
+			defined++
+		}
+		if metric.Histogram != nil {
+			defined++
+		}
+		if defined != 1 {
+			return fmt.Errorf("'%s' must have exactly one of gauge, counter or histogram", metric.Name)
+		}
+		labelKeys := make(map[string]bool)
+		for _, label := range metric.Labels {
+			if !metricLabelKeyRegex.MatchString(label.Key) {
+				return fmt.Errorf("'%s' label key '%s' must consist of alpha-numeric characters or '_', and must not start with a digit", metric.Name, label.Key)
+			}
+			if labelKeys[label.Key] {
+				return fmt.Errorf("'%s' label key '%s' is declared more than once", metric.Name, label.Key)
+			}
+			labelKeys[label.Key] = true
+		}
+		if first, ok := ctx.metrics[metric.Name]; ok {
+			if !sameMetricDeclaration(first, metric) {
+				return fmt.Errorf("'%s' must have the same type, help, label keys and buckets wherever it is declared", metric.Name)
+			}
+		} else {
+			ctx.metrics[metric.Name] = metric
+		}
+	}
+	return nil
+}
+
+// sameMetricDeclaration returns whether two declarations of a metric can be emitted to the same collector
+func sameMetricDeclaration(a, b wfv1.Prometheus) bool {
+	if (a.Gauge == nil) != (b.Gauge == nil) || (a.Counter == nil) != (b.Counter == nil) || (a.Histogram == nil) != (b.Histogram == nil) {
+		return false
+	}
+	if a.Help != b.Help || len(a.Labels) != len(b.Labels) {
+		return false
+	}
+	if a.Histogram != nil && !reflect.DeepEqual(a.Histogram.Buckets, b.Histogram.Buckets) {
+		return false
+	}
+	keys := make(map[string]bool)
+	for _, label := range a.Labels {
+		keys[label.Key] = true
+	}
+	for _, label := range b.Labels {
+		if !keys[label.Key] {
+			return false
+		}
+	}
+	return true
+}
+
+func validateMemoize(tmpl *wfv1.Template) error {
+	if tmpl.Memoize == nil {
+		return nil
+	}
+	switch tmpl.GetType() {
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+	default:
+		return fmt.Errorf("is only supported by container and script templates")
+	}
+	if tmpl.Memoize.Key == "" {
+		return fmt.Errorf("key is required")
+	}
+	if tmpl.Memoize.Cache == nil || tmpl.Memoize.Cache.ConfigMap == nil || tmpl.Memoize.Cache.ConfigMap.Name == "" {
+		return fmt.Errorf("cache.configMap.name is required")
+	}
+	if tmpl.Memoize.MaxAge != "" && !strings.Contains(tmpl.Memoize.MaxAge, "{{") {
+		maxAge, err := time.ParseDuration(tmpl.Memoize.MaxAge)
+		if err != nil || maxAge <= 0 {
+			return fmt.Errorf("maxAge '%s' must be a positive duration, e.g. 24h", tmpl.Memoize.MaxAge)
+		}
+	}
+	return nil
+}
+
+func validateSynchronization(synchronization *wfv1.Synchronization) error {
+	if synchronization == nil {
+		return nil
+	}
+	if (synchronization.Semaphore == nil) == (synchronization.Mutex == nil) {
+		return fmt.Errorf("must have exactly one of semaphore or mutex")
+	}
+	if synchronization.Mutex != nil {
+		if errs := apivalidation.IsDNS1123Subdomain(synchronization.Mutex.Name); len(errs) > 0 {
+			return fmt.Errorf("mutex.name '%s' is invalid: %s", synchronization.Mutex.Name, strings.Join(errs, ", "))
+		}
+	}
+	if synchronization.Semaphore != nil {
+		ref := synchronization.Semaphore.ConfigMapKeyRef
+		if ref == nil || ref.Name == "" || ref.Key == "" {
+			return fmt.Errorf("semaphore.configMapKeyRef.name and semaphore.configMapKeyRef.key are required")
+		}
+	}
+	return nil
+}
+
 func addItemsToScope(prefix string, withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, scope map[string]interface{}) error {
 	defined := 0
 	if len(withItems) > 0 {
@@ -674,11 +934,61 @@ func addItemsToScope(prefix string, withItems []wfv1.Item, withParam string, wit
 	return nil
 }
 
+// validateHTTP validates the request of an HTTP template, which the controller performs without a pod
+func validateHTTP(tmpl *wfv1.Template) error {
+	if !placeholderGenerator.IsPlaceholder(tmpl.HTTP.URL) && !strings.Contains(tmpl.HTTP.URL, "{{") {
+		u, err := url.Parse(tmpl.HTTP.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.url must be an absolute http or https URL", tmpl.Name)
+		}
+	}
+	if tmpl.HTTP.Method != "" && !placeholderGenerator.IsPlaceholder(tmpl.HTTP.Method) {
+		switch tmpl.HTTP.Method {
+		case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions:
+			// OK
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.method must be one of: GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS", tmpl.Name)
+		}
+	}
+	for i, header := range tmpl.HTTP.Headers {
+		if header.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers[%d].name is required", tmpl.Name, i)
+		}
+		if header.ValueFrom != nil {
+			if header.Value != "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers[%d] cannot specify both value and valueFrom", tmpl.Name, i)
+			}
+			if header.ValueFrom.SecretKeyRef == nil || header.ValueFrom.SecretKeyRef.Name == "" || header.ValueFrom.SecretKeyRef.Key == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers[%d].valueFrom.secretKeyRef requires a name and a key", tmpl.Name, i)
+			}
+		}
+	}
+	if tmpl.HTTP.TimeoutSeconds != nil && *tmpl.HTTP.TimeoutSeconds <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.timeoutSeconds must be a positive integer > 0", tmpl.Name)
+	}
+	if tmpl.HTTP.SuccessCondition != "" {
+		_, err := common.NewExpression(tmpl.HTTP.SuccessCondition)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.successCondition is invalid: %s", tmpl.Name, err)
+		}
+	}
+	if len(tmpl.Inputs.Artifacts) > 0 || len(tmpl.Outputs.Artifacts) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s artifacts are not supported by http templates", tmpl.Name)
+	}
+	if tmpl.Cluster != "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.cluster is not supported by http templates", tmpl.Name)
+	}
+	return nil
+}
+
 func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix string, scope map[string]interface{}, aggregate bool, isAncestor bool) {
 	if tmpl.Daemon != nil && *tmpl.Daemon {
 		scope[fmt.Sprintf("%s.ip", prefix)] = true
+		if tmpl.Stream != nil {
+			scope[fmt.Sprintf("%s.stream", prefix)] = true
+		}
 	}
-	if tmpl.Script != nil {
+	if tmpl.Script != nil || tmpl.HTTP != nil {
 		scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
 	}
 	for _, param := range tmpl.Outputs.Parameters {
@@ -699,7 +1009,7 @@ func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix
 	}
 	if aggregate {
 		switch tmpl.GetType() {
-		case wfv1.TemplateTypeScript:
+		case wfv1.TemplateTypeScript, wfv1.TemplateTypeHTTP:
 			scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
 		default:
 			scope[fmt.Sprintf("%s.outputs.parameters", prefix)] = true
@@ -739,6 +1049,9 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.path only valid in container/script templates", tmpl.Name, artRef)
 			}
 		}
+		if art.Raw != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.raw only valid in input artifacts", tmpl.Name, artRef)
+		}
 		if art.GlobalName != "" && !isParameter(art.GlobalName) {
 			errs := isValidParamOrArtifactName(art.GlobalName)
 			if len(errs) > 0 {
@@ -779,6 +1092,15 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 	return nil
 }
 
+func hasCluster(clusters []string, name string) bool {
+	for _, cluster := range clusters {
+		if cluster == name {
+			return true
+		}
+	}
+	return false
+}
+
 // validateBaseImageOutputs detects if the template contains an valid output from base image layer
 func (ctx *templateValidationCtx) validateBaseImageOutputs(tmpl *wfv1.Template) error {
 	switch ctx.ContainerRuntimeExecutor {
@@ -981,6 +1303,10 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
+		err = validateFailureThreshold(task.FailureThreshold, task.WithItems, task.WithParam, task.WithSequence)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+		}
 		err = resolveAllVariables(taskScope, string(taskBytes))
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
@@ -1055,8 +1381,14 @@ var (
 	// paramRegex matches a parameter. e.g. {{inputs.parameters.blah}}
 	paramRegex               = regexp.MustCompile(`{{[-a-zA-Z0-9]+(\.[-a-zA-Z0-9_]+)*}}`)
 	paramOrArtifactNameRegex = regexp.MustCompile(`^[-a-zA-Z0-9_]+[-a-zA-Z0-9_]*$`)
+	// metricNameRegex and metricLabelKeyRegex match the names accepted by prometheus
+	metricNameRegex     = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	metricLabelKeyRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
 )
 
+// reservedMetricPrefix is the prefix of the metrics of the controller, which custom metrics cannot use
+const reservedMetricPrefix = "argo_"
+
 func isParameter(p string) bool {
 	return paramRegex.MatchString(p)
 }
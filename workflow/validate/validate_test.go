@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -242,6 +243,32 @@ func TestResolveIOArtifactPathPlaceholders(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+var rawOutputArtifact = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: raw-output-artifact-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    outputs:
+      artifacts:
+      - name: text
+        path: /tmp/text
+        raw:
+          data: hello
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestRawOutputArtifact(t *testing.T) {
+	err := validate(rawOutputArtifact)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "raw only valid in input artifacts")
+	}
+}
+
 var outputParameterPath = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1193,6 +1220,91 @@ func TestInvalidArgumentNoValue(t *testing.T) {
 	}
 }
 
+var globalArgumentDefault = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: global-argument-default-
+spec:
+  entrypoint: whalesay
+  arguments:
+    parameters:
+    - name: message
+      default: hello world
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+      command: [cowsay]
+      args: ["{{workflow.parameters.message}}"]
+`
+
+func TestGlobalArgumentDefault(t *testing.T) {
+	err := validate(globalArgumentDefault)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(globalArgumentDefault)
+	wf.Spec.Arguments.Parameters[0].Default = nil
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "spec.arguments.message.value is required")
+	}
+}
+
+var expressionWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: expressions-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: count
+      value: "2"
+  templates:
+  - name: main
+    steps:
+    - - name: generate
+        template: generate
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: message
+            value: "{{=jsonpath([steps.generate.outputs.result], 'message') + ' ' + [item]}}"
+        withSequence:
+          count: "{{=asInt([workflow.parameters.count]) * 2}}"
+        when: "{{=[steps.generate.status] == 'Succeeded' && [workflow.parameters.count] > 1}}"
+  - name: generate
+    script:
+      image: python:alpine3.6
+      command: [python]
+      source: |
+        print('{"message": "hello"}')
+  - name: print
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:3.7
+      command: [echo, "{{=toUpper([inputs.parameters.message])}}"]
+`
+
+func TestExpression(t *testing.T) {
+	err := validate(expressionWf)
+	assert.NoError(t, err)
+
+	err = validate(strings.Replace(expressionWf, "[inputs.parameters.message]", "[inputs.parameters.msg]", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to resolve inputs.parameters.msg in {{=toUpper([inputs.parameters.msg])}}")
+	}
+	err = validate(strings.Replace(expressionWf, "[steps.generate.status] ==", "[steps.generate.status] = =", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid expression")
+	}
+}
+
 var validWithItems = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1791,6 +1903,46 @@ func TestInvalidResourceWorkflow(t *testing.T) {
 	assert.EqualError(t, err, "templates.whalesay.resource.action must be one of: get, create, apply, delete, replace, patch")
 }
 
+var resourceFinalizersWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: resource-finalizers-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    resource:
+      action: create
+      finalizers: [DeleteOnWorkflowCompletion]
+      manifest: |
+        apiVersion: v1
+        kind: ConfigMap
+        metadata:
+          name: whalesay-cm
+`
+
+// TestResourceFinalizers verifies the finalizers of resource templates.
+func TestResourceFinalizers(t *testing.T) {
+	wf := unmarshalWf(resourceFinalizersWorkflow)
+	err := ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	wf.Spec.Templates[0].Resource.Finalizers = []wfv1.ResourceFinalizer{"Foo"}
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.resource.finalizers unknown finalizer 'Foo'")
+
+	wf = unmarshalWf(resourceFinalizersWorkflow)
+	wf.Spec.Templates[0].Resource.Action = "get"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.resource.finalizers are only valid for the create and apply actions")
+
+	wf = unmarshalWf(resourceFinalizersWorkflow)
+	wf.Spec.Templates[0].Resource.Manifest = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  generateName: whalesay-\n"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.resource.finalizers require the manifest to set metadata.name")
+}
+
 var invalidPodGC = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1821,6 +1973,66 @@ func TestUnknownPodGCStrategy(t *testing.T) {
 	}
 }
 
+var invalidVolumeClaimGC = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: volume-claim-gc-strategy-unknown-
+spec:
+  volumeClaimGC:
+    strategy: Foo
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+      command: [cowsay]
+      args: ["hello world"]
+`
+
+// TestUnknownVolumeClaimGCStrategy verifies volume claim gc strategy is correct.
+func TestUnknownVolumeClaimGCStrategy(t *testing.T) {
+	wf := unmarshalWf(invalidVolumeClaimGC)
+	err := ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "volumeClaimGC.strategy unknown strategy 'Foo'")
+
+	for _, strat := range []wfv1.VolumeClaimGCStrategy{wfv1.VolumeClaimGCOnWorkflowCompletion, wfv1.VolumeClaimGCOnWorkflowSuccess} {
+		wf.Spec.VolumeClaimGC.Strategy = strat
+		err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	}
+}
+
+var invalidArtifactGC = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: artifact-gc-strategy-unknown-
+spec:
+  artifactGC:
+    strategy: Foo
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+      command: [cowsay]
+      args: ["hello world"]
+`
+
+// TestUnknownArtifactGCStrategy verifies artifact gc strategy is correct.
+func TestUnknownArtifactGCStrategy(t *testing.T) {
+	wf := unmarshalWf(invalidArtifactGC)
+	err := ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "artifactGC.strategy unknown strategy 'Foo'")
+
+	for _, strat := range []wfv1.ArtifactGCStrategy{wfv1.ArtifactGCOnWorkflowCompletion, wfv1.ArtifactGCOnWorkflowDeletion} {
+		wf.Spec.ArtifactGC.Strategy = strat
+		err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	}
+}
+
 var validAutomountServiceAccountTokenUseWfLevel = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2055,3 +2267,556 @@ func TestAllowPlaceholderInVariableTakenFromInputs(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+var invalidExecutionWindow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: invalid-execution-window-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    executionWindows:
+    - schedule: "0 1 * * *"
+      duration: "2x"
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestInvalidExecutionWindow verifies execution windows are validated
+func TestInvalidExecutionWindow(t *testing.T) {
+	err := validate(invalidExecutionWindow)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.whalesay.executionWindows execution window duration '2x' is malformed")
+	}
+
+	wf := unmarshalWf(invalidExecutionWindow)
+	wf.Spec.Templates[0].ExecutionWindows[0].Duration = "2h"
+	wf.Spec.Templates[0].ExecutionWindows[0].Timezone = "America/Los_Angeles"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+}
+
+var invalidStopSignal = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: invalid-stop-signal-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    stopSignal: SIGFOO
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestInvalidStopSignal verifies stop signals are validated
+func TestInvalidStopSignal(t *testing.T) {
+	err := validate(invalidStopSignal)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.whalesay.stopSignal unsupported signal 'SIGFOO'")
+	}
+
+	wf := unmarshalWf(invalidStopSignal)
+	wf.Spec.Templates[0].StopSignal = "SIGINT"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+}
+
+var invalidFailureThreshold = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: invalid-failure-threshold-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: batch
+        template: whalesay
+        withItems: [1, 2, 3]
+        failureThreshold:
+          percent: 150
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestInvalidFailureThreshold verifies failure thresholds are validated
+func TestInvalidFailureThreshold(t *testing.T) {
+	err := validate(invalidFailureThreshold)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "failureThreshold.percent must be between 0 and 100")
+	}
+
+	wf := unmarshalWf(invalidFailureThreshold)
+	wf.Spec.Templates[0].Steps[0].Steps[0].FailureThreshold.Percent = 50
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	wf.Spec.Templates[0].Steps[0].Steps[0].WithItems = nil
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "failureThreshold requires one of withItems, withParam, withSequence")
+	}
+}
+
+var invalidMetrics = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: invalid-metrics-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    metrics:
+      prometheus:
+      - name: whalesay-duration
+        help: Duration of whalesay
+        gauge:
+          value: "{{duration}}"
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestInvalidMetrics verifies custom metrics are validated
+func TestInvalidMetrics(t *testing.T) {
+	err := validate(invalidMetrics)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.whalesay.metrics name 'whalesay-duration' must consist of")
+	}
+
+	wf := unmarshalWf(invalidMetrics)
+	wf.Spec.Templates[0].Metrics.Prometheus[0].Name = "whalesay_duration"
+	wf.Spec.Templates[0].Metrics.Prometheus[0].Counter = &wfv1.Counter{Value: "1"}
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "must have exactly one of gauge, counter or histogram")
+	}
+
+	wf.Spec.Templates[0].Metrics.Prometheus[0].Counter = nil
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+}
+
+var conflictingMetrics = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: conflicting-metrics-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: a
+      - name: b
+        template: b
+  - name: a
+    metrics:
+      prometheus:
+      - name: step_duration
+        help: Duration of the step
+        labels:
+        - key: step
+          value: a
+        gauge:
+          value: "{{duration}}"
+    container:
+      image: docker/whalesay:latest
+  - name: b
+    metrics:
+      prometheus:
+      - name: step_duration
+        help: Duration of the step
+        labels:
+        - key: step
+          value: b
+        gauge:
+          value: "{{duration}}"
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestConflictingMetrics verifies the declarations of a custom metric must agree with each other
+func TestConflictingMetrics(t *testing.T) {
+	err := validate(conflictingMetrics)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(conflictingMetrics)
+	wf.Spec.Templates[2].Metrics.Prometheus[0].Gauge = nil
+	wf.Spec.Templates[2].Metrics.Prometheus[0].Counter = &wfv1.Counter{Value: "1"}
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "'step_duration' must have the same type, help, label keys and buckets")
+	}
+
+	wf = unmarshalWf(conflictingMetrics)
+	wf.Spec.Templates[2].Metrics.Prometheus[0].Labels[0].Key = "name"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "'step_duration' must have the same type, help, label keys and buckets")
+	}
+
+	wf = unmarshalWf(conflictingMetrics)
+	wf.Spec.Templates[1].Metrics.Prometheus = append(wf.Spec.Templates[1].Metrics.Prometheus, wf.Spec.Templates[1].Metrics.Prometheus[0])
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.a.metrics 'step_duration' is declared more than once")
+	}
+
+	wf = unmarshalWf(conflictingMetrics)
+	wf.Spec.Templates[1].Metrics.Prometheus[0].Name = "argo_workflow_info"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "must not start with 'argo_'")
+	}
+}
+
+var streamWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: stream-
+spec:
+  entrypoint: pipeline
+  templates:
+  - name: pipeline
+    steps:
+    - - name: consumer
+        template: consumer
+    - - name: producer
+        template: producer
+        arguments:
+          parameters:
+          - name: address
+            value: "{{steps.consumer.stream}}"
+  - name: consumer
+    daemon: true
+    stream:
+      port: 9000
+    container:
+      image: busybox
+  - name: producer
+    inputs:
+      parameters:
+      - name: address
+    container:
+      image: busybox
+`
+
+// TestStream verifies streams are only declared by daemons, whose address the next steps can refer to
+func TestStream(t *testing.T) {
+	err := validate(streamWorkflow)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(streamWorkflow)
+	wf.Spec.Templates[1].Daemon = nil
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.consumer.stream is only supported by daemon container and script templates")
+	}
+
+	wf = unmarshalWf(streamWorkflow)
+	wf.Spec.Templates[1].Stream.Port = 0
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.consumer.stream.port must be between 1 and 65535")
+	}
+
+	wf = unmarshalWf(streamWorkflow)
+	wf.Spec.Templates[1].Stream = nil
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "failed to resolve {{steps.consumer.stream}}")
+
+	}
+}
+
+var memoizedSteps = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoized-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: whalesay
+        template: whalesay
+        arguments:
+          parameters:
+          - name: message
+            value: hello
+  - name: whalesay
+    inputs:
+      parameters:
+      - name: message
+    memoize:
+      key: "{{inputs.parameters.message}}"
+      cache:
+        configMap:
+          name: whalesay-cache
+    container:
+      image: docker/whalesay:latest
+      args: ["{{inputs.parameters.message}}"]
+`
+
+// TestInvalidMemoize verifies memoization is validated
+func TestInvalidMemoize(t *testing.T) {
+	err := validate(memoizedSteps)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(memoizedSteps)
+	wf.Spec.Templates[1].Memoize.Cache.ConfigMap.Name = ""
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.whalesay.memoize cache.configMap.name is required")
+	}
+
+	wf = unmarshalWf(memoizedSteps)
+	wf.Spec.Templates[0].Memoize = wf.Spec.Templates[1].Memoize
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.main.memoize is only supported by container and script templates")
+	}
+
+	wf = unmarshalWf(memoizedSteps)
+	wf.Spec.Templates[1].Memoize.MaxAge = "1d"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.whalesay.memoize maxAge '1d' must be a positive duration")
+	}
+}
+
+var builtinTemplates = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: builtin-templates-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: wait-for-service
+        templateRef:
+          name: argo:builtin
+          template: poll-http-until
+        arguments:
+          parameters:
+          - name: url
+            value: http://my-service/healthz
+    - - name: sleep
+        templateRef:
+          name: argo:builtin
+          template: sleep
+        arguments:
+          parameters:
+          - name: duration
+            value: "30"
+    - - name: approve
+        templateRef:
+          name: argo:builtin
+          template: approve
+`
+
+// TestBuiltinTemplates verifies the built-in templates can be referred to without defining them
+func TestBuiltinTemplates(t *testing.T) {
+	err := validate(builtinTemplates)
+	assert.NoError(t, err)
+}
+
+var synchronizedWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: synchronized-
+spec:
+  entrypoint: whalesay
+  synchronization:
+    semaphore:
+      configMapKeyRef:
+        name: my-config
+        key: workflow
+  templates:
+  - name: whalesay
+    synchronization:
+      mutex:
+        name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestInvalidSynchronization verifies synchronization is validated
+func TestInvalidSynchronization(t *testing.T) {
+	err := validate(synchronizedWorkflow)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(synchronizedWorkflow)
+	wf.Spec.Synchronization.Semaphore.ConfigMapKeyRef.Key = ""
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "spec.synchronization semaphore.configMapKeyRef.name and semaphore.configMapKeyRef.key are required")
+	}
+
+	wf = unmarshalWf(synchronizedWorkflow)
+	wf.Spec.Templates[0].Synchronization.Semaphore = wf.Spec.Synchronization.Semaphore
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.whalesay.synchronization must have exactly one of semaphore or mutex")
+	}
+
+	wf = unmarshalWf(synchronizedWorkflow)
+	wf.Spec.Templates[0].Synchronization.Mutex.Name = "my/mutex"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "templates.whalesay.synchronization mutex.name 'my/mutex' is invalid")
+	}
+}
+
+var failFastSteps = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: fail-fast-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    failFast: true
+    steps:
+    - - name: a
+        template: whalesay
+      - name: b
+        template: whalesay
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestFailFast verifies failFast is only used by steps templates
+func TestFailFast(t *testing.T) {
+	wf := unmarshalWf(failFastSteps)
+	err := ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	wf.Spec.Templates[1].FailFast = true
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.failFast is only valid for steps templates")
+
+	wf = unmarshalWf(failFastSteps)
+	wf.Spec.Templates[0].Steps = nil
+	wf.Spec.Templates[0].DAG = &wfv1.DAGTemplate{
+		Tasks: []wfv1.DAGTask{{Name: "a", Template: "whalesay"}},
+	}
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.failFast is only valid for steps templates, DAG templates set dag.failFast")
+}
+
+var httpSteps = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: host
+      value: example.com
+  templates:
+  - name: main
+    steps:
+    - - name: request
+        template: request
+    - - name: print
+        template: whalesay
+        arguments:
+          parameters:
+          - name: message
+            value: "{{steps.request.outputs.result}}"
+  - name: request
+    http:
+      url: "https://{{workflow.parameters.host}}/api"
+      successCondition: "[response.statusCode] == 200"
+  - name: whalesay
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: docker/whalesay:latest
+      args: ["{{inputs.parameters.message}}"]
+`
+
+// TestHTTPTemplate verifies the request of HTTP templates and that their result is in scope
+func TestHTTPTemplate(t *testing.T) {
+	wf := unmarshalWf(httpSteps)
+	err := ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	wf = unmarshalWf(httpSteps)
+	wf.Spec.Templates[1].HTTP.URL = "example.com/api"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.request.http.url must be an absolute http or https URL")
+
+	wf = unmarshalWf(httpSteps)
+	wf.Spec.Templates[1].HTTP.Method = "FETCH"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.request.http.method must be one of: GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS")
+
+	wf = unmarshalWf(httpSteps)
+	wf.Spec.Templates[1].HTTP.SuccessCondition = "[response.statusCode] =="
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.request.http.successCondition is invalid")
+	}
+
+	wf = unmarshalWf(httpSteps)
+	wf.Spec.Templates[1].HTTP.Headers = []wfv1.HTTPHeader{{Name: "Authorization", ValueFrom: &wfv1.HTTPHeaderSource{}}}
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.request.http.headers[0].valueFrom.secretKeyRef requires a name and a key")
+
+	wf = unmarshalWf(httpSteps)
+	wf.Spec.Templates[1].Cluster = "compute"
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.request.cluster is not supported by http templates")
+
+	wf = unmarshalWf(httpSteps)
+	wf.Spec.Templates[1].Suspend = &wfv1.SuspendTemplate{}
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "multiple template types specified")
+	}
+}
+
+var clusterTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: cluster-
+spec:
+  entrypoint: heavy
+  templates:
+  - name: heavy
+    cluster: compute
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestTemplateCluster verifies the cluster of templates is among the clusters given by the controller
+func TestTemplateCluster(t *testing.T) {
+	wf := unmarshalWf(clusterTemplate)
+	err := ValidateWorkflow(wftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{Clusters: []string{"compute"}})
+	assert.NoError(t, err)
+	err = ValidateWorkflow(wftmplGetter, wf, ValidateOpts{Clusters: []string{}})
+	assert.EqualError(t, err, "templates.heavy.cluster 'compute' is not configured for the namespace of the workflow")
+}